@@ -0,0 +1,70 @@
+package atc
+
+import (
+	"encoding/json"
+)
+
+// AcrossVarConfig is a var and the list of values it takes on when running an
+// 'across' step.
+type AcrossVarConfig struct {
+	Var    string        `yaml:"var" json:"var" mapstructure:"var"`
+	Values []interface{} `yaml:"values" json:"values" mapstructure:"values"`
+}
+
+// AcrossCombinations returns every combination of the given vars' values. The
+// values in each combination are in the same order as the vars.
+func AcrossCombinations(vars []AcrossVarConfig) [][]interface{} {
+	combinations := [][]interface{}{{}}
+
+	for _, v := range vars {
+		var expanded [][]interface{}
+
+		for _, combination := range combinations {
+			for _, value := range v.Values {
+				next := make([]interface{}, len(combination), len(combination)+1)
+				copy(next, combination)
+
+				expanded = append(expanded, append(next, value))
+			}
+		}
+
+		combinations = expanded
+	}
+
+	return combinations
+}
+
+// InterpolateLocalVars returns a copy of the plan config with each reference
+// to one of the given vars, e.g. ((.:go_version)), replaced with its value.
+//
+// A reference making up an entire string is replaced with the value as-is,
+// otherwise the value is formatted into the string. References to any other
+// vars are left alone.
+func (config PlanConfig) InterpolateLocalVars(values map[string]interface{}) (PlanConfig, error) {
+	payload, err := json.Marshal(config)
+	if err != nil {
+		return PlanConfig{}, err
+	}
+
+	var raw interface{}
+	err = json.Unmarshal(payload, &raw)
+	if err != nil {
+		return PlanConfig{}, err
+	}
+
+	payload, err = json.Marshal(InterpolateLocalVarRefs(raw, func(name string) (interface{}, bool) {
+		value, found := values[name]
+		return value, found
+	}))
+	if err != nil {
+		return PlanConfig{}, err
+	}
+
+	var interpolated PlanConfig
+	err = json.Unmarshal(payload, &interpolated)
+	if err != nil {
+		return PlanConfig{}, err
+	}
+
+	return interpolated, nil
+}
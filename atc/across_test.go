@@ -0,0 +1,77 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Across", func() {
+	Describe("AcrossCombinations", func() {
+		It("returns every combination of the vars' values", func() {
+			Expect(atc.AcrossCombinations([]atc.AcrossVarConfig{
+				{Var: "a", Values: []interface{}{"a1", "a2"}},
+				{Var: "b", Values: []interface{}{"b1", "b2", "b3"}},
+			})).To(Equal([][]interface{}{
+				{"a1", "b1"},
+				{"a1", "b2"},
+				{"a1", "b3"},
+				{"a2", "b1"},
+				{"a2", "b2"},
+				{"a2", "b3"},
+			}))
+		})
+
+		It("returns nothing when a var has no values", func() {
+			Expect(atc.AcrossCombinations([]atc.AcrossVarConfig{
+				{Var: "a", Values: []interface{}{"a1", "a2"}},
+				{Var: "b"},
+			})).To(BeEmpty())
+		})
+	})
+
+	Describe("PlanConfig.InterpolateLocalVars", func() {
+		var (
+			config       atc.PlanConfig
+			interpolated atc.PlanConfig
+			err          error
+		)
+
+		BeforeEach(func() {
+			config = atc.PlanConfig{
+				Task: "test-((.:version))",
+				Params: atc.Params{
+					"VERSION": "((.:version))",
+					"FLAGS":   "((.:flags))",
+					"SECRET":  "((some-secret))",
+					"UNKNOWN": "((.:unknown))",
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			interpolated, err = config.InterpolateLocalVars(map[string]interface{}{
+				"version": "1.12",
+				"flags":   []interface{}{"-race", "-v"},
+			})
+		})
+
+		It("succeeds", func() {
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("formats values into strings", func() {
+			Expect(interpolated.Task).To(Equal("test-1.12"))
+		})
+
+		It("replaces whole references with their values", func() {
+			Expect(interpolated.Params).To(Equal(atc.Params{
+				"VERSION": "1.12",
+				"FLAGS":   []interface{}{"-race", "-v"},
+				"SECRET":  "((some-secret))",
+				"UNKNOWN": "((.:unknown))",
+			}))
+		})
+	})
+})
@@ -11,10 +11,12 @@ import (
 type Access interface {
 	IsAuthenticated() bool
 	IsAuthorized(string) bool
+	HasTeamRole(string, string) bool
 	IsAdmin() bool
 	IsSystem() bool
 	TeamNames() []string
 	CSRFToken() string
+	UserName() string
 }
 
 type access struct {
@@ -52,6 +54,17 @@ func (a *access) HasPermission(role string) bool {
 	}
 }
 
+// HasTeamRole returns true if the user has a role on the team with at least
+// the privileges of the given role, regardless of the action being accessed.
+func (a *access) HasTeamRole(team string, role string) bool {
+	for _, teamRole := range a.TeamRoles()[team] {
+		if atc.RoleSatisfies(teamRole, role) {
+			return true
+		}
+	}
+	return false
+}
+
 func (a *access) IsAdmin() bool {
 	if claims, ok := a.Token.Claims.(jwt.MapClaims); ok {
 		if isAdminClaim, ok := claims["is_admin"]; ok {
@@ -115,6 +128,17 @@ func (a *access) CSRFToken() string {
 	return ""
 }
 
+func (a *access) UserName() string {
+	if claims, ok := a.Token.Claims.(jwt.MapClaims); ok {
+		if userNameClaim, ok := claims["user_name"]; ok {
+			if userName, ok := userNameClaim.(string); ok {
+				return userName
+			}
+		}
+	}
+	return ""
+}
+
 var requiredRoles = map[string]string{
 	atc.SaveConfig:                    "member",
 	atc.GetConfig:                     "viewer",
@@ -123,17 +147,24 @@ var requiredRoles = map[string]string{
 	atc.GetBuildPlan:                  "viewer",
 	atc.CreateBuild:                   "member",
 	atc.ListBuilds:                    "viewer",
+	atc.ListBuildQueue:                "viewer",
 	atc.BuildEvents:                   "viewer",
 	atc.BuildResources:                "viewer",
+	atc.ListBuildArtifacts:            "viewer",
+	atc.GetBuildArtifact:              "viewer",
 	atc.AbortBuild:                    "member",
 	atc.GetBuildPreparation:           "viewer",
 	atc.GetJob:                        "viewer",
 	atc.CreateJobBuild:                "member",
+	atc.RerunJobBuild:                 "member",
+	atc.ApproveJobBuild:               "viewer",
+	atc.RejectJobBuild:                "viewer",
 	atc.ListAllJobs:                   "viewer",
 	atc.ListJobs:                      "viewer",
 	atc.ListJobBuilds:                 "viewer",
 	atc.ListJobInputs:                 "viewer",
 	atc.GetJobBuild:                   "viewer",
+	atc.ListJobBuildInputs:            "viewer",
 	atc.PauseJob:                      "member",
 	atc.UnpauseJob:                    "member",
 	atc.GetVersionsDB:                 "viewer",
@@ -150,6 +181,7 @@ var requiredRoles = map[string]string{
 	atc.CheckResource:                 "member",
 	atc.CheckResourceWebHook:          "member",
 	atc.CheckResourceType:             "member",
+	atc.ListResourceCheckHistory:      "viewer",
 	atc.ListResourceVersions:          "viewer",
 	atc.GetResourceVersion:            "viewer",
 	atc.EnableResourceVersion:         "member",
@@ -171,6 +203,7 @@ var requiredRoles = map[string]string{
 	atc.ListPipelineBuilds:            "viewer",
 	atc.CreatePipelineBuild:           "member",
 	atc.PipelineBadge:                 "viewer",
+	atc.ListNotificationDeliveries:    "viewer",
 	atc.RegisterWorker:                "member",
 	atc.LandWorker:                    "member",
 	atc.RetireWorker:                  "member",
@@ -270,6 +270,66 @@ var _ = Describe("Accessor", func() {
 		})
 	})
 
+	Describe("Get User Name", func() {
+		JustBeforeEach(func() {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+			tokenString, err := token.SignedString(key)
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
+			access = accessorFactory.Create(req, "some-action")
+		})
+
+		Context("when request has user_name claim set", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"user_name": "some-user"}
+			})
+			It("returns the user name", func() {
+				Expect(access.UserName()).To(Equal("some-user"))
+			})
+		})
+
+		Context("when request does not have user_name claim set", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{}
+			})
+			It("returns empty", func() {
+				Expect(access.UserName()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("Has Team Role", func() {
+		JustBeforeEach(func() {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+			tokenString, err := token.SignedString(key)
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
+			access = accessorFactory.Create(req, atc.ApproveJobBuild)
+		})
+
+		BeforeEach(func() {
+			claims = &jwt.MapClaims{"teams": map[string][]string{
+				"team-1": {"member"},
+				"team-2": {"viewer"},
+			}}
+		})
+
+		It("returns true for roles with at least the given privileges", func() {
+			Expect(access.HasTeamRole("team-1", "member")).To(BeTrue())
+			Expect(access.HasTeamRole("team-1", "viewer")).To(BeTrue())
+			Expect(access.HasTeamRole("team-2", "viewer")).To(BeTrue())
+		})
+
+		It("returns false for roles with fewer privileges", func() {
+			Expect(access.HasTeamRole("team-1", "owner")).To(BeFalse())
+			Expect(access.HasTeamRole("team-2", "member")).To(BeFalse())
+		})
+
+		It("returns false for other teams", func() {
+			Expect(access.HasTeamRole("team-3", "viewer")).To(BeFalse())
+		})
+	})
+
 	Describe("Get Team Names", func() {
 		JustBeforeEach(func() {
 			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
@@ -367,6 +427,10 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.ListBuilds, atc.ListBuilds, "member", true),
 		Entry("viewer :: "+atc.ListBuilds, atc.ListBuilds, "viewer", true),
 
+		Entry("owner :: "+atc.ListBuildQueue, atc.ListBuildQueue, "owner", true),
+		Entry("member :: "+atc.ListBuildQueue, atc.ListBuildQueue, "member", true),
+		Entry("viewer :: "+atc.ListBuildQueue, atc.ListBuildQueue, "viewer", true),
+
 		Entry("owner :: "+atc.BuildEvents, atc.BuildEvents, "owner", true),
 		Entry("member :: "+atc.BuildEvents, atc.BuildEvents, "member", true),
 		Entry("viewer :: "+atc.BuildEvents, atc.BuildEvents, "viewer", true),
@@ -375,6 +439,14 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.BuildResources, atc.BuildResources, "member", true),
 		Entry("viewer :: "+atc.BuildResources, atc.BuildResources, "viewer", true),
 
+		Entry("owner :: "+atc.ListBuildArtifacts, atc.ListBuildArtifacts, "owner", true),
+		Entry("member :: "+atc.ListBuildArtifacts, atc.ListBuildArtifacts, "member", true),
+		Entry("viewer :: "+atc.ListBuildArtifacts, atc.ListBuildArtifacts, "viewer", true),
+
+		Entry("owner :: "+atc.GetBuildArtifact, atc.GetBuildArtifact, "owner", true),
+		Entry("member :: "+atc.GetBuildArtifact, atc.GetBuildArtifact, "member", true),
+		Entry("viewer :: "+atc.GetBuildArtifact, atc.GetBuildArtifact, "viewer", true),
+
 		Entry("owner :: "+atc.AbortBuild, atc.AbortBuild, "owner", true),
 		Entry("member :: "+atc.AbortBuild, atc.AbortBuild, "member", true),
 		Entry("viewer :: "+atc.AbortBuild, atc.AbortBuild, "viewer", false),
@@ -391,6 +463,18 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.CreateJobBuild, atc.CreateJobBuild, "member", true),
 		Entry("viewer :: "+atc.CreateJobBuild, atc.CreateJobBuild, "viewer", false),
 
+		Entry("owner :: "+atc.RerunJobBuild, atc.RerunJobBuild, "owner", true),
+		Entry("member :: "+atc.RerunJobBuild, atc.RerunJobBuild, "member", true),
+		Entry("viewer :: "+atc.RerunJobBuild, atc.RerunJobBuild, "viewer", false),
+
+		Entry("owner :: "+atc.ApproveJobBuild, atc.ApproveJobBuild, "owner", true),
+		Entry("member :: "+atc.ApproveJobBuild, atc.ApproveJobBuild, "member", true),
+		Entry("viewer :: "+atc.ApproveJobBuild, atc.ApproveJobBuild, "viewer", true),
+
+		Entry("owner :: "+atc.RejectJobBuild, atc.RejectJobBuild, "owner", true),
+		Entry("member :: "+atc.RejectJobBuild, atc.RejectJobBuild, "member", true),
+		Entry("viewer :: "+atc.RejectJobBuild, atc.RejectJobBuild, "viewer", true),
+
 		Entry("owner :: "+atc.ListAllJobs, atc.ListAllJobs, "owner", true),
 		Entry("member :: "+atc.ListAllJobs, atc.ListAllJobs, "member", true),
 		Entry("viewer :: "+atc.ListAllJobs, atc.ListAllJobs, "viewer", true),
@@ -411,6 +495,10 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.GetJobBuild, atc.GetJobBuild, "member", true),
 		Entry("viewer :: "+atc.GetJobBuild, atc.GetJobBuild, "viewer", true),
 
+		Entry("owner :: "+atc.ListJobBuildInputs, atc.ListJobBuildInputs, "owner", true),
+		Entry("member :: "+atc.ListJobBuildInputs, atc.ListJobBuildInputs, "member", true),
+		Entry("viewer :: "+atc.ListJobBuildInputs, atc.ListJobBuildInputs, "viewer", true),
+
 		Entry("owner :: "+atc.PauseJob, atc.PauseJob, "owner", true),
 		Entry("member :: "+atc.PauseJob, atc.PauseJob, "member", true),
 		Entry("viewer :: "+atc.PauseJob, atc.PauseJob, "viewer", false),
@@ -471,6 +559,10 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.CheckResourceType, atc.CheckResourceType, "member", true),
 		Entry("viewer :: "+atc.CheckResourceType, atc.CheckResourceType, "viewer", false),
 
+		Entry("owner :: "+atc.ListResourceCheckHistory, atc.ListResourceCheckHistory, "owner", true),
+		Entry("member :: "+atc.ListResourceCheckHistory, atc.ListResourceCheckHistory, "member", true),
+		Entry("viewer :: "+atc.ListResourceCheckHistory, atc.ListResourceCheckHistory, "viewer", true),
+
 		Entry("owner :: "+atc.ListResourceVersions, atc.ListResourceVersions, "owner", true),
 		Entry("member :: "+atc.ListResourceVersions, atc.ListResourceVersions, "member", true),
 		Entry("viewer :: "+atc.ListResourceVersions, atc.ListResourceVersions, "viewer", true),
@@ -551,6 +643,10 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.PipelineBadge, atc.PipelineBadge, "member", true),
 		Entry("viewer :: "+atc.PipelineBadge, atc.PipelineBadge, "viewer", true),
 
+		Entry("owner :: "+atc.ListNotificationDeliveries, atc.ListNotificationDeliveries, "owner", true),
+		Entry("member :: "+atc.ListNotificationDeliveries, atc.ListNotificationDeliveries, "member", true),
+		Entry("viewer :: "+atc.ListNotificationDeliveries, atc.ListNotificationDeliveries, "viewer", true),
+
 		Entry("owner :: "+atc.RegisterWorker, atc.RegisterWorker, "owner", true),
 		Entry("member :: "+atc.RegisterWorker, atc.RegisterWorker, "member", true),
 		Entry("viewer :: "+atc.RegisterWorker, atc.RegisterWorker, "viewer", false),
@@ -18,6 +18,18 @@ type FakeAccess struct {
 	cSRFTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	HasTeamRoleStub        func(string, string) bool
+	hasTeamRoleMutex       sync.RWMutex
+	hasTeamRoleArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	hasTeamRoleReturns struct {
+		result1 bool
+	}
+	hasTeamRoleReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	IsAdminStub        func() bool
 	isAdminMutex       sync.RWMutex
 	isAdminArgsForCall []struct {
@@ -69,6 +81,16 @@ type FakeAccess struct {
 	teamNamesReturnsOnCall map[int]struct {
 		result1 []string
 	}
+	UserNameStub        func() string
+	userNameMutex       sync.RWMutex
+	userNameArgsForCall []struct {
+	}
+	userNameReturns struct {
+		result1 string
+	}
+	userNameReturnsOnCall map[int]struct {
+		result1 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -125,6 +147,67 @@ func (fake *FakeAccess) CSRFTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeAccess) HasTeamRole(arg1 string, arg2 string) bool {
+	fake.hasTeamRoleMutex.Lock()
+	ret, specificReturn := fake.hasTeamRoleReturnsOnCall[len(fake.hasTeamRoleArgsForCall)]
+	fake.hasTeamRoleArgsForCall = append(fake.hasTeamRoleArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("HasTeamRole", []interface{}{arg1, arg2})
+	fake.hasTeamRoleMutex.Unlock()
+	if fake.HasTeamRoleStub != nil {
+		return fake.HasTeamRoleStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.hasTeamRoleReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccess) HasTeamRoleCallCount() int {
+	fake.hasTeamRoleMutex.RLock()
+	defer fake.hasTeamRoleMutex.RUnlock()
+	return len(fake.hasTeamRoleArgsForCall)
+}
+
+func (fake *FakeAccess) HasTeamRoleCalls(stub func(string, string) bool) {
+	fake.hasTeamRoleMutex.Lock()
+	defer fake.hasTeamRoleMutex.Unlock()
+	fake.HasTeamRoleStub = stub
+}
+
+func (fake *FakeAccess) HasTeamRoleArgsForCall(i int) (string, string) {
+	fake.hasTeamRoleMutex.RLock()
+	defer fake.hasTeamRoleMutex.RUnlock()
+	argsForCall := fake.hasTeamRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAccess) HasTeamRoleReturns(result1 bool) {
+	fake.hasTeamRoleMutex.Lock()
+	defer fake.hasTeamRoleMutex.Unlock()
+	fake.HasTeamRoleStub = nil
+	fake.hasTeamRoleReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAccess) HasTeamRoleReturnsOnCall(i int, result1 bool) {
+	fake.hasTeamRoleMutex.Lock()
+	defer fake.hasTeamRoleMutex.Unlock()
+	fake.HasTeamRoleStub = nil
+	if fake.hasTeamRoleReturnsOnCall == nil {
+		fake.hasTeamRoleReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.hasTeamRoleReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeAccess) IsAdmin() bool {
 	fake.isAdminMutex.Lock()
 	ret, specificReturn := fake.isAdminReturnsOnCall[len(fake.isAdminArgsForCall)]
@@ -393,11 +476,65 @@ func (fake *FakeAccess) TeamNamesReturnsOnCall(i int, result1 []string) {
 	}{result1}
 }
 
+func (fake *FakeAccess) UserName() string {
+	fake.userNameMutex.Lock()
+	ret, specificReturn := fake.userNameReturnsOnCall[len(fake.userNameArgsForCall)]
+	fake.userNameArgsForCall = append(fake.userNameArgsForCall, struct {
+	}{})
+	fake.recordInvocation("UserName", []interface{}{})
+	fake.userNameMutex.Unlock()
+	if fake.UserNameStub != nil {
+		return fake.UserNameStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.userNameReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccess) UserNameCallCount() int {
+	fake.userNameMutex.RLock()
+	defer fake.userNameMutex.RUnlock()
+	return len(fake.userNameArgsForCall)
+}
+
+func (fake *FakeAccess) UserNameCalls(stub func() string) {
+	fake.userNameMutex.Lock()
+	defer fake.userNameMutex.Unlock()
+	fake.UserNameStub = stub
+}
+
+func (fake *FakeAccess) UserNameReturns(result1 string) {
+	fake.userNameMutex.Lock()
+	defer fake.userNameMutex.Unlock()
+	fake.UserNameStub = nil
+	fake.userNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeAccess) UserNameReturnsOnCall(i int, result1 string) {
+	fake.userNameMutex.Lock()
+	defer fake.userNameMutex.Unlock()
+	fake.UserNameStub = nil
+	if fake.userNameReturnsOnCall == nil {
+		fake.userNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.userNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeAccess) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.cSRFTokenMutex.RLock()
 	defer fake.cSRFTokenMutex.RUnlock()
+	fake.hasTeamRoleMutex.RLock()
+	defer fake.hasTeamRoleMutex.RUnlock()
 	fake.isAdminMutex.RLock()
 	defer fake.isAdminMutex.RUnlock()
 	fake.isAuthenticatedMutex.RLock()
@@ -408,6 +545,8 @@ func (fake *FakeAccess) Invocations() map[string][][]interface{} {
 	defer fake.isSystemMutex.RUnlock()
 	fake.teamNamesMutex.RLock()
 	defer fake.teamNamesMutex.RUnlock()
+	fake.userNameMutex.RLock()
+	defer fake.userNameMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -27,6 +27,7 @@ import (
 	"github.com/concourse/concourse/atc/api/jobserver/jobserverfakes"
 	"github.com/concourse/concourse/atc/api/resourceserver/resourceserverfakes"
 	"github.com/concourse/concourse/atc/engine/enginefakes"
+	"github.com/concourse/concourse/atc/scheduler/buildqueue/buildqueuefakes"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 	"github.com/concourse/concourse/atc/wrappa"
 )
@@ -47,6 +48,8 @@ var (
 	dbJobFactory            *dbfakes.FakeJobFactory
 	dbResourceFactory       *dbfakes.FakeResourceFactory
 	dbResourceConfigFactory *dbfakes.FakeResourceConfigFactory
+	dbCheckFactory          *dbfakes.FakeCheckFactory
+	fakeBuildQueue          *buildqueuefakes.FakeQueue
 	fakePipeline            *dbfakes.FakePipeline
 	fakeAccessor            *accessorfakes.FakeAccessFactory
 	dbWorkerFactory         *dbfakes.FakeWorkerFactory
@@ -99,6 +102,8 @@ var _ = BeforeEach(func() {
 	dbJobFactory = new(dbfakes.FakeJobFactory)
 	dbResourceFactory = new(dbfakes.FakeResourceFactory)
 	dbResourceConfigFactory = new(dbfakes.FakeResourceConfigFactory)
+	dbCheckFactory = new(dbfakes.FakeCheckFactory)
+	fakeBuildQueue = new(buildqueuefakes.FakeQueue)
 	dbBuildFactory = new(dbfakes.FakeBuildFactory)
 
 	interceptTimeoutFactory = new(containerserverfakes.FakeInterceptTimeoutFactory)
@@ -181,6 +186,8 @@ var _ = BeforeEach(func() {
 		fakeDestroyer,
 		dbBuildFactory,
 		dbResourceConfigFactory,
+		dbCheckFactory,
+		fakeBuildQueue,
 
 		peerURL,
 		constructedEventHandler.Construct,
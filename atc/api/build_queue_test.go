@@ -0,0 +1,128 @@
+package api_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Build Queue API", func() {
+	var (
+		fakeaccess *accessorfakes.FakeAccess
+	)
+
+	BeforeEach(func() {
+		fakeaccess = new(accessorfakes.FakeAccess)
+	})
+
+	JustBeforeEach(func() {
+		fakeAccessor.CreateReturns(fakeaccess)
+	})
+
+	Describe("GET /api/v1/build-queue", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/build-queue", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+
+			It("does not look at the queue", func() {
+				Expect(fakeBuildQueue.SnapshotCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+				fakeaccess.TeamNamesReturns([]string{"some-team"})
+
+				fakeBuildQueue.SnapshotReturns(atc.BuildQueue{
+					Teams: []atc.BuildQueueTeam{
+						{Name: "other-team", Active: 3, Queued: 1, Weight: 1},
+						{Name: "some-team", Active: 1, Queued: 1, Weight: 2, MaxInFlight: 4},
+					},
+					Builds: []atc.BuildQueueEntry{
+						{Position: 1, BuildID: 1, BuildName: "1", TeamName: "some-team", PipelineName: "some-pipeline", JobName: "some-job", Priority: 10, QueuedAt: 1552924800},
+						{Position: 2, BuildID: 2, BuildName: "7", TeamName: "other-team", PipelineName: "other-pipeline", JobName: "other-job", QueuedAt: 1552924700},
+					},
+				}, nil)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("returns Content-Type 'application/json'", func() {
+				Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+			})
+
+			It("returns only the queue of the teams the user belongs to", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`{
+					"teams": [
+						{"name": "some-team", "active": 1, "queued": 1, "weight": 2, "max_in_flight": 4}
+					],
+					"builds": [
+						{
+							"position": 1,
+							"build_id": 1,
+							"build_name": "1",
+							"team_name": "some-team",
+							"pipeline_name": "some-pipeline",
+							"job_name": "some-job",
+							"priority": 10,
+							"queued_at": 1552924800
+						}
+					]
+				}`))
+			})
+
+			Context("when the user is an admin", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAdminReturns(true)
+				})
+
+				It("returns the whole queue", func() {
+					var queue atc.BuildQueue
+					err := json.NewDecoder(response.Body).Decode(&queue)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(queue.Teams).To(HaveLen(2))
+					Expect(queue.Builds).To(HaveLen(2))
+				})
+			})
+
+			Context("when getting the queue fails", func() {
+				BeforeEach(func() {
+					fakeBuildQueue.SnapshotReturns(atc.BuildQueue{}, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,52 @@
+package buildqueueserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+)
+
+func (s *Server) ListBuildQueue(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-build-queue")
+
+	snapshot, err := s.buildQueue.Snapshot()
+	if err != nil {
+		logger.Error("failed-to-get-build-queue", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	acc := accessor.GetAccessor(r)
+	if !acc.IsAdmin() {
+		visible := map[string]bool{}
+		for _, teamName := range acc.TeamNames() {
+			visible[teamName] = true
+		}
+
+		teams := []atc.BuildQueueTeam{}
+		for _, team := range snapshot.Teams {
+			if visible[team.Name] {
+				teams = append(teams, team)
+			}
+		}
+
+		builds := []atc.BuildQueueEntry{}
+		for _, build := range snapshot.Builds {
+			if visible[build.TeamName] {
+				builds = append(builds, build)
+			}
+		}
+
+		snapshot.Teams = teams
+		snapshot.Builds = builds
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(snapshot)
+	if err != nil {
+		logger.Error("failed-to-encode-build-queue", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
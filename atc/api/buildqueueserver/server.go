@@ -0,0 +1,22 @@
+package buildqueueserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/scheduler/buildqueue"
+)
+
+type Server struct {
+	logger lager.Logger
+
+	buildQueue buildqueue.Queue
+}
+
+func NewServer(
+	logger lager.Logger,
+	buildQueue buildqueue.Queue,
+) *Server {
+	return &Server{
+		logger:     logger,
+		buildQueue: buildQueue,
+	}
+}
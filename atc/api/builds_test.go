@@ -8,6 +8,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -990,6 +991,189 @@ var _ = Describe("Builds API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/builds/:build_id/artifacts", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/builds/128/artifacts")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the build can be found", func() {
+			BeforeEach(func() {
+				build.IDReturns(128)
+				build.JobNameReturns("some-job")
+				build.TeamNameReturns("some-team")
+				build.PipelineReturns(fakePipeline, true, nil)
+				dbBuildFactory.BuildReturns(build, true, nil)
+			})
+
+			Context("when authenticated, but not authorized", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthenticatedReturns(true)
+					fakeaccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when not authenticated and the job is private", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthenticatedReturns(false)
+					fakePipeline.PublicReturns(true)
+
+					fakeJob := new(dbfakes.FakeJob)
+					fakeJob.ConfigReturns(atc.JobConfig{Name: "some-job", Public: false})
+					fakePipeline.JobReturns(fakeJob, true, nil)
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+			})
+
+			Context("when authorized", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthenticatedReturns(true)
+					fakeaccess.IsAuthorizedReturns(true)
+				})
+
+				Context("when getting the artifacts succeeds", func() {
+					BeforeEach(func() {
+						build.ArtifactsReturns([]db.BuildArtifact{
+							{BuildID: 128, Name: "some-output", CreatedAt: time.Unix(1, 0)},
+							{BuildID: 128, Name: "other-output", CreatedAt: time.Unix(2, 0)},
+						}, nil)
+					})
+
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					It("returns Content-Type 'application/json'", func() {
+						Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+					})
+
+					It("returns the build's artifacts", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`[
+							{"build_id": 128, "name": "some-output", "created_at": 1},
+							{"build_id": 128, "name": "other-output", "created_at": 2}
+						]`))
+					})
+				})
+
+				Context("when getting the artifacts fails", func() {
+					BeforeEach(func() {
+						build.ArtifactsReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+
+		Context("when the build can not be found", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				dbBuildFactory.BuildReturns(nil, false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/builds/:build_id/artifacts/:artifact_name", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/builds/128/artifacts/some-output")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the build can be found", func() {
+			BeforeEach(func() {
+				build.IDReturns(128)
+				build.JobNameReturns("some-job")
+				build.TeamNameReturns("some-team")
+				build.PipelineReturns(fakePipeline, true, nil)
+				dbBuildFactory.BuildReturns(build, true, nil)
+			})
+
+			Context("when authenticated, but not authorized", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthenticatedReturns(true)
+					fakeaccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when authorized", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthenticatedReturns(true)
+					fakeaccess.IsAuthorizedReturns(true)
+				})
+
+				Context("when the artifact is found", func() {
+					BeforeEach(func() {
+						build.ArtifactReturns(ioutil.NopCloser(strings.NewReader("some-tarball")), true, nil)
+					})
+
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					It("returns Content-Type 'application/gzip'", func() {
+						Expect(response.Header.Get("Content-Type")).To(Equal("application/gzip"))
+					})
+
+					It("streams the artifact", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(body)).To(Equal("some-tarball"))
+
+						Expect(build.ArtifactArgsForCall(0)).To(Equal("some-output"))
+					})
+				})
+
+				Context("when the artifact is not found", func() {
+					BeforeEach(func() {
+						build.ArtifactReturns(nil, false, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when getting the artifact fails", func() {
+					BeforeEach(func() {
+						build.ArtifactReturns(nil, false, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/builds/:build_id/abort", func() {
 		var (
 			response *http.Response
@@ -0,0 +1,72 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListBuildArtifacts(build db.Build) http.Handler {
+	logger := s.logger.Session("list-build-artifacts")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		artifacts, err := build.Artifacts()
+		if err != nil {
+			logger.Error("failed-to-get-build-artifacts", err, lager.Data{"build": build.ID()})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		presentedArtifacts := make([]atc.BuildArtifact, len(artifacts))
+		for i, artifact := range artifacts {
+			presentedArtifacts[i] = present.BuildArtifact(artifact)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(presentedArtifacts)
+		if err != nil {
+			logger.Error("failed-to-encode-build-artifacts", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func (s *Server) GetBuildArtifact(build db.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := r.FormValue(":artifact_name")
+
+		logger := s.logger.Session("get-build-artifact", lager.Data{
+			"build":    build.ID(),
+			"artifact": name,
+		})
+
+		artifact, found, err := build.Artifact(name)
+		if err != nil {
+			logger.Error("failed-to-get-build-artifact", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		defer artifact.Close()
+
+		w.Header().Set("Content-Type", "application/gzip")
+		w.WriteHeader(http.StatusOK)
+
+		_, err = io.Copy(w, artifact)
+		if err != nil {
+			logger.Error("failed-to-stream-build-artifact", err)
+		}
+	})
+}
@@ -261,6 +261,16 @@ var _ = Describe("Config API", func() {
 									fakeResourceType.TagsReturns(atc.Tags{"some-tag"})
 
 									fakePipeline.ResourceTypesReturns(db.ResourceTypes{fakeResourceType}, nil)
+
+									pipelineConfig.Notifications = atc.NotificationConfigs{
+										{
+											Name:   "chat",
+											URL:    "https://chat.example.com/hook",
+											Events: []atc.NotificationEvent{atc.NotificationEventFailed},
+										},
+									}
+
+									fakePipeline.NotificationsReturns(pipelineConfig.Notifications, nil)
 								})
 
 								It("returns 200", func() {
@@ -288,6 +298,98 @@ var _ = Describe("Config API", func() {
 										RawConfig: atc.RawConfig(rawConfig),
 									}))
 								})
+
+								Context("when the pipeline has var sources", func() {
+									BeforeEach(func() {
+										fakePipeline.VarSourcesReturns(atc.VarSourceConfigs{
+											{
+												Name:   "some-source",
+												Type:   "vault",
+												Config: map[string]interface{}{"url": "https://vault.example.com", "client_token": "some-token"},
+											},
+										}, nil)
+									})
+
+									decodeVarSources := func() atc.VarSourceConfigs {
+										var actualConfigResponse atc.ConfigResponse
+										err := json.NewDecoder(response.Body).Decode(&actualConfigResponse)
+										Expect(err).NotTo(HaveOccurred())
+
+										return actualConfigResponse.Config.VarSources
+									}
+
+									Context("when the user is a member of the team", func() {
+										BeforeEach(func() {
+											fakeaccess.HasTeamRoleReturns(true)
+										})
+
+										It("checks for the member role on the pipeline's team", func() {
+											Expect(fakeaccess.HasTeamRoleCallCount()).To(Equal(1))
+											team, role := fakeaccess.HasTeamRoleArgsForCall(0)
+											Expect(team).To(Equal("a-team"))
+											Expect(role).To(Equal("member"))
+										})
+
+										It("returns the var sources' config", func() {
+											Expect(decodeVarSources()).To(Equal(atc.VarSourceConfigs{
+												{
+													Name:   "some-source",
+													Type:   "vault",
+													Config: map[string]interface{}{"url": "https://vault.example.com", "client_token": "some-token"},
+												},
+											}))
+										})
+									})
+
+									Context("when the user is an admin", func() {
+										BeforeEach(func() {
+											fakeaccess.IsAdminReturns(true)
+										})
+
+										It("returns the var sources' config", func() {
+											Expect(decodeVarSources()[0].Config).To(HaveKeyWithValue("client_token", "some-token"))
+										})
+									})
+
+									Context("when the user is only a viewer", func() {
+										BeforeEach(func() {
+											fakeaccess.HasTeamRoleReturns(false)
+										})
+
+										It("redacts the var sources' config", func() {
+											Expect(decodeVarSources()).To(Equal(atc.VarSourceConfigs{
+												{
+													Name: "some-source",
+													Type: "vault",
+													Config: map[string]interface{}{
+														"url":          "((redacted))",
+														"client_token": "((redacted))",
+													},
+												},
+											}))
+										})
+									})
+								})
+
+								Context("when finding the var sources fails", func() {
+									BeforeEach(func() {
+										fakePipeline.VarSourcesReturns(nil, errors.New("failed"))
+									})
+
+									It("returns 500", func() {
+										Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+									})
+								})
+
+								Context("when finding the notifications fails", func() {
+									BeforeEach(func() {
+										fakePipeline.NotificationsReturns(nil, errors.New("failed"))
+									})
+
+									It("returns 500", func() {
+										Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+									})
+								})
 							})
 
 							Context("when finding the resource types fails", func() {
@@ -488,7 +590,7 @@ var _ = Describe("Config API", func() {
 						It("saves it", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-							name, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
+							name, savedConfig, id, pipelineState, _ := dbTeam.SavePipelineArgsForCall(0)
 							Expect(name).To(Equal("a-pipeline"))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
@@ -572,7 +674,7 @@ var _ = Describe("Config API", func() {
 						It("saves it", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-							name, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
+							name, savedConfig, id, pipelineState, _ := dbTeam.SavePipelineArgsForCall(0)
 							Expect(name).To(Equal("a-pipeline"))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
@@ -582,7 +684,7 @@ var _ = Describe("Config API", func() {
 						It("does not give the DB a map of empty interfaces to empty interfaces", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-							_, savedConfig, _, _ := dbTeam.SavePipelineArgsForCall(0)
+							_, savedConfig, _, _, _ := dbTeam.SavePipelineArgsForCall(0)
 							Expect(savedConfig).To(Equal(pipelineConfig))
 
 							_, err := json.Marshal(pipelineConfig)
@@ -626,7 +728,7 @@ jobs:
 							It("saves it", func() {
 								Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-								name, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
+								name, savedConfig, id, pipelineState, _ := dbTeam.SavePipelineArgsForCall(0)
 								Expect(name).To(Equal("a-pipeline"))
 								Expect(savedConfig).To(Equal(atc.Config{
 									Resources: []atc.ResourceConfig{
@@ -749,7 +851,7 @@ jobs:
 									It("passes validation and saves it un-interpolated", func() {
 										Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-										name, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
+										name, savedConfig, id, pipelineState, _ := dbTeam.SavePipelineArgsForCall(0)
 										Expect(name).To(Equal("a-pipeline"))
 										Expect(savedConfig).To(Equal(payloadAsConfig))
 
@@ -899,7 +1001,7 @@ jobs:
 							It("saves it", func() {
 								Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-								name, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
+								name, savedConfig, id, pipelineState, _ := dbTeam.SavePipelineArgsForCall(0)
 								Expect(name).To(Equal("a-pipeline"))
 								Expect(savedConfig).To(Equal(pipelineConfig))
 								Expect(id).To(Equal(db.ConfigVersion(42)))
@@ -1193,7 +1295,7 @@ jobs:
 					It("saves it", func() {
 						Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-						name, savedConfig, id, _ := dbTeam.SavePipelineArgsForCall(0)
+						name, savedConfig, id, _, _ := dbTeam.SavePipelineArgsForCall(0)
 						Expect(name).To(Equal("a-pipeline"))
 						Expect(savedConfig).To(Equal(atc.Config{
 							Jobs: atc.JobConfigs{
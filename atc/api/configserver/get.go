@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/lager"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/tedsuo/rata"
 )
 
@@ -63,11 +64,34 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	notifications, err := pipeline.Notifications()
+	if err != nil {
+		logger.Error("failed-to-get-notifications", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	varSources, err := pipeline.VarSources()
+	if err != nil {
+		logger.Error("failed-to-get-var-sources", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	// var sources hold the credentials of credential managers, so viewers
+	// only get to see which keys are configured
+	acc := accessor.GetAccessor(r)
+	if !acc.IsAdmin() && !acc.HasTeamRole(teamName, "member") {
+		varSources = redactVarSources(varSources)
+	}
+
 	config := atc.Config{
 		Groups:        pipeline.Groups(),
 		Resources:     resources.Configs(),
 		ResourceTypes: resourceTypes.Configs(),
 		Jobs:          jobs.Configs(),
+		VarSources:    varSources,
+		Notifications: notifications,
 	}
 
 	rawConfig, err := json.Marshal(config)
@@ -89,3 +113,26 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+func redactVarSources(varSources atc.VarSourceConfigs) atc.VarSourceConfigs {
+	if len(varSources) == 0 {
+		return varSources
+	}
+
+	redacted := make(atc.VarSourceConfigs, len(varSources))
+	for i, varSource := range varSources {
+		redacted[i] = atc.VarSourceConfig{
+			Name: varSource.Name,
+			Type: varSource.Type,
+		}
+
+		if varSource.Config != nil {
+			redacted[i].Config = map[string]interface{}{}
+			for key := range varSource.Config {
+				redacted[i].Config[key] = "((redacted))"
+			}
+		}
+	}
+
+	return redacted
+}
@@ -11,7 +11,6 @@ import (
 	"mime"
 	"mime/multipart"
 	"net/http"
-	"strings"
 
 	boshtemplate "github.com/cloudfoundry/bosh-cli/director/template"
 
@@ -20,7 +19,6 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/hashicorp/go-multierror"
-	"github.com/mitchellh/mapstructure"
 	"github.com/tedsuo/rata"
 	"gopkg.in/yaml.v2"
 )
@@ -146,7 +144,7 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, created, err := team.SavePipeline(pipelineName, config, version, pausedState)
+	_, created, err := team.SavePipeline(pipelineName, config, version, pausedState, 0)
 	if err != nil {
 		session.Error("failed-to-save-config", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -333,38 +331,13 @@ func saveConfigRequestUnmarshaler(r *http.Request) (atc.Config, db.PipelinePause
 		return atc.Config{}, db.PipelineNoChange, err
 	}
 
-	var config atc.Config
-	var md mapstructure.Metadata
-	msConfig := &mapstructure.DecoderConfig{
-		Metadata:         &md,
-		Result:           &config,
-		WeaklyTypedInput: true,
-		DecodeHook: mapstructure.ComposeDecodeHookFunc(
-			atc.SanitizeDecodeHook,
-			atc.VersionConfigDecodeHook,
-			atc.InputsConfigDecodeHook,
-			atc.ContainerLimitsDecodeHook,
-		),
-	}
-
-	decoder, err := mapstructure.NewDecoder(msConfig)
+	config, err := atc.DecodeConfig(configStructure)
 	if err != nil {
-		return atc.Config{}, db.PipelineNoChange, ErrFailedToConstructDecoder
-	}
-
-	if err := decoder.Decode(configStructure); err != nil {
-		return atc.Config{}, db.PipelineNoChange, ErrCouldNotDecode
-	}
-
-	nestedUnused := []string{}
-	for _, unused := range md.Unused {
-		if strings.Contains(unused, ".") {
-			nestedUnused = append(nestedUnused, unused)
+		if uke, ok := err.(atc.UnknownKeysError); ok {
+			return atc.Config{}, db.PipelineNoChange, ExtraKeysError{extraKeys: uke.Keys}
 		}
-	}
 
-	if len(nestedUnused) != 0 {
-		return atc.Config{}, db.PipelineNoChange, ExtraKeysError{extraKeys: nestedUnused}
+		return atc.Config{}, db.PipelineNoChange, ErrCouldNotDecode
 	}
 
 	return config, pausedState, nil
@@ -8,6 +8,7 @@ import (
 	"github.com/tedsuo/rata"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/buildqueueserver"
 	"github.com/concourse/concourse/atc/api/buildserver"
 	"github.com/concourse/concourse/atc/api/ccserver"
 	"github.com/concourse/concourse/atc/api/cliserver"
@@ -27,6 +28,7 @@ import (
 	"github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/gc"
 	"github.com/concourse/concourse/atc/mainredirect"
+	"github.com/concourse/concourse/atc/scheduler/buildqueue"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/wrappa"
 )
@@ -48,6 +50,8 @@ func NewHandler(
 	destroyer gc.Destroyer,
 	dbBuildFactory db.BuildFactory,
 	dbResourceConfigFactory db.ResourceConfigFactory,
+	dbCheckFactory db.CheckFactory,
+	buildQueue buildqueue.Queue,
 
 	peerURL string,
 	eventHandlerFactory buildserver.EventHandlerFactory,
@@ -83,7 +87,7 @@ func NewHandler(
 
 	buildServer := buildserver.NewServer(logger, externalURL, peerURL, engine, workerClient, dbTeamFactory, dbBuildFactory, eventHandlerFactory, drain)
 	jobServer := jobserver.NewServer(logger, schedulerFactory, externalURL, variablesFactory, dbJobFactory)
-	resourceServer := resourceserver.NewServer(logger, scannerFactory, variablesFactory, dbResourceFactory, dbResourceConfigFactory)
+	resourceServer := resourceserver.NewServer(logger, scannerFactory, variablesFactory, dbResourceFactory, dbResourceConfigFactory, dbCheckFactory)
 	versionServer := versionserver.NewServer(logger, externalURL)
 	pipelineServer := pipelineserver.NewServer(logger, dbTeamFactory, dbPipelineFactory, externalURL, engine)
 	configServer := configserver.NewServer(logger, dbTeamFactory, variablesFactory)
@@ -95,6 +99,7 @@ func NewHandler(
 	volumesServer := volumeserver.NewServer(logger, volumeRepository, destroyer)
 	teamServer := teamserver.NewServer(logger, dbTeamFactory, externalURL)
 	infoServer := infoserver.NewServer(logger, version, workerVersion, credsManagers)
+	buildQueueServer := buildqueueserver.NewServer(logger, buildQueue)
 
 	handlers := map[string]http.Handler{
 		atc.GetConfig:  http.HandlerFunc(configServer.GetConfig),
@@ -106,6 +111,8 @@ func NewHandler(
 		atc.CreateBuild:             teamHandlerFactory.HandlerFor(buildServer.CreateBuild),
 		atc.GetBuild:                buildHandlerFactory.HandlerFor(buildServer.GetBuild),
 		atc.BuildResources:          buildHandlerFactory.HandlerFor(buildServer.BuildResources),
+		atc.ListBuildArtifacts:      buildHandlerFactory.HandlerFor(buildServer.ListBuildArtifacts),
+		atc.GetBuildArtifact:        buildHandlerFactory.HandlerFor(buildServer.GetBuildArtifact),
 		atc.AbortBuild:              buildHandlerFactory.HandlerFor(buildServer.AbortBuild),
 		atc.GetBuildPlan:            buildHandlerFactory.HandlerFor(buildServer.GetBuildPlan),
 		atc.GetBuildPreparation:     buildHandlerFactory.HandlerFor(buildServer.GetBuildPreparation),
@@ -113,16 +120,22 @@ func NewHandler(
 		atc.SendInputToBuildPlan:    buildHandlerFactory.HandlerFor(buildServer.SendInputToBuildPlan),
 		atc.ReadOutputFromBuildPlan: buildHandlerFactory.HandlerFor(buildServer.ReadOutputFromBuildPlan),
 
-		atc.ListAllJobs:    http.HandlerFunc(jobServer.ListAllJobs),
-		atc.ListJobs:       pipelineHandlerFactory.HandlerFor(jobServer.ListJobs),
-		atc.GetJob:         pipelineHandlerFactory.HandlerFor(jobServer.GetJob),
-		atc.ListJobBuilds:  pipelineHandlerFactory.HandlerFor(jobServer.ListJobBuilds),
-		atc.ListJobInputs:  pipelineHandlerFactory.HandlerFor(jobServer.ListJobInputs),
-		atc.GetJobBuild:    pipelineHandlerFactory.HandlerFor(jobServer.GetJobBuild),
-		atc.CreateJobBuild: pipelineHandlerFactory.HandlerFor(jobServer.CreateJobBuild),
-		atc.PauseJob:       pipelineHandlerFactory.HandlerFor(jobServer.PauseJob),
-		atc.UnpauseJob:     pipelineHandlerFactory.HandlerFor(jobServer.UnpauseJob),
-		atc.JobBadge:       pipelineHandlerFactory.HandlerFor(jobServer.JobBadge),
+		atc.ListBuildQueue: http.HandlerFunc(buildQueueServer.ListBuildQueue),
+
+		atc.ListAllJobs:        http.HandlerFunc(jobServer.ListAllJobs),
+		atc.ListJobs:           pipelineHandlerFactory.HandlerFor(jobServer.ListJobs),
+		atc.GetJob:             pipelineHandlerFactory.HandlerFor(jobServer.GetJob),
+		atc.ListJobBuilds:      pipelineHandlerFactory.HandlerFor(jobServer.ListJobBuilds),
+		atc.ListJobInputs:      pipelineHandlerFactory.HandlerFor(jobServer.ListJobInputs),
+		atc.GetJobBuild:        pipelineHandlerFactory.HandlerFor(jobServer.GetJobBuild),
+		atc.ListJobBuildInputs: pipelineHandlerFactory.HandlerFor(jobServer.ListJobBuildInputs),
+		atc.CreateJobBuild:     pipelineHandlerFactory.HandlerFor(jobServer.CreateJobBuild),
+		atc.RerunJobBuild:      pipelineHandlerFactory.HandlerFor(jobServer.RerunJobBuild),
+		atc.ApproveJobBuild:    pipelineHandlerFactory.HandlerFor(jobServer.ApproveJobBuild),
+		atc.RejectJobBuild:     pipelineHandlerFactory.HandlerFor(jobServer.RejectJobBuild),
+		atc.PauseJob:           pipelineHandlerFactory.HandlerFor(jobServer.PauseJob),
+		atc.UnpauseJob:         pipelineHandlerFactory.HandlerFor(jobServer.UnpauseJob),
+		atc.JobBadge:           pipelineHandlerFactory.HandlerFor(jobServer.JobBadge),
 		atc.MainJobBadge: mainredirect.Handler{
 			Routes: atc.Routes,
 			Route:  atc.JobBadge,
@@ -145,14 +158,17 @@ func NewHandler(
 		atc.CreatePipelineBuild: pipelineHandlerFactory.HandlerFor(pipelineServer.CreateBuild),
 		atc.PipelineBadge:       pipelineHandlerFactory.HandlerFor(pipelineServer.PipelineBadge),
 
-		atc.ListAllResources:     http.HandlerFunc(resourceServer.ListAllResources),
-		atc.ListResources:        pipelineHandlerFactory.HandlerFor(resourceServer.ListResources),
-		atc.ListResourceTypes:    pipelineHandlerFactory.HandlerFor(resourceServer.ListVersionedResourceTypes),
-		atc.GetResource:          pipelineHandlerFactory.HandlerFor(resourceServer.GetResource),
-		atc.UnpinResource:        pipelineHandlerFactory.HandlerFor(resourceServer.UnpinResource),
-		atc.CheckResource:        pipelineHandlerFactory.HandlerFor(resourceServer.CheckResource),
-		atc.CheckResourceWebHook: pipelineHandlerFactory.HandlerFor(resourceServer.CheckResourceWebHook),
-		atc.CheckResourceType:    pipelineHandlerFactory.HandlerFor(resourceServer.CheckResourceType),
+		atc.ListNotificationDeliveries: pipelineHandlerFactory.HandlerFor(pipelineServer.ListNotificationDeliveries),
+
+		atc.ListAllResources:         http.HandlerFunc(resourceServer.ListAllResources),
+		atc.ListResources:            pipelineHandlerFactory.HandlerFor(resourceServer.ListResources),
+		atc.ListResourceTypes:        pipelineHandlerFactory.HandlerFor(resourceServer.ListVersionedResourceTypes),
+		atc.GetResource:              pipelineHandlerFactory.HandlerFor(resourceServer.GetResource),
+		atc.UnpinResource:            pipelineHandlerFactory.HandlerFor(resourceServer.UnpinResource),
+		atc.CheckResource:            pipelineHandlerFactory.HandlerFor(resourceServer.CheckResource),
+		atc.CheckResourceWebHook:     pipelineHandlerFactory.HandlerFor(resourceServer.CheckResourceWebHook),
+		atc.CheckResourceType:        pipelineHandlerFactory.HandlerFor(resourceServer.CheckResourceType),
+		atc.ListResourceCheckHistory: pipelineHandlerFactory.HandlerFor(resourceServer.ListResourceCheckHistory),
 
 		atc.ListResourceVersions:          pipelineHandlerFactory.HandlerFor(versionServer.ListResourceVersions),
 		atc.GetResourceVersion:            pipelineHandlerFactory.HandlerFor(versionServer.GetResourceVersion),
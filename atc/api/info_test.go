@@ -266,6 +266,7 @@ var _ = Describe("Pipelines API", func() {
             "path_prefix": "testpath",
 						"cache": false,
 						"max_lease": 60,
+						"shared_cache": false,
             "ca_cert": "",
             "server_name": "server-name",
 						"auth_backend": "backend-server",
@@ -477,6 +477,26 @@ var _ = Describe("Jobs API", func() {
 
 					})
 
+					Context("when inputs could not be resolved", func() {
+						BeforeEach(func() {
+							fakeJob.MaxInFlightReachedReturns(true)
+							fakeJob.InputResolutionErrorsReturns(map[string]string{
+								"some-name": "no version passed job 'a'",
+							})
+						})
+
+						It("returns why each input could not be resolved", func() {
+							var job atc.Job
+							err := json.NewDecoder(response.Body).Decode(&job)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(job.MaxInFlightReached).To(BeTrue())
+							Expect(job.Inputs).To(HaveLen(2))
+							Expect(job.Inputs[0].ResolveError).To(BeEmpty())
+							Expect(job.Inputs[1].ResolveError).To(Equal("no version passed job 'a'"))
+						})
+					})
+
 					Context("when there are no running or finished builds", func() {
 						BeforeEach(func() {
 							fakeJob.FinishedAndNextBuildReturns(nil, nil, nil)
@@ -1580,163 +1600,195 @@ var _ = Describe("Jobs API", func() {
 		})
 	})
 
-	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/inputs", func() {
+	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", func() {
+		var request *http.Request
 		var response *http.Response
 
+		var fakeScheduler *schedulerfakes.FakeBuildScheduler
+		var buildToRerun *dbfakes.FakeBuild
+
+		BeforeEach(func() {
+			var err error
+
+			request, err = http.NewRequest("POST", server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/3", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeScheduler = new(schedulerfakes.FakeBuildScheduler)
+			fakeSchedulerFactory.BuildSchedulerReturns(fakeScheduler)
+		})
+
 		JustBeforeEach(func() {
 			var err error
 
-			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/inputs")
+			response, err = client.Do(request)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
-		Context("when authorized", func() {
+		Context("when not authorized", func() {
 			BeforeEach(func() {
-				fakeaccess.IsAuthorizedReturns(true)
 				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
 			})
 
-			It("looked up the proper pipeline", func() {
-				Expect(dbTeam.PipelineCallCount()).To(Equal(1))
-				pipelineName := dbTeam.PipelineArgsForCall(0)
-				Expect(pipelineName).To(Equal("some-pipeline"))
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+
+			It("does not rerun the build", func() {
+				Expect(fakeScheduler.RerunImmediatelyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when authorized and authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(true)
+				fakeaccess.IsAuthenticatedReturns(true)
 			})
 
 			Context("when getting the job succeeds", func() {
-				var fakeJob *dbfakes.FakeJob
+				BeforeEach(func() {
+					fakeJob.NameReturns("some-job")
+					fakeJob.ConfigReturns(atc.JobConfig{
+						Name: "some-job",
+						Plan: atc.PlanSequence{
+							{
+								Get: "some-input",
+							},
+						},
+					})
+					fakePipeline.JobReturns(fakeJob, true, nil)
+				})
 
-				Context("when it contains the requested job", func() {
-					var fakeScheduler *schedulerfakes.FakeBuildScheduler
+				Context("when manual triggering is disabled", func() {
 					BeforeEach(func() {
-						fakeJob = new(dbfakes.FakeJob)
-						fakeJob.NameReturns("some-job")
 						fakeJob.ConfigReturns(atc.JobConfig{
-							Name: "some-job",
-							Plan: atc.PlanSequence{
-								{
-									Get:      "some-input",
-									Resource: "some-resource",
-									Passed:   []string{"job-a", "job-b"},
-									Params:   atc.Params{"some": "params"},
-								},
-								{
-									Get:      "some-other-input",
-									Resource: "some-other-resource",
-									Passed:   []string{"job-c", "job-d"},
-									Params:   atc.Params{"some": "other-params"},
-									Tags:     []string{"some-tag"},
-								},
-							},
+							Name:                 "some-job",
+							DisableManualTrigger: true,
 						})
-						fakePipeline.JobReturns(fakeJob, true, nil)
+					})
 
-						fakeScheduler = new(schedulerfakes.FakeBuildScheduler)
-						fakeSchedulerFactory.BuildSchedulerReturns(fakeScheduler)
+					It("should return 409", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					})
 
-						resource1 := new(dbfakes.FakeResource)
-						resource1.IDReturns(1)
-						resource1.NameReturns("some-resource")
-						resource1.TypeReturns("some-type")
-						resource1.SourceReturns(atc.Source{"some": "source"})
+					It("does not rerun the build", func() {
+						Expect(fakeScheduler.RerunImmediatelyCallCount()).To(Equal(0))
+					})
+				})
 
-						resource2 := new(dbfakes.FakeResource)
-						resource1.IDReturns(2)
-						resource2.NameReturns("some-other-resource")
-						resource2.TypeReturns("some-other-type")
-						resource2.SourceReturns(atc.Source{"some": "other-source"})
-						fakePipeline.ResourcesReturns([]db.Resource{resource1, resource2}, nil)
+				Context("when the build exists", func() {
+					BeforeEach(func() {
+						buildToRerun = new(dbfakes.FakeBuild)
+						buildToRerun.IDReturns(21)
+						buildToRerun.NameReturns("3")
+						buildToRerun.InputsReturns([]db.BuildInput{
+							{Name: "some-input", Version: atc.Version{"version": "1"}, ResourceID: 1},
+						}, nil)
+						fakeJob.BuildReturns(buildToRerun, true, nil)
 					})
 
-					Context("when the input versions for the job can be determined", func() {
+					Context("when versions of the build's inputs can no longer be found", func() {
 						BeforeEach(func() {
-							fakeJob.GetNextBuildInputsStub = func() ([]db.BuildInput, bool, error) {
-								defer GinkgoRecover()
-								Expect(fakeScheduler.SaveNextInputMappingCallCount()).To(Equal(1))
-								return []db.BuildInput{
-									{
-										Name:       "some-input",
-										Version:    atc.Version{"some": "version"},
-										ResourceID: 1,
-									},
-									{
-										Name:       "some-other-input",
-										Version:    atc.Version{"some": "other-version"},
-										ResourceID: 2,
-									},
-								}, true, nil
-							}
+							buildToRerun.InputsReturns(nil, db.BuildInputsNotFoundError{Names: []string{"some-input"}})
 						})
 
-						It("returns 200 OK", func() {
-							Expect(response.StatusCode).To(Equal(http.StatusOK))
-						})
+						It("returns 422 naming the inputs", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusUnprocessableEntity))
 
-						It("returns Content-Type 'application/json'", func() {
-							Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+							body, err := ioutil.ReadAll(response.Body)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(string(body)).To(Equal("failed to rerun: versions of inputs not found: some-input"))
 						})
 
-						It("created the scheduler with the correct fakePipeline and external URL", func() {
-							actualPipeline, actualExternalURL, actualVariables := fakeSchedulerFactory.BuildSchedulerArgsForCall(0)
-							Expect(actualPipeline.Name()).To(Equal(fakePipeline.Name()))
-							Expect(actualExternalURL).To(Equal(externalURL))
-							Expect(actualVariables).To(Equal(variables))
+						It("does not rerun the build", func() {
+							Expect(fakeScheduler.RerunImmediatelyCallCount()).To(Equal(0))
 						})
+					})
 
-						It("determined the inputs with the correct job config", func() {
-							_, receivedJob, _ := fakeScheduler.SaveNextInputMappingArgsForCall(0)
-							Expect(receivedJob.Name()).To(Equal(fakeJob.Name()))
+					Context("when the build did not use an input of the job", func() {
+						BeforeEach(func() {
+							buildToRerun.InputsReturns([]db.BuildInput{}, nil)
 						})
 
-						It("returns the inputs", func() {
+						It("returns 422 naming the inputs", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusUnprocessableEntity))
+
 							body, err := ioutil.ReadAll(response.Body)
 							Expect(err).NotTo(HaveOccurred())
+							Expect(string(body)).To(Equal("failed to rerun: versions of inputs not found: some-input"))
+						})
 
-							Expect(body).To(MatchJSON(`[
-									{
-										"name": "some-input",
-										"resource": "some-resource",
-										"type": "some-type",
-										"source": {"some": "source"},
-										"version": {"some": "version"},
-										"params": {"some": "params"}
-									},
-									{
-										"name": "some-other-input",
-										"resource": "some-other-resource",
-										"type": "some-other-type",
-										"source": {"some": "other-source"},
-										"version": {"some": "other-version"},
-										"params": {"some": "other-params"},
-										"tags": ["some-tag"]
-									}
-								]`))
-
+						It("does not rerun the build", func() {
+							Expect(fakeScheduler.RerunImmediatelyCallCount()).To(Equal(0))
 						})
+					})
 
-						Context("when getting the resources fails", func() {
-							BeforeEach(func() {
-								fakePipeline.ResourcesReturns(nil, errors.New("some-error"))
-							})
+					Context("when getting the build's inputs fails", func() {
+						BeforeEach(func() {
+							buildToRerun.InputsReturns(nil, errors.New("nope"))
+						})
 
-							It("returns 500", func() {
-								Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
-							})
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
 						})
 					})
 
-					Context("when the job has no input versions available", func() {
+					Context("when rerunning the build succeeds", func() {
 						BeforeEach(func() {
-							fakeJob.GetNextBuildInputsReturns(nil, false, nil)
+							build := new(dbfakes.FakeBuild)
+							build.IDReturns(42)
+							build.NameReturns("4")
+							build.JobNameReturns("some-job")
+							build.PipelineNameReturns("a-pipeline")
+							build.TeamNameReturns("some-team")
+							build.StatusReturns(db.BuildStatusPending)
+							build.RerunOfReturns(21)
+							build.RerunOfNameReturns("3")
+							fakeScheduler.RerunImmediatelyReturns(build, nil, nil)
+
+							fakePipeline.ResourcesReturns(db.Resources{}, nil)
 						})
 
-						It("returns 404", func() {
-							Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+						It("looks up the build by name", func() {
+							Expect(fakeJob.BuildCallCount()).To(Equal(1))
+							Expect(fakeJob.BuildArgsForCall(0)).To(Equal("3"))
+						})
+
+						It("reruns the build using the current config", func() {
+							Expect(fakeScheduler.RerunImmediatelyCallCount()).To(Equal(1))
+
+							_, job, build, resources, resourceTypes := fakeScheduler.RerunImmediatelyArgsForCall(0)
+							Expect(job).To(Equal(fakeJob))
+							Expect(build).To(Equal(buildToRerun))
+							Expect(resources).To(Equal(db.Resources{}))
+							Expect(resourceTypes).To(Equal(versionedResourceTypes))
+						})
+
+						It("returns 200 OK", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+						})
+
+						It("returns the new build linked to the original", func() {
+							body, err := ioutil.ReadAll(response.Body)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(body).To(MatchJSON(`{
+							"id": 42,
+							"name": "4",
+							"job_name": "some-job",
+							"status": "pending",
+							"api_url": "/api/v1/builds/42",
+							"pipeline_name": "a-pipeline",
+							"team_name": "some-team",
+							"rerun_of": 21,
+							"rerun_of_name": "3"
+						}`))
 						})
 					})
 
-					Context("when the input versions for the job can not be determined", func() {
+					Context("when rerunning the build fails", func() {
 						BeforeEach(func() {
-							fakeJob.GetNextBuildInputsReturns(nil, false, errors.New("oh no!"))
+							fakeScheduler.RerunImmediatelyReturns(nil, nil, errors.New("oh no!"))
 						})
 
 						It("returns 500", func() {
@@ -1744,59 +1796,640 @@ var _ = Describe("Jobs API", func() {
 						})
 					})
 				})
-			})
 
-			Context("when it does not contain the requested job", func() {
-				BeforeEach(func() {
-					fakePipeline.JobReturns(nil, false, nil)
+				Context("when the build is not found", func() {
+					BeforeEach(func() {
+						fakeJob.BuildReturns(nil, false, nil)
+					})
+
+					It("returns a 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
 				})
 
-				It("returns 404 Not Found", func() {
-					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				Context("when getting the build fails", func() {
+					BeforeEach(func() {
+						fakeJob.BuildReturns(nil, false, errors.New("nope"))
+					})
+
+					It("returns a 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
 				})
 			})
 
-			Context("when getting the job fails", func() {
+			Context("when the job is not found", func() {
 				BeforeEach(func() {
-					fakePipeline.JobReturns(nil, false, errors.New("some-error"))
+					fakePipeline.JobReturns(nil, false, nil)
 				})
 
-				It("returns 500", func() {
-					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				It("returns a 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
 				})
 			})
-
-		})
-
-		Context("when not authenticated", func() {
-			BeforeEach(func() {
-				fakeaccess.IsAuthenticatedReturns(false)
-			})
-
-			It("returns unauthorized", func() {
-				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
-			})
 		})
 	})
 
-	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", func() {
+	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name/approve", func() {
+		var request *http.Request
 		var response *http.Response
 
+		var build *dbfakes.FakeBuild
+		var waiting db.BuildApproval
+
+		BeforeEach(func() {
+			var err error
+
+			request, err = http.NewRequest("PUT", server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/3/approve", nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
 		JustBeforeEach(func() {
 			var err error
 
-			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/some-build")
+			response, err = client.Do(request)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
-		Context("when authorized", func() {
+		Context("when not authorized", func() {
 			BeforeEach(func() {
-				fakeaccess.IsAuthorizedReturns(true)
 				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
 			})
 
-			Context("when getting the job succeeds", func() {
-				var fakeJob *dbfakes.FakeJob
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized and authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(true)
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.HasTeamRoleReturns(true)
+				fakeaccess.UserNameReturns("some-user")
+
+				fakePipeline.TeamNameReturns("some-team")
+				fakePipeline.JobReturns(fakeJob, true, nil)
+
+				build = new(dbfakes.FakeBuild)
+				build.IDReturns(42)
+				fakeJob.BuildReturns(build, true, nil)
+
+				waiting = db.BuildApproval{
+					BuildID:     42,
+					PlanID:      "some-plan-id",
+					Name:        "ship-it",
+					Role:        "owner",
+					Status:      atc.ApprovalStatusWaiting,
+					RequestedAt: time.Unix(100, 0),
+				}
+
+				build.ApprovalsReturns([]db.BuildApproval{
+					{
+						BuildID: 42,
+						PlanID:  "some-other-plan-id",
+						Name:    "qa",
+						Role:    "member",
+						Status:  atc.ApprovalStatusApproved,
+					},
+					waiting,
+				}, nil)
+
+				approved := waiting
+				approved.Status = atc.ApprovalStatusApproved
+				approved.DecidedBy = "some-user"
+				approved.DecidedAt = time.Unix(200, 0)
+
+				build.DecideApprovalReturns(true, nil)
+				build.ApprovalReturns(approved, true, nil)
+			})
+
+			It("looks up the build by name", func() {
+				Expect(fakeJob.BuildCallCount()).To(Equal(1))
+				Expect(fakeJob.BuildArgsForCall(0)).To(Equal("3"))
+			})
+
+			It("checks the user has the role required by the approval", func() {
+				Expect(fakeaccess.HasTeamRoleCallCount()).To(Equal(1))
+				team, role := fakeaccess.HasTeamRoleArgsForCall(0)
+				Expect(team).To(Equal("some-team"))
+				Expect(role).To(Equal("owner"))
+			})
+
+			It("approves the waiting approval as the user", func() {
+				Expect(build.DecideApprovalCallCount()).To(Equal(1))
+				planID, status, decidedBy := build.DecideApprovalArgsForCall(0)
+				Expect(planID).To(Equal(atc.PlanID("some-plan-id")))
+				Expect(status).To(Equal(atc.ApprovalStatusApproved))
+				Expect(decidedBy).To(Equal("some-user"))
+			})
+
+			It("returns 200 OK with the decided approval", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`{
+					"build_id": 42,
+					"name": "ship-it",
+					"role": "owner",
+					"status": "approved",
+					"decided_by": "some-user",
+					"requested_at": 100,
+					"decided_at": 200
+				}`))
+			})
+
+			Context("when the user does not have the required role", func() {
+				BeforeEach(func() {
+					fakeaccess.HasTeamRoleReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+
+				It("does not decide the approval", func() {
+					Expect(build.DecideApprovalCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the build is not waiting for approval", func() {
+				BeforeEach(func() {
+					build.ApprovalsReturns([]db.BuildApproval{}, nil)
+				})
+
+				It("returns 409", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusConflict))
+				})
+			})
+
+			Context("when the build is waiting for more than one approval", func() {
+				BeforeEach(func() {
+					other := waiting
+					other.PlanID = "some-other-plan-id"
+					other.Name = "qa"
+
+					build.ApprovalsReturns([]db.BuildApproval{other, waiting}, nil)
+				})
+
+				It("returns 409", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusConflict))
+				})
+
+				Context("when the step is given", func() {
+					BeforeEach(func() {
+						request.URL.RawQuery = "step=ship-it"
+					})
+
+					It("decides the named approval", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+						planID, _, _ := build.DecideApprovalArgsForCall(0)
+						Expect(planID).To(Equal(atc.PlanID("some-plan-id")))
+					})
+				})
+			})
+
+			Context("when the approval was decided in the meantime", func() {
+				BeforeEach(func() {
+					build.DecideApprovalReturns(false, nil)
+				})
+
+				It("returns 409", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusConflict))
+				})
+			})
+
+			Context("when deciding the approval fails", func() {
+				BeforeEach(func() {
+					build.DecideApprovalReturns(false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the build is not found", func() {
+				BeforeEach(func() {
+					fakeJob.BuildReturns(nil, false, nil)
+				})
+
+				It("returns a 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns a 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name/reject", func() {
+		var response *http.Response
+		var build *dbfakes.FakeBuild
+
+		BeforeEach(func() {
+			fakeaccess.IsAuthorizedReturns(true)
+			fakeaccess.IsAuthenticatedReturns(true)
+			fakeaccess.HasTeamRoleReturns(true)
+			fakeaccess.UserNameReturns("some-user")
+
+			fakePipeline.JobReturns(fakeJob, true, nil)
+
+			build = new(dbfakes.FakeBuild)
+			fakeJob.BuildReturns(build, true, nil)
+
+			build.ApprovalsReturns([]db.BuildApproval{
+				{
+					PlanID: "some-plan-id",
+					Name:   "ship-it",
+					Role:   "member",
+					Status: atc.ApprovalStatusWaiting,
+				},
+			}, nil)
+			build.DecideApprovalReturns(true, nil)
+			build.ApprovalReturns(db.BuildApproval{Status: atc.ApprovalStatusRejected}, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/3/reject", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("rejects the waiting approval as the user", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+			Expect(build.DecideApprovalCallCount()).To(Equal(1))
+			planID, status, decidedBy := build.DecideApprovalArgsForCall(0)
+			Expect(planID).To(Equal(atc.PlanID("some-plan-id")))
+			Expect(status).To(Equal(atc.ApprovalStatusRejected))
+			Expect(decidedBy).To(Equal("some-user"))
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/inputs", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/inputs")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(true)
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			It("looked up the proper pipeline", func() {
+				Expect(dbTeam.PipelineCallCount()).To(Equal(1))
+				pipelineName := dbTeam.PipelineArgsForCall(0)
+				Expect(pipelineName).To(Equal("some-pipeline"))
+			})
+
+			Context("when getting the job succeeds", func() {
+				var fakeJob *dbfakes.FakeJob
+
+				Context("when it contains the requested job", func() {
+					var fakeScheduler *schedulerfakes.FakeBuildScheduler
+					BeforeEach(func() {
+						fakeJob = new(dbfakes.FakeJob)
+						fakeJob.NameReturns("some-job")
+						fakeJob.ConfigReturns(atc.JobConfig{
+							Name: "some-job",
+							Plan: atc.PlanSequence{
+								{
+									Get:      "some-input",
+									Resource: "some-resource",
+									Passed:   []string{"job-a", "job-b"},
+									Params:   atc.Params{"some": "params"},
+								},
+								{
+									Get:      "some-other-input",
+									Resource: "some-other-resource",
+									Passed:   []string{"job-c", "job-d"},
+									Params:   atc.Params{"some": "other-params"},
+									Tags:     []string{"some-tag"},
+								},
+							},
+						})
+						fakePipeline.JobReturns(fakeJob, true, nil)
+
+						fakeScheduler = new(schedulerfakes.FakeBuildScheduler)
+						fakeSchedulerFactory.BuildSchedulerReturns(fakeScheduler)
+
+						resource1 := new(dbfakes.FakeResource)
+						resource1.IDReturns(1)
+						resource1.NameReturns("some-resource")
+						resource1.TypeReturns("some-type")
+						resource1.SourceReturns(atc.Source{"some": "source"})
+
+						resource2 := new(dbfakes.FakeResource)
+						resource1.IDReturns(2)
+						resource2.NameReturns("some-other-resource")
+						resource2.TypeReturns("some-other-type")
+						resource2.SourceReturns(atc.Source{"some": "other-source"})
+						fakePipeline.ResourcesReturns([]db.Resource{resource1, resource2}, nil)
+					})
+
+					Context("when the input versions for the job can be determined", func() {
+						BeforeEach(func() {
+							fakeJob.GetNextBuildInputsStub = func() ([]db.BuildInput, bool, error) {
+								defer GinkgoRecover()
+								Expect(fakeScheduler.SaveNextInputMappingCallCount()).To(Equal(1))
+								return []db.BuildInput{
+									{
+										Name:       "some-input",
+										Version:    atc.Version{"some": "version"},
+										ResourceID: 1,
+									},
+									{
+										Name:       "some-other-input",
+										Version:    atc.Version{"some": "other-version"},
+										ResourceID: 2,
+									},
+								}, true, nil
+							}
+						})
+
+						It("returns 200 OK", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+						})
+
+						It("returns Content-Type 'application/json'", func() {
+							Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+						})
+
+						It("created the scheduler with the correct fakePipeline and external URL", func() {
+							actualPipeline, actualExternalURL, actualVariables := fakeSchedulerFactory.BuildSchedulerArgsForCall(0)
+							Expect(actualPipeline.Name()).To(Equal(fakePipeline.Name()))
+							Expect(actualExternalURL).To(Equal(externalURL))
+							Expect(actualVariables).To(Equal(variables))
+						})
+
+						It("determined the inputs with the correct job config", func() {
+							_, receivedJob, _ := fakeScheduler.SaveNextInputMappingArgsForCall(0)
+							Expect(receivedJob.Name()).To(Equal(fakeJob.Name()))
+						})
+
+						It("returns the inputs", func() {
+							body, err := ioutil.ReadAll(response.Body)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(body).To(MatchJSON(`[
+									{
+										"name": "some-input",
+										"resource": "some-resource",
+										"type": "some-type",
+										"source": {"some": "source"},
+										"version": {"some": "version"},
+										"params": {"some": "params"}
+									},
+									{
+										"name": "some-other-input",
+										"resource": "some-other-resource",
+										"type": "some-other-type",
+										"source": {"some": "other-source"},
+										"version": {"some": "other-version"},
+										"params": {"some": "other-params"},
+										"tags": ["some-tag"]
+									}
+								]`))
+
+						})
+
+						Context("when an input has a version filter", func() {
+							var filteredResource *dbfakes.FakeResource
+
+							BeforeEach(func() {
+								fakeJob.ConfigReturns(atc.JobConfig{
+									Name: "some-job",
+									Plan: atc.PlanSequence{
+										{
+											Get:           "some-input",
+											Resource:      "some-resource",
+											VersionFilter: &atc.VersionFilterConfig{Regex: "^v"},
+										},
+									},
+								})
+
+								filteredResource = new(dbfakes.FakeResource)
+								filteredResource.NameReturns("some-resource")
+								filteredResource.TypeReturns("some-type")
+								filteredResource.SourceReturns(atc.Source{"some": "source"})
+								filteredResource.VersionsReturns([]atc.ResourceVersion{
+									{ID: 3, Version: atc.Version{"some": "newest"}},
+									{ID: 2, Version: atc.Version{"some": "vnewer"}},
+									{ID: 1, Version: atc.Version{"some": "version"}},
+									{ID: 0, Version: atc.Version{"some": "older"}},
+								}, db.Pagination{}, true, nil)
+								fakePipeline.ResourcesReturns([]db.Resource{filteredResource}, nil)
+
+								fakeJob.GetNextBuildInputsReturns([]db.BuildInput{
+									{
+										Name:       "some-input",
+										Version:    atc.Version{"some": "version"},
+										ResourceID: 1,
+									},
+								}, true, nil)
+							})
+
+							It("returns the newer versions the filter excluded", func() {
+								body, err := ioutil.ReadAll(response.Body)
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(body).To(MatchJSON(`[
+									{
+										"name": "some-input",
+										"resource": "some-resource",
+										"type": "some-type",
+										"source": {"some": "source"},
+										"version": {"some": "version"},
+										"excluded_versions": [
+											{
+												"version": {"some": "newest"},
+												"reason": "some 'newest' does not match /^v/"
+											}
+										]
+									}
+								]`))
+							})
+
+							Context("when getting the resource versions fails", func() {
+								BeforeEach(func() {
+									filteredResource.VersionsReturns(nil, db.Pagination{}, false, errors.New("nope"))
+								})
+
+								It("returns 500", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+								})
+							})
+						})
+
+						Context("when getting the resources fails", func() {
+							BeforeEach(func() {
+								fakePipeline.ResourcesReturns(nil, errors.New("some-error"))
+							})
+
+							It("returns 500", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+							})
+						})
+					})
+
+					Context("when the job has no input versions available", func() {
+						BeforeEach(func() {
+							fakeJob.GetNextBuildInputsReturns(nil, false, nil)
+						})
+
+						It("returns 404", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+						})
+
+						Context("when an input's version filter excludes every version", func() {
+							BeforeEach(func() {
+								fakeJob.ConfigReturns(atc.JobConfig{
+									Name: "some-job",
+									Plan: atc.PlanSequence{
+										{
+											Get:           "some-input",
+											Resource:      "some-resource",
+											VersionFilter: &atc.VersionFilterConfig{Regex: "^v"},
+										},
+										{
+											Get:      "some-other-input",
+											Resource: "some-other-resource",
+										},
+									},
+								})
+
+								filteredResource := new(dbfakes.FakeResource)
+								filteredResource.NameReturns("some-resource")
+								filteredResource.TypeReturns("some-type")
+								filteredResource.SourceReturns(atc.Source{"some": "source"})
+								filteredResource.VersionsReturns([]atc.ResourceVersion{
+									{ID: 2, Version: atc.Version{"some": "newer"}},
+									{ID: 1, Version: atc.Version{"some": "older"}},
+								}, db.Pagination{}, true, nil)
+
+								otherResource := new(dbfakes.FakeResource)
+								otherResource.NameReturns("some-other-resource")
+
+								fakePipeline.ResourcesReturns([]db.Resource{filteredResource, otherResource}, nil)
+							})
+
+							It("returns 404", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+							})
+
+							It("returns every version the filter excluded", func() {
+								Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+								body, err := ioutil.ReadAll(response.Body)
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(body).To(MatchJSON(`[
+									{
+										"name": "some-input",
+										"resource": "some-resource",
+										"type": "some-type",
+										"source": {"some": "source"},
+										"version": null,
+										"excluded_versions": [
+											{
+												"version": {"some": "newer"},
+												"reason": "some 'newer' does not match /^v/"
+											},
+											{
+												"version": {"some": "older"},
+												"reason": "some 'older' does not match /^v/"
+											}
+										]
+									}
+								]`))
+							})
+						})
+					})
+
+					Context("when the input versions for the job can not be determined", func() {
+						BeforeEach(func() {
+							fakeJob.GetNextBuildInputsReturns(nil, false, errors.New("oh no!"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+				})
+			})
+
+			Context("when it does not contain the requested job", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when getting the job fails", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, errors.New("some-error"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/some-build")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(true)
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when getting the job succeeds", func() {
+				var fakeJob *dbfakes.FakeJob
 
 				BeforeEach(func() {
 					fakeJob = new(dbfakes.FakeJob)
@@ -1940,6 +2573,234 @@ var _ = Describe("Jobs API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name/inputs", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/some-build/inputs")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(true)
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when getting the job succeeds", func() {
+				var fakeJob *dbfakes.FakeJob
+
+				BeforeEach(func() {
+					fakeJob = new(dbfakes.FakeJob)
+					fakeJob.NameReturns("some-job")
+					fakeJob.ConfigReturns(atc.JobConfig{
+						Name: "some-job",
+						Plan: atc.PlanSequence{
+							{
+								Get:      "some-input",
+								Resource: "some-resource",
+								Params:   atc.Params{"some": "params"},
+							},
+							{
+								Get:      "some-other-input",
+								Resource: "some-other-resource",
+								Tags:     []string{"some-tag"},
+							},
+						},
+					})
+					fakePipeline.JobReturns(fakeJob, true, nil)
+				})
+
+				Context("when getting the build succeeds", func() {
+					var fakeBuild *dbfakes.FakeBuild
+
+					BeforeEach(func() {
+						fakeBuild = new(dbfakes.FakeBuild)
+						fakeJob.BuildReturns(fakeBuild, true, nil)
+
+						resource1 := new(dbfakes.FakeResource)
+						resource1.IDReturns(1)
+						resource1.NameReturns("some-resource")
+						resource1.TypeReturns("some-type")
+						resource1.SourceReturns(atc.Source{"some": "source"})
+
+						resource2 := new(dbfakes.FakeResource)
+						resource2.IDReturns(2)
+						resource2.NameReturns("some-other-resource")
+						resource2.TypeReturns("some-other-type")
+						resource2.SourceReturns(atc.Source{"some": "other-source"})
+						fakePipeline.ResourcesReturns([]db.Resource{resource1, resource2}, nil)
+					})
+
+					Context("when getting the build inputs succeeds", func() {
+						BeforeEach(func() {
+							fakeBuild.InputsReturns([]db.BuildInput{
+								{
+									Name:       "some-input",
+									Version:    atc.Version{"some": "version"},
+									ResourceID: 1,
+								},
+								{
+									Name:       "some-other-input",
+									Version:    atc.Version{"some": "other-version"},
+									ResourceID: 2,
+								},
+							}, nil)
+						})
+
+						It("looked up the build by name", func() {
+							Expect(fakePipeline.JobArgsForCall(0)).To(Equal("some-job"))
+							Expect(fakeJob.BuildArgsForCall(0)).To(Equal("some-build"))
+						})
+
+						It("returns 200 OK", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+						})
+
+						It("returns Content-Type 'application/json'", func() {
+							Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+						})
+
+						It("returns the inputs", func() {
+							body, err := ioutil.ReadAll(response.Body)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(body).To(MatchJSON(`[
+								{
+									"name": "some-input",
+									"resource": "some-resource",
+									"type": "some-type",
+									"source": {"some": "source"},
+									"version": {"some": "version"},
+									"params": {"some": "params"}
+								},
+								{
+									"name": "some-other-input",
+									"resource": "some-other-resource",
+									"type": "some-other-type",
+									"source": {"some": "other-source"},
+									"version": {"some": "other-version"},
+									"tags": ["some-tag"]
+								}
+							]`))
+						})
+					})
+
+					Context("when a resource used by the build has been removed from the pipeline", func() {
+						BeforeEach(func() {
+							fakeBuild.InputsReturns([]db.BuildInput{
+								{
+									Name:       "some-input",
+									Version:    atc.Version{"some": "version"},
+									ResourceID: 1,
+								},
+								{
+									Name:       "some-removed-input",
+									Version:    atc.Version{"some": "removed-version"},
+									ResourceID: 3,
+								},
+							}, nil)
+						})
+
+						It("returns 422 naming the inputs which cannot be reconstructed", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusUnprocessableEntity))
+
+							body, err := ioutil.ReadAll(response.Body)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(string(body)).To(Equal("cannot reconstruct inputs: some-removed-input"))
+						})
+					})
+
+					Context("when versions of the build's inputs can no longer be found", func() {
+						BeforeEach(func() {
+							fakeBuild.InputsReturns(nil, db.BuildInputsNotFoundError{Names: []string{"some-input", "some-other-input"}})
+						})
+
+						It("returns 422 naming the inputs which cannot be reconstructed", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusUnprocessableEntity))
+
+							body, err := ioutil.ReadAll(response.Body)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(string(body)).To(Equal("cannot reconstruct inputs: some-input, some-other-input"))
+						})
+					})
+
+					Context("when getting the build inputs fails", func() {
+						BeforeEach(func() {
+							fakeBuild.InputsReturns(nil, errors.New("nope"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+
+					Context("when getting the resources fails", func() {
+						BeforeEach(func() {
+							fakePipeline.ResourcesReturns(nil, errors.New("nope"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+				})
+
+				Context("when the build is not found", func() {
+					BeforeEach(func() {
+						fakeJob.BuildReturns(nil, false, nil)
+					})
+
+					It("returns 404 Not Found", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when getting the build fails", func() {
+					BeforeEach(func() {
+						fakeJob.BuildReturns(nil, false, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when getting the job fails", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/pause", func() {
 		var response *http.Response
 
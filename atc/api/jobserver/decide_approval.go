@@ -0,0 +1,134 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ApproveJobBuild(pipeline db.Pipeline) http.Handler {
+	return s.decideJobBuildApproval(pipeline, atc.ApprovalStatusApproved, "approve-job-build")
+}
+
+func (s *Server) RejectJobBuild(pipeline db.Pipeline) http.Handler {
+	return s.decideJobBuildApproval(pipeline, atc.ApprovalStatusRejected, "reject-job-build")
+}
+
+// decideJobBuildApproval decides one of the build's waiting approvals. The
+// approval may be selected by name with the 'step' form value, which may be
+// omitted if only one approval is waiting.
+func (s *Server) decideJobBuildApproval(pipeline db.Pipeline, status atc.BuildApprovalStatus, session string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session(session)
+
+		jobName := r.FormValue(":job_name")
+		buildName := r.FormValue(":build_name")
+		stepName := r.FormValue("step")
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		build, found, err := job.Build(buildName)
+		if err != nil {
+			logger.Error("failed-to-get-build", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		approvals, err := build.Approvals()
+		if err != nil {
+			logger.Error("failed-to-get-approvals", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		waiting := []db.BuildApproval{}
+		for _, approval := range approvals {
+			if approval.IsDecided() {
+				continue
+			}
+
+			if stepName != "" && approval.Name != stepName {
+				continue
+			}
+
+			waiting = append(waiting, approval)
+		}
+
+		switch len(waiting) {
+		case 0:
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprintf(w, "build is not waiting for approval")
+			return
+		case 1:
+		default:
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprintf(w, "build is waiting for more than one approval; specify the step")
+			return
+		}
+
+		approval := waiting[0]
+
+		acc := accessor.GetAccessor(r)
+		if !acc.HasTeamRole(pipeline.TeamName(), approval.Role) {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintf(w, "deciding '%s' requires the %s role", approval.Name, approval.Role)
+			return
+		}
+
+		logger = logger.WithData(lager.Data{"approval": approval.Name})
+
+		decided, err := build.DecideApproval(approval.PlanID, status, acc.UserName())
+		if err != nil {
+			logger.Error("failed-to-decide-approval", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !decided {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprintf(w, "approval '%s' has already been decided", approval.Name)
+			return
+		}
+
+		approval, found, err = build.Approval(approval.PlanID)
+		if err != nil {
+			logger.Error("failed-to-get-approval", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(present.BuildApproval(approval))
+		if err != nil {
+			logger.Error("failed-to-encode-approval", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
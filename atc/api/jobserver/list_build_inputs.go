@@ -0,0 +1,107 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListJobBuildInputs(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("list-job-build-inputs")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jobName := r.FormValue(":job_name")
+		buildName := r.FormValue(":build_name")
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		build, found, err := job.Build(buildName)
+		if err != nil {
+			logger.Error("failed-to-get-job-build", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		buildInputs, err := build.Inputs()
+		if err != nil {
+			if notFound, ok := err.(db.BuildInputsNotFoundError); ok {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				fmt.Fprintf(w, "cannot reconstruct inputs: %s", strings.Join(notFound.Names, ", "))
+				return
+			}
+
+			logger.Error("failed-to-get-build-inputs", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		resources, err := pipeline.Resources()
+		if err != nil {
+			logger.Error("failed-to-get-resources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		jobInputs := job.Config().Inputs()
+		presentedBuildInputs := []atc.BuildInput{}
+		removed := []string{}
+		for _, input := range buildInputs {
+			var resource db.Resource
+			for _, r := range resources {
+				if r.ID() == input.ResourceID {
+					resource = r
+					break
+				}
+			}
+
+			// the resource may have been removed from the pipeline since the
+			// build ran, in which case there is no way to fetch the input again
+			if resource == nil {
+				removed = append(removed, input.Name)
+				continue
+			}
+
+			var config atc.JobInput
+			for _, jobInput := range jobInputs {
+				if jobInput.Name == input.Name {
+					config = jobInput
+					break
+				}
+			}
+
+			presentedBuildInputs = append(presentedBuildInputs, present.BuildInput(input, config, resource))
+		}
+
+		if len(removed) > 0 {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprintf(w, "cannot reconstruct inputs: %s", strings.Join(removed, ", "))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(presentedBuildInputs)
+		if err != nil {
+			logger.Error("failed-to-encode-build-inputs", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -3,12 +3,18 @@ package jobserver
 import (
 	"encoding/json"
 	"net/http"
+	"reflect"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/algorithm"
 )
 
+// the number of most recent versions inspected when explaining which versions
+// an input's version filter excluded
+const excludedVersionsLimit = 100
+
 func (s *Server) ListJobInputs(pipeline db.Pipeline) http.Handler {
 	logger := s.logger.Session("list-job-inputs")
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -50,12 +56,48 @@ func (s *Server) ListJobInputs(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
+		jobInputs := job.Config().Inputs()
+
 		if !found {
+			// explain which versions were excluded, as the version filter of an
+			// input rejecting every version is the most likely reason for there
+			// being no versions to choose from
+			excludedInputs := []atc.BuildInput{}
+			for _, config := range jobInputs {
+				resource, found := resources.Lookup(config.Resource)
+				if !found || config.VersionFilter == nil {
+					continue
+				}
+
+				excluded, err := excludedVersions(resource, *config.VersionFilter, nil)
+				if err != nil {
+					logger.Error("failed-to-determine-excluded-versions", err)
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+
+				input := present.BuildInput(db.BuildInput{Name: config.Name}, config, resource)
+				input.ExcludedVersions = excluded
+
+				excludedInputs = append(excludedInputs, input)
+			}
+
+			if len(excludedInputs) == 0 {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
 			w.WriteHeader(http.StatusNotFound)
+
+			err = json.NewEncoder(w).Encode(excludedInputs)
+			if err != nil {
+				logger.Error("failed-to-encode-build-inputs", err)
+			}
+
 			return
 		}
 
-		jobInputs := job.Config().Inputs()
 		presentedBuildInputs := make([]atc.BuildInput, len(buildInputs))
 		for i, input := range buildInputs {
 			var config atc.JobInput
@@ -65,9 +107,20 @@ func (s *Server) ListJobInputs(pipeline db.Pipeline) http.Handler {
 					break
 				}
 			}
-			resource, _ := resources.Lookup(config.Resource)
+			resource, found := resources.Lookup(config.Resource)
 
 			presentedBuildInputs[i] = present.BuildInput(input, config, resource)
+
+			if found && config.VersionFilter != nil {
+				excluded, err := excludedVersions(resource, *config.VersionFilter, input.Version)
+				if err != nil {
+					logger.Error("failed-to-determine-excluded-versions", err)
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+
+				presentedBuildInputs[i].ExcludedVersions = excluded
+			}
 		}
 
 		w.Header().Set("Content-Type", "application/json")
@@ -78,3 +131,34 @@ func (s *Server) ListJobInputs(pipeline db.Pipeline) http.Handler {
 		}
 	})
 }
+
+// excludedVersions lists the versions newer than the chosen one that the
+// input's version filter rejected, along with the reason for each.
+func excludedVersions(resource db.Resource, filterConfig atc.VersionFilterConfig, chosen atc.Version) ([]atc.ExcludedVersion, error) {
+	filter, err := algorithm.NewVersionFilter(filterConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, _, _, err := resource.Versions(db.Page{Limit: excludedVersionsLimit})
+	if err != nil {
+		return nil, err
+	}
+
+	excluded := []atc.ExcludedVersion{}
+	for _, version := range versions {
+		if reflect.DeepEqual(version.Version, chosen) {
+			break
+		}
+
+		ok, reason := filter.Check(version.Version)
+		if !ok {
+			excluded = append(excluded, atc.ExcludedVersion{
+				Version: version.Version,
+				Reason:  reason,
+			})
+		}
+	}
+
+	return excluded, nil
+}
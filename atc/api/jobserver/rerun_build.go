@@ -0,0 +1,115 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) RerunJobBuild(pipeline db.Pipeline) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		logger := s.logger.Session("rerun-job-build")
+
+		jobName := r.FormValue(":job_name")
+		buildName := r.FormValue(":build_name")
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if job.Config().DisableManualTrigger {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		buildToRerun, found, err := job.Build(buildName)
+		if err != nil {
+			logger.Error("failed-to-get-build", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		inputs, err := buildToRerun.Inputs()
+		if err != nil {
+			if notFound, ok := err.(db.BuildInputsNotFoundError); ok {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				fmt.Fprintf(w, "failed to rerun: %s", notFound)
+				return
+			}
+
+			logger.Error("failed-to-get-build-inputs", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		missing := []string{}
+		for _, jobInput := range job.Config().Inputs() {
+			found := false
+			for _, input := range inputs {
+				if input.Name == jobInput.Name {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				missing = append(missing, jobInput.Name)
+			}
+		}
+
+		if len(missing) > 0 {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprintf(w, "failed to rerun: %s", db.BuildInputsNotFoundError{Names: missing})
+			return
+		}
+
+		scheduler := s.schedulerFactory.BuildScheduler(pipeline, s.externalURL, s.variablesFactory.NewVariables(pipeline.TeamName(), pipeline.Name()))
+
+		resourceTypes, err := pipeline.ResourceTypes()
+		if err != nil {
+			logger.Error("failed-to-get-resource-types", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		versionedResourceTypes := resourceTypes.Deserialize()
+
+		resources, err := pipeline.Resources()
+		if err != nil {
+			logger.Error("failed-to-get-resources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		build, _, err := scheduler.RerunImmediately(logger, job, buildToRerun, resources, versionedResourceTypes)
+		if err != nil {
+			logger.Error("failed-to-rerun", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprintf(w, "failed to rerun: %s", err)
+			return
+		}
+
+		err = json.NewEncoder(w).Encode(present.Build(build))
+		if err != nil {
+			logger.Error("failed-to-encode-build", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
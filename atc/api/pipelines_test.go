@@ -1330,6 +1330,16 @@ var _ = Describe("Pipelines API", func() {
 						ResourceIDs: map[string]int{
 							"resource-127": 127,
 						},
+						Versions: map[int]atc.Version{
+							73: atc.Version{"ref": "v1"},
+						},
+						DisabledVersions: []algorithm.ResourceVersion{
+							{
+								VersionID:  74,
+								ResourceID: 127,
+								CheckOrder: 124,
+							},
+						},
 					},
 					nil,
 				)
@@ -1384,7 +1394,17 @@ var _ = Describe("Pipelines API", func() {
 				},
 				"ResourceIDs": {
 					"resource-127": 127
-				}
+				},
+				"Versions": {
+					"73": {"ref": "v1"}
+				},
+				"DisabledVersions": [
+					{
+						"VersionID": 74,
+						"ResourceID": 127,
+						"CheckOrder": 124
+					}
+				]
 				}`))
 			})
 		})
@@ -1820,4 +1840,147 @@ var _ = Describe("Pipelines API", func() {
 			})
 		})
 	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/notification-deliveries", func() {
+		var response *http.Response
+		var query string
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/notification-deliveries"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authenticated and authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when notifications have been delivered", func() {
+				BeforeEach(func() {
+					fakePipeline.NotificationDeliveriesReturns([]db.NotificationDelivery{
+						{
+							ID:             2,
+							BuildID:        12,
+							BuildName:      "3",
+							JobName:        "some-job",
+							Notification:   "chat",
+							Event:          atc.NotificationEventFailed,
+							Status:         atc.NotificationDeliveryPending,
+							Attempts:       1,
+							ResponseStatus: 502,
+							Error:          "unexpected response: 502 Bad Gateway",
+							CreatedAt:      time.Unix(100, 0),
+							LastAttemptAt:  time.Unix(105, 0),
+						},
+						{
+							ID:           1,
+							BuildID:      12,
+							BuildName:    "3",
+							JobName:      "some-job",
+							Notification: "audit",
+							Event:        atc.NotificationEventStarted,
+							Status:       atc.NotificationDeliveryDelivered,
+							Attempts:     1,
+							CreatedAt:    time.Unix(40, 0),
+						},
+					}, nil)
+				})
+
+				It("returns 200 OK", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns Content-Type 'application/json'", func() {
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+				})
+
+				It("fetches the default number of deliveries", func() {
+					Expect(fakePipeline.NotificationDeliveriesCallCount()).To(Equal(1))
+					Expect(fakePipeline.NotificationDeliveriesArgsForCall(0)).To(Equal(atc.PaginationAPIDefaultLimit))
+				})
+
+				It("returns the deliveries", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"id": 2,
+							"notification": "chat",
+							"event": "failed",
+							"build_id": 12,
+							"build_name": "3",
+							"job_name": "some-job",
+							"status": "pending",
+							"attempts": 1,
+							"response_status": 502,
+							"error": "unexpected response: 502 Bad Gateway",
+							"created_at": 100,
+							"last_attempt_at": 105
+						},
+						{
+							"id": 1,
+							"notification": "audit",
+							"event": "started",
+							"build_id": 12,
+							"build_name": "3",
+							"job_name": "some-job",
+							"status": "delivered",
+							"attempts": 1,
+							"created_at": 40
+						}
+					]`))
+				})
+
+				Context("when a limit is given", func() {
+					BeforeEach(func() {
+						query = "?limit=5"
+					})
+
+					It("fetches that many deliveries", func() {
+						Expect(fakePipeline.NotificationDeliveriesArgsForCall(0)).To(Equal(5))
+					})
+				})
+			})
+
+			Context("when getting the deliveries fails", func() {
+				BeforeEach(func() {
+					fakePipeline.NotificationDeliveriesReturns(nil, errors.New("oops"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
 })
@@ -26,6 +26,8 @@ func Build(build db.Build) atc.Build {
 		TeamName:     build.TeamName(),
 		Status:       string(build.Status()),
 		APIURL:       apiURL,
+		RerunOf:      build.RerunOf(),
+		RerunOfName:  build.RerunOfName(),
 	}
 
 	if !build.StartTime().IsZero() {
@@ -0,0 +1,23 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func BuildApproval(approval db.BuildApproval) atc.BuildApproval {
+	atcApproval := atc.BuildApproval{
+		BuildID:     approval.BuildID,
+		Name:        approval.Name,
+		Role:        approval.Role,
+		Status:      approval.Status,
+		DecidedBy:   approval.DecidedBy,
+		RequestedAt: approval.RequestedAt.Unix(),
+	}
+
+	if !approval.DecidedAt.IsZero() {
+		atcApproval.DecidedAt = approval.DecidedAt.Unix()
+	}
+
+	return atcApproval
+}
@@ -0,0 +1,14 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func BuildArtifact(artifact db.BuildArtifact) atc.BuildArtifact {
+	return atc.BuildArtifact{
+		BuildID:   artifact.BuildID,
+		Name:      artifact.Name,
+		CreatedAt: artifact.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,19 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func CheckHistoryEntry(entry db.CheckHistoryEntry) atc.ResourceCheckHistoryEntry {
+	return atc.ResourceCheckHistoryEntry{
+		ID:         entry.ID,
+		StartTime:  entry.StartTime.Unix(),
+		EndTime:    entry.EndTime.Unix(),
+		WorkerName: entry.WorkerName,
+		ExitStatus: entry.ExitStatus,
+		Stderr:     entry.Stderr,
+		Error:      entry.CheckError,
+		Versions:   entry.Versions,
+	}
+}
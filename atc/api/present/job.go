@@ -36,6 +36,8 @@ func Job(
 			Resource: input.Resource,
 			Passed:   input.Passed,
 			Trigger:  input.Trigger,
+
+			ResolveError: job.InputResolutionErrors()[input.Name],
 		})
 	}
 
@@ -59,6 +61,7 @@ func Job(
 		FinishedBuild:        presentedFinishedBuild,
 		NextBuild:            presentedNextBuild,
 		TransitionBuild:      presentedTransitionBuild,
+		MaxInFlightReached:   job.MaxInFlightReached(),
 
 		Inputs:  sanitizedInputs,
 		Outputs: sanitizedOutputs,
@@ -0,0 +1,28 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func NotificationDelivery(delivery db.NotificationDelivery) atc.NotificationDelivery {
+	presented := atc.NotificationDelivery{
+		ID:             delivery.ID,
+		Notification:   delivery.Notification,
+		Event:          delivery.Event,
+		BuildID:        delivery.BuildID,
+		BuildName:      delivery.BuildName,
+		JobName:        delivery.JobName,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		Error:          delivery.Error,
+		CreatedAt:      delivery.CreatedAt.Unix(),
+	}
+
+	if !delivery.LastAttemptAt.IsZero() {
+		presented.LastAttemptAt = delivery.LastAttemptAt.Unix()
+	}
+
+	return presented
+}
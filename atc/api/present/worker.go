@@ -27,6 +27,9 @@ func Worker(workerInfo db.Worker) atc.Worker {
 		NoProxy:          workerInfo.NoProxy(),
 		ActiveContainers: workerInfo.ActiveContainers(),
 		ActiveVolumes:    workerInfo.ActiveVolumes(),
+		MaxContainers:    workerInfo.MaxContainers(),
+		FreeMemory:       workerInfo.FreeMemory(),
+		FreeDisk:         workerInfo.FreeDisk(),
 		ResourceTypes:    workerInfo.ResourceTypes(),
 		Platform:         workerInfo.Platform(),
 		Tags:             workerInfo.Tags(),
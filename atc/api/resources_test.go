@@ -1037,6 +1037,176 @@ var _ = Describe("Resources API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check-history", func() {
+		var response *http.Response
+		var query string
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/some-resource/check-history"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authenticated and authorized", func() {
+			var fakeResource *dbfakes.FakeResource
+
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+
+				fakeResource = new(dbfakes.FakeResource)
+				fakePipeline.ResourceReturns(fakeResource, true, nil)
+			})
+
+			Context("when the resource has been checked", func() {
+				BeforeEach(func() {
+					exitStatus := 1
+
+					fakeResource.CheckHistoryReturns([]db.CheckHistoryEntry{
+						{
+							ID:         2,
+							StartTime:  time.Unix(100, 0),
+							EndTime:    time.Unix(105, 0),
+							WorkerName: "some-worker",
+							ExitStatus: &exitStatus,
+							Stderr:     "some-stderr",
+							CheckError: "some-error",
+						},
+						{
+							ID:         1,
+							StartTime:  time.Unix(40, 0),
+							EndTime:    time.Unix(42, 0),
+							WorkerName: "some-worker",
+							Versions:   []atc.Version{{"ref": "v1"}},
+						},
+					}, nil)
+				})
+
+				It("returns 200 OK", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns Content-Type 'application/json'", func() {
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+				})
+
+				It("looks up the resource by name", func() {
+					Expect(fakePipeline.ResourceCallCount()).To(Equal(1))
+					Expect(fakePipeline.ResourceArgsForCall(0)).To(Equal("some-resource"))
+				})
+
+				It("fetches the default number of entries", func() {
+					Expect(fakeResource.CheckHistoryCallCount()).To(Equal(1))
+					Expect(fakeResource.CheckHistoryArgsForCall(0)).To(Equal(atc.PaginationAPIDefaultLimit))
+				})
+
+				It("returns the check history", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"id": 2,
+							"start_time": 100,
+							"end_time": 105,
+							"worker_name": "some-worker",
+							"exit_status": 1,
+							"stderr": "some-stderr",
+							"error": "some-error"
+						},
+						{
+							"id": 1,
+							"start_time": 40,
+							"end_time": 42,
+							"worker_name": "some-worker",
+							"versions": [{"ref": "v1"}]
+						}
+					]`))
+				})
+
+				Context("when a limit is given", func() {
+					BeforeEach(func() {
+						query = "?limit=5"
+					})
+
+					It("fetches that many entries", func() {
+						Expect(fakeResource.CheckHistoryArgsForCall(0)).To(Equal(5))
+					})
+				})
+			})
+
+			Context("when the resource has never been checked", func() {
+				BeforeEach(func() {
+					fakeResource.CheckHistoryReturns([]db.CheckHistoryEntry{}, nil)
+				})
+
+				It("returns an empty list", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[]`))
+				})
+			})
+
+			Context("when getting the check history fails", func() {
+				BeforeEach(func() {
+					fakeResource.CheckHistoryReturns(nil, errors.New("oops"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the resource is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when getting the resource fails", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceReturns(nil, false, errors.New("oops"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
 	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/resource-types/:resource_type_name/check", func() {
 		var checkRequestBody atc.CheckRequestBody
 		var response *http.Response
@@ -1139,7 +1309,6 @@ var _ = Describe("Resources API", func() {
 
 	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check/webhook", func() {
 		var (
-			fakeScanner               *radarfakes.FakeScanner
 			checkRequestBody          atc.CheckRequestBody
 			response                  *http.Response
 			fakeResource              *dbfakes.FakeResource
@@ -1148,11 +1317,10 @@ var _ = Describe("Resources API", func() {
 		)
 
 		BeforeEach(func() {
-			fakeScanner = new(radarfakes.FakeScanner)
-			fakeScannerFactory.NewResourceScannerReturns(fakeScanner)
 			checkRequestBody = atc.CheckRequestBody{}
 
 			fakeResource = new(dbfakes.FakeResource)
+			fakeResource.IDReturns(42)
 			fakeResource.NameReturns("resource-name")
 			fakeResourceConfig = new(dbfakes.FakeResourceConfig)
 			fakeResourceConfigVersion = new(dbfakes.FakeResourceConfigVersion)
@@ -1199,7 +1367,7 @@ var _ = Describe("Resources API", func() {
 				})
 
 				It("tries to find the resource config using the resource config id", func() {
-					Eventually(dbResourceConfigFactory.FindResourceConfigByIDCallCount).Should(Equal(1))
+					Expect(dbResourceConfigFactory.FindResourceConfigByIDCallCount()).To(Equal(1))
 					Expect(dbResourceConfigFactory.FindResourceConfigByIDArgsForCall(0)).To(Equal(1))
 				})
 
@@ -1222,16 +1390,37 @@ var _ = Describe("Resources API", func() {
 							fakeResourceConfig.LatestVersionReturns(fakeResourceConfigVersion, true, nil)
 						})
 
-						It("tries to scan with the latest version", func() {
-							Eventually(fakeScanner.ScanFromVersionCallCount).Should(Equal(1))
-							_, actualResourceName, actualFromVersion := fakeScanner.ScanFromVersionArgsForCall(0)
-							Expect(actualResourceName).To(Equal("resource-name"))
+						It("queues a check from the latest version", func() {
+							Expect(dbCheckFactory.CreateResourceCheckCallCount()).To(Equal(1))
+							actualResourceID, actualFromVersion, manuallyTriggered := dbCheckFactory.CreateResourceCheckArgsForCall(0)
+							Expect(actualResourceID).To(Equal(42))
 							Expect(actualFromVersion).To(Equal(atc.Version{"some": "version"}))
+							Expect(manuallyTriggered).To(BeTrue())
 						})
 
 						It("returns 200", func() {
 							Expect(response.StatusCode).To(Equal(http.StatusOK))
 						})
+
+						Context("when a check is already queued", func() {
+							BeforeEach(func() {
+								dbCheckFactory.CreateResourceCheckReturns(false, nil)
+							})
+
+							It("returns 200", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusOK))
+							})
+						})
+
+						Context("when queueing the check fails", func() {
+							BeforeEach(func() {
+								dbCheckFactory.CreateResourceCheckReturns(false, errors.New("disaster"))
+							})
+
+							It("returns 500", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+							})
+						})
 					})
 
 					Context("when the latest version is not found", func() {
@@ -1239,10 +1428,10 @@ var _ = Describe("Resources API", func() {
 							fakeResourceConfig.LatestVersionReturns(nil, false, nil)
 						})
 
-						It("tries to scan with no version specified", func() {
-							Eventually(fakeScanner.ScanFromVersionCallCount).Should(Equal(1))
-							_, actualResourceName, actualFromVersion := fakeScanner.ScanFromVersionArgsForCall(0)
-							Expect(actualResourceName).To(Equal("resource-name"))
+						It("queues a check with no version specified", func() {
+							Expect(dbCheckFactory.CreateResourceCheckCallCount()).To(Equal(1))
+							actualResourceID, actualFromVersion, _ := dbCheckFactory.CreateResourceCheckArgsForCall(0)
+							Expect(actualResourceID).To(Equal(42))
 							Expect(actualFromVersion).To(BeNil())
 						})
 
@@ -1256,8 +1445,12 @@ var _ = Describe("Resources API", func() {
 							fakeResourceConfig.LatestVersionReturns(nil, false, errors.New("disaster"))
 						})
 
-						It("does not scan from version", func() {
-							Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(0))
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+
+						It("does not queue a check", func() {
+							Expect(dbCheckFactory.CreateResourceCheckCallCount()).To(Equal(0))
 						})
 					})
 				})
@@ -1267,8 +1460,10 @@ var _ = Describe("Resources API", func() {
 						dbResourceConfigFactory.FindResourceConfigByIDReturns(nil, false, nil)
 					})
 
-					It("tries to scan", func() {
-						Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(1))
+					It("queues a check with no version specified", func() {
+						Expect(dbCheckFactory.CreateResourceCheckCallCount()).To(Equal(1))
+						_, actualFromVersion, _ := dbCheckFactory.CreateResourceCheckArgsForCall(0)
+						Expect(actualFromVersion).To(BeNil())
 					})
 				})
 			})
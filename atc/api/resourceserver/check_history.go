@@ -0,0 +1,58 @@
+package resourceserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListResourceCheckHistory(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("list-resource-check-history")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resourceName := r.FormValue(":resource_name")
+
+		limit, _ := strconv.Atoi(r.FormValue(atc.PaginationQueryLimit))
+		if limit <= 0 {
+			limit = atc.PaginationAPIDefaultLimit
+		}
+
+		dbResource, found, err := pipeline.Resource(resourceName)
+		if err != nil {
+			logger.Error("failed-to-get-resource", err, lager.Data{"resource": resourceName})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			logger.Debug("resource-not-found", lager.Data{"resource": resourceName})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		entries, err := dbResource.CheckHistory(limit)
+		if err != nil {
+			logger.Error("failed-to-get-check-history", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		presentedEntries := make([]atc.ResourceCheckHistoryEntry, len(entries))
+		for i, entry := range entries {
+			presentedEntries[i] = present.CheckHistoryEntry(entry)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(presentedEntries)
+		if err != nil {
+			logger.Error("failed-to-encode-check-history", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -46,29 +46,35 @@ func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		go func() {
-			var fromVersion atc.Version
-			resourceConfigId := pipelineResource.ResourceConfigID()
-			resourceConfig, found, err := s.resourceConfigFactory.FindResourceConfigByID(resourceConfigId)
+		var fromVersion atc.Version
+		resourceConfigId := pipelineResource.ResourceConfigID()
+		resourceConfig, found, err := s.resourceConfigFactory.FindResourceConfigByID(resourceConfigId)
+		if err != nil {
+			logger.Error("failed-to-get-resource-config", err, lager.Data{"resource-config-id": resourceConfigId})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if found {
+			latestVersion, found, err := resourceConfig.LatestVersion()
 			if err != nil {
-				logger.Error("failed-to-get-resource-config", err, lager.Data{"resource-config-id": resourceConfigId})
+				logger.Error("failed-to-get-latest-resource-version", err, lager.Data{"resource-config-id": resourceConfigId})
+				w.WriteHeader(http.StatusInternalServerError)
 				return
 			}
-
 			if found {
-				latestVersion, found, err := resourceConfig.LatestVersion()
-				if err != nil {
-					logger.Error("failed-to-get-latest-resource-version", err, lager.Data{"resource-config-id": resourceConfigId})
-					return
-				}
-				if found {
-					fromVersion = atc.Version(latestVersion.Version())
-				}
+				fromVersion = atc.Version(latestVersion.Version())
 			}
+		}
 
-			scanner := s.scannerFactory.NewResourceScanner(dbPipeline)
-			scanner.ScanFromVersion(logger, resourceName, fromVersion)
-		}()
+		// a check already waiting in the queue will find the new versions just
+		// the same, so it is not an error if none is created
+		_, err = s.checkFactory.CreateResourceCheck(pipelineResource.ID(), fromVersion, true)
+		if err != nil {
+			logger.Error("failed-to-create-check", err, lager.Data{"resource-name": resourceName})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
 
 		w.WriteHeader(http.StatusOK)
 	})
@@ -20,6 +20,7 @@ type Server struct {
 	variablesFactory      creds.VariablesFactory
 	resourceFactory       db.ResourceFactory
 	resourceConfigFactory db.ResourceConfigFactory
+	checkFactory          db.CheckFactory
 }
 
 func NewServer(
@@ -28,6 +29,7 @@ func NewServer(
 	variablesFactory creds.VariablesFactory,
 	resourceFactory db.ResourceFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
+	checkFactory db.CheckFactory,
 ) *Server {
 	return &Server{
 		logger:                logger,
@@ -35,5 +37,6 @@ func NewServer(
 		variablesFactory:      variablesFactory,
 		resourceFactory:       resourceFactory,
 		resourceConfigFactory: resourceConfigFactory,
+		checkFactory:          checkFactory,
 	}
 }
@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/clock"
@@ -27,7 +28,10 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/creds/noop"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/artifactstore"
+	"github.com/concourse/concourse/atc/db/blobstore"
 	"github.com/concourse/concourse/atc/db/encryption"
+	"github.com/concourse/concourse/atc/db/eventstore"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/db/migration"
 	"github.com/concourse/concourse/atc/engine"
@@ -35,11 +39,14 @@ import (
 	"github.com/concourse/concourse/atc/gc"
 	"github.com/concourse/concourse/atc/lockrunner"
 	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/notifications"
 	"github.com/concourse/concourse/atc/pipelines"
 	"github.com/concourse/concourse/atc/radar"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/scheduler"
+	"github.com/concourse/concourse/atc/scheduler/buildqueue"
 	"github.com/concourse/concourse/atc/syslog"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/image"
 	"github.com/concourse/concourse/atc/wrappa"
@@ -62,6 +69,7 @@ import (
 
 	// dynamically registered credential managers
 	_ "github.com/concourse/concourse/atc/creds/credhub"
+	_ "github.com/concourse/concourse/atc/creds/httpsecrets"
 	_ "github.com/concourse/concourse/atc/creds/kubernetes"
 	_ "github.com/concourse/concourse/atc/creds/secretsmanager"
 	_ "github.com/concourse/concourse/atc/creds/ssm"
@@ -94,6 +102,8 @@ type RunCommand struct {
 	CredentialManagement creds.CredentialManagementConfig `group:"Credential Management"`
 	CredentialManagers   creds.Managers
 
+	EnableRedactSecrets bool `long:"enable-redact-secrets" description:"Redact the credentials resolved during a build from its output. Vars loaded by load_var are redacted unless revealed."`
+
 	EncryptionKey    flag.Cipher `long:"encryption-key"     description:"A 16 or 32 length key used to encrypt sensitive information before storing it in the database."`
 	OldEncryptionKey flag.Cipher `long:"old-encryption-key" description:"Encryption key previously used for encrypting sensitive information. If provided without a new key, data is encrypted. If provided with a new key, data is re-encrypted."`
 
@@ -106,8 +116,27 @@ type RunCommand struct {
 	ResourceCheckingInterval     time.Duration `long:"resource-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources."`
 	ResourceTypeCheckingInterval time.Duration `long:"resource-type-checking-interval" default:"1m" description:"Interval on which to check for new versions of resource types."`
 
-	ContainerPlacementStrategy        string        `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"least-build-containers" description:"Method by which a worker is selected during container placement."`
+	CheckerWorkers  int     `long:"checker-workers"   default:"10" description:"Maximum number of checks to run at once on this ATC."`
+	ChecksPerSecond float64 `long:"checks-per-second"              description:"Maximum number of checks to start per second across all ATCs. Unlimited if not set."`
+
+	ContainerPlacementStrategy        string        `long:"container-placement-strategy" default:"volume-locality" description:"Comma-separated methods by which a worker is selected during container placement, each narrowing down the workers left by the previous one: volume-locality, fewest-build-containers, resource-aware or random."`
 	BaggageclaimResponseHeaderTimeout time.Duration `long:"baggageclaim-response-header-timeout" default:"1m" description:"How long to wait for Baggageclaim to send the response header."`
+	WorkerWaitTimeout                 time.Duration `long:"worker-wait-timeout" description:"How long a step waits for a worker satisfying its tags, team and platform to register before erroring. Steps error immediately if not set."`
+
+	BuildQueue struct {
+		TeamWeights        map[string]int `long:"team-weight"            description:"Give a team a larger share of the running builds when builds are queued. Builds are only queued when a max in flight is set. Teams have a weight of 1 by default. Can be specified multiple times." value-name:"TEAM:WEIGHT"`
+		MaxInFlightPerTeam int            `long:"max-in-flight-per-team" description:"Maximum number of builds each team may run at once across the cluster, 0 means unlimited."`
+		TeamMaxInFlight    map[string]int `long:"team-max-in-flight"     description:"Maximum number of builds a team may run at once across the cluster, overriding --build-queue-max-in-flight-per-team. Can be specified multiple times." value-name:"TEAM:LIMIT"`
+		HeartbeatTimeout   time.Duration  `long:"heartbeat-timeout"      default:"1m" description:"How long a queued build keeps its place in the queue without being considered by the scheduler, e.g. when its pipeline is paused."`
+	} `group:"Build Queue" namespace:"build-queue"`
+
+	ResourceAwarePlacement struct {
+		LocalityWeight          float64 `long:"locality-weight"           default:"1"   description:"How much having the step's inputs already on a worker counts towards choosing it."`
+		LoadWeight              float64 `long:"load-weight"               default:"1"   description:"How much a worker's free containers, memory and disk count towards choosing it."`
+		MaxContainerUtilization float64 `long:"max-container-utilization" default:"0.9" description:"Fraction of its max containers above which a worker is not chosen, 0 means no limit."`
+		MinFreeMemory           int64   `long:"min-free-memory"           description:"Megabytes of memory a worker must have free to be chosen, 0 means no limit."`
+		MinFreeDisk             int64   `long:"min-free-disk"             description:"Megabytes of disk a worker must have free to be chosen, 0 means no limit."`
+	} `group:"Resource-Aware Container Placement" namespace:"resource-aware-placement"`
 
 	CLIArtifactsDir flag.Dir `long:"cli-artifacts-dir" description:"Directory containing downloadable CLI binaries."`
 
@@ -127,6 +156,8 @@ type RunCommand struct {
 		CaptureErrorMetrics bool              `long:"capture-error-metrics" description:"Enable capturing of error log metrics"`
 	} `group:"Metrics & Diagnostics"`
 
+	Tracing tracing.Config `group:"Tracing" namespace:"tracing"`
+
 	Server struct {
 		XFrameOptions string `long:"x-frame-options" description:"The value to set for X-Frame-Options. If omitted, the header is not set."`
 	} `group:"Web Server"`
@@ -138,8 +169,23 @@ type RunCommand struct {
 
 		OneOffBuildGracePeriod time.Duration `long:"one-off-grace-period" default:"5m" description:"Period after which one-off build containers will be garbage-collected."`
 		MissingGracePeriod     time.Duration `long:"missing-grace-period" default:"5m" description:"Period after which to reap containers and volumes that were created but went missing from the worker."`
+
+		StaleCheckTimeout     time.Duration `long:"stale-check-timeout" default:"2h" description:"Period after which checks which were started but never finished, e.g. because their ATC went away, are errored."`
+		CheckHistoryRetention time.Duration `long:"check-history-retention" default:"24h" description:"Period for which to keep the history of resource checks."`
+
+		NotificationDeliveryRetention time.Duration `long:"notification-delivery-retention" default:"168h" description:"Period for which to keep the log of delivered and failed notifications."`
 	} `group:"Garbage Collection" namespace:"gc"`
 
+	Notifications struct {
+		Interval time.Duration `long:"interval" default:"10s" description:"Interval on which to send pending notifications."`
+		Timeout  time.Duration `long:"timeout" default:"30s" description:"Timeout for each request to a notification's webhook."`
+
+		MaxInFlightPerEndpoint int `long:"max-in-flight-per-endpoint" default:"4" description:"Maximum number of requests sent to each webhook host at once."`
+
+		AllowedNetworks []notifications.CIDR `long:"allowed-network" description:"Network to which webhooks may be sent, e.g. 203.0.113.0/24. Any network which is not denied is allowed if none are given. Can be specified multiple times." value-name:"CIDR"`
+		DeniedNetworks  []notifications.CIDR `long:"denied-network" default:"127.0.0.0/8" default:"::1/128" default:"169.254.0.0/16" default:"fe80::/10" default:"0.0.0.0/8" description:"Network to which webhooks may not be sent, overriding --notifications-allowed-network. Loopback, link-local and unspecified addresses are denied by default. Can be specified multiple times." value-name:"CIDR"`
+	} `group:"Notifications" namespace:"notifications"`
+
 	BuildTrackerInterval time.Duration `long:"build-tracker-interval" default:"10s" description:"Interval on which to run build tracking."`
 
 	TelemetryOptIn bool `long:"telemetry-opt-in" hidden:"true" description:"Enable anonymous concourse version reporting."`
@@ -154,10 +200,21 @@ type RunCommand struct {
 		Hostname      string        `long:"syslog-hostname" description:"Client hostname with which the build logs will be sent to the syslog server." default:"atc-syslog-drainer"`
 		Address       string        `long:"syslog-address" description:"Remote syslog server address with port (Example: 0.0.0.0:514)."`
 		Transport     string        `long:"syslog-transport" description:"Transport protocol for syslog messages (Currently supporting tcp, udp & tls)."`
+		Format        string        `long:"syslog-format" description:"Format of the messages sent to the syslog server. 'rfc5424' and 'json' include build status events and the build's metadata as structured fields." choice:"plain" choice:"rfc5424" choice:"json" default:"plain"`
 		DrainInterval time.Duration `long:"syslog-drain-interval" description:"Interval over which checking is done for new build logs to send to syslog server (duration measurement units are s/m/h; eg. 30s/30m/1h)" default:"30s"`
 		CACerts       []string      `long:"syslog-ca-cert"              description:"Paths to PEM-encoded CA cert files to use to verify the Syslog server SSL cert."`
 	} ` group:"Syslog Drainer Configuration"`
 
+	BuildEventStore struct {
+		Dir flag.Dir           `long:"dir" description:"Directory in which to store the events of completed builds rather than in the database, e.g. a shared volume."`
+		S3  blobstore.S3Config `namespace:"s3"`
+	} `group:"Build Event Store" namespace:"build-event-store"`
+
+	BuildArtifactStore struct {
+		Dir flag.Dir           `long:"dir" description:"Directory in which to store the artifacts persisted by tasks, e.g. a shared volume."`
+		S3  blobstore.S3Config `namespace:"s3"`
+	} `group:"Build Artifact Store" namespace:"build-artifact-store"`
+
 	Auth struct {
 		AuthFlags     skycmd.AuthFlags
 		MainTeamFlags skycmd.AuthTeamFlags `group:"Authentication (Main Team)" namespace:"main-team"`
@@ -369,6 +426,10 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		return nil, err
 	}
 
+	if err := cmd.configureTracing(logger); err != nil {
+		return nil, err
+	}
+
 	lockConn, err := cmd.constructLockConn(retryingDriverName)
 	if err != nil {
 		return nil, err
@@ -386,12 +447,17 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		return nil, err
 	}
 
+	buildStores, err := cmd.buildStores()
+	if err != nil {
+		return nil, err
+	}
+
 	storage, err := storage.NewPostgresStorage(logger, cmd.Postgres)
 	if err != nil {
 		return nil, err
 	}
 
-	members, err := cmd.constructMembers(logger, reconfigurableSink, apiConn, backendConn, storage, lockFactory)
+	members, err := cmd.constructMembers(logger, reconfigurableSink, apiConn, backendConn, storage, lockFactory, buildStores)
 	if err != nil {
 		return nil, err
 	}
@@ -418,6 +484,9 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 	}
 
 	onExit := func() {
+		// export the spans of the builds which were interrupted
+		tracing.Deconfigure()
+
 		for _, closer := range []Closer{lockConn, apiConn, backendConn, storage} {
 			closer.Close()
 		}
@@ -433,6 +502,7 @@ func (cmd *RunCommand) constructMembers(
 	backendConn db.Conn,
 	storage storage.Storage,
 	lockFactory lock.LockFactory,
+	buildStores db.BuildStores,
 ) ([]grouper.Member, error) {
 	if cmd.TelemetryOptIn {
 		url := fmt.Sprintf("http://telemetry.concourse-ci.org/?version=%s", concourse.Version)
@@ -444,12 +514,12 @@ func (cmd *RunCommand) constructMembers(
 		}()
 	}
 
-	apiMembers, err := cmd.constructAPIMembers(logger, reconfigurableSink, apiConn, storage, lockFactory)
+	apiMembers, err := cmd.constructAPIMembers(logger, reconfigurableSink, apiConn, storage, lockFactory, buildStores)
 	if err != nil {
 		return nil, err
 	}
 
-	backendMembers, err := cmd.constructBackendMembers(logger, backendConn, lockFactory)
+	backendMembers, err := cmd.constructBackendMembers(logger, backendConn, lockFactory, buildStores)
 	if err != nil {
 		return nil, err
 	}
@@ -463,8 +533,9 @@ func (cmd *RunCommand) constructAPIMembers(
 	dbConn db.Conn,
 	storage storage.Storage,
 	lockFactory lock.LockFactory,
+	buildStores db.BuildStores,
 ) ([]grouper.Member, error) {
-	teamFactory := db.NewTeamFactory(dbConn, lockFactory)
+	teamFactory := db.NewTeamFactory(dbConn, lockFactory, buildStores)
 
 	_, err := teamFactory.CreateDefaultTeamIfNotExists()
 	if err != nil {
@@ -524,10 +595,13 @@ func (cmd *RunCommand) constructAPIMembers(
 		cmd.BaggageclaimResponseHeaderTimeout,
 	)
 
-	workerClient := cmd.constructWorkerPool(
+	workerClient, err := cmd.constructWorkerPool(
 		logger,
 		workerProvider,
 	)
+	if err != nil {
+		return nil, err
+	}
 
 	resourceFetcher := resourceFetcherFactory.FetcherFor(workerClient)
 	resourceFactory := resource.NewResourceFactory(workerClient)
@@ -536,12 +610,14 @@ func (cmd *RunCommand) constructAPIMembers(
 		return nil, err
 	}
 
-	variablesFactory, err := cmd.variablesFactory(logger)
+	variablesFactory, err := cmd.variablesFactory(logger, dbConn, teamFactory)
 	if err != nil {
 		return nil, err
 	}
 
-	engine := cmd.constructEngine(workerClient, resourceFetcher, resourceFactory, dbResourceCacheFactory, dbResourceConfigFactory, variablesFactory, defaultLimits)
+	engine := cmd.constructEngine(workerClient, resourceFetcher, resourceFactory, dbResourceCacheFactory, dbResourceConfigFactory, variablesFactory, teamFactory, defaultLimits)
+
+	buildQueue := cmd.constructBuildQueue(dbConn)
 
 	radarSchedulerFactory := pipelines.NewRadarSchedulerFactory(
 		resourceFactory,
@@ -549,6 +625,7 @@ func (cmd *RunCommand) constructAPIMembers(
 		cmd.ResourceTypeCheckingInterval,
 		cmd.ResourceCheckingInterval,
 		engine,
+		buildQueue,
 	)
 
 	radarScannerFactory := radar.NewScannerFactory(
@@ -562,12 +639,13 @@ func (cmd *RunCommand) constructAPIMembers(
 
 	drain := make(chan struct{})
 	credsManagers := cmd.CredentialManagers
-	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
-	dbJobFactory := db.NewJobFactory(dbConn, lockFactory)
+	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory, buildStores)
+	dbJobFactory := db.NewJobFactory(dbConn, lockFactory, buildStores)
 	dbResourceFactory := db.NewResourceFactory(dbConn, lockFactory)
 	dbContainerRepository := db.NewContainerRepository(dbConn)
 	gcContainerDestroyer := gc.NewDestroyer(logger, dbContainerRepository, dbVolumeRepository)
-	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, cmd.GC.OneOffBuildGracePeriod)
+	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, buildStores, cmd.GC.OneOffBuildGracePeriod)
+	dbCheckFactory := db.NewCheckFactory(dbConn, lockFactory, buildStores)
 	accessFactory := accessor.NewAccessFactory(authHandler.PublicKey())
 
 	apiHandler, err := cmd.constructAPIHandler(
@@ -583,6 +661,8 @@ func (cmd *RunCommand) constructAPIMembers(
 		gcContainerDestroyer,
 		dbBuildFactory,
 		dbResourceConfigFactory,
+		dbCheckFactory,
+		buildQueue,
 		engine,
 		workerClient,
 		workerProvider,
@@ -675,6 +755,7 @@ func (cmd *RunCommand) constructBackendMembers(
 	logger lager.Logger,
 	dbConn db.Conn,
 	lockFactory lock.LockFactory,
+	buildStores db.BuildStores,
 ) ([]grouper.Member, error) {
 
 	if cmd.Syslog.Address != "" && cmd.Syslog.Transport == "" {
@@ -688,7 +769,7 @@ func (cmd *RunCommand) constructBackendMembers(
 
 	drain := make(chan struct{})
 
-	teamFactory := db.NewTeamFactory(dbConn, lockFactory)
+	teamFactory := db.NewTeamFactory(dbConn, lockFactory, buildStores)
 
 	dbResourceCacheFactory := db.NewResourceCacheFactory(dbConn, lockFactory)
 	resourceFetcherFactory := resource.NewFetcherFactory(lockFactory, clock.NewClock(), dbResourceCacheFactory)
@@ -721,10 +802,13 @@ func (cmd *RunCommand) constructBackendMembers(
 		workerVersion,
 		cmd.BaggageclaimResponseHeaderTimeout,
 	)
-	workerClient := cmd.constructWorkerPool(
+	workerClient, err := cmd.constructWorkerPool(
 		logger,
 		workerProvider,
 	)
+	if err != nil {
+		return nil, err
+	}
 
 	resourceFetcher := resourceFetcherFactory.FetcherFor(workerClient)
 	resourceFactory := resource.NewResourceFactory(workerClient)
@@ -733,11 +817,13 @@ func (cmd *RunCommand) constructBackendMembers(
 		return nil, err
 	}
 
-	variablesFactory, err := cmd.variablesFactory(logger)
+	variablesFactory, err := cmd.variablesFactory(logger, dbConn, teamFactory)
 	if err != nil {
 		return nil, err
 	}
-	engine := cmd.constructEngine(workerClient, resourceFetcher, resourceFactory, dbResourceCacheFactory, dbResourceConfigFactory, variablesFactory, defaultLimits)
+	engine := cmd.constructEngine(workerClient, resourceFetcher, resourceFactory, dbResourceCacheFactory, dbResourceConfigFactory, variablesFactory, teamFactory, defaultLimits)
+
+	buildQueue := cmd.constructBuildQueue(dbConn)
 
 	radarSchedulerFactory := pipelines.NewRadarSchedulerFactory(
 		resourceFactory,
@@ -745,14 +831,25 @@ func (cmd *RunCommand) constructBackendMembers(
 		cmd.ResourceTypeCheckingInterval,
 		cmd.ResourceCheckingInterval,
 		engine,
+		buildQueue,
 	)
 	dbWorkerLifecycle := db.NewWorkerLifecycle(dbConn)
 	dbResourceCacheLifecycle := db.NewResourceCacheLifecycle(dbConn)
 	dbContainerRepository := db.NewContainerRepository(dbConn)
 	resourceConfigCheckSessionLifecycle := db.NewResourceConfigCheckSessionLifecycle(dbConn)
-	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, cmd.GC.OneOffBuildGracePeriod)
+	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, buildStores, cmd.GC.OneOffBuildGracePeriod)
 	bus := dbConn.Bus()
-	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
+	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory, buildStores)
+	dbCheckFactory := db.NewCheckFactory(dbConn, lockFactory, buildStores)
+	dbNotificationDeliveryFactory := db.NewNotificationDeliveryFactory(dbConn)
+	radarScannerFactory := radar.NewScannerFactory(
+		resourceFactory,
+		dbResourceConfigFactory,
+		cmd.ResourceTypeCheckingInterval,
+		cmd.ResourceCheckingInterval,
+		cmd.ExternalURL.String(),
+		variablesFactory,
+	)
 	members := []grouper.Member{
 		{Name: "drainer", Runner: drainer{
 			logger: logger.Session("drain"),
@@ -774,6 +871,29 @@ func (cmd *RunCommand) constructBackendMembers(
 			Interval: 10 * time.Second,
 			Clock:    clock.NewClock(),
 		}},
+		{Name: "check-enqueuer", Runner: lockrunner.NewRunner(
+			logger.Session("check-enqueuer"),
+			radar.NewCheckEnqueuer(
+				clock.NewClock(),
+				dbPipelineFactory,
+				dbCheckFactory,
+				cmd.ResourceTypeCheckingInterval,
+				cmd.ResourceCheckingInterval,
+			),
+			"check-enqueuer",
+			lockFactory,
+			clock.NewClock(),
+			10*time.Second,
+		)},
+		{Name: "checker", Runner: radar.NewChecker(
+			logger.Session("checker"),
+			cmd.Developer.Noop,
+			clock.NewClock(),
+			dbCheckFactory,
+			radarScannerFactory,
+			cmd.CheckerWorkers,
+			cmd.ChecksPerSecond,
+		)},
 		{Name: "builds", Runner: builds.TrackerRunner{
 			Tracker: builds.NewTracker(
 				logger.Session("build-tracker"),
@@ -827,12 +947,96 @@ func (cmd *RunCommand) constructBackendMembers(
 					cmd.MaxBuildLogsToRetain,
 				),
 				syslogDrainConfigured,
+				buildStores.Events,
 			),
 			"build-reaper",
 			lockFactory,
 			clock.NewClock(),
 			30*time.Second,
 		)},
+		{Name: "check-collector", Runner: lockrunner.NewRunner(
+			logger.Session("check-collector"),
+			gc.NewCheckCollector(
+				dbCheckFactory,
+				cmd.GC.StaleCheckTimeout,
+				cmd.GC.CheckHistoryRetention,
+			),
+			"check-collector",
+			lockFactory,
+			clock.NewClock(),
+			cmd.GC.Interval,
+		)},
+		{Name: "notification-deliverer", Runner: lockrunner.NewRunner(
+			logger.Session("notification-deliverer"),
+			notifications.NewDeliverer(
+				dbNotificationDeliveryFactory,
+				dbBuildFactory,
+				variablesFactory,
+				cmd.ExternalURL.String(),
+				notifications.NewHTTPClient(cmd.Notifications.Timeout, notifications.DestinationPolicy{
+					Allowed: cmd.Notifications.AllowedNetworks,
+					Denied:  cmd.Notifications.DeniedNetworks,
+				}),
+				clock.NewClock(),
+				100,
+				cmd.Notifications.MaxInFlightPerEndpoint,
+			),
+			"notification-deliverer",
+			lockFactory,
+			clock.NewClock(),
+			cmd.Notifications.Interval,
+		)},
+		{Name: "notification-delivery-collector", Runner: lockrunner.NewRunner(
+			logger.Session("notification-delivery-collector"),
+			gc.NewNotificationDeliveryCollector(
+				dbNotificationDeliveryFactory,
+				cmd.GC.NotificationDeliveryRetention,
+			),
+			"notification-delivery-collector",
+			lockFactory,
+			clock.NewClock(),
+			cmd.GC.Interval,
+		)},
+	}
+
+	if buildStores.Events != nil {
+		members = append(members, grouper.Member{
+			Name: "build-event-archiver", Runner: lockrunner.NewRunner(
+				logger.Session("build-event-archiver"),
+				gc.NewBuildEventArchiver(
+					dbBuildFactory,
+					buildStores.Events,
+					100,
+				),
+				"build-event-archiver",
+				lockFactory,
+				clock.NewClock(),
+				30*time.Second,
+			)},
+		)
+	}
+
+	for name, manager := range cmd.CredentialManagers {
+		sharedCacheManager, ok := manager.(creds.SharedCacheManager)
+		if !ok || !manager.IsConfigured() {
+			continue
+		}
+
+		reaper, enabled := sharedCacheManager.SharedCacheReaper(logger.Session("secret-cache-reaper", lager.Data{"name": name}))
+		if !enabled {
+			continue
+		}
+
+		members = append(members, grouper.Member{
+			Name: "secret-cache-reaper", Runner: lockrunner.NewRunner(
+				logger.Session("secret-cache-reaper"),
+				reaper,
+				"secret-cache-reaper",
+				lockFactory,
+				clock.NewClock(),
+				time.Minute,
+			)},
+		)
 	}
 
 	//Syslog Drainer Configuration
@@ -845,6 +1049,7 @@ func (cmd *RunCommand) constructBackendMembers(
 					cmd.Syslog.Transport,
 					cmd.Syslog.Address,
 					cmd.Syslog.Hostname,
+					cmd.Syslog.Format,
 					cmd.Syslog.CACerts,
 					dbBuildFactory,
 				),
@@ -865,7 +1070,7 @@ func workerVersion() (version.Version, error) {
 	return version.NewVersionFromString(concourse.WorkerVersion)
 }
 
-func (cmd *RunCommand) variablesFactory(logger lager.Logger) (creds.VariablesFactory, error) {
+func (cmd *RunCommand) variablesFactory(logger lager.Logger, dbConn db.Conn, teamFactory db.TeamFactory) (creds.VariablesFactory, error) {
 	var variablesFactory creds.VariablesFactory = noop.NewNoopFactory()
 	for name, manager := range cmd.CredentialManagers {
 		if !manager.IsConfigured() {
@@ -888,6 +1093,10 @@ func (cmd *RunCommand) variablesFactory(logger lager.Logger) (creds.VariablesFac
 			return nil, fmt.Errorf("credential manager '%s' misconfigured: %s", name, err)
 		}
 
+		if sharedCacheManager, ok := manager.(creds.SharedCacheManager); ok {
+			sharedCacheManager.UseSharedCache(db.NewSecretCache(dbConn))
+		}
+
 		variablesFactory, err = manager.NewVariablesFactory(credsLogger)
 		if err != nil {
 			return nil, err
@@ -895,7 +1104,72 @@ func (cmd *RunCommand) variablesFactory(logger lager.Logger) (creds.VariablesFac
 
 		break
 	}
-	return creds.NewRetryableVariablesFactory(variablesFactory, cmd.CredentialManagement.RetryConfig), nil
+
+	varSourcePool := creds.NewVarSourcePool(
+		logger.Session("var-sources"),
+		cmd.CredentialManagement.VarSourceCacheDuration,
+		clock.NewClock(),
+	)
+
+	return creds.NewVarSourcesVariablesFactory(
+		creds.NewRetryableVariablesFactory(variablesFactory, cmd.CredentialManagement.RetryConfig),
+		pipelineVarSourcesLookup(teamFactory),
+		varSourcePool,
+	), nil
+}
+
+type cachedVarSources struct {
+	configVersion db.ConfigVersion
+	varSources    atc.VarSourceConfigs
+}
+
+// pipelineVarSourcesLookup looks up a pipeline's var sources, only decrypting
+// them again once the pipeline's config has changed.
+func pipelineVarSourcesLookup(teamFactory db.TeamFactory) creds.VarSourcesLookup {
+	var lock sync.Mutex
+	cache := map[int]cachedVarSources{}
+
+	return func(teamName string, pipelineName string) (atc.VarSourceConfigs, error) {
+		team, found, err := teamFactory.FindTeam(teamName)
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			return nil, nil
+		}
+
+		pipeline, found, err := team.Pipeline(pipelineName)
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			return nil, nil
+		}
+
+		lock.Lock()
+		cached, found := cache[pipeline.ID()]
+		lock.Unlock()
+
+		if found && cached.configVersion == pipeline.ConfigVersion() {
+			return cached.varSources, nil
+		}
+
+		varSources, err := pipeline.VarSources()
+		if err != nil {
+			return nil, err
+		}
+
+		lock.Lock()
+		cache[pipeline.ID()] = cachedVarSources{
+			configVersion: pipeline.ConfigVersion(),
+			varSources:    varSources,
+		}
+		lock.Unlock()
+
+		return varSources, nil
+	}
 }
 
 func (cmd *RunCommand) newKey() *encryption.Key {
@@ -1081,6 +1355,20 @@ func (cmd *RunCommand) validate() error {
 		)
 	}
 
+	if cmd.BuildEventStore.Dir != "" && cmd.BuildEventStore.S3.IsConfigured() {
+		errs = multierror.Append(
+			errs,
+			errors.New("must specify only one of --build-event-store-dir and --build-event-store-s3-bucket"),
+		)
+	}
+
+	if cmd.BuildArtifactStore.Dir != "" && cmd.BuildArtifactStore.S3.IsConfigured() {
+		errs = multierror.Append(
+			errs,
+			errors.New("must specify only one of --build-artifact-store-dir and --build-artifact-store-s3-bucket"),
+		)
+	}
+
 	return errs.ErrorOrNil()
 }
 
@@ -1105,6 +1393,57 @@ func (cmd *RunCommand) configureMetrics(logger lager.Logger) error {
 	return metric.Initialize(logger.Session("metrics"), host, cmd.Metrics.Attributes)
 }
 
+func (cmd *RunCommand) configureTracing(logger lager.Logger) error {
+	if !cmd.Tracing.IsConfigured() {
+		return nil
+	}
+
+	exporter, err := cmd.Tracing.Exporter()
+	if err != nil {
+		return err
+	}
+
+	tracing.Configure(logger.Session("tracing"), exporter)
+
+	return nil
+}
+
+func (cmd *RunCommand) buildStores() (db.BuildStores, error) {
+	var stores db.BuildStores
+
+	eventBlobs, err := blobStore(cmd.BuildEventStore.Dir, cmd.BuildEventStore.S3)
+	if err != nil {
+		return db.BuildStores{}, err
+	}
+
+	if eventBlobs != nil {
+		stores.Events = eventstore.New(eventBlobs)
+	}
+
+	artifactBlobs, err := blobStore(cmd.BuildArtifactStore.Dir, cmd.BuildArtifactStore.S3)
+	if err != nil {
+		return db.BuildStores{}, err
+	}
+
+	if artifactBlobs != nil {
+		stores.Artifacts = artifactstore.New(artifactBlobs)
+	}
+
+	return stores, nil
+}
+
+func blobStore(dir flag.Dir, s3Config blobstore.S3Config) (blobstore.Store, error) {
+	if dir != "" {
+		return blobstore.NewFileStore(dir.Path()), nil
+	}
+
+	if s3Config.IsConfigured() {
+		return blobstore.NewS3Store(s3Config)
+	}
+
+	return nil, nil
+}
+
 func (cmd *RunCommand) constructDBConn(
 	driverName string,
 	logger lager.Logger,
@@ -1152,22 +1491,32 @@ func (cmd *RunCommand) constructLockConn(driverName string) (*sql.DB, error) {
 func (cmd *RunCommand) constructWorkerPool(
 	logger lager.Logger,
 	workerProvider worker.WorkerProvider,
-) worker.Client {
+) (worker.Client, error) {
+	strategyOptions := worker.ContainerPlacementStrategyOptions{
+		ResourceAware: worker.ResourceAwarePlacementOptions{
+			LocalityWeight:          cmd.ResourceAwarePlacement.LocalityWeight,
+			LoadWeight:              cmd.ResourceAwarePlacement.LoadWeight,
+			MaxContainerUtilization: cmd.ResourceAwarePlacement.MaxContainerUtilization,
+			MinFreeMemory:           cmd.ResourceAwarePlacement.MinFreeMemory * 1024 * 1024,
+			MinFreeDisk:             cmd.ResourceAwarePlacement.MinFreeDisk * 1024 * 1024,
+		},
+	}
 
-	var strategy worker.ContainerPlacementStrategy
-	switch cmd.ContainerPlacementStrategy {
-	case "random":
-		strategy = worker.NewRandomPlacementStrategy()
-	case "least-build-containers":
-		strategy = worker.NewLeastBuildContainersPlacementStrategy()
-	default:
-		strategy = worker.NewVolumeLocalityPlacementStrategy()
+	strategy, err := worker.NewContainerPlacementStrategy(
+		strings.Split(cmd.ContainerPlacementStrategy, ","),
+		strategyOptions,
+	)
+	if err != nil {
+		return nil, err
 	}
 
 	return worker.NewPool(
+		clock.NewClock(),
 		workerProvider,
 		strategy,
-	)
+		strategyOptions,
+		cmd.WorkerWaitTimeout,
+	), nil
 }
 
 func (cmd *RunCommand) configureAuthForDefaultTeam(teamFactory db.TeamFactory) error {
@@ -1193,6 +1542,18 @@ func (cmd *RunCommand) configureAuthForDefaultTeam(teamFactory db.TeamFactory) e
 	return nil
 }
 
+func (cmd *RunCommand) constructBuildQueue(dbConn db.Conn) buildqueue.Queue {
+	return buildqueue.NewQueue(
+		db.NewBuildQueue(dbConn),
+		buildqueue.Policy{
+			TeamWeights:        cmd.BuildQueue.TeamWeights,
+			MaxInFlightPerTeam: cmd.BuildQueue.MaxInFlightPerTeam,
+			TeamMaxInFlight:    cmd.BuildQueue.TeamMaxInFlight,
+		},
+		cmd.BuildQueue.HeartbeatTimeout,
+	)
+}
+
 func (cmd *RunCommand) constructEngine(
 	workerClient worker.Client,
 	resourceFetcher resource.Fetcher,
@@ -1200,6 +1561,7 @@ func (cmd *RunCommand) constructEngine(
 	resourceCacheFactory db.ResourceCacheFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	variablesFactory creds.VariablesFactory,
+	teamFactory db.TeamFactory,
 	defaultLimits atc.ContainerLimits,
 ) engine.Engine {
 	gardenFactory := exec.NewGardenFactory(
@@ -1209,6 +1571,7 @@ func (cmd *RunCommand) constructEngine(
 		resourceCacheFactory,
 		resourceConfigFactory,
 		variablesFactory,
+		teamFactory,
 		defaultLimits,
 	)
 
@@ -1216,6 +1579,7 @@ func (cmd *RunCommand) constructEngine(
 		gardenFactory,
 		engine.NewBuildDelegateFactory(),
 		cmd.ExternalURL.String(),
+		cmd.EnableRedactSecrets,
 	)
 
 	execV1Engine := engine.NewExecV1DummyEngine()
@@ -1267,6 +1631,8 @@ func (cmd *RunCommand) constructAPIHandler(
 	gcContainerDestroyer gc.Destroyer,
 	dbBuildFactory db.BuildFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
+	dbCheckFactory db.CheckFactory,
+	buildQueue buildqueue.Queue,
 	engine engine.Engine,
 	workerClient worker.Client,
 	workerProvider worker.WorkerProvider,
@@ -1310,6 +1676,8 @@ func (cmd *RunCommand) constructAPIHandler(
 		gcContainerDestroyer,
 		dbBuildFactory,
 		resourceConfigFactory,
+		dbCheckFactory,
+		buildQueue,
 
 		cmd.PeerURLOrDefault().String(),
 		buildserver.NewEventHandler,
@@ -1367,19 +1735,6 @@ func (cmd *RunCommand) constructPipelineSyncer(
 		func(pipeline db.Pipeline) ifrit.Runner {
 			variables := variablesFactory.NewVariables(pipeline.TeamName(), pipeline.Name())
 			return grouper.NewParallel(os.Interrupt, grouper.Members{
-				{
-					Name: fmt.Sprintf("radar:%d", pipeline.ID()),
-					Runner: radar.NewRunner(
-						logger.Session("radar").WithData(lager.Data{
-							"team":     pipeline.TeamName(),
-							"pipeline": pipeline.Name(),
-						}),
-						cmd.Developer.Noop,
-						radarSchedulerFactory.BuildScanRunnerFactory(pipeline, cmd.ExternalURL.String(), variables),
-						pipeline,
-						1*time.Minute,
-					),
-				},
 				{
 					Name: fmt.Sprintf("scheduler:%d", pipeline.ID()),
 					Runner: &scheduler.Runner{
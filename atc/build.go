@@ -22,6 +22,8 @@ type Build struct {
 	StartTime    int64  `json:"start_time,omitempty"`
 	EndTime      int64  `json:"end_time,omitempty"`
 	ReapTime     int64  `json:"reap_time,omitempty"`
+	RerunOf      int    `json:"rerun_of,omitempty"`
+	RerunOfName  string `json:"rerun_of_name,omitempty"`
 }
 
 func (b Build) IsRunning() bool {
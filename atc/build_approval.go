@@ -0,0 +1,59 @@
+package atc
+
+// Team roles which may be required to decide an approval step, from the most
+// to the least privileged.
+const (
+	RoleOwner  = "owner"
+	RoleMember = "member"
+	RoleViewer = "viewer"
+)
+
+var Roles = []string{RoleOwner, RoleMember, RoleViewer}
+
+// DefaultApprovalRole is required to decide an approval step which does not
+// specify a role.
+const DefaultApprovalRole = RoleMember
+
+type BuildApprovalStatus string
+
+const (
+	ApprovalStatusWaiting  BuildApprovalStatus = "waiting"
+	ApprovalStatusApproved BuildApprovalStatus = "approved"
+	ApprovalStatusRejected BuildApprovalStatus = "rejected"
+	ApprovalStatusExpired  BuildApprovalStatus = "expired"
+)
+
+type BuildApproval struct {
+	BuildID     int                 `json:"build_id"`
+	Name        string              `json:"name"`
+	Role        string              `json:"role"`
+	Status      BuildApprovalStatus `json:"status"`
+	DecidedBy   string              `json:"decided_by,omitempty"`
+	RequestedAt int64               `json:"requested_at"`
+	DecidedAt   int64               `json:"decided_at,omitempty"`
+}
+
+// RoleSatisfies returns true if a user with the given role on a team has at
+// least the privileges of the required role.
+func RoleSatisfies(role string, requiredRole string) bool {
+	switch requiredRole {
+	case RoleOwner:
+		return role == RoleOwner
+	case RoleMember:
+		return role == RoleOwner || role == RoleMember
+	case RoleViewer:
+		return role == RoleOwner || role == RoleMember || role == RoleViewer
+	default:
+		return false
+	}
+}
+
+func isRole(role string) bool {
+	for _, r := range Roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,7 @@
+package atc
+
+type BuildArtifact struct {
+	BuildID   int    `json:"build_id"`
+	Name      string `json:"name"`
+	CreatedAt int64  `json:"created_at"`
+}
@@ -0,0 +1,26 @@
+package atc
+
+type BuildQueue struct {
+	Teams  []BuildQueueTeam  `json:"teams"`
+	Builds []BuildQueueEntry `json:"builds"`
+}
+
+type BuildQueueTeam struct {
+	Name        string `json:"name"`
+	Active      int    `json:"active"`
+	Queued      int    `json:"queued"`
+	Weight      int    `json:"weight"`
+	MaxInFlight int    `json:"max_in_flight,omitempty"`
+}
+
+type BuildQueueEntry struct {
+	Position     int    `json:"position"`
+	BuildID      int    `json:"build_id"`
+	BuildName    string `json:"build_name"`
+	TeamName     string `json:"team_name"`
+	PipelineName string `json:"pipeline_name,omitempty"`
+	JobName      string `json:"job_name,omitempty"`
+	Priority     int    `json:"priority,omitempty"`
+	QueuedAt     int64  `json:"queued_at"`
+	Blocked      string `json:"blocked,omitempty"`
+}
@@ -0,0 +1,231 @@
+package condition
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Env provides the values a Condition may refer to while being evaluated.
+type Env interface {
+	// Metadata returns the value of the given build metadata, e.g.
+	// BUILD_JOB_NAME.
+	Metadata(string) (string, bool)
+
+	// Var returns the value of the given pipeline var.
+	Var(string) (interface{}, bool, error)
+
+	// File returns the contents of a file in the form ARTIFACT/FILE/PATH.
+	File(string) ([]byte, error)
+}
+
+// UndefinedError is returned when a condition refers to metadata or a var
+// which is not defined.
+type UndefinedError struct {
+	Kind string
+	Name string
+}
+
+func (err UndefinedError) Error() string {
+	return fmt.Sprintf("undefined %s '%s'", err.Kind, err.Name)
+}
+
+// Condition is a parsed boolean expression.
+//
+// The grammar is as follows:
+//
+//	expr    = and { "||" and }
+//	and     = unary { "&&" unary }
+//	unary   = "!" unary | compare
+//	compare = operand [ ( "==" | "!=" ) operand ]
+//	operand = "true" | "false" | string | call | "(" expr ")"
+//	call    = ( "metadata" | "var" | "file" ) "(" string ")"
+//
+// Every value is compared as a string. A value on its own is true if it is
+// the string "true", ignoring surrounding whitespace.
+type Condition struct {
+	raw  string
+	root node
+}
+
+// Parse parses the given expression, returning a ParseError if it is not
+// valid.
+func Parse(expression string) (Condition, error) {
+	root, err := newParser(expression).parse()
+	if err != nil {
+		return Condition{}, err
+	}
+
+	return Condition{
+		raw:  expression,
+		root: root,
+	}, nil
+}
+
+// String returns the expression the Condition was parsed from.
+func (condition Condition) String() string {
+	return condition.raw
+}
+
+// Eval evaluates the condition against the given Env.
+func (condition Condition) Eval(env Env) (bool, error) {
+	val, err := condition.root.value(env)
+	if err != nil {
+		return false, err
+	}
+
+	return truthy(val), nil
+}
+
+type node interface {
+	value(Env) (string, error)
+}
+
+type literalNode string
+
+func (n literalNode) value(Env) (string, error) {
+	return string(n), nil
+}
+
+type callNode struct {
+	function string
+	arg      string
+}
+
+func (n callNode) value(env Env) (string, error) {
+	switch n.function {
+	case "metadata":
+		val, found := env.Metadata(n.arg)
+		if !found {
+			return "", UndefinedError{Kind: "metadata", Name: n.arg}
+		}
+
+		return val, nil
+
+	case "var":
+		val, found, err := env.Var(n.arg)
+		if err != nil {
+			return "", err
+		}
+
+		if !found {
+			return "", UndefinedError{Kind: "var", Name: n.arg}
+		}
+
+		return stringify(val), nil
+
+	case "file":
+		contents, err := env.File(n.arg)
+		if err != nil {
+			return "", err
+		}
+
+		return strings.TrimSpace(string(contents)), nil
+	}
+
+	return "", fmt.Errorf("unknown function '%s'", n.function)
+}
+
+type notNode struct {
+	operand node
+}
+
+func (n notNode) value(env Env) (string, error) {
+	val, err := n.operand.value(env)
+	if err != nil {
+		return "", err
+	}
+
+	return boolString(!truthy(val)), nil
+}
+
+type andNode struct {
+	left, right node
+}
+
+func (n andNode) value(env Env) (string, error) {
+	left, err := n.left.value(env)
+	if err != nil {
+		return "", err
+	}
+
+	if !truthy(left) {
+		return boolString(false), nil
+	}
+
+	right, err := n.right.value(env)
+	if err != nil {
+		return "", err
+	}
+
+	return boolString(truthy(right)), nil
+}
+
+type orNode struct {
+	left, right node
+}
+
+func (n orNode) value(env Env) (string, error) {
+	left, err := n.left.value(env)
+	if err != nil {
+		return "", err
+	}
+
+	if truthy(left) {
+		return boolString(true), nil
+	}
+
+	right, err := n.right.value(env)
+	if err != nil {
+		return "", err
+	}
+
+	return boolString(truthy(right)), nil
+}
+
+type compareNode struct {
+	left, right node
+	negate      bool
+}
+
+func (n compareNode) value(env Env) (string, error) {
+	left, err := n.left.value(env)
+	if err != nil {
+		return "", err
+	}
+
+	right, err := n.right.value(env)
+	if err != nil {
+		return "", err
+	}
+
+	return boolString((left == right) != n.negate), nil
+}
+
+func truthy(val string) bool {
+	return strings.TrimSpace(val) == "true"
+}
+
+func boolString(b bool) string {
+	if b {
+		return "true"
+	}
+
+	return "false"
+}
+
+func stringify(val interface{}) string {
+	switch v := val.(type) {
+	case string:
+		return v
+	case bool:
+		return boolString(v)
+	}
+
+	payload, err := json.Marshal(val)
+	if err != nil {
+		return fmt.Sprintf("%v", val)
+	}
+
+	return string(payload)
+}
@@ -0,0 +1,146 @@
+package condition_test
+
+import (
+	"errors"
+
+	"github.com/concourse/concourse/atc/condition"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+type staticEnv struct {
+	metadata map[string]string
+	vars     map[string]interface{}
+	files    map[string]string
+}
+
+func (env staticEnv) Metadata(name string) (string, bool) {
+	val, found := env.metadata[name]
+	return val, found
+}
+
+func (env staticEnv) Var(name string) (interface{}, bool, error) {
+	val, found := env.vars[name]
+	return val, found, nil
+}
+
+func (env staticEnv) File(path string) ([]byte, error) {
+	contents, found := env.files[path]
+	if !found {
+		return nil, errors.New("file not found: " + path)
+	}
+
+	return []byte(contents), nil
+}
+
+var _ = Describe("Condition", func() {
+	var env staticEnv
+
+	BeforeEach(func() {
+		env = staticEnv{
+			metadata: map[string]string{
+				"BUILD_JOB_NAME":      "deploy",
+				"BUILD_PIPELINE_NAME": "main",
+			},
+			vars: map[string]interface{}{
+				"enabled": true,
+				"branch":  "master",
+				"count":   3,
+			},
+			files: map[string]string{
+				"flags/skip":    "true\n",
+				"flags/version": "1.2.3\n",
+			},
+		}
+	})
+
+	DescribeTable("evaluating",
+		func(expression string, expected bool) {
+			cond, err := condition.Parse(expression)
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := cond.Eval(env)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(expected))
+		},
+		Entry("true literal", `true`, true),
+		Entry("false literal", `false`, false),
+		Entry("negation", `!false`, true),
+		Entry("bool var", `var("enabled")`, true),
+		Entry("string comparison", `var("branch") == "master"`, true),
+		Entry("single quoted strings", `var("branch") != 'master'`, false),
+		Entry("non-string var", `var("count") == "3"`, true),
+		Entry("metadata", `metadata("BUILD_JOB_NAME") == "deploy"`, true),
+		Entry("file contents, trimmed", `file("flags/version") == "1.2.3"`, true),
+		Entry("truthy file", `!file("flags/skip")`, false),
+		Entry("and", `var("enabled") && metadata("BUILD_PIPELINE_NAME") == "other"`, false),
+		Entry("or", `var("branch") == "dev" || var("enabled")`, true),
+		Entry("precedence of && over ||", `true || false && false`, true),
+		Entry("grouping", `(true || false) && false`, false),
+	)
+
+	It("short-circuits", func() {
+		cond, err := condition.Parse(`false && var("missing")`)
+		Expect(err).ToNot(HaveOccurred())
+
+		result, err := cond.Eval(env)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeFalse())
+	})
+
+	It("returns the original expression as a string", func() {
+		cond, err := condition.Parse(`var("enabled")`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cond.String()).To(Equal(`var("enabled")`))
+	})
+
+	Context("when a var is not defined", func() {
+		It("returns an UndefinedError", func() {
+			cond, err := condition.Parse(`var("missing")`)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = cond.Eval(env)
+			Expect(err).To(Equal(condition.UndefinedError{Kind: "var", Name: "missing"}))
+		})
+	})
+
+	Context("when metadata is not defined", func() {
+		It("returns an UndefinedError", func() {
+			cond, err := condition.Parse(`metadata("BOGUS")`)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = cond.Eval(env)
+			Expect(err).To(Equal(condition.UndefinedError{Kind: "metadata", Name: "BOGUS"}))
+		})
+	})
+
+	Context("when a file cannot be read", func() {
+		It("returns the error", func() {
+			cond, err := condition.Parse(`file("flags/missing")`)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = cond.Eval(env)
+			Expect(err).To(MatchError("file not found: flags/missing"))
+		})
+	})
+
+	DescribeTable("parse errors",
+		func(expression string, position int) {
+			_, err := condition.Parse(expression)
+			Expect(err).To(HaveOccurred())
+
+			parseErr, ok := err.(condition.ParseError)
+			Expect(ok).To(BeTrue())
+			Expect(parseErr.Position).To(Equal(position))
+		},
+		Entry("empty", ``, 0),
+		Entry("unknown function", `bogus("x")`, 0),
+		Entry("missing argument", `var()`, 4),
+		Entry("non-string argument", `var(true)`, 4),
+		Entry("unterminated string", `var("x`, 4),
+		Entry("unbalanced parens", `(true`, 5),
+		Entry("trailing tokens", `true false`, 5),
+		Entry("unexpected character", `true & false`, 5),
+	)
+})
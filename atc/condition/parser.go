@@ -0,0 +1,310 @@
+package condition
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ParseError is returned when an expression cannot be parsed.
+type ParseError struct {
+	Expression string
+	Position   int
+	Message    string
+}
+
+func (err ParseError) Error() string {
+	return fmt.Sprintf("%s at position %d in '%s'", err.Message, err.Position, err.Expression)
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenLParen
+	tokenRParen
+	tokenNot
+	tokenAnd
+	tokenOr
+	tokenEq
+	tokenNotEq
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+var functions = map[string]bool{
+	"metadata": true,
+	"var":      true,
+	"file":     true,
+}
+
+type parser struct {
+	expression string
+	tokens     []token
+	current    int
+}
+
+func newParser(expression string) *parser {
+	return &parser{expression: expression}
+}
+
+func (p *parser) parse() (node, error) {
+	tokens, err := p.lex()
+	if err != nil {
+		return nil, err
+	}
+
+	p.tokens = tokens
+
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if next := p.peek(); next.kind != tokenEOF {
+		return nil, p.errorf(next, "unexpected '%s'", next.text)
+	}
+
+	return root, nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenOr {
+		p.next()
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = orNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenAnd {
+		p.next()
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = andNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.peek().kind == tokenNot {
+		p.next()
+
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return notNode{operand: operand}, nil
+	}
+
+	return p.parseCompare()
+}
+
+func (p *parser) parseCompare() (node, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	switch p.peek().kind {
+	case tokenEq, tokenNotEq:
+		op := p.next()
+
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+
+		return compareNode{left: left, right: right, negate: op.kind == tokenNotEq}, nil
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseOperand() (node, error) {
+	tok := p.next()
+
+	switch tok.kind {
+	case tokenString:
+		return literalNode(tok.text), nil
+
+	case tokenLParen:
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if closing := p.next(); closing.kind != tokenRParen {
+			return nil, p.errorf(closing, "expected ')'")
+		}
+
+		return inner, nil
+
+	case tokenIdent:
+		switch tok.text {
+		case "true", "false":
+			return literalNode(tok.text), nil
+		}
+
+		if !functions[tok.text] {
+			return nil, p.errorf(tok, "unknown function '%s'", tok.text)
+		}
+
+		if open := p.next(); open.kind != tokenLParen {
+			return nil, p.errorf(open, "expected '(' after '%s'", tok.text)
+		}
+
+		arg := p.next()
+		if arg.kind != tokenString {
+			return nil, p.errorf(arg, "expected string argument to '%s'", tok.text)
+		}
+
+		if closing := p.next(); closing.kind != tokenRParen {
+			return nil, p.errorf(closing, "expected ')'")
+		}
+
+		return callNode{function: tok.text, arg: arg.text}, nil
+
+	case tokenEOF:
+		return nil, p.errorf(tok, "unexpected end of expression")
+	}
+
+	return nil, p.errorf(tok, "unexpected '%s'", tok.text)
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.current]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.current]
+	if tok.kind != tokenEOF {
+		p.current++
+	}
+
+	return tok
+}
+
+func (p *parser) errorf(tok token, message string, args ...interface{}) error {
+	return ParseError{
+		Expression: p.expression,
+		Position:   tok.pos,
+		Message:    fmt.Sprintf(message, args...),
+	}
+}
+
+func (p *parser) lex() ([]token, error) {
+	tokens := []token{}
+	input := p.expression
+
+	for i := 0; i < len(input); {
+		c := rune(input[i])
+
+		switch {
+		case unicode.IsSpace(c):
+			i++
+
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: i})
+			i++
+
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: i})
+			i++
+
+		case strings.HasPrefix(input[i:], "&&"):
+			tokens = append(tokens, token{kind: tokenAnd, text: "&&", pos: i})
+			i += 2
+
+		case strings.HasPrefix(input[i:], "||"):
+			tokens = append(tokens, token{kind: tokenOr, text: "||", pos: i})
+			i += 2
+
+		case strings.HasPrefix(input[i:], "=="):
+			tokens = append(tokens, token{kind: tokenEq, text: "==", pos: i})
+			i += 2
+
+		case strings.HasPrefix(input[i:], "!="):
+			tokens = append(tokens, token{kind: tokenNotEq, text: "!=", pos: i})
+			i += 2
+
+		case c == '!':
+			tokens = append(tokens, token{kind: tokenNot, text: "!", pos: i})
+			i++
+
+		case c == '"' || c == '\'':
+			end := i + 1
+			for end < len(input) && input[end] != input[i] {
+				if input[end] == '\\' {
+					end++
+				}
+				end++
+			}
+
+			if end >= len(input) {
+				return nil, ParseError{Expression: input, Position: i, Message: "unterminated string"}
+			}
+
+			text := input[i+1 : end]
+			if c == '"' {
+				unquoted, err := strconv.Unquote(input[i : end+1])
+				if err != nil {
+					return nil, ParseError{Expression: input, Position: i, Message: "invalid string"}
+				}
+
+				text = unquoted
+			}
+
+			tokens = append(tokens, token{kind: tokenString, text: text, pos: i})
+			i = end + 1
+
+		case isIdentRune(c):
+			end := i
+			for end < len(input) && isIdentRune(rune(input[end])) {
+				end++
+			}
+
+			tokens = append(tokens, token{kind: tokenIdent, text: input[i:end], pos: i})
+			i = end
+
+		default:
+			return nil, ParseError{Expression: input, Position: i, Message: fmt.Sprintf("unexpected '%c'", c)}
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, pos: len(input)}), nil
+}
+
+func isIdentRune(c rune) bool {
+	return c == '_' || unicode.IsLetter(c) || unicode.IsDigit(c)
+}
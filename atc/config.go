@@ -20,10 +20,13 @@ type ConfigResponse struct {
 }
 
 type Config struct {
-	Groups        GroupConfigs    `yaml:"groups" json:"groups" mapstructure:"groups"`
-	Resources     ResourceConfigs `yaml:"resources" json:"resources" mapstructure:"resources"`
-	ResourceTypes ResourceTypes   `yaml:"resource_types" json:"resource_types" mapstructure:"resource_types"`
-	Jobs          JobConfigs      `yaml:"jobs" json:"jobs" mapstructure:"jobs"`
+	Groups        GroupConfigs     `yaml:"groups" json:"groups" mapstructure:"groups"`
+	Resources     ResourceConfigs  `yaml:"resources" json:"resources" mapstructure:"resources"`
+	ResourceTypes ResourceTypes    `yaml:"resource_types" json:"resource_types" mapstructure:"resource_types"`
+	Jobs          JobConfigs       `yaml:"jobs" json:"jobs" mapstructure:"jobs"`
+	VarSources    VarSourceConfigs `yaml:"var_sources,omitempty" json:"var_sources,omitempty" mapstructure:"var_sources"`
+
+	Notifications NotificationConfigs `yaml:"notifications,omitempty" json:"notifications,omitempty" mapstructure:"notifications"`
 }
 
 type RawConfig string
@@ -50,6 +53,78 @@ func (groups GroupConfigs) Lookup(name string) (GroupConfig, int, bool) {
 	return GroupConfig{}, -1, false
 }
 
+// VarSourceConfig configures a credential manager from which the pipeline
+// can fetch vars, referenced as ((name:path)).
+type VarSourceConfig struct {
+	Name   string                 `yaml:"name" json:"name" mapstructure:"name"`
+	Type   string                 `yaml:"type" json:"type" mapstructure:"type"`
+	Config map[string]interface{} `yaml:"config,omitempty" json:"config,omitempty" mapstructure:"config"`
+}
+
+type VarSourceConfigs []VarSourceConfig
+
+func (sources VarSourceConfigs) Lookup(name string) (VarSourceConfig, bool) {
+	for _, source := range sources {
+		if source.Name == name {
+			return source, true
+		}
+	}
+
+	return VarSourceConfig{}, false
+}
+
+// NotificationConfig configures a webhook to which the pipeline's builds
+// send their status as they start and finish. Notifications are only
+// configured per pipeline; teams cannot configure them for all of their
+// pipelines at once.
+type NotificationConfig struct {
+	Name string `yaml:"name" json:"name" mapstructure:"name"`
+	URL  string `yaml:"url" json:"url" mapstructure:"url"`
+
+	// Events to send; all of them if empty.
+	Events []NotificationEvent `yaml:"events,omitempty" json:"events,omitempty" mapstructure:"events"`
+
+	// Secret with which to sign the payload, sent as an HMAC-SHA256 digest in
+	// the X-Concourse-Signature header.
+	Secret string `yaml:"secret,omitempty" json:"secret,omitempty" mapstructure:"secret"`
+
+	Headers map[string]string `yaml:"headers,omitempty" json:"headers,omitempty" mapstructure:"headers"`
+
+	// Payload is a text/template for the request body, which defaults to a
+	// JSON description of the build.
+	Payload string `yaml:"payload,omitempty" json:"payload,omitempty" mapstructure:"payload"`
+
+	// Attempts is the number of times to try each delivery before giving up.
+	Attempts int `yaml:"attempts,omitempty" json:"attempts,omitempty" mapstructure:"attempts"`
+}
+
+// Subscribes returns true if the notification should be sent the event.
+func (config NotificationConfig) Subscribes(event NotificationEvent) bool {
+	if len(config.Events) == 0 {
+		return true
+	}
+
+	for _, e := range config.Events {
+		if e == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+type NotificationConfigs []NotificationConfig
+
+func (configs NotificationConfigs) Lookup(name string) (NotificationConfig, bool) {
+	for _, config := range configs {
+		if config.Name == name {
+			return config, true
+		}
+	}
+
+	return NotificationConfig{}, false
+}
+
 type ResourceConfig struct {
 	Name         string  `yaml:"name" json:"name" mapstructure:"name"`
 	WebhookToken string  `yaml:"webhook_token,omitempty" json:"webhook_token" mapstructure:"webhook_token"`
@@ -207,6 +282,16 @@ func (c *VersionConfig) MarshalJSON() ([]byte, error) {
 	return json.Marshal("")
 }
 
+// A VersionFilterConfig restricts the versions of a resource that may be used
+// for an input, either by a semver constraint or a regular expression matched
+// against one of the version's fields. When Field is empty the version must
+// have exactly one field, which is used.
+type VersionFilterConfig struct {
+	Field  string `yaml:"field,omitempty" json:"field,omitempty" mapstructure:"field"`
+	Semver string `yaml:"semver,omitempty" json:"semver,omitempty" mapstructure:"semver"`
+	Regex  string `yaml:"regex,omitempty" json:"regex,omitempty" mapstructure:"regex"`
+}
+
 // A InputsConfig represents the choice to include every artifact within the
 // job as an input to the put step or specific ones.
 type InputsConfig struct {
@@ -305,6 +390,12 @@ func (c InputsConfig) MarshalJSON() ([]byte, error) {
 type PlanConfig struct {
 	// makes the Plan conditional
 	// conditions on which to perform a nested sequence
+	If string `yaml:"if,omitempty" json:"if,omitempty" mapstructure:"if"`
+
+	// runs the step once for every combination of the given vars' values
+	Across []AcrossVarConfig `yaml:"across,omitempty" json:"across,omitempty" mapstructure:"across"`
+	// limits how many combinations of an 'across' step run at once
+	MaxInFlight int `yaml:"max_in_flight,omitempty" json:"max_in_flight,omitempty" mapstructure:"max_in_flight"`
 
 	// compose a nested sequence of plans
 	// name of the nested 'do'
@@ -338,13 +429,31 @@ type PlanConfig struct {
 	Task string `yaml:"task,omitempty" json:"task,omitempty" mapstructure:"task"`
 	// run task privileged
 	Privileged bool `yaml:"privileged,omitempty" json:"privileged,omitempty" mapstructure:"privileged"`
-	// task config path, e.g. foo/build.yml
+	// task config, pipeline config or var file path, e.g. foo/build.yml
 	TaskConfigPath string `yaml:"file,omitempty" json:"file,omitempty" mapstructure:"file"`
-	// task variables, if task is specified as external file via TaskConfigPath
+	// task or pipeline variables, if the config is specified as external file via TaskConfigPath
 	TaskVars Params `yaml:"vars,omitempty" json:"vars,omitempty" mapstructure:"vars"`
 	// inlined task config
 	TaskConfig *TaskConfig `yaml:"config,omitempty" json:"config,omitempty" mapstructure:"config"`
 
+	// corresponds to a SetPipeline plan
+	// name of the pipeline to configure from the config at TaskConfigPath
+	SetPipeline string `yaml:"set_pipeline,omitempty" json:"set_pipeline,omitempty" mapstructure:"set_pipeline"`
+
+	// corresponds to a LoadVar plan
+	// name of the build var to load from the file at TaskConfigPath
+	LoadVar string `yaml:"load_var,omitempty" json:"load_var,omitempty" mapstructure:"load_var"`
+	// format of the file, i.e. raw, json or yaml; detected from its extension if empty
+	Format string `yaml:"format,omitempty" json:"format,omitempty" mapstructure:"format"`
+	// show the var's value in the build log rather than redacting it
+	Reveal bool `yaml:"reveal,omitempty" json:"reveal,omitempty" mapstructure:"reveal"`
+
+	// corresponds to an Approval plan
+	// name of the decision the build waits for, e.g. deploy-to-prod
+	Approval string `yaml:"approval,omitempty" json:"approval,omitempty" mapstructure:"approval"`
+	// team role required to approve or reject, i.e. owner, member or viewer
+	Role string `yaml:"role,omitempty" json:"role,omitempty" mapstructure:"role"`
+
 	// used by Get and Put for specifying params to the resource
 	Params Params `yaml:"params,omitempty" json:"params,omitempty" mapstructure:"params"`
 
@@ -361,6 +470,12 @@ type PlanConfig struct {
 	// used by any step to specify which workers are eligible to run the step
 	Tags Tags `yaml:"tags,omitempty" json:"tags,omitempty" mapstructure:"tags"`
 
+	// used by Task to override the cluster's chain of container placement strategies
+	ContainerPlacementStrategy []string `yaml:"container_placement_strategy,omitempty" json:"container_placement_strategy,omitempty" mapstructure:"container_placement_strategy"`
+
+	// used by Task to persist outputs as build artifacts which can be downloaded after the build
+	Artifacts []string `yaml:"artifacts,omitempty" json:"artifacts,omitempty" mapstructure:"artifacts"`
+
 	// used by any step to run something when the build is aborted during execution of the step
 	Abort *PlanConfig `yaml:"on_abort,omitempty" json:"on_abort,omitempty" mapstructure:"on_abort"`
 
@@ -386,6 +501,9 @@ type PlanConfig struct {
 	Attempts int `yaml:"attempts,omitempty" json:"attempts,omitempty" mapstructure:"attempts"`
 
 	Version *VersionConfig `yaml:"version,omitempty" json:"version,omitempty" mapstructure:"version"`
+
+	// used on Get steps to restrict which versions of the resource are eligible
+	VersionFilter *VersionFilterConfig `yaml:"version_filter,omitempty" json:"version_filter,omitempty" mapstructure:"version_filter"`
 }
 
 func (config PlanConfig) Name() string {
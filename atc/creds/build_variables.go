@@ -0,0 +1,185 @@
+package creds
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// BuildVariables holds the vars set during a build, e.g. by a 'load_var'
+// step. They are referenced as ((.:name)).
+//
+// It also keeps track of the values to be redacted from the build's output,
+// i.e. those of redacted vars and, unless disabled, any credentials resolved
+// during the build.
+type BuildVariables struct {
+	lock     sync.RWMutex
+	vars     map[string]interface{}
+	redacted map[string]bool
+
+	untrackedCredentials bool
+}
+
+func NewBuildVariables() *BuildVariables {
+	return &BuildVariables{
+		vars:     map[string]interface{}{},
+		redacted: map[string]bool{},
+	}
+}
+
+// TrackCredentials configures whether credentials resolved during the build
+// are redacted from its output. Redacted vars are redacted either way.
+func (v *BuildVariables) TrackCredentials(track bool) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	v.untrackedCredentials = !track
+}
+
+// SetVar sets the var to the given value, replacing any previous value. If
+// redact is true, the value is to be redacted from the build's output.
+func (v *BuildVariables) SetVar(name string, value interface{}, redact bool) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	v.vars[name] = value
+
+	if redact {
+		for _, str := range redactableStrings(value) {
+			v.redacted[str] = true
+		}
+	}
+}
+
+// Track records a value resolved from a credential manager during the build,
+// so that it is redacted from the build's output.
+func (v *BuildVariables) Track(value interface{}) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if v.untrackedCredentials {
+		return
+	}
+
+	for _, str := range redactableStrings(value) {
+		v.redacted[str] = true
+	}
+}
+
+// Var returns the value of the var, if it has been set.
+func (v *BuildVariables) Var(name string) (interface{}, bool) {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+
+	value, found := v.vars[name]
+	return value, found
+}
+
+// RedactedValues returns the values to be redacted from the build's output,
+// longest first so that values containing others are redacted as a whole.
+func (v *BuildVariables) RedactedValues() []string {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+
+	values := make([]string, 0, len(v.redacted))
+	for value := range v.redacted {
+		values = append(values, value)
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		if len(values[i]) != len(values[j]) {
+			return len(values[i]) > len(values[j])
+		}
+
+		return values[i] < values[j]
+	})
+
+	return values
+}
+
+// Redact replaces each of the values to be redacted in the given text.
+func (v *BuildVariables) Redact(text string) string {
+	for _, value := range v.RedactedValues() {
+		text = strings.Replace(text, value, "((redacted))", -1)
+	}
+
+	return text
+}
+
+type UndefinedBuildVarsError struct {
+	Vars []string
+}
+
+func (err UndefinedBuildVarsError) Error() string {
+	return fmt.Sprintf("undefined build vars: %s", strings.Join(err.Vars, ", "))
+}
+
+func (v *BuildVariables) interpolate(node interface{}) (interface{}, error) {
+	missing := map[string]bool{}
+
+	interpolated := atc.InterpolateLocalVarRefs(node, func(name string) (interface{}, bool) {
+		value, found := v.Var(name)
+		if !found {
+			missing[name] = true
+		}
+
+		return value, found
+	})
+
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		return nil, UndefinedBuildVarsError{Vars: names}
+	}
+
+	return interpolated, nil
+}
+
+func redactableStrings(value interface{}) []string {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		var strs []string
+		for _, val := range v {
+			strs = append(strs, redactableStrings(val)...)
+		}
+
+		return strs
+
+	case map[interface{}]interface{}:
+		var strs []string
+		for _, val := range v {
+			strs = append(strs, redactableStrings(val)...)
+		}
+
+		return strs
+
+	case []interface{}:
+		var strs []string
+		for _, val := range v {
+			strs = append(strs, redactableStrings(val)...)
+		}
+
+		return strs
+
+	case string:
+		// surrounding whitespace, e.g. a file's trailing newline, is unlikely
+		// to be printed along with the value
+		trimmed := strings.TrimSpace(v)
+		if trimmed == "" {
+			return nil
+		}
+
+		return []string{trimmed}
+	}
+
+	// numbers and booleans are too likely to show up in output on their own
+	return nil
+}
@@ -0,0 +1,139 @@
+package creds_test
+
+import (
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildVariables", func() {
+	var buildVariables *creds.BuildVariables
+
+	BeforeEach(func() {
+		buildVariables = creds.NewBuildVariables()
+	})
+
+	Describe("SetVar", func() {
+		It("makes the var available", func() {
+			buildVariables.SetVar("some-var", "some-value", false)
+
+			value, found := buildVariables.Var("some-var")
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("some-value"))
+
+			_, found = buildVariables.Var("bogus-var")
+			Expect(found).To(BeFalse())
+		})
+
+		It("only tracks values to be redacted", func() {
+			buildVariables.SetVar("some-var", "some-value", false)
+			buildVariables.SetVar("some-secret", " some-secret-value\n", true)
+
+			Expect(buildVariables.RedactedValues()).To(Equal([]string{"some-secret-value"}))
+		})
+
+		It("tracks the strings within structured values", func() {
+			buildVariables.SetVar("some-var", map[string]interface{}{
+				"password": "hunter2",
+				"port":     float64(5432),
+				"hosts":    []interface{}{"db.example.com", ""},
+			}, true)
+
+			Expect(buildVariables.RedactedValues()).To(Equal([]string{"db.example.com", "hunter2"}))
+		})
+	})
+
+	Describe("Track", func() {
+		It("tracks the value to be redacted without setting a var", func() {
+			buildVariables.Track(map[string]interface{}{
+				"username": "some-username",
+				"password": "some-password",
+			})
+
+			Expect(buildVariables.RedactedValues()).To(Equal([]string{"some-password", "some-username"}))
+
+			_, found := buildVariables.Var("username")
+			Expect(found).To(BeFalse())
+		})
+
+		Context("when credentials are not tracked", func() {
+			BeforeEach(func() {
+				buildVariables.TrackCredentials(false)
+			})
+
+			It("ignores the value", func() {
+				buildVariables.Track("some-password")
+				Expect(buildVariables.RedactedValues()).To(BeEmpty())
+			})
+
+			It("still tracks redacted vars", func() {
+				buildVariables.SetVar("some-var", "some-value", true)
+				Expect(buildVariables.RedactedValues()).To(Equal([]string{"some-value"}))
+			})
+		})
+	})
+
+	Describe("Redact", func() {
+		BeforeEach(func() {
+			buildVariables.SetVar("short", "abc", true)
+			buildVariables.SetVar("long", "abcdef", true)
+		})
+
+		It("replaces the longest values first", func() {
+			Expect(buildVariables.Redact("abcdef abc ab")).To(Equal("((redacted)) ((redacted)) ab"))
+		})
+	})
+
+	Describe("interpolating Params and Source", func() {
+		var variables template.StaticVariables
+
+		BeforeEach(func() {
+			variables = template.StaticVariables{"some-cred": "some-cred-value"}
+
+			buildVariables.SetVar("version", "1.2.3", false)
+			buildVariables.SetVar("config", map[string]interface{}{"some": "config"}, false)
+		})
+
+		It("resolves references to build vars", func() {
+			params, err := creds.NewParams(variables, atc.Params{
+				"cred":    "((some-cred))",
+				"version": "v((.:version))",
+				"config":  "((.:config))",
+			}).WithBuildVariables(buildVariables).Evaluate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(params).To(Equal(atc.Params{
+				"cred":    "some-cred-value",
+				"version": "v1.2.3",
+				"config":  map[string]interface{}{"some": "config"},
+			}))
+
+			source, err := creds.NewSource(variables, atc.Source{
+				"uri": "https://example.com/((.:version))",
+			}).WithBuildVariables(buildVariables).Evaluate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(source).To(Equal(atc.Source{
+				"uri": "https://example.com/1.2.3",
+			}))
+		})
+
+		It("errors when a build var is undefined", func() {
+			_, err := creds.NewParams(variables, atc.Params{
+				"version": "((.:bogus))",
+			}).WithBuildVariables(buildVariables).Evaluate()
+			Expect(err).To(Equal(creds.UndefinedBuildVarsError{Vars: []string{"bogus"}}))
+		})
+
+		It("leaves references alone without build vars", func() {
+			params, err := creds.NewParams(variables, atc.Params{
+				"version": "((.:version))",
+			}).Evaluate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(params).To(Equal(atc.Params{
+				"version": "((.:version))",
+			}))
+		})
+	})
+})
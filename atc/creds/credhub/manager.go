@@ -14,25 +14,25 @@ import (
 )
 
 type CredHubManager struct {
-	URL string `long:"url" description:"CredHub server address used to access secrets."`
+	URL string `long:"url" description:"CredHub server address used to access secrets." mapstructure:"url"`
 
-	PathPrefix string `long:"path-prefix" default:"/concourse" description:"Path under which to namespace credential lookup."`
+	PathPrefix string `long:"path-prefix" default:"/concourse" description:"Path under which to namespace credential lookup." mapstructure:"path_prefix"`
 
-	TLS    TLS
-	UAA    UAA
-	Client *LazyCredhub
+	TLS    TLS          `mapstructure:",squash"`
+	UAA    UAA          `mapstructure:",squash"`
+	Client *LazyCredhub `mapstructure:"-"`
 }
 
 type TLS struct {
-	CACerts    []string `long:"ca-cert"              description:"Paths to PEM-encoded CA cert files to use to verify the CredHub server SSL cert."`
-	ClientCert string   `long:"client-cert"          description:"Path to the client certificate for mutual TLS authorization."`
-	ClientKey  string   `long:"client-key"           description:"Path to the client private key for mutual TLS authorization."`
-	Insecure   bool     `long:"insecure-skip-verify" description:"Enable insecure SSL verification."`
+	CACerts    []string `long:"ca-cert"              description:"Paths to PEM-encoded CA cert files to use to verify the CredHub server SSL cert." mapstructure:"ca_certs"`
+	ClientCert string   `long:"client-cert"          description:"Path to the client certificate for mutual TLS authorization." mapstructure:"client_cert"`
+	ClientKey  string   `long:"client-key"           description:"Path to the client private key for mutual TLS authorization." mapstructure:"client_key"`
+	Insecure   bool     `long:"insecure-skip-verify" description:"Enable insecure SSL verification." mapstructure:"insecure_skip_verify"`
 }
 
 type UAA struct {
-	ClientId     string `long:"client-id"     description:"Client ID for CredHub authorization."`
-	ClientSecret string `long:"client-secret" description:"Client secret for CredHub authorization."`
+	ClientId     string `long:"client-id"     description:"Client ID for CredHub authorization." mapstructure:"client_id"`
+	ClientSecret string `long:"client-secret" description:"Client secret for CredHub authorization." mapstructure:"client_secret"`
 }
 
 func (manager *CredHubManager) MarshalJSON() ([]byte, error) {
@@ -27,3 +27,16 @@ func (factory *credhubManagerFactory) AddConfig(group *flags.Group) creds.Manage
 
 	return manager
 }
+
+func (factory *credhubManagerFactory) NewInstance(config interface{}) (creds.Manager, error) {
+	manager := &CredHubManager{
+		PathPrefix: "/concourse",
+	}
+
+	err := creds.DecodeManagerConfig(config, manager)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager, nil
+}
@@ -0,0 +1,141 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package credsfakes
+
+import (
+	sync "sync"
+
+	creds "github.com/concourse/concourse/atc/creds"
+)
+
+type FakeClosingVariablesFactory struct {
+	CloseStub        func()
+	closeMutex       sync.RWMutex
+	closeArgsForCall []struct {
+	}
+	NewVariablesStub        func(string, string) creds.Variables
+	newVariablesMutex       sync.RWMutex
+	newVariablesArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	newVariablesReturns struct {
+		result1 creds.Variables
+	}
+	newVariablesReturnsOnCall map[int]struct {
+		result1 creds.Variables
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeClosingVariablesFactory) Close() {
+	fake.closeMutex.Lock()
+	fake.closeArgsForCall = append(fake.closeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Close", []interface{}{})
+	fake.closeMutex.Unlock()
+	if fake.CloseStub != nil {
+		fake.CloseStub()
+	}
+}
+
+func (fake *FakeClosingVariablesFactory) CloseCallCount() int {
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	return len(fake.closeArgsForCall)
+}
+
+func (fake *FakeClosingVariablesFactory) CloseCalls(stub func()) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = stub
+}
+
+func (fake *FakeClosingVariablesFactory) NewVariables(arg1 string, arg2 string) creds.Variables {
+	fake.newVariablesMutex.Lock()
+	ret, specificReturn := fake.newVariablesReturnsOnCall[len(fake.newVariablesArgsForCall)]
+	fake.newVariablesArgsForCall = append(fake.newVariablesArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("NewVariables", []interface{}{arg1, arg2})
+	fake.newVariablesMutex.Unlock()
+	if fake.NewVariablesStub != nil {
+		return fake.NewVariablesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.newVariablesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClosingVariablesFactory) NewVariablesCallCount() int {
+	fake.newVariablesMutex.RLock()
+	defer fake.newVariablesMutex.RUnlock()
+	return len(fake.newVariablesArgsForCall)
+}
+
+func (fake *FakeClosingVariablesFactory) NewVariablesCalls(stub func(string, string) creds.Variables) {
+	fake.newVariablesMutex.Lock()
+	defer fake.newVariablesMutex.Unlock()
+	fake.NewVariablesStub = stub
+}
+
+func (fake *FakeClosingVariablesFactory) NewVariablesArgsForCall(i int) (string, string) {
+	fake.newVariablesMutex.RLock()
+	defer fake.newVariablesMutex.RUnlock()
+	argsForCall := fake.newVariablesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClosingVariablesFactory) NewVariablesReturns(result1 creds.Variables) {
+	fake.newVariablesMutex.Lock()
+	defer fake.newVariablesMutex.Unlock()
+	fake.NewVariablesStub = nil
+	fake.newVariablesReturns = struct {
+		result1 creds.Variables
+	}{result1}
+}
+
+func (fake *FakeClosingVariablesFactory) NewVariablesReturnsOnCall(i int, result1 creds.Variables) {
+	fake.newVariablesMutex.Lock()
+	defer fake.newVariablesMutex.Unlock()
+	fake.NewVariablesStub = nil
+	if fake.newVariablesReturnsOnCall == nil {
+		fake.newVariablesReturnsOnCall = make(map[int]struct {
+			result1 creds.Variables
+		})
+	}
+	fake.newVariablesReturnsOnCall[i] = struct {
+		result1 creds.Variables
+	}{result1}
+}
+
+func (fake *FakeClosingVariablesFactory) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	fake.newVariablesMutex.RLock()
+	defer fake.newVariablesMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeClosingVariablesFactory) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ creds.ClosingVariablesFactory = new(FakeClosingVariablesFactory)
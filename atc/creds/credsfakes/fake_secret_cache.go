@@ -0,0 +1,411 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package credsfakes
+
+import (
+	sync "sync"
+	time "time"
+
+	creds "github.com/concourse/concourse/atc/creds"
+)
+
+type FakeSecretCache struct {
+	DeleteStub        func(string) error
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 string
+	}
+	deleteReturns struct {
+		result1 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteExpiredStub        func() error
+	deleteExpiredMutex       sync.RWMutex
+	deleteExpiredArgsForCall []struct {
+	}
+	deleteExpiredReturns struct {
+		result1 error
+	}
+	deleteExpiredReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetStub        func(string) ([]byte, bool, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 string
+	}
+	getReturns struct {
+		result1 []byte
+		result2 bool
+		result3 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 bool
+		result3 error
+	}
+	LeasesStub        func() (map[string]string, error)
+	leasesMutex       sync.RWMutex
+	leasesArgsForCall []struct {
+	}
+	leasesReturns struct {
+		result1 map[string]string
+		result2 error
+	}
+	leasesReturnsOnCall map[int]struct {
+		result1 map[string]string
+		result2 error
+	}
+	SetStub        func(string, []byte, string, time.Duration) error
+	setMutex       sync.RWMutex
+	setArgsForCall []struct {
+		arg1 string
+		arg2 []byte
+		arg3 string
+		arg4 time.Duration
+	}
+	setReturns struct {
+		result1 error
+	}
+	setReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSecretCache) Delete(arg1 string) error {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("Delete", []interface{}{arg1})
+	fake.deleteMutex.Unlock()
+	if fake.DeleteStub != nil {
+		return fake.DeleteStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deleteReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecretCache) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeSecretCache) DeleteCalls(stub func(string) error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = stub
+}
+
+func (fake *FakeSecretCache) DeleteArgsForCall(i int) string {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	argsForCall := fake.deleteArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSecretCache) DeleteReturns(result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecretCache) DeleteReturnsOnCall(i int, result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecretCache) DeleteExpired() error {
+	fake.deleteExpiredMutex.Lock()
+	ret, specificReturn := fake.deleteExpiredReturnsOnCall[len(fake.deleteExpiredArgsForCall)]
+	fake.deleteExpiredArgsForCall = append(fake.deleteExpiredArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DeleteExpired", []interface{}{})
+	fake.deleteExpiredMutex.Unlock()
+	if fake.DeleteExpiredStub != nil {
+		return fake.DeleteExpiredStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deleteExpiredReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecretCache) DeleteExpiredCallCount() int {
+	fake.deleteExpiredMutex.RLock()
+	defer fake.deleteExpiredMutex.RUnlock()
+	return len(fake.deleteExpiredArgsForCall)
+}
+
+func (fake *FakeSecretCache) DeleteExpiredCalls(stub func() error) {
+	fake.deleteExpiredMutex.Lock()
+	defer fake.deleteExpiredMutex.Unlock()
+	fake.DeleteExpiredStub = stub
+}
+
+func (fake *FakeSecretCache) DeleteExpiredReturns(result1 error) {
+	fake.deleteExpiredMutex.Lock()
+	defer fake.deleteExpiredMutex.Unlock()
+	fake.DeleteExpiredStub = nil
+	fake.deleteExpiredReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecretCache) DeleteExpiredReturnsOnCall(i int, result1 error) {
+	fake.deleteExpiredMutex.Lock()
+	defer fake.deleteExpiredMutex.Unlock()
+	fake.DeleteExpiredStub = nil
+	if fake.deleteExpiredReturnsOnCall == nil {
+		fake.deleteExpiredReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteExpiredReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecretCache) Get(arg1 string) ([]byte, bool, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("Get", []interface{}{arg1})
+	fake.getMutex.Unlock()
+	if fake.GetStub != nil {
+		return fake.GetStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSecretCache) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeSecretCache) GetCalls(stub func(string) ([]byte, bool, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeSecretCache) GetArgsForCall(i int) string {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSecretCache) GetReturns(result1 []byte, result2 bool, result3 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 []byte
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSecretCache) GetReturnsOnCall(i int, result1 []byte, result2 bool, result3 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 bool
+			result3 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSecretCache) Leases() (map[string]string, error) {
+	fake.leasesMutex.Lock()
+	ret, specificReturn := fake.leasesReturnsOnCall[len(fake.leasesArgsForCall)]
+	fake.leasesArgsForCall = append(fake.leasesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Leases", []interface{}{})
+	fake.leasesMutex.Unlock()
+	if fake.LeasesStub != nil {
+		return fake.LeasesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.leasesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSecretCache) LeasesCallCount() int {
+	fake.leasesMutex.RLock()
+	defer fake.leasesMutex.RUnlock()
+	return len(fake.leasesArgsForCall)
+}
+
+func (fake *FakeSecretCache) LeasesCalls(stub func() (map[string]string, error)) {
+	fake.leasesMutex.Lock()
+	defer fake.leasesMutex.Unlock()
+	fake.LeasesStub = stub
+}
+
+func (fake *FakeSecretCache) LeasesReturns(result1 map[string]string, result2 error) {
+	fake.leasesMutex.Lock()
+	defer fake.leasesMutex.Unlock()
+	fake.LeasesStub = nil
+	fake.leasesReturns = struct {
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecretCache) LeasesReturnsOnCall(i int, result1 map[string]string, result2 error) {
+	fake.leasesMutex.Lock()
+	defer fake.leasesMutex.Unlock()
+	fake.LeasesStub = nil
+	if fake.leasesReturnsOnCall == nil {
+		fake.leasesReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+			result2 error
+		})
+	}
+	fake.leasesReturnsOnCall[i] = struct {
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecretCache) Set(arg1 string, arg2 []byte, arg3 string, arg4 time.Duration) error {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.setMutex.Lock()
+	ret, specificReturn := fake.setReturnsOnCall[len(fake.setArgsForCall)]
+	fake.setArgsForCall = append(fake.setArgsForCall, struct {
+		arg1 string
+		arg2 []byte
+		arg3 string
+		arg4 time.Duration
+	}{arg1, arg2Copy, arg3, arg4})
+	fake.recordInvocation("Set", []interface{}{arg1, arg2Copy, arg3, arg4})
+	fake.setMutex.Unlock()
+	if fake.SetStub != nil {
+		return fake.SetStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecretCache) SetCallCount() int {
+	fake.setMutex.RLock()
+	defer fake.setMutex.RUnlock()
+	return len(fake.setArgsForCall)
+}
+
+func (fake *FakeSecretCache) SetCalls(stub func(string, []byte, string, time.Duration) error) {
+	fake.setMutex.Lock()
+	defer fake.setMutex.Unlock()
+	fake.SetStub = stub
+}
+
+func (fake *FakeSecretCache) SetArgsForCall(i int) (string, []byte, string, time.Duration) {
+	fake.setMutex.RLock()
+	defer fake.setMutex.RUnlock()
+	argsForCall := fake.setArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSecretCache) SetReturns(result1 error) {
+	fake.setMutex.Lock()
+	defer fake.setMutex.Unlock()
+	fake.SetStub = nil
+	fake.setReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecretCache) SetReturnsOnCall(i int, result1 error) {
+	fake.setMutex.Lock()
+	defer fake.setMutex.Unlock()
+	fake.SetStub = nil
+	if fake.setReturnsOnCall == nil {
+		fake.setReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecretCache) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.deleteExpiredMutex.RLock()
+	defer fake.deleteExpiredMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.leasesMutex.RLock()
+	defer fake.leasesMutex.RUnlock()
+	fake.setMutex.RLock()
+	defer fake.setMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSecretCache) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ creds.SecretCache = new(FakeSecretCache)
@@ -22,5 +22,50 @@ func evaluate(variablesResolver Variables, in, out interface{}) error {
 		return err
 	}
 
+	sourcedVariables, ok := variablesResolver.(SourcedVariables)
+	if !ok {
+		return yaml.Unmarshal(bytes, out)
+	}
+
+	var untyped interface{}
+	err = yaml.Unmarshal(bytes, &untyped)
+	if err != nil {
+		return err
+	}
+
+	interpolated, err := evaluateSourcedVars(sourcedVariables, untyped)
+	if err != nil {
+		return err
+	}
+
+	bytes, err = yaml.Marshal(interpolated)
+	if err != nil {
+		return err
+	}
+
 	return yaml.Unmarshal(bytes, out)
 }
+
+// InterpolateRefs resolves the var references in the given node (as decoded
+// from JSON or YAML) which plain templating leaves alone: ((source:path))
+// references to the pipeline's var sources and ((.:name)) references to the
+// build's vars.
+func InterpolateRefs(variables Variables, buildVariables *BuildVariables, node interface{}) (interface{}, error) {
+	var err error
+
+	if sourcedVariables, ok := variables.(SourcedVariables); ok {
+		node, err = evaluateSourcedVars(sourcedVariables, node)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if buildVariables != nil {
+		node, err = buildVariables.interpolate(node)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return node, nil
+}
@@ -0,0 +1,152 @@
+package httpsecrets
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// UnexpectedResponseError is returned when the secret provider responds
+// with an unexpected status.
+//
+// It implements net.Error so that server errors, e.g. while the provider is
+// being restarted, are retried by creds.RetryableVariablesFactory.
+type UnexpectedResponseError struct {
+	StatusCode int
+	Message    string
+}
+
+func (err UnexpectedResponseError) Error() string {
+	if err.Message == "" {
+		return fmt.Sprintf("unexpected response status %d", err.StatusCode)
+	}
+
+	return fmt.Sprintf("unexpected response status %d: %s", err.StatusCode, err.Message)
+}
+
+func (err UnexpectedResponseError) Timeout() bool {
+	return err.StatusCode == http.StatusGatewayTimeout
+}
+
+func (err UnexpectedResponseError) Temporary() bool {
+	switch err.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// Client talks to a secret provider over the protocol described in the
+// package documentation.
+type Client struct {
+	url        string
+	token      string
+	httpClient *http.Client
+}
+
+func NewClient(url string, token string, httpClient *http.Client) *Client {
+	return &Client{
+		url:        strings.TrimRight(url, "/"),
+		token:      token,
+		httpClient: httpClient,
+	}
+}
+
+// Health returns the provider's health response.
+func (client *Client) Health() (interface{}, error) {
+	var health interface{}
+	_, err := client.get("/health", nil, &health)
+	if err != nil {
+		return nil, err
+	}
+
+	return health, nil
+}
+
+type pathsResponse struct {
+	Paths []string `json:"paths"`
+}
+
+// Paths returns the provider's secret path templates, in order of
+// precedence.
+func (client *Client) Paths() ([]string, error) {
+	var response pathsResponse
+	_, err := client.get("/paths", nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Paths, nil
+}
+
+type secretResponse struct {
+	Value interface{} `json:"value"`
+}
+
+// Secret returns the value of the secret at the given path, if it exists.
+func (client *Client) Secret(path string) (interface{}, bool, error) {
+	var response secretResponse
+	found, err := client.get("/secret", url.Values{"path": {path}}, &response)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	return response.Value, true, nil
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func (client *Client) get(path string, query url.Values, dest interface{}) (bool, error) {
+	requestURL := client.url + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	request, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return false, err
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	if client.token != "" {
+		request.Header.Set("Authorization", "Bearer "+client.token)
+	}
+
+	response, err := client.httpClient.Do(request)
+	if err != nil {
+		return false, err
+	}
+
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		var errResponse errorResponse
+		_ = json.NewDecoder(response.Body).Decode(&errResponse)
+
+		return false, UnexpectedResponseError{
+			StatusCode: response.StatusCode,
+			Message:    errResponse.Error,
+		}
+	}
+
+	err = json.NewDecoder(response.Body).Decode(dest)
+	if err != nil {
+		return false, fmt.Errorf("invalid response: %s", err)
+	}
+
+	return true, nil
+}
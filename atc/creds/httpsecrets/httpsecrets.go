@@ -0,0 +1,132 @@
+// Package httpsecrets implements a credential manager backed by an external
+// secret provider speaking a small HTTP/JSON protocol, so that secret stores
+// can be integrated without adding a package to the ATC.
+//
+// The provider must serve the following endpoints under its configured URL:
+//
+//	GET /health
+//	  Responds 200 with any JSON body, which is reported as the manager's
+//	  health.
+//
+//	GET /paths
+//	  Responds 200 with {"paths": [...]}, the templates of the paths at
+//	  which secrets are looked up, in order of precedence, e.g.
+//	  "/concourse/{{.Team}}/{{.Pipeline}}/{{.Secret}}". Only used if no
+//	  templates are configured on the ATC.
+//
+//	GET /secret?path=<path>
+//	  Responds 200 with {"value": <any JSON value>} if the secret exists, or
+//	  404 if it does not.
+//
+// If a token is configured, it is sent in an "Authorization: Bearer <token>"
+// header. Any other response status is treated as an error, with the message
+// taken from an {"error": "..."} body if present. 502, 503 and 504 responses
+// are retried.
+package httpsecrets
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+
+	"code.cloudfoundry.org/lager"
+	varTemplate "github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+// A SecretReader reads the secret at the given path.
+type SecretReader interface {
+	Secret(path string) (interface{}, bool, error)
+}
+
+type HTTPSecrets struct {
+	log           lager.Logger
+	reader        SecretReader
+	TeamName      string
+	PipelineName  string
+	PathTemplates []*template.Template
+}
+
+type Secret struct {
+	Team     string
+	Pipeline string
+	Secret   string
+}
+
+func NewHTTPSecrets(log lager.Logger, reader SecretReader, teamName string, pipelineName string, pathTemplates []*template.Template) *HTTPSecrets {
+	return &HTTPSecrets{
+		log:           log,
+		reader:        reader,
+		TeamName:      teamName,
+		PipelineName:  pipelineName,
+		PathTemplates: pathTemplates,
+	}
+}
+
+func (s *HTTPSecrets) Get(varDef varTemplate.VariableDefinition) (interface{}, bool, error) {
+	for _, pt := range s.PathTemplates {
+		var buf bytes.Buffer
+		err := pt.Execute(&buf, &Secret{
+			Team:     s.TeamName,
+			Pipeline: s.PipelineName,
+			Secret:   varDef.Name,
+		})
+		if err != nil {
+			s.log.Error("failed-to-build-secret-path", err, lager.Data{
+				"template": pt.Name(),
+				"secret":   varDef.Name,
+			})
+			return nil, false, err
+		}
+
+		path := buf.String()
+
+		// If pipeline name is empty, double slashes may be present in the path
+		if strings.Contains(path, "//") {
+			continue
+		}
+
+		value, found, err := s.reader.Secret(path)
+		if err != nil {
+			s.log.Error("failed-to-get-secret", err, lager.Data{
+				"template": pt.Name(),
+				"secret":   varDef.Name,
+				"path":     path,
+			})
+			return nil, false, err
+		}
+
+		if found {
+			return untyped(value), true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+func (s *HTTPSecrets) List() ([]varTemplate.VariableDefinition, error) {
+	// not supported by the protocol
+	return []varTemplate.VariableDefinition{}, nil
+}
+
+// untyped converts the maps in a JSON value to the type expected when
+// interpolating a secret's fields, e.g. ((secret.field)).
+func untyped(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		evenLessTyped := map[interface{}]interface{}{}
+		for key, val := range v {
+			evenLessTyped[key] = untyped(val)
+		}
+
+		return evenLessTyped
+
+	case []interface{}:
+		for i, val := range v {
+			v[i] = untyped(val)
+		}
+
+		return v
+	}
+
+	return value
+}
@@ -0,0 +1,26 @@
+package httpsecrets
+
+import (
+	"text/template"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type httpSecretsFactory struct {
+	log           lager.Logger
+	reader        SecretReader
+	pathTemplates []*template.Template
+}
+
+func NewHTTPSecretsFactory(log lager.Logger, reader SecretReader, pathTemplates []*template.Template) *httpSecretsFactory {
+	return &httpSecretsFactory{
+		log:           log,
+		reader:        reader,
+		pathTemplates: pathTemplates,
+	}
+}
+
+func (factory *httpSecretsFactory) NewVariables(teamName string, pipelineName string) creds.Variables {
+	return NewHTTPSecrets(factory.log, factory.reader, teamName, pipelineName, factory.pathTemplates)
+}
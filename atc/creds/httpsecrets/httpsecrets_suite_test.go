@@ -0,0 +1,13 @@
+package httpsecrets_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestHTTPSecrets(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "HTTP Secrets Creds Suite")
+}
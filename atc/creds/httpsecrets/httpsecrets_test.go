@@ -0,0 +1,165 @@
+package httpsecrets_test
+
+import (
+	"net"
+	"net/http"
+	"text/template"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	varTemplate "github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/httpsecrets"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("HTTPSecrets", func() {
+	var (
+		server    *ghttp.Server
+		variables *httpsecrets.HTTPSecrets
+		varDef    varTemplate.VariableDefinition
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+
+		client := httpsecrets.NewClient(server.URL(), "", http.DefaultClient)
+
+		variables = httpsecrets.NewHTTPSecrets(
+			lagertest.NewTestLogger("test"),
+			client,
+			"some-team",
+			"some-pipeline",
+			[]*template.Template{
+				template.Must(template.New("pipeline").Parse("/concourse/{{.Team}}/{{.Pipeline}}/{{.Secret}}")),
+				template.Must(template.New("team").Parse("/concourse/{{.Team}}/{{.Secret}}")),
+			},
+		)
+
+		varDef = varTemplate.VariableDefinition{Name: "some-secret"}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("gets the secret from the first path it exists at", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/secret", "path=%2Fconcourse%2Fsome-team%2Fsome-pipeline%2Fsome-secret"),
+				ghttp.RespondWith(http.StatusNotFound, nil),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/secret", "path=%2Fconcourse%2Fsome-team%2Fsome-secret"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{"value": "some-value"}),
+			),
+		)
+
+		value, found, err := variables.Get(varDef)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("some-value"))
+	})
+
+	It("returns structured secrets so that their fields can be interpolated", func() {
+		server.AppendHandlers(ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+			"value": map[string]interface{}{
+				"username": "some-username",
+				"keys":     []interface{}{map[string]interface{}{"id": "some-id"}},
+			},
+		}))
+
+		source, err := creds.NewSource(variables, atc.Source{
+			"username": "((some-secret.username))",
+		}).Evaluate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(source).To(Equal(atc.Source{"username": "some-username"}))
+	})
+
+	It("returns not found if the secret does not exist at any path", func() {
+		server.AppendHandlers(
+			ghttp.RespondWith(http.StatusNotFound, nil),
+			ghttp.RespondWith(http.StatusNotFound, nil),
+		)
+
+		_, found, err := variables.Get(varDef)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	Context("when the provider is unavailable", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(ghttp.RespondWithJSONEncoded(http.StatusServiceUnavailable, map[string]string{"error": "restarting"}))
+		})
+
+		It("returns an error which is retried", func() {
+			_, _, err := variables.Get(varDef)
+			Expect(err).To(Equal(httpsecrets.UnexpectedResponseError{
+				StatusCode: http.StatusServiceUnavailable,
+				Message:    "restarting",
+			}))
+
+			netErr, ok := err.(net.Error)
+			Expect(ok).To(BeTrue())
+			Expect(netErr.Temporary()).To(BeTrue())
+		})
+
+		It("succeeds when retried through the retryable variables factory", func() {
+			server.AppendHandlers(ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{"value": "some-value"}))
+
+			factory := creds.NewRetryableVariablesFactory(fakeFactory{variables}, creds.SecretRetryConfig{
+				Attempts: 2,
+				Interval: time.Millisecond,
+			})
+
+			value, found, err := factory.NewVariables("some-team", "some-pipeline").Get(varDef)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("some-value"))
+		})
+	})
+
+	Context("when the provider rejects the request", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusForbidden, nil))
+		})
+
+		It("returns an error which is not retried", func() {
+			_, _, err := variables.Get(varDef)
+			Expect(err).To(MatchError("unexpected response status 403"))
+
+			netErr, ok := err.(net.Error)
+			Expect(ok).To(BeTrue())
+			Expect(netErr.Temporary()).To(BeFalse())
+		})
+	})
+
+	Context("when the pipeline name is empty", func() {
+		BeforeEach(func() {
+			variables.PipelineName = ""
+		})
+
+		It("skips the pipeline path", func() {
+			server.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/secret", "path=%2Fconcourse%2Fsome-team%2Fsome-secret"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{"value": "some-value"}),
+			))
+
+			_, found, err := variables.Get(varDef)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+	})
+})
+
+type fakeFactory struct {
+	variables creds.Variables
+}
+
+func (factory fakeFactory) NewVariables(string, string) creds.Variables {
+	return factory.variables
+}
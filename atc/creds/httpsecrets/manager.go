@@ -0,0 +1,194 @@
+package httpsecrets
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type Manager struct {
+	URL           string        `long:"url" description:"Address of the secret provider." mapstructure:"url"`
+	Token         string        `long:"token" description:"Bearer token sent to the secret provider." mapstructure:"token"`
+	PathTemplates []string      `long:"path-template" description:"Template of a path at which to look up secrets, in order of precedence. Can be specified multiple times. If not set, the templates are fetched from the secret provider." mapstructure:"path_templates"`
+	Timeout       time.Duration `long:"timeout" default:"10s" description:"Timeout for requests to the secret provider." mapstructure:"timeout"`
+
+	TLS    TLS     `mapstructure:",squash"`
+	Client *Client `mapstructure:"-"`
+}
+
+type TLS struct {
+	CACert     string `long:"ca-cert"              description:"Path to a PEM-encoded CA cert file to use to verify the secret provider's SSL cert." mapstructure:"ca_cert"`
+	ClientCert string `long:"client-cert"          description:"Path to the client certificate for mutual TLS." mapstructure:"client_cert"`
+	ClientKey  string `long:"client-key"           description:"Path to the client private key for mutual TLS." mapstructure:"client_key"`
+	Insecure   bool   `long:"insecure-skip-verify" description:"Enable insecure SSL verification." mapstructure:"insecure_skip_verify"`
+}
+
+func buildPathTemplate(name, tmpl string) (*template.Template, error) {
+	t, err := template.New(name).Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return nil, err
+	}
+	if parse.IsEmptyTree(t.Root) {
+		return nil, errors.New("path template should not be empty")
+	}
+	return t, nil
+}
+
+func (manager *Manager) Init(log lager.Logger) error {
+	tlsConfig, err := manager.tlsConfig()
+	if err != nil {
+		log.Error("failed-to-configure-tls", err)
+		return err
+	}
+
+	httpClient := &http.Client{
+		Timeout: manager.Timeout,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		},
+	}
+
+	manager.Client = NewClient(manager.URL, manager.Token, httpClient)
+
+	return nil
+}
+
+func (manager *Manager) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: manager.TLS.Insecure,
+	}
+
+	if manager.TLS.CACert != "" {
+		caCert, err := ioutil.ReadFile(manager.TLS.CACert)
+		if err != nil {
+			return nil, err
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no certificates found in %s", manager.TLS.CACert)
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if manager.TLS.ClientCert != "" {
+		cert, err := tls.LoadX509KeyPair(manager.TLS.ClientCert, manager.TLS.ClientKey)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+func (manager *Manager) MarshalJSON() ([]byte, error) {
+	health, err := manager.Health()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&map[string]interface{}{
+		"url":            manager.URL,
+		"path_templates": manager.PathTemplates,
+		"timeout":        manager.Timeout,
+		"ca_cert":        manager.TLS.CACert,
+		"health":         health,
+	})
+}
+
+func (manager *Manager) Health() (*creds.HealthResponse, error) {
+	health := &creds.HealthResponse{
+		Method: "GET /health",
+	}
+
+	response, err := manager.Client.Health()
+	if err != nil {
+		health.Error = err.Error()
+		return health, nil
+	}
+
+	health.Response = response
+	return health, nil
+}
+
+func (manager *Manager) IsConfigured() bool {
+	return manager.URL != ""
+}
+
+func (manager *Manager) Validate() error {
+	_, err := url.Parse(manager.URL)
+	if err != nil {
+		return fmt.Errorf("invalid URL: %s", err)
+	}
+
+	if manager.TLS.ClientCert != "" && manager.TLS.ClientKey == "" {
+		return errors.New("must provide client key along with client cert")
+	}
+
+	if manager.TLS.ClientKey != "" && manager.TLS.ClientCert == "" {
+		return errors.New("must provide client cert along with client key")
+	}
+
+	_, err = pathTemplates(manager.PathTemplates)
+	return err
+}
+
+func (manager *Manager) NewVariablesFactory(log lager.Logger) (creds.VariablesFactory, error) {
+	paths := manager.PathTemplates
+	if len(paths) == 0 {
+		var err error
+		paths, err = manager.Client.Paths()
+		if err != nil {
+			log.Error("failed-to-get-path-templates", err)
+			return nil, err
+		}
+
+		if len(paths) == 0 {
+			return nil, errors.New("secret provider returned no path templates")
+		}
+	}
+
+	templates, err := pathTemplates(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewHTTPSecretsFactory(log, manager.Client, templates), nil
+}
+
+func pathTemplates(paths []string) ([]*template.Template, error) {
+	var templates []*template.Template
+	for i, path := range paths {
+		t, err := buildPathTemplate(fmt.Sprintf("path-template-%d", i), path)
+		if err != nil {
+			return nil, err
+		}
+
+		// Execute the template on dummy data to verify that it does not
+		// expect additional data
+		dummy := Secret{Team: "team", Pipeline: "pipeline", Secret: "secret"}
+		err = t.Execute(ioutil.Discard, &dummy)
+		if err != nil {
+			return nil, err
+		}
+
+		templates = append(templates, t)
+	}
+
+	return templates, nil
+}
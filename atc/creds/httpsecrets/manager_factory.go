@@ -0,0 +1,44 @@
+package httpsecrets
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/creds"
+	flags "github.com/jessevdk/go-flags"
+)
+
+type managerFactory struct{}
+
+func init() {
+	creds.Register("httpsecrets", NewManagerFactory())
+}
+
+func NewManagerFactory() creds.ManagerFactory {
+	return &managerFactory{}
+}
+
+func (factory *managerFactory) AddConfig(group *flags.Group) creds.Manager {
+	manager := &Manager{}
+
+	subGroup, err := group.AddGroup("HTTP Secrets Credential Management", "", manager)
+	if err != nil {
+		panic(err)
+	}
+
+	subGroup.Namespace = "http-secrets"
+
+	return manager
+}
+
+func (factory *managerFactory) NewInstance(config interface{}) (creds.Manager, error) {
+	manager := &Manager{
+		Timeout: 10 * time.Second,
+	}
+
+	err := creds.DecodeManagerConfig(config, manager)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager, nil
+}
@@ -0,0 +1,151 @@
+package httpsecrets_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/httpsecrets"
+	flags "github.com/jessevdk/go-flags"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Manager", func() {
+	var (
+		manager *httpsecrets.Manager
+		server  *ghttp.Server
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+
+		manager = &httpsecrets.Manager{}
+		_, err := flags.ParseArgs(manager, []string{})
+		Expect(err).ToNot(HaveOccurred())
+
+		manager.URL = server.URL()
+		manager.Token = "some-token"
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("IsConfigured()", func() {
+		It("fails without a URL", func() {
+			manager.URL = ""
+			Expect(manager.IsConfigured()).To(BeFalse())
+		})
+
+		It("passes if the URL is set", func() {
+			Expect(manager.IsConfigured()).To(BeTrue())
+		})
+	})
+
+	Describe("Validate()", func() {
+		It("passes on default parameters", func() {
+			Expect(manager.Validate()).To(Succeed())
+		})
+
+		It("fails on an invalid path template", func() {
+			manager.PathTemplates = []string{"/concourse/{{.Team}"}
+			Expect(manager.Validate()).ToNot(Succeed())
+		})
+
+		It("fails on a path template expecting additional data", func() {
+			manager.PathTemplates = []string{"/concourse/{{.Bogus}}"}
+			Expect(manager.Validate()).ToNot(Succeed())
+		})
+
+		It("fails on a client cert without a key", func() {
+			manager.TLS.ClientCert = "some-cert"
+			Expect(manager.Validate()).ToNot(Succeed())
+		})
+	})
+
+	Context("when initialized", func() {
+		BeforeEach(func() {
+			Expect(manager.Init(lagertest.NewTestLogger("test"))).To(Succeed())
+		})
+
+		Describe("Health()", func() {
+			It("returns the provider's health", func() {
+				server.AppendHandlers(ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/health"),
+					ghttp.VerifyHeaderKV("Authorization", "Bearer some-token"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]string{"status": "UP"}),
+				))
+
+				health, err := manager.Health()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(health).To(Equal(&creds.HealthResponse{
+					Method:   "GET /health",
+					Response: map[string]interface{}{"status": "UP"},
+				}))
+			})
+
+			It("reports errors", func() {
+				server.AppendHandlers(ghttp.RespondWithJSONEncoded(http.StatusInternalServerError, map[string]string{"error": "disaster"}))
+
+				health, err := manager.Health()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(health.Error).To(Equal("unexpected response status 500: disaster"))
+			})
+		})
+
+		Describe("NewVariablesFactory()", func() {
+			var variables creds.Variables
+
+			JustBeforeEach(func() {
+				factory, err := manager.NewVariablesFactory(lagertest.NewTestLogger("test"))
+				Expect(err).ToNot(HaveOccurred())
+
+				variables = factory.NewVariables("some-team", "some-pipeline")
+			})
+
+			Context("when no path templates are configured", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/paths"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+							"paths": []string{"/shared/{{.Secret}}"},
+						}),
+					))
+				})
+
+				It("uses the provider's path templates", func() {
+					server.AppendHandlers(ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/secret", "path=%2Fshared%2Fsome-secret"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{"value": "some-value"}),
+					))
+
+					value, found, err := variables.Get(template.VariableDefinition{Name: "some-secret"})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(value).To(Equal("some-value"))
+				})
+			})
+
+			Context("when path templates are configured", func() {
+				BeforeEach(func() {
+					manager.PathTemplates = []string{"/{{.Team}}/{{.Secret}}"}
+				})
+
+				It("does not ask the provider for them", func() {
+					server.AppendHandlers(ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/secret", "path=%2Fsome-team%2Fsome-secret"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{"value": "some-value"}),
+					))
+
+					_, found, err := variables.Get(template.VariableDefinition{Name: "some-secret"})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+				})
+			})
+		})
+	})
+})
@@ -13,9 +13,9 @@ import (
 )
 
 type KubernetesManager struct {
-	InClusterConfig bool   `long:"in-cluster" description:"Enables the in-cluster client."`
-	ConfigPath      string `long:"config-path" description:"Path to Kubernetes config when running ATC outside Kubernetes."`
-	NamespacePrefix string `long:"namespace-prefix" default:"concourse-" description:"Prefix to use for Kubernetes namespaces under which secrets will be looked up."`
+	InClusterConfig bool   `long:"in-cluster" description:"Enables the in-cluster client." mapstructure:"in_cluster"`
+	ConfigPath      string `long:"config-path" description:"Path to Kubernetes config when running ATC outside Kubernetes." mapstructure:"config_path"`
+	NamespacePrefix string `long:"namespace-prefix" default:"concourse-" description:"Prefix to use for Kubernetes namespaces under which secrets will be looked up." mapstructure:"namespace_prefix"`
 }
 
 func (manager *KubernetesManager) MarshalJSON() ([]byte, error) {
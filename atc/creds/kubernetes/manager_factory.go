@@ -27,3 +27,16 @@ func (factory *kubernetesManagerFactory) AddConfig(group *flags.Group) creds.Man
 
 	return manager
 }
+
+func (factory *kubernetesManagerFactory) NewInstance(config interface{}) (creds.Manager, error) {
+	manager := &KubernetesManager{
+		NamespacePrefix: "concourse-",
+	}
+
+	err := creds.DecodeManagerConfig(config, manager)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager, nil
+}
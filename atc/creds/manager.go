@@ -1,10 +1,15 @@
 package creds
 
 import (
+	"context"
+	"time"
+
 	"code.cloudfoundry.org/lager"
 	flags "github.com/jessevdk/go-flags"
 )
 
+//go:generate counterfeiter . Manager
+
 type Manager interface {
 	IsConfigured() bool
 	Validate() error
@@ -14,14 +19,47 @@ type Manager interface {
 	NewVariablesFactory(lager.Logger) (VariablesFactory, error)
 }
 
+//go:generate counterfeiter . ManagerFactory
+
 type ManagerFactory interface {
 	AddConfig(*flags.Group) Manager
+	NewInstance(interface{}) (Manager, error)
+}
+
+// A SharedCacheManager can cache the secrets it fetches in a SecretCache
+// shared by all ATCs, if configured to.
+type SharedCacheManager interface {
+	UseSharedCache(SecretCache)
+
+	// SharedCacheReaper returns the task which deletes expired and revoked
+	// secrets from the shared cache. It is to be run periodically by one
+	// process, rather than by every variables factory using the cache.
+	SharedCacheReaper(lager.Logger) (SharedCacheReaper, bool)
+}
+
+type SharedCacheReaper interface {
+	Run(context.Context) error
+}
+
+//go:generate counterfeiter . SecretCache
+
+// SecretCache stores secrets until they expire. It is implemented in the
+// database by db.NewSecretCache.
+type SecretCache interface {
+	Get(key string) ([]byte, bool, error)
+	Set(key string, value []byte, leaseID string, ttl time.Duration) error
+	Delete(key string) error
+
+	Leases() (map[string]string, error)
+	DeleteExpired() error
 }
 
 type Managers map[string]Manager
 
 type CredentialManagementConfig struct {
 	RetryConfig SecretRetryConfig
+
+	VarSourceCacheDuration time.Duration `long:"var-source-cache-duration" default:"1m" description:"How long to cache vars fetched from a pipeline's var sources. Set to 0 to disable caching."`
 }
 
 type HealthResponse struct {
@@ -7,6 +7,7 @@ import (
 
 type Params struct {
 	variablesResolver Variables
+	buildVariables    *BuildVariables
 	rawParams         atc.Params
 }
 
@@ -17,6 +18,13 @@ func NewParams(variables Variables, params atc.Params) Params {
 	}
 }
 
+// WithBuildVariables returns a copy of the params which also resolves
+// references to the build's vars, e.g. ((.:name)).
+func (p Params) WithBuildVariables(buildVariables *BuildVariables) Params {
+	p.buildVariables = buildVariables
+	return p
+}
+
 func (p Params) Evaluate() (atc.Params, error) {
 	var untypedInput interface{}
 
@@ -25,6 +33,13 @@ func (p Params) Evaluate() (atc.Params, error) {
 		return nil, err
 	}
 
+	if p.buildVariables != nil {
+		untypedInput, err = p.buildVariables.interpolate(untypedInput)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var metadata mapstructure.Metadata
 	var params atc.Params
 
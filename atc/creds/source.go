@@ -7,6 +7,7 @@ import (
 
 type Source struct {
 	variablesResolver Variables
+	buildVariables    *BuildVariables
 	rawSource         atc.Source
 }
 
@@ -17,6 +18,13 @@ func NewSource(variables Variables, source atc.Source) Source {
 	}
 }
 
+// WithBuildVariables returns a copy of the source which also resolves
+// references to the build's vars, e.g. ((.:name)).
+func (s Source) WithBuildVariables(buildVariables *BuildVariables) Source {
+	s.buildVariables = buildVariables
+	return s
+}
+
 func (s Source) Evaluate() (atc.Source, error) {
 	var untypedInput interface{}
 
@@ -25,6 +33,13 @@ func (s Source) Evaluate() (atc.Source, error) {
 		return nil, err
 	}
 
+	if s.buildVariables != nil {
+		untypedInput, err = s.buildVariables.interpolate(untypedInput)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var metadata mapstructure.Metadata
 	var source atc.Source
 
@@ -25,3 +25,17 @@ func (factory *ssmManagerFactory) AddConfig(group *flags.Group) creds.Manager {
 	subGroup.Namespace = "aws-ssm"
 	return manager
 }
+
+func (factory *ssmManagerFactory) NewInstance(config interface{}) (creds.Manager, error) {
+	manager := &SsmManager{
+		PipelineSecretTemplate: DefaultPipelineSecretTemplate,
+		TeamSecretTemplate:     DefaultTeamSecretTemplate,
+	}
+
+	err := creds.DecodeManagerConfig(config, manager)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager, nil
+}
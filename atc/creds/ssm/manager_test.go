@@ -109,4 +109,27 @@ var _ = Describe("SsmManager", func() {
 			Expect(manager.Validate()).ToNot(BeNil())
 		})
 	})
+	Describe("NewInstance()", func() {
+		It("decodes the config on top of the defaults", func() {
+			instance, err := ssm.NewSsmManagerFactory().NewInstance(map[string]interface{}{
+				"region":     "some-region",
+				"access_key": "some-access-key",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(instance).To(Equal(&ssm.SsmManager{
+				AwsRegion:              "some-region",
+				AwsAccessKeyID:         "some-access-key",
+				PipelineSecretTemplate: ssm.DefaultPipelineSecretTemplate,
+				TeamSecretTemplate:     ssm.DefaultTeamSecretTemplate,
+			}))
+		})
+
+		It("fails on unknown keys", func() {
+			_, err := ssm.NewSsmManagerFactory().NewInstance(map[string]interface{}{
+				"bogus": "value",
+			})
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
@@ -0,0 +1,54 @@
+package creds
+
+import "github.com/cloudfoundry/bosh-cli/director/template"
+
+type trackedVariables struct {
+	Variables
+
+	buildVariables *BuildVariables
+}
+
+// NewTrackedVariables wraps the given Variables so that every value resolved
+// through them is tracked by the build's vars, to be redacted from its output.
+func NewTrackedVariables(variables Variables, buildVariables *BuildVariables) Variables {
+	if buildVariables == nil {
+		return variables
+	}
+
+	tracked := trackedVariables{
+		Variables:      variables,
+		buildVariables: buildVariables,
+	}
+
+	if _, ok := variables.(SourcedVariables); ok {
+		return trackedSourcedVariables{tracked}
+	}
+
+	return tracked
+}
+
+func (variables trackedVariables) Get(varDef template.VariableDefinition) (interface{}, bool, error) {
+	value, found, err := variables.Variables.Get(varDef)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if found {
+		variables.buildVariables.Track(value)
+	}
+
+	return value, found, nil
+}
+
+type trackedSourcedVariables struct {
+	trackedVariables
+}
+
+func (variables trackedSourcedVariables) SourceVariables(name string) (Variables, error) {
+	sourceVariables, err := variables.Variables.(SourcedVariables).SourceVariables(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTrackedVariables(sourceVariables, variables.buildVariables), nil
+}
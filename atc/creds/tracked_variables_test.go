@@ -0,0 +1,51 @@
+package creds_test
+
+import (
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TrackedVariables", func() {
+	var (
+		buildVariables *creds.BuildVariables
+		variables      creds.Variables
+	)
+
+	BeforeEach(func() {
+		buildVariables = creds.NewBuildVariables()
+
+		variables = creds.NewTrackedVariables(template.StaticVariables{
+			"some-cred":  "some-cred-value",
+			"other-cred": "other-cred-value",
+		}, buildVariables)
+	})
+
+	It("tracks the values resolved through them", func() {
+		params, err := creds.NewParams(variables, atc.Params{
+			"cred": "((some-cred))",
+		}).Evaluate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(params).To(Equal(atc.Params{"cred": "some-cred-value"}))
+
+		Expect(buildVariables.RedactedValues()).To(Equal([]string{"some-cred-value"}))
+	})
+
+	It("does not track vars which are not found", func() {
+		_, found, err := variables.Get(template.VariableDefinition{Name: "bogus-cred"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+
+		Expect(buildVariables.RedactedValues()).To(BeEmpty())
+	})
+
+	Context("without build vars", func() {
+		It("returns the variables as-is", func() {
+			static := template.StaticVariables{}
+			Expect(creds.NewTrackedVariables(static, nil)).To(Equal(static))
+		})
+	})
+})
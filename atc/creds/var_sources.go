@@ -0,0 +1,453 @@
+package creds
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc"
+	"github.com/mitchellh/mapstructure"
+)
+
+// SourcedVariables are Variables which can also resolve vars from a
+// pipeline's var sources, referenced as ((source:path)).
+type SourcedVariables interface {
+	Variables
+
+	SourceVariables(name string) (Variables, error)
+}
+
+type UnknownVarSourceError struct {
+	Name string
+}
+
+func (err UnknownVarSourceError) Error() string {
+	return fmt.Sprintf("unknown var source: %s", err.Name)
+}
+
+type UnknownVarSourceTypeError struct {
+	Type string
+}
+
+func (err UnknownVarSourceTypeError) Error() string {
+	return fmt.Sprintf("unknown var source type: %s", err.Type)
+}
+
+type UndefinedSourcedVarsError struct {
+	Vars []string
+}
+
+func (err UndefinedSourcedVarsError) Error() string {
+	return fmt.Sprintf("undefined vars: %s", strings.Join(err.Vars, ", "))
+}
+
+// DecodeManagerConfig decodes a var source's config into the manager, which
+// should have its defaults set already. Its fields are matched with the
+// config's keys using their 'mapstructure' tags.
+func DecodeManagerConfig(config interface{}, manager Manager) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:           manager,
+		ErrorUnused:      true,
+		WeaklyTypedInput: true,
+		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return decoder.Decode(config)
+}
+
+// VarSourcesLookup returns the var sources configured for a pipeline.
+type VarSourcesLookup func(teamName string, pipelineName string) (atc.VarSourceConfigs, error)
+
+// VarSourcePool creates and caches the managers for var sources, so that
+// pipelines configuring the same source share one manager and one cache of
+// fetched vars. Managers are dropped, and closed if they hold on to
+// resources, once no pipeline's var sources configure them any more.
+type VarSourcePool struct {
+	logger        lager.Logger
+	cacheDuration time.Duration
+	clock         clock.Clock
+
+	lock      sync.Mutex
+	factories map[string]VariablesFactory
+
+	// the keys of the sources last looked up for each pipeline, and the
+	// number of pipelines referencing each key
+	pipelineKeys map[string][]string
+	references   map[string]int
+}
+
+func NewVarSourcePool(logger lager.Logger, cacheDuration time.Duration, clock clock.Clock) *VarSourcePool {
+	return &VarSourcePool{
+		logger:        logger,
+		cacheDuration: cacheDuration,
+		clock:         clock,
+
+		factories: map[string]VariablesFactory{},
+
+		pipelineKeys: map[string][]string{},
+		references:   map[string]int{},
+	}
+}
+
+// Track records the var sources currently configured for the pipeline. The
+// managers of sources which the pipeline configured before, and which no
+// other pipeline configures, are dropped and closed.
+func (pool *VarSourcePool) Track(teamName string, pipelineName string, sources atc.VarSourceConfigs) error {
+	keys := make([]string, len(sources))
+	for i, source := range sources {
+		key, err := varSourceKey(source)
+		if err != nil {
+			return err
+		}
+
+		keys[i] = key
+	}
+
+	pipeline := teamName + "/" + pipelineName
+
+	pool.lock.Lock()
+	defer pool.lock.Unlock()
+
+	for _, key := range keys {
+		pool.references[key]++
+	}
+
+	for _, key := range pool.pipelineKeys[pipeline] {
+		pool.references[key]--
+
+		if pool.references[key] > 0 {
+			continue
+		}
+
+		delete(pool.references, key)
+
+		factory, found := pool.factories[key]
+		if !found {
+			continue
+		}
+
+		delete(pool.factories, key)
+
+		if closer, ok := factory.(ClosingVariablesFactory); ok {
+			closer.Close()
+		}
+	}
+
+	if len(keys) == 0 {
+		delete(pool.pipelineKeys, pipeline)
+	} else {
+		pool.pipelineKeys[pipeline] = keys
+	}
+
+	return nil
+}
+
+// VariablesFactory returns the factory for the given var source, initializing
+// a manager for it the first time the source is seen.
+func (pool *VarSourcePool) VariablesFactory(source atc.VarSourceConfig) (VariablesFactory, error) {
+	key, err := varSourceKey(source)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.lock.Lock()
+	defer pool.lock.Unlock()
+
+	if factory, found := pool.factories[key]; found {
+		return factory, nil
+	}
+
+	managerFactory, found := ManagerFactories()[source.Type]
+	if !found {
+		return nil, UnknownVarSourceTypeError{Type: source.Type}
+	}
+
+	manager, err := managerFactory.NewInstance(source.Config)
+	if err != nil {
+		return nil, fmt.Errorf("var source '%s': %s", source.Name, err)
+	}
+
+	logger := pool.logger.Session("var-source", lager.Data{
+		"name": source.Name,
+		"type": source.Type,
+	})
+
+	err = manager.Init(logger)
+	if err != nil {
+		return nil, fmt.Errorf("var source '%s': %s", source.Name, err)
+	}
+
+	err = manager.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("var source '%s' misconfigured: %s", source.Name, err)
+	}
+
+	factory, err := manager.NewVariablesFactory(logger)
+	if err != nil {
+		return nil, fmt.Errorf("var source '%s': %s", source.Name, err)
+	}
+
+	if pool.cacheDuration > 0 {
+		factory = newCachingVariablesFactory(factory, pool.cacheDuration, pool.clock)
+	}
+
+	pool.factories[key] = factory
+
+	return factory, nil
+}
+
+func varSourceKey(source atc.VarSourceConfig) (string, error) {
+	configPayload, err := json.Marshal(source.Config)
+	if err != nil {
+		return "", err
+	}
+
+	return source.Type + ":" + string(configPayload), nil
+}
+
+type varSourcesVariablesFactory struct {
+	factory VariablesFactory
+	lookup  VarSourcesLookup
+	pool    *VarSourcePool
+}
+
+// NewVarSourcesVariablesFactory wraps the given factory so that its Variables
+// can also resolve vars from the pipeline's var sources.
+func NewVarSourcesVariablesFactory(factory VariablesFactory, lookup VarSourcesLookup, pool *VarSourcePool) VariablesFactory {
+	return varSourcesVariablesFactory{
+		factory: factory,
+		lookup:  lookup,
+		pool:    pool,
+	}
+}
+
+func (factory varSourcesVariablesFactory) NewVariables(teamName string, pipelineName string) Variables {
+	return varSourcesVariables{
+		Variables: factory.factory.NewVariables(teamName, pipelineName),
+
+		teamName:     teamName,
+		pipelineName: pipelineName,
+		sources: &pipelineVarSources{
+			lookup: func() (atc.VarSourceConfigs, error) {
+				sources, err := factory.lookup(teamName, pipelineName)
+				if err != nil {
+					return nil, err
+				}
+
+				err = factory.pool.Track(teamName, pipelineName, sources)
+				if err != nil {
+					return nil, err
+				}
+
+				return sources, nil
+			},
+		},
+		pool: factory.pool,
+	}
+}
+
+// pipelineVarSources looks up the pipeline's var sources the first time
+// they're needed, so that resolving several vars only looks them up once.
+type pipelineVarSources struct {
+	lookup func() (atc.VarSourceConfigs, error)
+
+	once    sync.Once
+	sources atc.VarSourceConfigs
+	err     error
+}
+
+func (sources *pipelineVarSources) get() (atc.VarSourceConfigs, error) {
+	sources.once.Do(func() {
+		sources.sources, sources.err = sources.lookup()
+	})
+
+	return sources.sources, sources.err
+}
+
+type varSourcesVariables struct {
+	Variables
+
+	teamName     string
+	pipelineName string
+	sources      *pipelineVarSources
+	pool         *VarSourcePool
+}
+
+func (variables varSourcesVariables) SourceVariables(name string) (Variables, error) {
+	if variables.pipelineName == "" {
+		return nil, UnknownVarSourceError{Name: name}
+	}
+
+	sources, err := variables.sources.get()
+	if err != nil {
+		return nil, err
+	}
+
+	source, found := sources.Lookup(name)
+	if !found {
+		return nil, UnknownVarSourceError{Name: name}
+	}
+
+	factory, err := variables.pool.VariablesFactory(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return factory.NewVariables(variables.teamName, variables.pipelineName), nil
+}
+
+type cachedVar struct {
+	value   interface{}
+	found   bool
+	expires time.Time
+}
+
+type cachingVariablesFactory struct {
+	factory  VariablesFactory
+	duration time.Duration
+	clock    clock.Clock
+
+	lock  *sync.Mutex
+	cache map[string]cachedVar
+}
+
+func newCachingVariablesFactory(factory VariablesFactory, duration time.Duration, clock clock.Clock) VariablesFactory {
+	return cachingVariablesFactory{
+		factory:  factory,
+		duration: duration,
+		clock:    clock,
+
+		lock:  &sync.Mutex{},
+		cache: map[string]cachedVar{},
+	}
+}
+
+func (factory cachingVariablesFactory) NewVariables(teamName string, pipelineName string) Variables {
+	return cachingVariables{
+		Variables: factory.factory.NewVariables(teamName, pipelineName),
+
+		keyPrefix: teamName + "/" + pipelineName + "/",
+		factory:   factory,
+	}
+}
+
+// Close closes the wrapped factory, if it holds on to resources.
+func (factory cachingVariablesFactory) Close() {
+	if closer, ok := factory.factory.(ClosingVariablesFactory); ok {
+		closer.Close()
+	}
+}
+
+type cachingVariables struct {
+	Variables
+
+	keyPrefix string
+	factory   cachingVariablesFactory
+}
+
+func (variables cachingVariables) Get(varDef template.VariableDefinition) (interface{}, bool, error) {
+	key := variables.keyPrefix + varDef.Name
+	now := variables.factory.clock.Now()
+
+	variables.factory.lock.Lock()
+	cached, hit := variables.factory.cache[key]
+	variables.factory.lock.Unlock()
+
+	if hit && now.Before(cached.expires) {
+		return cached.value, cached.found, nil
+	}
+
+	value, found, err := variables.Variables.Get(varDef)
+	if err != nil {
+		return nil, false, err
+	}
+
+	variables.factory.lock.Lock()
+	variables.factory.cache[key] = cachedVar{
+		value:   value,
+		found:   found,
+		expires: now.Add(variables.factory.duration),
+	}
+	variables.factory.lock.Unlock()
+
+	return value, found, nil
+}
+
+func evaluateSourcedVars(variables SourcedVariables, node interface{}) (interface{}, error) {
+	var firstErr error
+	undefined := map[string]bool{}
+
+	interpolated := atc.InterpolateSourcedVarRefs(node, func(sourceName string, path string) (interface{}, bool) {
+		if firstErr != nil {
+			return nil, false
+		}
+
+		sourceVariables, err := variables.SourceVariables(sourceName)
+		if err != nil {
+			firstErr = err
+			return nil, false
+		}
+
+		segments := strings.Split(path, ".")
+
+		value, found, err := sourceVariables.Get(template.VariableDefinition{Name: segments[0]})
+		if err != nil {
+			firstErr = err
+			return nil, false
+		}
+
+		for _, field := range segments[1:] {
+			if !found {
+				break
+			}
+
+			value, found = lookupField(value, field)
+		}
+
+		if !found {
+			undefined[sourceName+":"+path] = true
+			return nil, false
+		}
+
+		return value, true
+	})
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	if len(undefined) > 0 {
+		vars := make([]string, 0, len(undefined))
+		for name := range undefined {
+			vars = append(vars, name)
+		}
+
+		sort.Strings(vars)
+
+		return nil, UndefinedSourcedVarsError{Vars: vars}
+	}
+
+	return interpolated, nil
+}
+
+func lookupField(value interface{}, field string) (interface{}, bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		fieldValue, found := v[field]
+		return fieldValue, found
+	case map[interface{}]interface{}:
+		fieldValue, found := v[field]
+		return fieldValue, found
+	}
+
+	return nil, false
+}
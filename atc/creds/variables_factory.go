@@ -8,6 +8,16 @@ type VariablesFactory interface {
 	NewVariables(string, string) Variables
 }
 
+//go:generate counterfeiter . ClosingVariablesFactory
+
+// A ClosingVariablesFactory holds on to resources, e.g. a client which keeps
+// itself logged in, which are released by Close once it is no longer used.
+type ClosingVariablesFactory interface {
+	VariablesFactory
+
+	Close()
+}
+
 //go:generate counterfeiter . Variables
 
 type Variables interface {
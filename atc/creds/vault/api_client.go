@@ -1,6 +1,7 @@
 package vault
 
 import (
+	"net/http"
 	"path"
 	"sync/atomic"
 	"time"
@@ -49,6 +50,37 @@ func (ac *APIClient) Read(path string) (*vaultapi.Secret, error) {
 	return ac.client().Logical().Read(path)
 }
 
+// LookupLease returns whether the lease is still valid, i.e. it has neither
+// expired nor been revoked.
+func (ac *APIClient) LookupLease(leaseID string) (bool, error) {
+	client := ac.client()
+
+	request := client.NewRequest("PUT", "/v1/sys/leases/lookup")
+
+	err := request.SetJSONBody(map[string]interface{}{
+		"lease_id": leaseID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	response, err := client.RawRequest(request)
+	if response != nil {
+		defer response.Body.Close()
+
+		// vault responds with 400 for unknown leases
+		if response.StatusCode == http.StatusBadRequest {
+			return false, nil
+		}
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (ac *APIClient) loginParams() map[string]interface{} {
 	loginParams := make(map[string]interface{})
 	for k, v := range ac.authConfig.Params {
@@ -14,36 +14,40 @@ import (
 )
 
 type VaultManager struct {
-	URL string `long:"url" description:"Vault server address used to access secrets."`
+	URL string `long:"url" description:"Vault server address used to access secrets." mapstructure:"url"`
 
-	PathPrefix string `long:"path-prefix" default:"/concourse" description:"Path under which to namespace credential lookup."`
+	PathPrefix string `long:"path-prefix" default:"/concourse" description:"Path under which to namespace credential lookup." mapstructure:"path_prefix"`
 
-	Cache    bool          `long:"cache" description:"Cache returned secrets for their lease duration in memory"`
-	MaxLease time.Duration `long:"max-lease" description:"If the cache is enabled, and this is set, override secrets lease duration with a maximum value"`
+	Cache    bool          `long:"cache" description:"Cache returned secrets for their lease duration in memory" mapstructure:"cache"`
+	MaxLease time.Duration `long:"max-lease" description:"If the cache is enabled, and this is set, override secrets lease duration with a maximum value" mapstructure:"max_lease"`
 
-	TLS    TLS
-	Auth   AuthConfig
-	Client *APIClient
+	SharedCache bool `long:"shared-cache" description:"Cache returned secrets for their lease duration in the database, shared by all ATCs. Takes precedence over --vault-cache." mapstructure:"shared_cache"`
+
+	TLS    TLS        `mapstructure:",squash"`
+	Auth   AuthConfig `mapstructure:",squash"`
+	Client *APIClient `mapstructure:"-"`
+
+	secretCache creds.SecretCache
 }
 
 type TLS struct {
-	CACert     string `long:"ca-cert"              description:"Path to a PEM-encoded CA cert file to use to verify the vault server SSL cert."`
-	CAPath     string `long:"ca-path"              description:"Path to a directory of PEM-encoded CA cert files to verify the vault server SSL cert."`
-	ClientCert string `long:"client-cert"          description:"Path to the client certificate for Vault authorization."`
-	ClientKey  string `long:"client-key"           description:"Path to the client private key for Vault authorization."`
-	ServerName string `long:"server-name"          description:"If set, is used to set the SNI host when connecting via TLS."`
-	Insecure   bool   `long:"insecure-skip-verify" description:"Enable insecure SSL verification."`
+	CACert     string `long:"ca-cert"              description:"Path to a PEM-encoded CA cert file to use to verify the vault server SSL cert." mapstructure:"ca_cert"`
+	CAPath     string `long:"ca-path"              description:"Path to a directory of PEM-encoded CA cert files to verify the vault server SSL cert." mapstructure:"ca_path"`
+	ClientCert string `long:"client-cert"          description:"Path to the client certificate for Vault authorization." mapstructure:"client_cert"`
+	ClientKey  string `long:"client-key"           description:"Path to the client private key for Vault authorization." mapstructure:"client_key"`
+	ServerName string `long:"server-name"          description:"If set, is used to set the SNI host when connecting via TLS." mapstructure:"server_name"`
+	Insecure   bool   `long:"insecure-skip-verify" description:"Enable insecure SSL verification." mapstructure:"insecure_skip_verify"`
 }
 
 type AuthConfig struct {
-	ClientToken string `long:"client-token" description:"Client token for accessing secrets within the Vault server."`
+	ClientToken string `long:"client-token" description:"Client token for accessing secrets within the Vault server." mapstructure:"client_token"`
 
-	Backend       string        `long:"auth-backend"               description:"Auth backend to use for logging in to Vault."`
-	BackendMaxTTL time.Duration `long:"auth-backend-max-ttl"       description:"Time after which to force a re-login. If not set, the token will just be continuously renewed."`
-	RetryMax      time.Duration `long:"retry-max"     default:"5m" description:"The maximum time between retries when logging in or re-authing a secret."`
-	RetryInitial  time.Duration `long:"retry-initial" default:"1s" description:"The initial time between retries when logging in or re-authing a secret."`
+	Backend       string        `long:"auth-backend"               description:"Auth backend to use for logging in to Vault." mapstructure:"auth_backend"`
+	BackendMaxTTL time.Duration `long:"auth-backend-max-ttl"       description:"Time after which to force a re-login. If not set, the token will just be continuously renewed." mapstructure:"auth_backend_max_ttl"`
+	RetryMax      time.Duration `long:"retry-max"     default:"5m" description:"The maximum time between retries when logging in or re-authing a secret." mapstructure:"auth_retry_max"`
+	RetryInitial  time.Duration `long:"retry-initial" default:"1s" description:"The initial time between retries when logging in or re-authing a secret." mapstructure:"auth_retry_initial"`
 
-	Params map[string]string `long:"auth-param"  description:"Paramter to pass when logging in via the backend. Can be specified multiple times." value-name:"NAME:VALUE"`
+	Params map[string]string `long:"auth-param"  description:"Paramter to pass when logging in via the backend. Can be specified multiple times." value-name:"NAME:VALUE" mapstructure:"auth_params"`
 }
 
 func (manager *VaultManager) Init(log lager.Logger) error {
@@ -78,6 +82,7 @@ func (manager *VaultManager) MarshalJSON() ([]byte, error) {
 		"path_prefix":        manager.PathPrefix,
 		"cache":              manager.Cache,
 		"max_lease":          manager.MaxLease,
+		"shared_cache":       manager.SharedCache,
 		"ca_cert":            manager.TLS.CACert,
 		"server_name":        manager.TLS.ServerName,
 		"auth_backend":       manager.Auth.Backend,
@@ -124,12 +129,29 @@ func (manager VaultManager) Health() (*creds.HealthResponse, error) {
 	return health, nil
 }
 
+// UseSharedCache sets the cache to use if the shared cache is enabled.
+func (manager *VaultManager) UseSharedCache(secretCache creds.SecretCache) {
+	manager.secretCache = secretCache
+}
+
+// SharedCacheReaper returns the task which cleans up the shared cache, if it
+// is enabled.
+func (manager *VaultManager) SharedCacheReaper(logger lager.Logger) (creds.SharedCacheReaper, bool) {
+	if !manager.SharedCache || manager.secretCache == nil {
+		return nil, false
+	}
+
+	return NewSharedCacheReaper(logger, manager.Client, manager.secretCache), true
+}
+
 func (manager VaultManager) NewVariablesFactory(logger lager.Logger) (creds.VariablesFactory, error) {
 	ra := NewReAuther(manager.Client, manager.Auth.BackendMaxTTL, manager.Auth.RetryInitial, manager.Auth.RetryMax)
 	var sr SecretReader = manager.Client
-	if manager.Cache {
+	if manager.SharedCache && manager.secretCache != nil {
+		sr = NewSharedCache(logger.Session("shared-cache"), manager.Client, manager.secretCache, manager.URL, manager.MaxLease)
+	} else if manager.Cache {
 		sr = NewCache(manager.Client, manager.MaxLease)
 	}
 
-	return NewVaultFactory(sr, ra.LoggedIn(), manager.PathPrefix), nil
+	return NewVaultFactory(sr, ra, manager.PathPrefix), nil
 }
@@ -1,6 +1,8 @@
 package vault
 
 import (
+	"time"
+
 	"github.com/concourse/concourse/atc/creds"
 	flags "github.com/jessevdk/go-flags"
 )
@@ -27,3 +29,20 @@ func (factory *vaultManagerFactory) AddConfig(group *flags.Group) creds.Manager
 
 	return manager
 }
+
+func (factory *vaultManagerFactory) NewInstance(config interface{}) (creds.Manager, error) {
+	manager := &VaultManager{
+		PathPrefix: "/concourse",
+		Auth: AuthConfig{
+			RetryMax:     5 * time.Minute,
+			RetryInitial: time.Second,
+		},
+	}
+
+	err := creds.DecodeManagerConfig(config, manager)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager, nil
+}
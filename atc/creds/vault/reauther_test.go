@@ -38,6 +38,7 @@ func (ma *MockAuther) Renew() (time.Duration, error) {
 func TestReAuther(t *testing.T) {
 	testWithoutVaultErrors(t)
 	testExponentialBackoff(t)
+	testClose(t)
 }
 
 func testWithoutVaultErrors(t *testing.T) {
@@ -135,3 +136,28 @@ func testExponentialBackoff(t *testing.T) {
 		t.Error("maxRetryInterval reached, but login was reattempted before maxRetryInterval")
 	}
 }
+
+func testClose(t *testing.T) {
+	ma := &MockAuther{
+		LoginAttempt: make(chan bool, 1),
+		Renewed:      make(chan bool, 1),
+		Delay:        1 * time.Second,
+	}
+	ra := NewReAuther(ma, 0, 1*time.Second, 64*time.Second)
+
+	select {
+	case <-ma.LoginAttempt:
+	case <-time.After(1 * time.Second):
+		t.Fatal("Didn't issue login within timeout")
+	}
+
+	ra.Close()
+
+	select {
+	case <-ma.LoginAttempt:
+		t.Error("Should not have logged in again after closing")
+	case <-ma.Renewed:
+		t.Error("Should not have renewed after closing")
+	case <-time.After(2 * time.Second):
+	}
+}
@@ -0,0 +1,154 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/metric"
+	vaultapi "github.com/hashicorp/vault/api"
+)
+
+// A LeaseLookuper checks whether a secret's lease is still valid.
+type LeaseLookuper interface {
+	LookupLease(leaseID string) (bool, error)
+}
+
+// A SharedCache caches secrets read from a SecretReader in a
+// creds.SecretCache shared by all ATCs, so that each secret is only read
+// from vault once per lease rather than once per ATC.
+//
+// Secrets are cached for half their lease duration, like Cache. Expired and
+// revoked secrets are deleted by a SharedCacheReaper.
+type SharedCache struct {
+	logger lager.Logger
+
+	sr       SecretReader
+	store    creds.SecretCache
+	prefix   string
+	maxLease time.Duration
+}
+
+// NewSharedCache using the underlying secret reader. Secrets are stored
+// under the given prefix, e.g. the vault URL, so that secrets from different
+// vaults do not collide.
+func NewSharedCache(logger lager.Logger, sr SecretReader, store creds.SecretCache, prefix string, maxLease time.Duration) *SharedCache {
+	return &SharedCache{
+		logger: logger,
+
+		sr:       sr,
+		store:    store,
+		prefix:   prefix,
+		maxLease: maxLease,
+	}
+}
+
+// A SharedCacheReaper deletes expired secrets from the shared cache, along
+// with any whose lease has been revoked.
+type SharedCacheReaper struct {
+	logger lager.Logger
+
+	leases LeaseLookuper
+	store  creds.SecretCache
+}
+
+func NewSharedCacheReaper(logger lager.Logger, leases LeaseLookuper, store creds.SecretCache) *SharedCacheReaper {
+	return &SharedCacheReaper{
+		logger: logger,
+
+		leases: leases,
+		store:  store,
+	}
+}
+
+func (r *SharedCacheReaper) Run(ctx context.Context) error {
+	logger := r.logger.Session("reap")
+
+	err := r.store.DeleteExpired()
+	if err != nil {
+		logger.Error("failed-to-delete-expired-secrets", err)
+	}
+
+	leases, err := r.store.Leases()
+	if err != nil {
+		logger.Error("failed-to-get-leases", err)
+		return err
+	}
+
+	for key, leaseID := range leases {
+		valid, err := r.leases.LookupLease(leaseID)
+		if err != nil {
+			logger.Error("failed-to-lookup-lease", err, lager.Data{"lease-id": leaseID})
+			continue
+		}
+
+		if valid {
+			continue
+		}
+
+		logger.Debug("lease-revoked", lager.Data{"lease-id": leaseID})
+
+		err = r.store.Delete(key)
+		if err != nil {
+			logger.Error("failed-to-delete-revoked-secret", err, lager.Data{"lease-id": leaseID})
+		}
+	}
+
+	return nil
+}
+
+// Read a secret from the shared cache or the underlying secret reader if not
+// present. Errors from the shared cache are logged, falling back to the
+// secret reader.
+func (c *SharedCache) Read(path string) (*vaultapi.Secret, error) {
+	logger := c.logger.Session("read")
+
+	key := c.prefix + path
+
+	payload, found, err := c.store.Get(key)
+	if err != nil {
+		logger.Error("failed-to-get-cached-secret", err)
+	}
+
+	if found {
+		var secret vaultapi.Secret
+		err := json.Unmarshal(payload, &secret)
+		if err == nil {
+			metric.SecretCacheHits.Inc()
+			return &secret, nil
+		}
+
+		logger.Error("failed-to-unmarshal-cached-secret", err)
+	}
+
+	metric.SecretCacheMisses.Inc()
+
+	secret, err := c.sr.Read(path)
+	if err != nil || secret == nil {
+		return secret, err
+	}
+
+	dur := time.Duration(secret.LeaseDuration) * time.Second / 2
+	if c.maxLease != 0 && dur > c.maxLease {
+		dur = c.maxLease
+	}
+
+	if dur == 0 {
+		return secret, nil
+	}
+
+	payload, err = json.Marshal(secret)
+	if err != nil {
+		logger.Error("failed-to-marshal-secret", err)
+		return secret, nil
+	}
+
+	err = c.store.Set(key, payload, secret.LeaseID, dur)
+	if err != nil {
+		logger.Error("failed-to-cache-secret", err)
+	}
+
+	return secret, nil
+}
@@ -0,0 +1,158 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+	vaultapi "github.com/hashicorp/vault/api"
+)
+
+type MockLeaseLookuper struct {
+	revoked map[string]bool
+}
+
+func (mll *MockLeaseLookuper) LookupLease(leaseID string) (bool, error) {
+	return !mll.revoked[leaseID], nil
+}
+
+func TestSharedCache(t *testing.T) {
+	secret := &vaultapi.Secret{
+		RequestID:     "1",
+		LeaseID:       "some-lease",
+		LeaseDuration: 10,
+	}
+
+	msr := &MockSecretReader{
+		secrets: []*vaultapi.Secret{secret},
+	}
+
+	store := new(credsfakes.FakeSecretCache)
+
+	cache := &SharedCache{
+		logger:   lagertest.NewTestLogger("test"),
+		sr:       msr,
+		store:    store,
+		prefix:   "https://vault",
+		maxLease: 3 * time.Second,
+	}
+
+	// miss
+	read, err := cache.Read("path1")
+	if err != nil {
+		t.Error("got error reading valid secret", err)
+	}
+	if read.RequestID != secret.RequestID {
+		t.Errorf("read secret %s expected %s", read.RequestID, secret.RequestID)
+	}
+	if len(msr.reads) != 1 {
+		t.Errorf("Got reads [%v], expected [\"%s\"]", msr.reads, "path1")
+	}
+	if store.SetCallCount() != 1 {
+		t.Fatalf("stored %d secrets, expected 1", store.SetCallCount())
+	}
+
+	key, payload, leaseID, ttl := store.SetArgsForCall(0)
+	if key != "https://vaultpath1" {
+		t.Errorf("stored secret as %s expected %s", key, "https://vaultpath1")
+	}
+	if leaseID != "some-lease" {
+		t.Errorf("stored lease %s expected %s", leaseID, "some-lease")
+	}
+	if ttl != 3*time.Second {
+		t.Errorf("stored secret for %s expected %s", ttl, 3*time.Second)
+	}
+
+	// hit
+	store.GetReturns(payload, true, nil)
+
+	read, err = cache.Read("path1")
+	if err != nil {
+		t.Error("got error reading valid secret from cache", err)
+	}
+	if read.RequestID != secret.RequestID {
+		t.Errorf("read secret %s expected %s", read.RequestID, secret.RequestID)
+	}
+	if len(msr.reads) != 1 {
+		t.Errorf("Got reads [%v], expected [\"%s\"]", msr.reads, "path1")
+	}
+}
+
+func TestSharedCacheUnleasedSecret(t *testing.T) {
+	msr := &MockSecretReader{
+		secrets: []*vaultapi.Secret{{RequestID: "1"}},
+	}
+
+	store := new(credsfakes.FakeSecretCache)
+
+	cache := &SharedCache{
+		logger: lagertest.NewTestLogger("test"),
+		sr:     msr,
+		store:  store,
+	}
+
+	_, err := cache.Read("path1")
+	if err != nil {
+		t.Error("got error reading valid secret", err)
+	}
+	if store.SetCallCount() != 0 {
+		t.Errorf("stored %d secrets, expected none", store.SetCallCount())
+	}
+}
+
+func TestSharedCacheReap(t *testing.T) {
+	store := new(credsfakes.FakeSecretCache)
+	store.LeasesReturns(map[string]string{
+		"some-key":    "some-lease",
+		"revoked-key": "revoked-lease",
+	}, nil)
+
+	reaper := NewSharedCacheReaper(
+		lagertest.NewTestLogger("test"),
+		&MockLeaseLookuper{
+			revoked: map[string]bool{"revoked-lease": true},
+		},
+		store,
+	)
+
+	err := reaper.Run(context.Background())
+	if err != nil {
+		t.Error("got error reaping", err)
+	}
+
+	if store.DeleteExpiredCallCount() != 1 {
+		t.Errorf("deleted expired secrets %d times, expected once", store.DeleteExpiredCallCount())
+	}
+	if store.DeleteCallCount() != 1 {
+		t.Fatalf("deleted %d secrets, expected 1", store.DeleteCallCount())
+	}
+	if key := store.DeleteArgsForCall(0); key != "revoked-key" {
+		t.Errorf("deleted secret %s expected %s", key, "revoked-key")
+	}
+}
+
+func TestSharedCacheCorruptSecret(t *testing.T) {
+	msr := &MockSecretReader{
+		secrets: []*vaultapi.Secret{{RequestID: "1"}},
+	}
+
+	store := new(credsfakes.FakeSecretCache)
+	store.GetReturns(json.RawMessage("{"), true, nil)
+
+	cache := &SharedCache{
+		logger: lagertest.NewTestLogger("test"),
+		sr:     msr,
+		store:  store,
+	}
+
+	read, err := cache.Read("path1")
+	if err != nil {
+		t.Error("got error reading valid secret", err)
+	}
+	if read.RequestID != "1" || len(msr.reads) != 1 {
+		t.Errorf("expected secret to be read from vault")
+	}
+}
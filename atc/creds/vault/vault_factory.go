@@ -10,24 +10,29 @@ import (
 type vaultFactory struct {
 	sr       SecretReader
 	prefix   string
-	loggedIn <-chan struct{}
+	reAuther *ReAuther
 }
 
-func NewVaultFactory(sr SecretReader, loggedIn <-chan struct{}, prefix string) *vaultFactory {
+func NewVaultFactory(sr SecretReader, reAuther *ReAuther, prefix string) *vaultFactory {
 	factory := &vaultFactory{
 		sr:       sr,
 		prefix:   prefix,
-		loggedIn: loggedIn,
+		reAuther: reAuther,
 	}
 
 	return factory
 }
 
+// Close stops keeping the client logged in.
+func (factory *vaultFactory) Close() {
+	factory.reAuther.Close()
+}
+
 // NewVariables will block until the loggedIn channel passed to the
 // constructor signals a successful login.
 func (factory *vaultFactory) NewVariables(teamName string, pipelineName string) creds.Variables {
 	select {
-	case <-factory.loggedIn:
+	case <-factory.reAuther.LoggedIn():
 	case <-time.After(5 * time.Second):
 	}
 
@@ -1,6 +1,7 @@
 package algorithm_test
 
 import (
+	"github.com/concourse/concourse/atc"
 	. "github.com/onsi/ginkgo/extensions/table"
 )
 
@@ -819,4 +820,126 @@ var _ = DescribeTable("Input resolving",
 			},
 		},
 	}),
+
+	Entry("uses the latest version matching the version filter", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "1.0.0", CheckOrder: 1},
+				{Resource: "resource-x", Version: "1.1.0", CheckOrder: 2},
+				{Resource: "resource-x", Version: "2.0.0", CheckOrder: 3},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Filter:   &atc.VersionFilterConfig{Semver: "< 2.0.0"},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "1.1.0",
+			},
+		},
+	}),
+
+	Entry("does not resolve when no version matches the version filter", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Filter:   &atc.VersionFilterConfig{Regex: "^release-"},
+			},
+		},
+
+		Result: Result{
+			OK:     false,
+			Values: map[string]string{},
+		},
+	}),
+
+	Entry("only considers every version matching the version filter", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Resource: "resource-x", Version: "rxv2-rc", CheckOrder: 2},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Version:  Version{Every: true},
+				Filter:   &atc.VersionFilterConfig{Regex: "^rxv[0-9]+$"},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv1",
+			},
+		},
+	}),
+
+	Entry("only considers passed versions matching the version filter", Example{
+		DB: DB{
+			BuildOutputs: []DBRow{
+				{Job: "simple-a", BuildID: 1, Resource: "resource-x", Version: "1.0.0", CheckOrder: 1},
+				{Job: "simple-a", BuildID: 2, Resource: "resource-x", Version: "2.0.0-beta", CheckOrder: 2},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Passed:   []string{"simple-a"},
+				Filter:   &atc.VersionFilterConfig{Semver: ">= 1.0.0"},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "1.0.0",
+			},
+		},
+	}),
+
+	Entry("uses a pinned version regardless of the version filter", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "1.0.0", CheckOrder: 1},
+				{Resource: "resource-x", Version: "2.0.0", CheckOrder: 2},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Version:  Version{Pinned: "2.0.0"},
+				Filter:   &atc.VersionFilterConfig{Semver: "< 2.0.0"},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "2.0.0",
+			},
+		},
+	}),
 )
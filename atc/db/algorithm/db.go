@@ -1,11 +1,21 @@
 package algorithm
 
+import "github.com/concourse/concourse/atc"
+
 type VersionsDB struct {
 	ResourceVersions []ResourceVersion
 	BuildOutputs     []BuildOutput
 	BuildInputs      []BuildInput
 	JobIDs           map[string]int
 	ResourceIDs      map[string]int
+
+	// the fields of each version, keyed by version ID; used for evaluating
+	// version filters
+	Versions map[int]atc.Version
+
+	// versions that have been disabled, and so are absent from
+	// ResourceVersions; used for explaining resolution failures
+	DisabledVersions []ResourceVersion
 }
 
 type ResourceVersion struct {
@@ -70,6 +80,49 @@ func (db VersionsDB) LatestVersionOfResource(resourceID int) (VersionCandidate,
 	return candidate, found
 }
 
+func (db VersionsDB) LatestVersionOfResourceMatching(resourceID int, filter *VersionFilter) (VersionCandidate, bool) {
+	var candidate VersionCandidate
+	var found bool
+
+	for _, v := range db.ResourceVersions {
+		if v.ResourceID == resourceID && v.CheckOrder > candidate.CheckOrder && db.VersionMatches(v.VersionID, filter) {
+			candidate = VersionCandidate{
+				VersionID:  v.VersionID,
+				CheckOrder: v.CheckOrder,
+			}
+
+			found = true
+		}
+	}
+
+	return candidate, found
+}
+
+func (db VersionsDB) IsVersionDisabled(resourceID int, versionID int) bool {
+	for _, v := range db.DisabledVersions {
+		if v.ResourceID == resourceID && v.VersionID == versionID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (db VersionsDB) HasDisabledVersions(resourceID int) bool {
+	for _, v := range db.DisabledVersions {
+		if v.ResourceID == resourceID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (db VersionsDB) VersionMatches(versionID int, filter *VersionFilter) bool {
+	ok, _ := filter.Check(db.Versions[versionID])
+	return ok
+}
+
 func (db VersionsDB) FindVersionOfResource(resourceID int, versionID int) (VersionCandidate, bool) {
 	var candidate VersionCandidate
 	var found bool
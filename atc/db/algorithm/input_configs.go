@@ -8,6 +8,7 @@ type InputConfig struct {
 	Passed          JobSet
 	UseEveryVersion bool
 	PinnedVersionID int
+	VersionFilter   *VersionFilter
 	ResourceID      int
 	JobID           int
 }
@@ -22,12 +23,15 @@ func (configs InputConfigs) Resolve(db *VersionsDB) (InputMapping, bool) {
 		if len(inputConfig.Passed) == 0 {
 			if inputConfig.UseEveryVersion {
 				versionCandidates = db.AllVersionsOfResource(inputConfig.ResourceID)
+				versionCandidates = inputConfig.filterCandidates(db, versionCandidates)
 			} else {
 				var versionCandidate VersionCandidate
 				var found bool
 
 				if inputConfig.PinnedVersionID != 0 {
 					versionCandidate, found = db.FindVersionOfResource(inputConfig.ResourceID, inputConfig.PinnedVersionID)
+				} else if inputConfig.VersionFilter != nil {
+					versionCandidate, found = db.LatestVersionOfResourceMatching(inputConfig.ResourceID, inputConfig.VersionFilter)
 				} else {
 					versionCandidate, found = db.LatestVersionOfResource(inputConfig.ResourceID)
 				}
@@ -48,6 +52,8 @@ func (configs InputConfigs) Resolve(db *VersionsDB) (InputMapping, bool) {
 				inputConfig.Passed,
 			)
 
+			versionCandidates = inputConfig.filterCandidates(db, versionCandidates)
+
 			if versionCandidates.IsEmpty() {
 				return nil, false
 			}
@@ -88,3 +94,15 @@ func (configs InputConfigs) Resolve(db *VersionsDB) (InputMapping, bool) {
 
 	return mapping, true
 }
+
+// filterCandidates removes candidates rejected by the input's version filter.
+// A pinned version is used regardless of the filter.
+func (config InputConfig) filterCandidates(db *VersionsDB, candidates VersionCandidates) VersionCandidates {
+	if config.VersionFilter == nil || config.PinnedVersionID != 0 {
+		return candidates
+	}
+
+	return candidates.Filter(func(versionID int) bool {
+		return db.VersionMatches(versionID, config.VersionFilter)
+	})
+}
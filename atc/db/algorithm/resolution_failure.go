@@ -0,0 +1,163 @@
+package algorithm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// A ResolutionFailure describes why an input could not be resolved to a
+// version.
+type ResolutionFailure string
+
+const (
+	NoVersionsAvailable         ResolutionFailure = "no versions available"
+	AllVersionsDisabled         ResolutionFailure = "all versions are disabled"
+	NoVersionsMatchFilter       ResolutionFailure = "no versions match the version filter"
+	PinnedVersionNotFound       ResolutionFailure = "pinned version not found"
+	PinnedVersionDisabled       ResolutionFailure = "pinned version is disabled"
+	PinnedVersionNotPassed      ResolutionFailure = "pinned version has not passed the required jobs"
+	NoSatisfiableVersionsPassed ResolutionFailure = "no versions satisfy the passed constraints together with the other inputs"
+)
+
+func NoVersionPassedJobs(jobNames []string) ResolutionFailure {
+	if len(jobNames) == 1 {
+		return ResolutionFailure(fmt.Sprintf("no version passed job '%s'", jobNames[0]))
+	}
+
+	return ResolutionFailure(fmt.Sprintf("no version passed all of jobs '%s'", strings.Join(jobNames, "', '")))
+}
+
+func NoVersionPassedJobsWithInputs(jobNames []string, inputNames []string) ResolutionFailure {
+	jobs := fmt.Sprintf("job '%s'", jobNames[0])
+	if len(jobNames) > 1 {
+		jobs = fmt.Sprintf("jobs '%s'", strings.Join(jobNames, "', '"))
+	}
+
+	inputs := fmt.Sprintf("input '%s'", inputNames[0])
+	if len(inputNames) > 1 {
+		inputs = fmt.Sprintf("inputs '%s'", strings.Join(inputNames, "', '"))
+	}
+
+	return ResolutionFailure(fmt.Sprintf("no version passed %s in the same build as a usable version of %s", jobs, inputs))
+}
+
+// ExplainFailure determines why the input cannot be resolved on its own. It is
+// only meaningful when resolving the input by itself has failed.
+func (config InputConfig) ExplainFailure(db *VersionsDB) ResolutionFailure {
+	if len(config.Passed) == 0 {
+		if config.PinnedVersionID != 0 {
+			if db.IsVersionDisabled(config.ResourceID, config.PinnedVersionID) {
+				return PinnedVersionDisabled
+			}
+
+			return PinnedVersionNotFound
+		}
+
+		if db.AllVersionsOfResource(config.ResourceID).IsEmpty() {
+			if db.HasDisabledVersions(config.ResourceID) {
+				return AllVersionsDisabled
+			}
+
+			return NoVersionsAvailable
+		}
+
+		if config.VersionFilter != nil {
+			return NoVersionsMatchFilter
+		}
+
+		return NoVersionsAvailable
+	}
+
+	jobNames := db.jobNames(config.Passed)
+
+	for _, jobName := range jobNames {
+		candidates := db.VersionsOfResourcePassedJobs(config.ResourceID, JobSet{db.JobIDs[jobName]: struct{}{}})
+		if candidates.IsEmpty() {
+			return NoVersionPassedJobs([]string{jobName})
+		}
+	}
+
+	candidates := db.VersionsOfResourcePassedJobs(config.ResourceID, config.Passed)
+	if candidates.IsEmpty() {
+		return NoVersionPassedJobs(jobNames)
+	}
+
+	if config.PinnedVersionID != 0 {
+		if candidates.ForVersion(config.PinnedVersionID).IsEmpty() {
+			return PinnedVersionNotPassed
+		}
+	} else if config.VersionFilter != nil {
+		if config.filterCandidates(db, candidates).IsEmpty() {
+			return NoVersionsMatchFilter
+		}
+	}
+
+	return NoSatisfiableVersionsPassed
+}
+
+// ExplainConflict determines why the named input cannot be resolved together
+// with the other inputs. It is only meaningful when each input resolves on its
+// own, but resolving them all together has failed.
+//
+// Inputs which share passed jobs must use versions from the same builds of
+// those jobs, so the conflicting inputs are those sharing a passed job with
+// which the input cannot be resolved as a pair.
+func (configs InputConfigs) ExplainConflict(db *VersionsDB, name string) ResolutionFailure {
+	var config InputConfig
+	for _, c := range configs {
+		if c.Name == name {
+			config = c
+		}
+	}
+
+	conflictingJobs := JobSet{}
+	conflictingInputs := []string{}
+	for _, other := range configs {
+		if other.Name == name {
+			continue
+		}
+
+		sharedJobs := JobSet{}
+		for jobID := range config.Passed {
+			if _, found := other.Passed[jobID]; found {
+				sharedJobs[jobID] = struct{}{}
+			}
+		}
+
+		if len(sharedJobs) == 0 {
+			continue
+		}
+
+		if _, ok := (InputConfigs{config, other}).Resolve(db); ok {
+			continue
+		}
+
+		for jobID := range sharedJobs {
+			conflictingJobs[jobID] = struct{}{}
+		}
+
+		conflictingInputs = append(conflictingInputs, other.Name)
+	}
+
+	if len(conflictingInputs) == 0 {
+		return NoSatisfiableVersionsPassed
+	}
+
+	sort.Strings(conflictingInputs)
+
+	return NoVersionPassedJobsWithInputs(db.jobNames(conflictingJobs), conflictingInputs)
+}
+
+func (db VersionsDB) jobNames(jobs JobSet) []string {
+	names := []string{}
+	for name, id := range db.JobIDs {
+		if _, found := jobs[id]; found {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,148 @@
+package algorithm_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/algorithm"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ExplainFailure", func() {
+	var (
+		versionsDB *algorithm.VersionsDB
+		config     algorithm.InputConfig
+	)
+
+	BeforeEach(func() {
+		versionsDB = &algorithm.VersionsDB{
+			JobIDs: map[string]int{"current": 1, "upstream-a": 2, "upstream-b": 3},
+			ResourceVersions: []algorithm.ResourceVersion{
+				{VersionID: 1, ResourceID: 11, CheckOrder: 1},
+				{VersionID: 2, ResourceID: 11, CheckOrder: 2},
+			},
+			DisabledVersions: []algorithm.ResourceVersion{
+				{VersionID: 3, ResourceID: 11, CheckOrder: 3},
+				{VersionID: 4, ResourceID: 12, CheckOrder: 1},
+			},
+			BuildOutputs: []algorithm.BuildOutput{
+				{
+					ResourceVersion: algorithm.ResourceVersion{VersionID: 1, ResourceID: 11, CheckOrder: 1},
+					BuildID:         10,
+					JobID:           2,
+				},
+				{
+					ResourceVersion: algorithm.ResourceVersion{VersionID: 2, ResourceID: 11, CheckOrder: 2},
+					BuildID:         20,
+					JobID:           3,
+				},
+			},
+			Versions: map[int]atc.Version{
+				1: {"ref": "v1"},
+				2: {"ref": "v2"},
+			},
+		}
+
+		config = algorithm.InputConfig{
+			Name:       "some-input",
+			ResourceID: 11,
+			JobID:      1,
+			Passed:     algorithm.JobSet{},
+		}
+	})
+
+	It("explains that a resource has no versions", func() {
+		config.ResourceID = 13
+		Expect(config.ExplainFailure(versionsDB)).To(Equal(algorithm.NoVersionsAvailable))
+	})
+
+	It("explains that all versions of a resource are disabled", func() {
+		config.ResourceID = 12
+		Expect(config.ExplainFailure(versionsDB)).To(Equal(algorithm.AllVersionsDisabled))
+	})
+
+	It("explains that a pinned version is disabled", func() {
+		config.PinnedVersionID = 3
+		Expect(config.ExplainFailure(versionsDB)).To(Equal(algorithm.PinnedVersionDisabled))
+	})
+
+	It("explains that a pinned version is missing", func() {
+		config.PinnedVersionID = 99
+		Expect(config.ExplainFailure(versionsDB)).To(Equal(algorithm.PinnedVersionNotFound))
+	})
+
+	It("explains that no version matches the version filter", func() {
+		filter, err := algorithm.NewVersionFilter(atc.VersionFilterConfig{Regex: "^release-"})
+		Expect(err).ToNot(HaveOccurred())
+
+		config.VersionFilter = filter
+		Expect(config.ExplainFailure(versionsDB)).To(Equal(algorithm.NoVersionsMatchFilter))
+	})
+
+	It("names the passed job no version has gone through", func() {
+		config.ResourceID = 12
+		config.Passed = algorithm.JobSet{2: struct{}{}}
+		Expect(config.ExplainFailure(versionsDB)).To(Equal(algorithm.ResolutionFailure("no version passed job 'upstream-a'")))
+	})
+
+	It("names the passed jobs no single version has gone through", func() {
+		config.Passed = algorithm.JobSet{2: struct{}{}, 3: struct{}{}}
+		Expect(config.ExplainFailure(versionsDB)).To(Equal(algorithm.ResolutionFailure("no version passed all of jobs 'upstream-a', 'upstream-b'")))
+	})
+
+	It("explains that a pinned version has not passed the required jobs", func() {
+		config.Passed = algorithm.JobSet{2: struct{}{}}
+		config.PinnedVersionID = 2
+		Expect(config.ExplainFailure(versionsDB)).To(Equal(algorithm.PinnedVersionNotPassed))
+	})
+})
+
+var _ = Describe("ExplainConflict", func() {
+	var (
+		versionsDB *algorithm.VersionsDB
+		configs    algorithm.InputConfigs
+	)
+
+	BeforeEach(func() {
+		versionsDB = &algorithm.VersionsDB{
+			JobIDs: map[string]int{"current": 1, "upstream-a": 2, "upstream-b": 3},
+			ResourceVersions: []algorithm.ResourceVersion{
+				{VersionID: 1, ResourceID: 11, CheckOrder: 1},
+				{VersionID: 2, ResourceID: 12, CheckOrder: 1},
+				{VersionID: 3, ResourceID: 13, CheckOrder: 1},
+			},
+			BuildOutputs: []algorithm.BuildOutput{
+				{
+					ResourceVersion: algorithm.ResourceVersion{VersionID: 1, ResourceID: 11, CheckOrder: 1},
+					BuildID:         10,
+					JobID:           2,
+				},
+				{
+					ResourceVersion: algorithm.ResourceVersion{VersionID: 2, ResourceID: 12, CheckOrder: 1},
+					BuildID:         11,
+					JobID:           2,
+				},
+				{
+					ResourceVersion: algorithm.ResourceVersion{VersionID: 3, ResourceID: 13, CheckOrder: 1},
+					BuildID:         12,
+					JobID:           3,
+				},
+			},
+		}
+
+		configs = algorithm.InputConfigs{
+			{Name: "a", ResourceID: 11, JobID: 1, Passed: algorithm.JobSet{2: struct{}{}}},
+			{Name: "b", ResourceID: 12, JobID: 1, Passed: algorithm.JobSet{2: struct{}{}}},
+			{Name: "c", ResourceID: 13, JobID: 1, Passed: algorithm.JobSet{3: struct{}{}}},
+		}
+	})
+
+	It("names the inputs which did not pass the shared job in the same build", func() {
+		Expect(configs.ExplainConflict(versionsDB, "a")).To(Equal(algorithm.ResolutionFailure("no version passed job 'upstream-a' in the same build as a usable version of input 'b'")))
+		Expect(configs.ExplainConflict(versionsDB, "b")).To(Equal(algorithm.ResolutionFailure("no version passed job 'upstream-a' in the same build as a usable version of input 'a'")))
+	})
+
+	It("falls back to a general explanation for inputs not sharing a job with a conflicting input", func() {
+		Expect(configs.ExplainConflict(versionsDB, "c")).To(Equal(algorithm.NoSatisfiableVersionsPassed))
+	})
+})
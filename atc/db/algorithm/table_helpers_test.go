@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db/algorithm"
 	. "github.com/onsi/gomega"
 )
@@ -39,6 +40,7 @@ type Input struct {
 	Resource string
 	Passed   []string
 	Version  Version
+	Filter   *atc.VersionFilterConfig
 }
 
 type Version struct {
@@ -77,7 +79,7 @@ func (mapping StringMapping) Name(id int) string {
 const CurrentJobName = "current"
 
 func (example Example) Run() {
-	db := &algorithm.VersionsDB{}
+	db := &algorithm.VersionsDB{Versions: map[int]atc.Version{}}
 
 	jobIDs := StringMapping{}
 	resourceIDs := StringMapping{}
@@ -112,6 +114,7 @@ func (example Example) Run() {
 				ResourceID: resourceIDs.ID(row.Resource),
 				CheckOrder: row.CheckOrder,
 			}
+			db.Versions[version.VersionID] = atc.Version{"ref": row.Version}
 			db.ResourceVersions = append(db.ResourceVersions, version)
 		}
 		for _, row := range example.DB.BuildInputs {
@@ -120,6 +123,7 @@ func (example Example) Run() {
 				ResourceID: resourceIDs.ID(row.Resource),
 				CheckOrder: row.CheckOrder,
 			}
+			db.Versions[version.VersionID] = atc.Version{"ref": row.Version}
 			db.BuildInputs = append(db.BuildInputs, algorithm.BuildInput{
 				ResourceVersion: version,
 				BuildID:         row.BuildID,
@@ -132,6 +136,7 @@ func (example Example) Run() {
 				ResourceID: resourceIDs.ID(row.Resource),
 				CheckOrder: row.CheckOrder,
 			}
+			db.Versions[version.VersionID] = atc.Version{"ref": row.Version}
 			db.BuildOutputs = append(db.BuildOutputs, algorithm.BuildOutput{
 				ResourceVersion: version,
 				BuildID:         row.BuildID,
@@ -152,12 +157,20 @@ func (example Example) Run() {
 			versionID = versionIDs.ID(input.Version.Pinned)
 		}
 
+		var filter *algorithm.VersionFilter
+		if input.Filter != nil {
+			var err error
+			filter, err = algorithm.NewVersionFilter(*input.Filter)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
 		inputConfigs[i] = algorithm.InputConfig{
 			Name:            input.Name,
 			Passed:          passed,
 			ResourceID:      resourceIDs.ID(input.Resource),
 			UseEveryVersion: input.Version.Every,
 			PinnedVersionID: versionID,
+			VersionFilter:   filter,
 			JobID:           jobIDs.ID(CurrentJobName),
 		}
 	}
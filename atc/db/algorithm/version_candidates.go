@@ -147,6 +147,20 @@ func (candidates VersionCandidates) VersionIDs() *VersionsIter {
 	}
 }
 
+func (candidates VersionCandidates) Filter(match func(versionID int) bool) VersionCandidates {
+	newCandidates := VersionCandidates{
+		constraints: candidates.constraints,
+	}
+
+	for _, version := range candidates.versions {
+		if match(version.id) {
+			newCandidates.Merge(version)
+		}
+	}
+
+	return newCandidates
+}
+
 func (candidates VersionCandidates) ForVersion(versionID int) VersionCandidates {
 	newCandidates := VersionCandidates{}
 	for _, version := range candidates.versions {
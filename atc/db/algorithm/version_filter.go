@@ -0,0 +1,78 @@
+package algorithm
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/Masterminds/semver"
+	"github.com/concourse/concourse/atc"
+)
+
+// VersionFilter decides whether a version of a resource may be used for an
+// input, according to the input's configured version filter.
+type VersionFilter struct {
+	config     atc.VersionFilterConfig
+	constraint *semver.Constraints
+	regex      *regexp.Regexp
+}
+
+func NewVersionFilter(config atc.VersionFilterConfig) (*VersionFilter, error) {
+	filter := &VersionFilter{config: config}
+
+	if config.Semver != "" {
+		constraint, err := semver.NewConstraint(config.Semver)
+		if err != nil {
+			return nil, fmt.Errorf("invalid semver constraint '%s': %s", config.Semver, err)
+		}
+
+		filter.constraint = constraint
+	}
+
+	if config.Regex != "" {
+		regex, err := regexp.Compile(config.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex '%s': %s", config.Regex, err)
+		}
+
+		filter.regex = regex
+	}
+
+	return filter, nil
+}
+
+// Check returns whether the version passes the filter. When it does not, the
+// reason it was excluded is returned as well.
+func (filter *VersionFilter) Check(version atc.Version) (bool, string) {
+	field := filter.config.Field
+	if field == "" {
+		if len(version) != 1 {
+			return false, "version has more than one field; a field must be specified to filter on"
+		}
+
+		for name := range version {
+			field = name
+		}
+	}
+
+	value, found := version[field]
+	if !found {
+		return false, fmt.Sprintf("version has no field '%s'", field)
+	}
+
+	if filter.constraint != nil {
+		semverValue, err := semver.NewVersion(value)
+		if err != nil {
+			return false, fmt.Sprintf("%s '%s' is not a semantic version", field, value)
+		}
+
+		if !filter.constraint.Check(semverValue) {
+			return false, fmt.Sprintf("%s '%s' does not satisfy '%s'", field, value, filter.config.Semver)
+		}
+	}
+
+	if filter.regex != nil && !filter.regex.MatchString(value) {
+		return false, fmt.Sprintf("%s '%s' does not match /%s/", field, value, filter.config.Regex)
+	}
+
+	return true, ""
+}
@@ -0,0 +1,78 @@
+package algorithm_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/algorithm"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VersionFilter", func() {
+	var (
+		config atc.VersionFilterConfig
+		filter *algorithm.VersionFilter
+	)
+
+	JustBeforeEach(func() {
+		var err error
+		filter, err = algorithm.NewVersionFilter(config)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Context("with a semver constraint", func() {
+		BeforeEach(func() {
+			config = atc.VersionFilterConfig{Semver: "~1.2"}
+		})
+
+		It("accepts versions satisfying the constraint", func() {
+			ok, reason := filter.Check(atc.Version{"tag": "1.2.7"})
+			Expect(ok).To(BeTrue())
+			Expect(reason).To(BeEmpty())
+		})
+
+		It("rejects versions outside the constraint", func() {
+			ok, reason := filter.Check(atc.Version{"tag": "1.3.0"})
+			Expect(ok).To(BeFalse())
+			Expect(reason).To(Equal("tag '1.3.0' does not satisfy '~1.2'"))
+		})
+
+		It("rejects values that are not semantic versions", func() {
+			ok, reason := filter.Check(atc.Version{"tag": "latest"})
+			Expect(ok).To(BeFalse())
+			Expect(reason).To(Equal("tag 'latest' is not a semantic version"))
+		})
+
+		It("rejects versions with more than one field", func() {
+			ok, reason := filter.Check(atc.Version{"tag": "1.2.0", "digest": "sha256:abc"})
+			Expect(ok).To(BeFalse())
+			Expect(reason).To(ContainSubstring("more than one field"))
+		})
+	})
+
+	Context("with a field and a regex", func() {
+		BeforeEach(func() {
+			config = atc.VersionFilterConfig{Field: "ref", Regex: "^release-"}
+		})
+
+		It("matches the regex against the field", func() {
+			ok, _ := filter.Check(atc.Version{"ref": "release-1", "digest": "abc"})
+			Expect(ok).To(BeTrue())
+
+			ok, reason := filter.Check(atc.Version{"ref": "main-1", "digest": "abc"})
+			Expect(ok).To(BeFalse())
+			Expect(reason).To(Equal("ref 'main-1' does not match /^release-/"))
+		})
+
+		It("rejects versions missing the field", func() {
+			ok, reason := filter.Check(atc.Version{"digest": "abc"})
+			Expect(ok).To(BeFalse())
+			Expect(reason).To(Equal("version has no field 'ref'"))
+		})
+	})
+
+	It("fails to construct with an invalid regex", func() {
+		_, err := algorithm.NewVersionFilter(atc.VersionFilterConfig{Regex: "(oops"})
+		Expect(err).To(HaveOccurred())
+	})
+})
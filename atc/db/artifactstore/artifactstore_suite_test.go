@@ -0,0 +1,13 @@
+package artifactstore_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestArtifactStore(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Artifact Store Suite")
+}
@@ -0,0 +1,53 @@
+package artifactstore
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc/db/blobstore"
+)
+
+// Store stores each of a build's artifacts as a blob, with the keys of the
+// build's artifacts sharing the build's ID as their directory.
+type Store struct {
+	blobs blobstore.Store
+}
+
+func New(blobs blobstore.Store) *Store {
+	return &Store{
+		blobs: blobs,
+	}
+}
+
+func (store *Store) Put(buildID int, name string, artifact io.Reader) error {
+	key, err := key(buildID, name)
+	if err != nil {
+		return err
+	}
+
+	return store.blobs.Put(key, "application/gzip", artifact)
+}
+
+func (store *Store) Get(buildID int, name string) (io.ReadCloser, error) {
+	key, err := key(buildID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return store.blobs.Get(key)
+}
+
+// Delete removes all of the build's artifacts, if any have been stored.
+func (store *Store) Delete(buildID int) error {
+	return store.blobs.DeleteAll(strconv.Itoa(buildID))
+}
+
+func key(buildID int, name string) (string, error) {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return "", fmt.Errorf("invalid artifact name: %q", name)
+	}
+
+	return strconv.Itoa(buildID) + "/" + name + ".tgz", nil
+}
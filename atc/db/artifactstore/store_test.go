@@ -0,0 +1,82 @@
+package artifactstore_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/concourse/atc/db/artifactstore"
+	"github.com/concourse/concourse/atc/db/blobstore"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Store", func() {
+	var (
+		dir   string
+		store *artifactstore.Store
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "artifact-store")
+		Expect(err).ToNot(HaveOccurred())
+
+		store = artifactstore.New(blobstore.NewFileStore(dir))
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("stores and returns the build's artifacts", func() {
+		err := store.Put(42, "some-output", strings.NewReader("some-tarball"))
+		Expect(err).ToNot(HaveOccurred())
+
+		artifact, err := store.Get(42, "some-output")
+		Expect(err).ToNot(HaveOccurred())
+
+		defer artifact.Close()
+
+		contents, err := ioutil.ReadAll(artifact)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(contents)).To(Equal("some-tarball"))
+	})
+
+	It("stores the build's artifacts in a directory of their own", func() {
+		err := store.Put(42, "some-output", strings.NewReader("some-tarball"))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = os.Stat(filepath.Join(dir, "42", "some-output.tgz"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("refuses names which would escape the build's directory", func() {
+		err := store.Put(42, "../some-output", strings.NewReader("some-tarball"))
+		Expect(err).To(HaveOccurred())
+
+		_, err = store.Get(42, "..")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("deletes all of the build's artifacts", func() {
+		Expect(store.Put(42, "some-output", strings.NewReader("some-tarball"))).To(Succeed())
+		Expect(store.Put(42, "other-output", strings.NewReader("other-tarball"))).To(Succeed())
+		Expect(store.Put(43, "some-output", strings.NewReader("some-tarball"))).To(Succeed())
+
+		err := store.Delete(42)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = os.Stat(filepath.Join(dir, "42"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+
+		_, err = os.Stat(filepath.Join(dir, "43", "some-output.tgz"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("does not fail to delete artifacts that were never stored", func() {
+		Expect(store.Delete(42)).To(Succeed())
+	})
+})
@@ -0,0 +1,19 @@
+package blobstore
+
+import (
+	"io"
+)
+
+// A Store stores blobs outside of the database, e.g. on the filesystem or in
+// S3. Blobs are identified by slash-separated keys, and the blobs sharing a
+// key prefix can be deleted together.
+type Store interface {
+	Put(key string, contentType string, blob io.Reader) error
+	Get(key string) (io.ReadCloser, error)
+
+	// Delete removes the blob, if it has been stored.
+	Delete(key string) error
+
+	// DeleteAll removes every blob whose key is within the directory.
+	DeleteAll(dir string) error
+}
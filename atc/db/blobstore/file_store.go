@@ -0,0 +1,75 @@
+package blobstore
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// FileStore stores blobs as files in a directory, e.g. one mounted from a
+// shared volume. Keys are cleaned before use, so no blob can be stored
+// outside of the directory.
+type FileStore struct {
+	dir string
+}
+
+func NewFileStore(dir string) *FileStore {
+	return &FileStore{
+		dir: dir,
+	}
+}
+
+// Put writes the blob to a temporary file which is renamed once complete, so
+// that partially written blobs are never read. The content type is not
+// recorded.
+func (store *FileStore) Put(key string, contentType string, blob io.Reader) error {
+	path := store.path(key)
+
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	file, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(file, blob)
+	if err != nil {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+		return err
+	}
+
+	err = file.Close()
+	if err != nil {
+		_ = os.Remove(file.Name())
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+func (store *FileStore) Get(key string) (io.ReadCloser, error) {
+	return os.Open(store.path(key))
+}
+
+func (store *FileStore) Delete(key string) error {
+	err := os.Remove(store.path(key))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (store *FileStore) DeleteAll(dir string) error {
+	return os.RemoveAll(store.path(dir))
+}
+
+func (store *FileStore) path(key string) string {
+	return filepath.Join(store.dir, filepath.FromSlash(path.Clean("/"+key)))
+}
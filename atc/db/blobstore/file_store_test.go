@@ -0,0 +1,93 @@
+package blobstore_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/concourse/atc/db/blobstore"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FileStore", func() {
+	var (
+		dir   string
+		store *blobstore.FileStore
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "blob-store")
+		Expect(err).ToNot(HaveOccurred())
+
+		store = blobstore.NewFileStore(dir)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("stores and returns blobs", func() {
+		err := store.Put("some/blob", "text/plain", strings.NewReader("some-contents"))
+		Expect(err).ToNot(HaveOccurred())
+
+		blob, err := store.Get("some/blob")
+		Expect(err).ToNot(HaveOccurred())
+
+		defer blob.Close()
+
+		contents, err := ioutil.ReadAll(blob)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(contents)).To(Equal("some-contents"))
+	})
+
+	It("does not leave temporary files behind", func() {
+		err := store.Put("some/blob", "text/plain", strings.NewReader("some-contents"))
+		Expect(err).ToNot(HaveOccurred())
+
+		files, err := ioutil.ReadDir(filepath.Join(dir, "some"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+		Expect(files[0].Name()).To(Equal("blob"))
+	})
+
+	It("does not store blobs outside of the directory", func() {
+		err := store.Put("../../some-blob", "text/plain", strings.NewReader("some-contents"))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = os.Stat(filepath.Join(dir, "some-blob"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("deletes blobs", func() {
+		Expect(store.Put("some-blob", "text/plain", strings.NewReader("some-contents"))).To(Succeed())
+
+		err := store.Delete("some-blob")
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = os.Stat(filepath.Join(dir, "some-blob"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("does not fail to delete blobs that were never stored", func() {
+		Expect(store.Delete("some-blob")).To(Succeed())
+	})
+
+	It("deletes every blob within a directory", func() {
+		Expect(store.Put("some/blob", "text/plain", strings.NewReader("some-contents"))).To(Succeed())
+		Expect(store.Put("some/other-blob", "text/plain", strings.NewReader("other-contents"))).To(Succeed())
+		Expect(store.Put("other/blob", "text/plain", strings.NewReader("some-contents"))).To(Succeed())
+
+		err := store.DeleteAll("some")
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = os.Stat(filepath.Join(dir, "some"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+
+		_, err = os.Stat(filepath.Join(dir, "other", "blob"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
@@ -0,0 +1,140 @@
+package blobstore
+
+import (
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+type S3Config struct {
+	Bucket          string `long:"bucket"            description:"S3 bucket in which to store the blobs."`
+	Prefix          string `long:"prefix"            description:"Prefix of the keys under which to store the blobs."`
+	Region          string `long:"region"            description:"AWS region of the bucket."`
+	Endpoint        string `long:"endpoint"          description:"Endpoint of an S3-compatible API, if not using AWS."`
+	ForcePathStyle  bool   `long:"force-path-style"  description:"Use path-style addressing, as required by some S3-compatible APIs."`
+	AccessKeyID     string `long:"access-key"        description:"AWS Access key ID. If not set, credentials are obtained from the environment."`
+	SecretAccessKey string `long:"secret-key"        description:"AWS Secret Access Key"`
+	SessionToken    string `long:"session-token"     description:"AWS Session Token"`
+}
+
+func (config S3Config) IsConfigured() bool {
+	return config.Bucket != ""
+}
+
+// S3Store stores blobs as objects in an S3-compatible bucket, with the
+// configured prefix prepended to each key.
+type S3Store struct {
+	api      s3iface.S3API
+	uploader *s3manager.Uploader
+
+	bucket string
+	prefix string
+}
+
+func NewS3Store(config S3Config) (*S3Store, error) {
+	awsConfig := &aws.Config{
+		Region:           aws.String(config.Region),
+		S3ForcePathStyle: aws.Bool(config.ForcePathStyle),
+	}
+
+	if config.Endpoint != "" {
+		awsConfig.Endpoint = aws.String(config.Endpoint)
+	}
+
+	if config.AccessKeyID != "" {
+		awsConfig.Credentials = credentials.NewStaticCredentials(config.AccessKeyID, config.SecretAccessKey, config.SessionToken)
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	api := s3.New(sess)
+
+	return &S3Store{
+		api:      api,
+		uploader: s3manager.NewUploaderWithClient(api),
+
+		bucket: config.Bucket,
+		prefix: config.Prefix,
+	}, nil
+}
+
+func (store *S3Store) Put(key string, contentType string, blob io.Reader) error {
+	_, err := store.uploader.Upload(&s3manager.UploadInput{
+		Bucket:      aws.String(store.bucket),
+		Key:         aws.String(store.key(key)),
+		Body:        blob,
+		ContentType: aws.String(contentType),
+	})
+	return err
+}
+
+func (store *S3Store) Get(key string) (io.ReadCloser, error) {
+	output, err := store.api.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(store.key(key)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Body, nil
+}
+
+func (store *S3Store) Delete(key string) error {
+	_, err := store.api.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(store.key(key)),
+	})
+	return err
+}
+
+func (store *S3Store) DeleteAll(dir string) error {
+	var deleteErr error
+
+	err := store.api.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket: aws.String(store.bucket),
+		Prefix: aws.String(store.key(dir) + "/"),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if len(page.Contents) == 0 {
+			return true
+		}
+
+		objects := make([]*s3.ObjectIdentifier, len(page.Contents))
+		for i, object := range page.Contents {
+			objects[i] = &s3.ObjectIdentifier{Key: object.Key}
+		}
+
+		var output *s3.DeleteObjectsOutput
+		output, deleteErr = store.api.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(store.bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if deleteErr == nil && len(output.Errors) > 0 {
+			deleteErr = fmt.Errorf("failed to delete %s: %s", aws.StringValue(output.Errors[0].Key), aws.StringValue(output.Errors[0].Message))
+		}
+
+		return deleteErr == nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return deleteErr
+}
+
+func (store *S3Store) key(key string) string {
+	return path.Join(store.prefix, key)
+}
@@ -0,0 +1,126 @@
+package blobstore_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/concourse/concourse/atc/db/blobstore"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("S3Store", func() {
+	var (
+		server *ghttp.Server
+		store  *blobstore.S3Store
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+
+		var err error
+		store, err = blobstore.NewS3Store(blobstore.S3Config{
+			Bucket:          "some-bucket",
+			Prefix:          "some/prefix",
+			Region:          "us-east-1",
+			Endpoint:        server.URL(),
+			ForcePathStyle:  true,
+			AccessKeyID:     "some-access-key",
+			SecretAccessKey: "some-secret-key",
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("uploads blobs under the prefix", func() {
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", "/some-bucket/some/prefix/some/blob"),
+			ghttp.VerifyHeaderKV("Content-Type", "text/plain"),
+			ghttp.VerifyBody([]byte("some-contents")),
+			ghttp.RespondWith(http.StatusOK, nil),
+		))
+
+		err := store.Put("some/blob", "text/plain", strings.NewReader("some-contents"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("downloads blobs", func() {
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", "/some-bucket/some/prefix/some/blob"),
+			ghttp.RespondWith(http.StatusOK, "some-contents"),
+		))
+
+		blob, err := store.Get("some/blob")
+		Expect(err).ToNot(HaveOccurred())
+
+		defer blob.Close()
+
+		contents, err := ioutil.ReadAll(blob)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(contents)).To(Equal("some-contents"))
+	})
+
+	It("deletes blobs", func() {
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("DELETE", "/some-bucket/some/prefix/some/blob"),
+			ghttp.RespondWith(http.StatusNoContent, nil),
+		))
+
+		Expect(store.Delete("some/blob")).To(Succeed())
+	})
+
+	It("deletes every blob within a directory", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/some-bucket", "list-type=2&prefix=some%2Fprefix%2F42%2F"),
+				ghttp.RespondWith(http.StatusOK, `<?xml version="1.0" encoding="UTF-8"?>
+<ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Name>some-bucket</Name>
+  <Prefix>some/prefix/42/</Prefix>
+  <KeyCount>2</KeyCount>
+  <IsTruncated>false</IsTruncated>
+  <Contents><Key>some/prefix/42/some-blob</Key></Contents>
+  <Contents><Key>some/prefix/42/other-blob</Key></Contents>
+</ListBucketResult>`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/some-bucket", "delete="),
+				func(w http.ResponseWriter, r *http.Request) {
+					body, err := ioutil.ReadAll(r.Body)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(body)).To(ContainSubstring("<Key>some/prefix/42/some-blob</Key>"))
+					Expect(string(body)).To(ContainSubstring("<Key>some/prefix/42/other-blob</Key>"))
+				},
+				ghttp.RespondWith(http.StatusOK, `<?xml version="1.0" encoding="UTF-8"?>
+<DeleteResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/"></DeleteResult>`),
+			),
+		)
+
+		Expect(store.DeleteAll("42")).To(Succeed())
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("does not delete anything if the directory is empty", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/some-bucket", "list-type=2&prefix=some%2Fprefix%2F42%2F"),
+				ghttp.RespondWith(http.StatusOK, `<?xml version="1.0" encoding="UTF-8"?>
+<ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Name>some-bucket</Name>
+  <Prefix>some/prefix/42/</Prefix>
+  <KeyCount>0</KeyCount>
+  <IsTruncated>false</IsTruncated>
+</ListBucketResult>`),
+			),
+		)
+
+		Expect(store.DeleteAll("42")).To(Succeed())
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+})
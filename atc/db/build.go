@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/lager"
@@ -43,11 +45,12 @@ const (
 	BuildStatusErrored   BuildStatus = "errored"
 )
 
-var buildsQuery = psql.Select("b.id, b.name, b.job_id, b.team_id, b.status, b.manually_triggered, b.scheduled, b.engine, b.engine_metadata, b.public_plan, b.start_time, b.end_time, b.reap_time, j.name, b.pipeline_id, p.name, t.name, b.nonce, b.tracked_by, b.drained").
+var buildsQuery = psql.Select("b.id, b.name, b.job_id, b.team_id, b.status, b.manually_triggered, b.scheduled, b.engine, b.engine_metadata, b.public_plan, b.create_time, b.start_time, b.end_time, b.reap_time, j.name, b.pipeline_id, p.name, t.name, b.nonce, b.tracked_by, b.drained, b.rerun_of, rb.name").
 	From("builds b").
 	JoinClause("LEFT OUTER JOIN jobs j ON b.job_id = j.id").
 	JoinClause("LEFT OUTER JOIN pipelines p ON b.pipeline_id = p.id").
-	JoinClause("LEFT OUTER JOIN teams t ON b.team_id = t.id")
+	JoinClause("LEFT OUTER JOIN teams t ON b.team_id = t.id").
+	JoinClause("LEFT OUTER JOIN builds rb ON b.rerun_of = rb.id")
 
 var minMaxIdQuery = psql.Select("COALESCE(MAX(b.id), 0)", "COALESCE(MIN(b.id), 0)").
 	From("builds as b")
@@ -67,6 +70,7 @@ type Build interface {
 	EngineMetadata() string
 	PublicPlan() *json.RawMessage
 	Status() BuildStatus
+	CreateTime() time.Time
 	StartTime() time.Time
 	EndTime() time.Time
 	ReapTime() time.Time
@@ -74,6 +78,8 @@ type Build interface {
 	IsManuallyTriggered() bool
 	IsScheduled() bool
 	IsRunning() bool
+	RerunOf() int
+	RerunOfName() string
 
 	Reload() (bool, error)
 
@@ -91,11 +97,25 @@ type Build interface {
 
 	Events(uint) (EventSource, error)
 	SaveEvent(event atc.Event) error
+	ArchiveEvents() error
 
 	SaveOutput(lager.Logger, string, atc.Source, creds.VersionedResourceTypes, atc.Version, ResourceConfigMetadataFields, string, string) error
 	UseInputs(inputs []BuildInput) error
 
 	Resources() ([]BuildInput, []BuildOutput, error)
+	Inputs() ([]BuildInput, error)
+	RerunInputs() ([]BuildInput, error)
+
+	RequestApproval(planID atc.PlanID, name string, role string) (BuildApproval, error)
+	Approval(planID atc.PlanID) (BuildApproval, bool, error)
+	Approvals() ([]BuildApproval, error)
+	DecideApproval(planID atc.PlanID, status atc.BuildApprovalStatus, decidedBy string) (bool, error)
+	ApprovalNotifier(planID atc.PlanID) (Notifier, error)
+
+	SaveArtifact(name string, artifact io.Reader) error
+	Artifact(name string) (io.ReadCloser, bool, error)
+	Artifacts() ([]BuildArtifact, error)
+
 	SaveImageResourceVersion(UsedResourceCache) error
 
 	Pipeline() (Pipeline, bool, error)
@@ -125,23 +145,29 @@ type build struct {
 
 	isManuallyTriggered bool
 
+	rerunOf     int
+	rerunOfName string
+
 	engine         string
 	engineMetadata string
 	publicPlan     *json.RawMessage
 
-	startTime time.Time
-	endTime   time.Time
-	reapTime  time.Time
+	createTime time.Time
+	startTime  time.Time
+	endTime    time.Time
+	reapTime   time.Time
 
 	trackedBy string
 
 	conn        Conn
 	lockFactory lock.LockFactory
+	stores      BuildStores
 	drained     bool
 }
 
 var ErrBuildDisappeared = errors.New("build disappeared from db")
 var ErrBuildHasNoPipeline = errors.New("build has no pipeline")
+var ErrBuildStillRunning = errors.New("build is still running")
 
 func (b *build) ID() int                      { return b.id }
 func (b *build) Name() string                 { return b.name }
@@ -155,6 +181,7 @@ func (b *build) IsManuallyTriggered() bool    { return b.isManuallyTriggered }
 func (b *build) Engine() string               { return b.engine }
 func (b *build) EngineMetadata() string       { return b.engineMetadata }
 func (b *build) PublicPlan() *json.RawMessage { return b.publicPlan }
+func (b *build) CreateTime() time.Time        { return b.createTime }
 func (b *build) StartTime() time.Time         { return b.startTime }
 func (b *build) EndTime() time.Time           { return b.endTime }
 func (b *build) ReapTime() time.Time          { return b.reapTime }
@@ -162,6 +189,8 @@ func (b *build) Status() BuildStatus          { return b.status }
 func (b *build) Tracker() string              { return b.trackedBy }
 func (b *build) IsScheduled() bool            { return b.scheduled }
 func (b *build) IsDrained() bool              { return b.drained }
+func (b *build) RerunOf() int                 { return b.rerunOf }
+func (b *build) RerunOfName() string          { return b.rerunOfName }
 
 func (b *build) IsRunning() bool {
 	switch b.status {
@@ -282,6 +311,13 @@ func (b *build) Start(engine, metadata string, plan atc.Plan) (bool, error) {
 		}
 	}
 
+	if b.pipelineID != 0 {
+		err = queueNotificationDeliveries(tx, b.id, b.pipelineID, atc.NotificationEventStarted)
+		if err != nil {
+			return false, err
+		}
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return false, err
@@ -380,6 +416,13 @@ func (b *build) Finish(status BuildStatus) error {
 		}
 	}
 
+	if event, ok := notificationEventForStatus(status); ok && b.pipelineID != 0 {
+		err = queueNotificationDeliveries(tx, b.id, b.pipelineID, event)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return err
@@ -493,7 +536,7 @@ func (b *build) Pipeline() (Pipeline, bool, error) {
 		RunWith(b.conn).
 		QueryRow()
 
-	pipeline := newPipeline(b.conn, b.lockFactory)
+	pipeline := newPipeline(b.conn, b.lockFactory, b.stores)
 	err := scanPipeline(pipeline, row)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -612,7 +655,7 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 		maxInFlightReachedStatus = BuildPreparationStatusBlocking
 	}
 
-	tf := NewTeamFactory(b.conn, b.lockFactory)
+	tf := NewTeamFactory(b.conn, b.lockFactory, b.stores)
 	t, found, err := tf.FindTeam(b.teamName)
 	if err != nil {
 		return BuildPreparation{}, false, err
@@ -740,15 +783,11 @@ func (b *build) Events(from uint) (EventSource, error) {
 		return nil, err
 	}
 
-	table := fmt.Sprintf("team_build_events_%d", b.teamID)
-	if b.pipelineID != 0 {
-		table = fmt.Sprintf("pipeline_build_events_%d", b.pipelineID)
-	}
-
 	return newBuildEventSource(
 		b.id,
-		table,
+		b.eventsTable(),
 		b.conn,
+		b.stores.Events,
 		notifier,
 		from,
 	), nil
@@ -775,6 +814,111 @@ func (b *build) SaveEvent(event atc.Event) error {
 	return b.conn.Bus().Notify(buildEventsChannel(b.id))
 }
 
+// ArchiveEvents moves the events of the completed build to the configured
+// build event store, leaving only an index entry in the database. It does
+// nothing if no store is configured.
+func (b *build) ArchiveEvents() error {
+	store := b.stores.Events
+	if store == nil {
+		return nil
+	}
+
+	if b.IsRunning() {
+		return ErrBuildStillRunning
+	}
+
+	reader, writer := io.Pipe()
+
+	go func() {
+		writer.CloseWithError(b.writeEvents(writer))
+	}()
+
+	err := store.Put(b.id, reader)
+
+	// unblock the writer if the store gave up early
+	_ = reader.Close()
+
+	if err != nil {
+		return err
+	}
+
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	result, err := psql.Delete(b.eventsTable()).
+		Where(sq.Eq{"build_id": b.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	eventCount, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("build_event_archives").
+		Columns("build_id", "event_count").
+		Values(b.id, eventCount).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (b *build) writeEvents(writer io.Writer) error {
+	rows, err := psql.Select("type", "version", "payload").
+		From(b.eventsTable()).
+		Where(sq.Eq{"build_id": b.id}).
+		OrderBy("event_id ASC").
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	defer Close(rows)
+
+	encoder := json.NewEncoder(writer)
+
+	for rows.Next() {
+		var t, v, p string
+		err := rows.Scan(&t, &v, &p)
+		if err != nil {
+			return err
+		}
+
+		data := json.RawMessage(p)
+
+		err = encoder.Encode(event.Envelope{
+			Data:    &data,
+			Event:   atc.EventType(t),
+			Version: atc.EventVersion(v),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func (b *build) eventsTable() string {
+	if b.pipelineID != 0 {
+		return fmt.Sprintf("pipeline_build_events_%d", b.pipelineID)
+	}
+
+	return fmt.Sprintf("team_build_events_%d", b.teamID)
+}
+
 func (b *build) SaveOutput(
 	logger lager.Logger,
 	resourceType string,
@@ -1002,6 +1146,83 @@ func (b *build) Resources() ([]BuildInput, []BuildOutput, error) {
 	return inputs, outputs, nil
 }
 
+// BuildInputsNotFoundError is returned when the versions used by some of a
+// build's inputs can no longer be found.
+type BuildInputsNotFoundError struct {
+	Names []string
+}
+
+func (e BuildInputsNotFoundError) Error() string {
+	return fmt.Sprintf("versions of inputs not found: %s", strings.Join(e.Names, ", "))
+}
+
+// Inputs returns every input used by the build, including those which were
+// also produced as outputs, unlike Resources.
+func (b *build) Inputs() ([]BuildInput, error) {
+	return buildInputs(b.conn, b.id)
+}
+
+// RerunInputs returns every input used by the build this build is a rerun
+// of, including those which were also produced as outputs, so that the rerun
+// uses exactly the same versions.
+func (b *build) RerunInputs() ([]BuildInput, error) {
+	return buildInputs(b.conn, b.rerunOf)
+}
+
+func buildInputs(conn Conn, buildID int) ([]BuildInput, error) {
+	rows, err := psql.Select("inputs.name", "inputs.resource_id", "inputs.version").
+		From("build_resource_config_version_inputs inputs").
+		Where(sq.Eq{"inputs.build_id": buildID}).
+		OrderBy("inputs.name").
+		RunWith(conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	inputs := []BuildInput{}
+	missing := []string{}
+	for rows.Next() {
+		var (
+			inputName   string
+			resourceID  int
+			versionBlob sql.NullString
+			version     atc.Version
+		)
+
+		err = rows.Scan(&inputName, &resourceID, &versionBlob)
+		if err != nil {
+			return nil, err
+		}
+
+		// inputs recorded before their versions were stored with the build
+		// can only be recovered while the resource's config still has them
+		if !versionBlob.Valid {
+			missing = append(missing, inputName)
+			continue
+		}
+
+		err = json.Unmarshal([]byte(versionBlob.String), &version)
+		if err != nil {
+			return nil, err
+		}
+
+		inputs = append(inputs, BuildInput{
+			Name:       inputName,
+			Version:    version,
+			ResourceID: resourceID,
+		})
+	}
+
+	if len(missing) > 0 {
+		return nil, BuildInputsNotFoundError{Names: missing}
+	}
+
+	return inputs, nil
+}
+
 func (p *build) saveInputTx(tx Tx, buildID int, input BuildInput) error {
 	versionJSON, err := json.Marshal(input.Version)
 	if err != nil {
@@ -1009,8 +1230,8 @@ func (p *build) saveInputTx(tx Tx, buildID int, input BuildInput) error {
 	}
 
 	_, err = psql.Insert("build_resource_config_version_inputs").
-		Columns("build_id", "resource_id", "version_md5", "name").
-		Values(buildID, input.ResourceID, sq.Expr(fmt.Sprintf("md5('%s')", versionJSON)), input.Name).
+		Columns("build_id", "resource_id", "version_md5", "name", "version").
+		Values(buildID, input.ResourceID, sq.Expr(fmt.Sprintf("md5('%s')", versionJSON)), input.Name, string(versionJSON)).
 		Suffix("ON CONFLICT DO NOTHING").
 		RunWith(tx).
 		Exec()
@@ -1034,13 +1255,14 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		jobID, pipelineID                                                    sql.NullInt64
 		engine, engineMetadata, jobName, pipelineName, publicPlan, trackedBy sql.NullString
 		startTime, endTime, reapTime                                         pq.NullTime
-		nonce                                                                sql.NullString
+		nonce, rerunOfName                                                   sql.NullString
+		rerunOf                                                              sql.NullInt64
 		drained                                                              bool
 
 		status string
 	)
 
-	err := row.Scan(&b.id, &b.name, &jobID, &b.teamID, &status, &b.isManuallyTriggered, &b.scheduled, &engine, &engineMetadata, &publicPlan, &startTime, &endTime, &reapTime, &jobName, &pipelineID, &pipelineName, &b.teamName, &nonce, &trackedBy, &drained)
+	err := row.Scan(&b.id, &b.name, &jobID, &b.teamID, &status, &b.isManuallyTriggered, &b.scheduled, &engine, &engineMetadata, &publicPlan, &b.createTime, &startTime, &endTime, &reapTime, &jobName, &pipelineID, &pipelineName, &b.teamName, &nonce, &trackedBy, &drained, &rerunOf, &rerunOfName)
 	if err != nil {
 		return err
 	}
@@ -1056,6 +1278,8 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.reapTime = reapTime.Time
 	b.trackedBy = trackedBy.String
 	b.drained = drained
+	b.rerunOf = int(rerunOf.Int64)
+	b.rerunOfName = rerunOfName.String
 
 	var (
 		noncense                *string
@@ -1088,11 +1312,7 @@ func (b *build) saveEvent(tx Tx, event atc.Event) error {
 		return err
 	}
 
-	table := fmt.Sprintf("team_build_events_%d", b.teamID)
-	if b.pipelineID != 0 {
-		table = fmt.Sprintf("pipeline_build_events_%d", b.pipelineID)
-	}
-	_, err = psql.Insert(table).
+	_, err = psql.Insert(b.eventsTable()).
 		Columns("event_id", "build_id", "type", "version", "payload").
 		Values(sq.Expr("nextval('"+buildEventSeq(b.id)+"')"), b.id, string(event.EventType()), string(event.Version()), payload).
 		RunWith(tx).
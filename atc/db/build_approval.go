@@ -0,0 +1,173 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+// A BuildApproval is a decision which a build's approval step waits for.
+type BuildApproval struct {
+	BuildID     int
+	PlanID      atc.PlanID
+	Name        string
+	Role        string
+	Status      atc.BuildApprovalStatus
+	DecidedBy   string
+	RequestedAt time.Time
+	DecidedAt   time.Time
+}
+
+// IsDecided is true once the approval is no longer waiting.
+func (approval BuildApproval) IsDecided() bool {
+	return approval.Status != atc.ApprovalStatusWaiting
+}
+
+var buildApprovalsQuery = psql.Select("a.build_id, a.plan_id, a.name, a.role, a.status, a.decided_by, a.requested_at, a.decided_at").
+	From("build_approvals a")
+
+// RequestApproval records that the build is waiting for the approval step
+// with the given plan ID to be decided. If the approval has already been
+// requested, e.g. because the build is being resumed, it is left as it is.
+func (b *build) RequestApproval(planID atc.PlanID, name string, role string) (BuildApproval, error) {
+	_, err := psql.Insert("build_approvals").
+		Columns("build_id", "plan_id", "name", "role").
+		Values(b.id, string(planID), name, role).
+		Suffix("ON CONFLICT (build_id, plan_id) DO NOTHING").
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return BuildApproval{}, err
+	}
+
+	approval, found, err := b.Approval(planID)
+	if err != nil {
+		return BuildApproval{}, err
+	}
+
+	if !found {
+		return BuildApproval{}, ErrBuildDisappeared
+	}
+
+	return approval, nil
+}
+
+func (b *build) Approval(planID atc.PlanID) (BuildApproval, bool, error) {
+	row := buildApprovalsQuery.
+		Where(sq.Eq{
+			"a.build_id": b.id,
+			"a.plan_id":  string(planID),
+		}).
+		RunWith(b.conn).
+		QueryRow()
+
+	approval, err := scanBuildApproval(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return BuildApproval{}, false, nil
+		}
+		return BuildApproval{}, false, err
+	}
+
+	return approval, true, nil
+}
+
+// Approvals returns every approval requested by the build, in the order they
+// were requested.
+func (b *build) Approvals() ([]BuildApproval, error) {
+	rows, err := buildApprovalsQuery.
+		Where(sq.Eq{"a.build_id": b.id}).
+		OrderBy("a.requested_at ASC").
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	approvals := []BuildApproval{}
+	for rows.Next() {
+		approval, err := scanBuildApproval(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		approvals = append(approvals, approval)
+	}
+
+	return approvals, nil
+}
+
+// DecideApproval records the outcome of a waiting approval and notifies the
+// approval step. It returns false if the approval was not waiting, i.e. it
+// has already been decided or has expired.
+func (b *build) DecideApproval(planID atc.PlanID, status atc.BuildApprovalStatus, decidedBy string) (bool, error) {
+	result, err := psql.Update("build_approvals").
+		Set("status", string(status)).
+		Set("decided_by", decidedBy).
+		Set("decided_at", sq.Expr("now()")).
+		Where(sq.Eq{
+			"build_id": b.id,
+			"plan_id":  string(planID),
+			"status":   string(atc.ApprovalStatusWaiting),
+		}).
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	return true, b.conn.Bus().Notify(buildApprovalChannel(b.id))
+}
+
+// ApprovalNotifier returns a Notifier that can be watched for when the
+// approval with the given plan ID is decided.
+func (b *build) ApprovalNotifier(planID atc.PlanID) (Notifier, error) {
+	return newConditionNotifier(b.conn.Bus(), buildApprovalChannel(b.id), func() (bool, error) {
+		approval, found, err := b.Approval(planID)
+		if err != nil {
+			return false, err
+		}
+
+		return found && approval.IsDecided(), nil
+	})
+}
+
+func scanBuildApproval(row scannable) (BuildApproval, error) {
+	var (
+		approval       BuildApproval
+		planID, status string
+		decidedBy      sql.NullString
+		decidedAt      pq.NullTime
+	)
+
+	err := row.Scan(&approval.BuildID, &planID, &approval.Name, &approval.Role, &status, &decidedBy, &approval.RequestedAt, &decidedAt)
+	if err != nil {
+		return BuildApproval{}, err
+	}
+
+	approval.PlanID = atc.PlanID(planID)
+	approval.Status = atc.BuildApprovalStatus(status)
+	approval.DecidedBy = decidedBy.String
+	approval.DecidedAt = decidedAt.Time
+
+	return approval, nil
+}
+
+func buildApprovalChannel(buildID int) string {
+	return fmt.Sprintf("build_approval_%d", buildID)
+}
@@ -0,0 +1,135 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildApproval", func() {
+	var build db.Build
+
+	BeforeEach(func() {
+		team, err := teamFactory.CreateTeam(atc.Team{Name: "some-team"})
+		Expect(err).ToNot(HaveOccurred())
+
+		build, err = team.CreateOneOffBuild()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("RequestApproval", func() {
+		It("records a waiting approval", func() {
+			approval, err := build.RequestApproval("some-plan-id", "deploy-to-prod", "owner")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(approval.BuildID).To(Equal(build.ID()))
+			Expect(approval.PlanID).To(Equal(atc.PlanID("some-plan-id")))
+			Expect(approval.Name).To(Equal("deploy-to-prod"))
+			Expect(approval.Role).To(Equal("owner"))
+			Expect(approval.Status).To(Equal(atc.ApprovalStatusWaiting))
+			Expect(approval.IsDecided()).To(BeFalse())
+			Expect(approval.RequestedAt).NotTo(BeZero())
+		})
+
+		Context("when the approval has already been decided", func() {
+			BeforeEach(func() {
+				_, err := build.RequestApproval("some-plan-id", "deploy-to-prod", "owner")
+				Expect(err).ToNot(HaveOccurred())
+
+				decided, err := build.DecideApproval("some-plan-id", atc.ApprovalStatusApproved, "some-user")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(decided).To(BeTrue())
+			})
+
+			It("keeps the decision", func() {
+				approval, err := build.RequestApproval("some-plan-id", "deploy-to-prod", "owner")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(approval.Status).To(Equal(atc.ApprovalStatusApproved))
+				Expect(approval.DecidedBy).To(Equal("some-user"))
+			})
+		})
+	})
+
+	Describe("DecideApproval", func() {
+		BeforeEach(func() {
+			_, err := build.RequestApproval("some-plan-id", "deploy-to-prod", "member")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("records who decided and when", func() {
+			decided, err := build.DecideApproval("some-plan-id", atc.ApprovalStatusRejected, "some-user")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decided).To(BeTrue())
+
+			approval, found, err := build.Approval("some-plan-id")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(approval.Status).To(Equal(atc.ApprovalStatusRejected))
+			Expect(approval.DecidedBy).To(Equal("some-user"))
+			Expect(approval.DecidedAt).NotTo(BeZero())
+		})
+
+		It("does not decide an approval twice", func() {
+			decided, err := build.DecideApproval("some-plan-id", atc.ApprovalStatusApproved, "some-user")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decided).To(BeTrue())
+
+			decided, err = build.DecideApproval("some-plan-id", atc.ApprovalStatusRejected, "some-other-user")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decided).To(BeFalse())
+
+			approval, _, err := build.Approval("some-plan-id")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(approval.Status).To(Equal(atc.ApprovalStatusApproved))
+			Expect(approval.DecidedBy).To(Equal("some-user"))
+		})
+
+		It("does not decide an approval which was never requested", func() {
+			decided, err := build.DecideApproval("some-other-plan-id", atc.ApprovalStatusApproved, "some-user")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decided).To(BeFalse())
+		})
+	})
+
+	Describe("Approvals", func() {
+		It("returns the approvals in the order they were requested", func() {
+			_, err := build.RequestApproval("some-plan-id", "deploy-to-staging", "member")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = build.RequestApproval("some-other-plan-id", "deploy-to-prod", "owner")
+			Expect(err).ToNot(HaveOccurred())
+
+			approvals, err := build.Approvals()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(approvals).To(HaveLen(2))
+			Expect(approvals[0].Name).To(Equal("deploy-to-staging"))
+			Expect(approvals[1].Name).To(Equal("deploy-to-prod"))
+		})
+	})
+
+	Describe("ApprovalNotifier", func() {
+		var notifier db.Notifier
+
+		BeforeEach(func() {
+			_, err := build.RequestApproval("some-plan-id", "deploy-to-prod", "member")
+			Expect(err).ToNot(HaveOccurred())
+
+			notifier, err = build.ApprovalNotifier("some-plan-id")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			_ = notifier.Close()
+		})
+
+		It("notifies once the approval is decided", func() {
+			Consistently(notifier.Notify()).ShouldNot(Receive())
+
+			_, err := build.DecideApproval("some-plan-id", atc.ApprovalStatusApproved, "some-user")
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(notifier.Notify()).Should(Receive())
+		})
+	})
+})
@@ -0,0 +1,113 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"io"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+var ErrNoBuildArtifactStore = errors.New("no build artifact store configured")
+
+// A BuildArtifact is a task output persisted by a build.
+type BuildArtifact struct {
+	BuildID   int
+	Name      string
+	CreatedAt time.Time
+}
+
+var buildArtifactsQuery = psql.Select("a.build_id, a.name, a.created_at").
+	From("build_artifacts a")
+
+// SaveArtifact stores the artifact, a gzipped tarball, in the build's
+// artifact store and records it against the build. Saving an artifact with
+// the same name again replaces it.
+func (b *build) SaveArtifact(name string, artifact io.Reader) error {
+	store := b.stores.Artifacts
+	if store == nil {
+		return ErrNoBuildArtifactStore
+	}
+
+	err := store.Put(b.id, name, artifact)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("build_artifacts").
+		Columns("build_id", "name").
+		Values(b.id, name).
+		Suffix("ON CONFLICT (build_id, name) DO UPDATE SET created_at = now()").
+		RunWith(b.conn).
+		Exec()
+	return err
+}
+
+// Artifact returns the contents of the build's artifact with the given name.
+func (b *build) Artifact(name string) (io.ReadCloser, bool, error) {
+	row := buildArtifactsQuery.
+		Where(sq.Eq{
+			"a.build_id": b.id,
+			"a.name":     name,
+		}).
+		RunWith(b.conn).
+		QueryRow()
+
+	_, err := scanBuildArtifact(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	store := b.stores.Artifacts
+	if store == nil {
+		return nil, false, ErrNoBuildArtifactStore
+	}
+
+	artifact, err := store.Get(b.id, name)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return artifact, true, nil
+}
+
+// Artifacts returns the artifacts persisted by the build, ordered by name.
+func (b *build) Artifacts() ([]BuildArtifact, error) {
+	rows, err := buildArtifactsQuery.
+		Where(sq.Eq{"a.build_id": b.id}).
+		OrderBy("a.name ASC").
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	artifacts := []BuildArtifact{}
+	for rows.Next() {
+		artifact, err := scanBuildArtifact(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		artifacts = append(artifacts, artifact)
+	}
+
+	return artifacts, nil
+}
+
+func scanBuildArtifact(row scannable) (BuildArtifact, error) {
+	var artifact BuildArtifact
+
+	err := row.Scan(&artifact.BuildID, &artifact.Name, &artifact.CreatedAt)
+	if err != nil {
+		return BuildArtifact{}, err
+	}
+
+	return artifact, nil
+}
@@ -0,0 +1,17 @@
+package db
+
+import (
+	"io"
+)
+
+//go:generate counterfeiter . BuildArtifactStore
+
+// A BuildArtifactStore stores the artifacts persisted by builds, e.g. on the
+// filesystem or in S3, so that they can be downloaded after the build's
+// volumes have been garbage collected. Each artifact is stored as a gzipped
+// tarball.
+type BuildArtifactStore interface {
+	Put(buildID int, name string, artifact io.Reader) error
+	Get(buildID int, name string) (io.ReadCloser, error)
+	Delete(buildID int) error
+}
@@ -0,0 +1,179 @@
+package db_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"strings"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildArtifact", func() {
+	var (
+		fakeStore *dbfakes.FakeBuildArtifactStore
+		pipeline  db.Pipeline
+		build     db.Build
+	)
+
+	BeforeEach(func() {
+		fakeStore = new(dbfakes.FakeBuildArtifactStore)
+
+		storeTeamFactory := db.NewTeamFactory(dbConn, lockFactory, db.BuildStores{Artifacts: fakeStore})
+
+		storeTeam, found, err := storeTeamFactory.FindTeam(defaultTeam.Name())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		pipeline, found, err = storeTeam.Pipeline(defaultPipeline.Name())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		job, found, err := pipeline.Job(defaultJob.Name())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		build, err = job.CreateBuild()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("SaveArtifact", func() {
+		It("stores the artifact and records it", func() {
+			err := build.SaveArtifact("some-output", strings.NewReader("some-tarball"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeStore.PutCallCount()).To(Equal(1))
+			buildID, name, artifact := fakeStore.PutArgsForCall(0)
+			Expect(buildID).To(Equal(build.ID()))
+			Expect(name).To(Equal("some-output"))
+
+			contents, err := ioutil.ReadAll(artifact)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-tarball"))
+
+			artifacts, err := build.Artifacts()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(artifacts).To(HaveLen(1))
+			Expect(artifacts[0].BuildID).To(Equal(build.ID()))
+			Expect(artifacts[0].Name).To(Equal("some-output"))
+			Expect(artifacts[0].CreatedAt).NotTo(BeZero())
+		})
+
+		It("can replace an artifact", func() {
+			Expect(build.SaveArtifact("some-output", strings.NewReader("some-tarball"))).To(Succeed())
+			Expect(build.SaveArtifact("some-output", strings.NewReader("other-tarball"))).To(Succeed())
+
+			artifacts, err := build.Artifacts()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(artifacts).To(HaveLen(1))
+		})
+
+		Context("when the store fails", func() {
+			BeforeEach(func() {
+				fakeStore.PutReturns(errors.New("nope"))
+			})
+
+			It("does not record the artifact", func() {
+				err := build.SaveArtifact("some-output", strings.NewReader("some-tarball"))
+				Expect(err).To(MatchError("nope"))
+
+				artifacts, err := build.Artifacts()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(artifacts).To(BeEmpty())
+			})
+		})
+
+		Context("when no store is configured", func() {
+			It("errors", func() {
+				build, err := defaultJob.CreateBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				err = build.SaveArtifact("some-output", strings.NewReader("some-tarball"))
+				Expect(err).To(Equal(db.ErrNoBuildArtifactStore))
+			})
+		})
+	})
+
+	Describe("Artifact", func() {
+		BeforeEach(func() {
+			Expect(build.SaveArtifact("some-output", strings.NewReader("some-tarball"))).To(Succeed())
+
+			fakeStore.GetReturns(ioutil.NopCloser(strings.NewReader("some-tarball")), nil)
+		})
+
+		It("returns the artifact from the store", func() {
+			artifact, found, err := build.Artifact("some-output")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			contents, err := ioutil.ReadAll(artifact)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-tarball"))
+
+			buildID, name := fakeStore.GetArgsForCall(0)
+			Expect(buildID).To(Equal(build.ID()))
+			Expect(name).To(Equal("some-output"))
+		})
+
+		It("does not find artifacts the build did not save", func() {
+			_, found, err := build.Artifact("other-output")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+			Expect(fakeStore.GetCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("Artifacts", func() {
+		It("returns the build's artifacts ordered by name", func() {
+			Expect(build.SaveArtifact("b-output", strings.NewReader("some-tarball"))).To(Succeed())
+			Expect(build.SaveArtifact("a-output", strings.NewReader("some-tarball"))).To(Succeed())
+
+			artifacts, err := build.Artifacts()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(artifacts).To(HaveLen(2))
+			Expect(artifacts[0].Name).To(Equal("a-output"))
+			Expect(artifacts[1].Name).To(Equal("b-output"))
+		})
+	})
+
+	Describe("DeleteBuildArtifactsByBuildIDs", func() {
+		var otherBuild db.Build
+
+		BeforeEach(func() {
+			Expect(build.SaveArtifact("some-output", strings.NewReader("some-tarball"))).To(Succeed())
+
+			var err error
+			otherBuild, err = defaultJob.CreateBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("deletes the artifacts of builds which have any", func() {
+			err := pipeline.DeleteBuildArtifactsByBuildIDs([]int{build.ID(), otherBuild.ID()})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeStore.DeleteCallCount()).To(Equal(1))
+			Expect(fakeStore.DeleteArgsForCall(0)).To(Equal(build.ID()))
+
+			artifacts, err := build.Artifacts()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(artifacts).To(BeEmpty())
+		})
+
+		Context("when the store fails", func() {
+			BeforeEach(func() {
+				fakeStore.DeleteReturns(errors.New("nope"))
+			})
+
+			It("keeps track of the artifacts", func() {
+				err := pipeline.DeleteBuildArtifactsByBuildIDs([]int{build.ID()})
+				Expect(err).To(MatchError("nope"))
+
+				artifacts, err := build.Artifacts()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(artifacts).To(HaveLen(1))
+			})
+		})
+	})
+})
@@ -1,10 +1,13 @@
 package db
 
 import (
+	"database/sql"
 	"encoding/json"
 	"errors"
+	"io"
 	"sync"
 
+	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/event"
 )
@@ -23,6 +26,7 @@ func newBuildEventSource(
 	buildID int,
 	table string,
 	conn Conn,
+	store BuildEventStore,
 	notifier Notifier,
 	from uint,
 ) *buildEventSource {
@@ -32,7 +36,8 @@ func newBuildEventSource(
 		buildID: buildID,
 		table:   table,
 
-		conn: conn,
+		conn:  conn,
+		store: store,
 
 		notifier: notifier,
 
@@ -52,6 +57,7 @@ type buildEventSource struct {
 	table   string
 
 	conn     Conn
+	store    BuildEventStore
 	notifier Notifier
 
 	events chan event.Envelope
@@ -165,7 +171,8 @@ func (source *buildEventSource) collectEvents(cursor uint) {
 		}
 
 		if completed {
-			source.err = ErrEndOfBuildEventStream
+			// the events may have been archived since the build completed
+			source.err = source.collectArchivedEvents(cursor)
 			close(source.events)
 			return
 		}
@@ -179,3 +186,61 @@ func (source *buildEventSource) collectEvents(cursor uint) {
 		}
 	}
 }
+
+// collectArchivedEvents sends the events from the cursor onwards from the
+// build event store, if the build's events have been archived.
+func (source *buildEventSource) collectArchivedEvents(cursor uint) error {
+	if source.store == nil {
+		return ErrEndOfBuildEventStream
+	}
+
+	var eventCount uint
+	err := psql.Select("event_count").
+		From("build_event_archives").
+		Where(sq.Eq{"build_id": source.buildID}).
+		RunWith(source.conn).
+		QueryRow().
+		Scan(&eventCount)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrEndOfBuildEventStream
+		}
+
+		return err
+	}
+
+	if cursor >= eventCount {
+		return ErrEndOfBuildEventStream
+	}
+
+	events, err := source.store.Get(source.buildID)
+	if err != nil {
+		return err
+	}
+
+	defer events.Close()
+
+	decoder := json.NewDecoder(events)
+
+	for i := uint(0); ; i++ {
+		var ev event.Envelope
+		err := decoder.Decode(&ev)
+		if err != nil {
+			if err == io.EOF {
+				return ErrEndOfBuildEventStream
+			}
+
+			return err
+		}
+
+		if i < cursor {
+			continue
+		}
+
+		select {
+		case source.events <- ev:
+		case <-source.stop:
+			return ErrBuildEventStreamClosed
+		}
+	}
+}
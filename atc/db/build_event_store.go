@@ -0,0 +1,16 @@
+package db
+
+import (
+	"io"
+)
+
+//go:generate counterfeiter . BuildEventStore
+
+// A BuildEventStore stores the events of completed builds outside of the
+// database, e.g. on the filesystem or in S3. The events of a build are
+// stored as a stream of JSON-encoded event envelopes.
+type BuildEventStore interface {
+	Put(buildID int, events io.Reader) error
+	Get(buildID int) (io.ReadCloser, error)
+	Delete(buildID int) error
+}
@@ -19,6 +19,9 @@ type BuildFactory interface {
 	PublicBuilds(Page) ([]Build, Pagination, error)
 	GetAllStartedBuilds() ([]Build, error)
 	GetDrainableBuilds() ([]Build, error)
+	GetArchivableBuilds(limit int) ([]Build, error)
+	OrphanedEventArchives() ([]int, error)
+	DeleteEventArchives(buildIDs []int) error
 	// TODO: move to BuildLifecycle, new interface (see WorkerLifecycle)
 	MarkNonInterceptibleBuilds() error
 }
@@ -26,13 +29,15 @@ type BuildFactory interface {
 type buildFactory struct {
 	conn              Conn
 	lockFactory       lock.LockFactory
+	stores            BuildStores
 	oneOffGracePeriod time.Duration
 }
 
-func NewBuildFactory(conn Conn, lockFactory lock.LockFactory, oneOffGracePeriod time.Duration) BuildFactory {
+func NewBuildFactory(conn Conn, lockFactory lock.LockFactory, stores BuildStores, oneOffGracePeriod time.Duration) BuildFactory {
 	return &buildFactory{
 		conn:              conn,
 		lockFactory:       lockFactory,
+		stores:            stores,
 		oneOffGracePeriod: oneOffGracePeriod,
 	}
 }
@@ -41,6 +46,7 @@ func (f *buildFactory) Build(buildID int) (Build, bool, error) {
 	build := &build{
 		conn:        f.conn,
 		lockFactory: f.lockFactory,
+		stores:      f.stores,
 	}
 
 	row := buildsQuery.
@@ -65,7 +71,7 @@ func (f *buildFactory) VisibleBuildsWithTime(teamNames []string, page Page) ([]B
 			sq.Eq{"p.public": true},
 			sq.Eq{"t.name": teamNames},
 		})
-	return getBuildsWithDates(newBuildsQuery, minMaxIdQuery, page, f.conn, f.lockFactory)
+	return getBuildsWithDates(newBuildsQuery, minMaxIdQuery, page, f.conn, f.lockFactory, f.stores)
 }
 
 func (f *buildFactory) VisibleBuilds(teamNames []string, page Page) ([]Build, Pagination, error) {
@@ -76,13 +82,13 @@ func (f *buildFactory) VisibleBuilds(teamNames []string, page Page) ([]Build, Pa
 		})
 
 	return getBuildsWithPagination(newBuildsQuery, minMaxIdQuery,
-		page, f.conn, f.lockFactory)
+		page, f.conn, f.lockFactory, f.stores)
 }
 
 func (f *buildFactory) PublicBuilds(page Page) ([]Build, Pagination, error) {
 	return getBuildsWithPagination(
 		buildsQuery.Where(sq.Eq{"p.public": true}), minMaxIdQuery,
-		page, f.conn, f.lockFactory)
+		page, f.conn, f.lockFactory, f.stores)
 }
 
 func (f *buildFactory) MarkNonInterceptibleBuilds() error {
@@ -111,7 +117,64 @@ func (f *buildFactory) GetDrainableBuilds() ([]Build, error) {
 		"b.drained":   false,
 	})
 
-	return getBuilds(query, f.conn, f.lockFactory)
+	return getBuilds(query, f.conn, f.lockFactory, f.stores)
+}
+
+// GetArchivableBuilds returns completed builds whose events are still in the
+// database, oldest first.
+func (f *buildFactory) GetArchivableBuilds(limit int) ([]Build, error) {
+	query := buildsQuery.
+		Where(sq.Eq{
+			"b.completed": true,
+			"b.reap_time": nil,
+		}).
+		Where(sq.Expr("NOT EXISTS (SELECT 1 FROM build_event_archives a WHERE a.build_id = b.id)")).
+		OrderBy("b.id ASC").
+		Limit(uint64(limit))
+
+	return getBuilds(query, f.conn, f.lockFactory, f.stores)
+}
+
+// OrphanedEventArchives returns the IDs of deleted builds whose events are
+// still archived, e.g. because their pipeline was destroyed.
+func (f *buildFactory) OrphanedEventArchives() ([]int, error) {
+	rows, err := psql.Select("a.build_id").
+		From("build_event_archives a").
+		Where(sq.Expr("NOT EXISTS (SELECT 1 FROM builds b WHERE b.id = a.build_id)")).
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	buildIDs := []int{}
+	for rows.Next() {
+		var buildID int
+		err := rows.Scan(&buildID)
+		if err != nil {
+			return nil, err
+		}
+
+		buildIDs = append(buildIDs, buildID)
+	}
+
+	return buildIDs, nil
+}
+
+// DeleteEventArchives removes the index entries of the builds' archived
+// events. The events must be removed from the build event store separately.
+func (f *buildFactory) DeleteEventArchives(buildIDs []int) error {
+	if len(buildIDs) == 0 {
+		return nil
+	}
+
+	_, err := psql.Delete("build_event_archives").
+		Where(sq.Eq{"build_id": buildIDs}).
+		RunWith(f.conn).
+		Exec()
+	return err
 }
 
 func (f *buildFactory) GetAllStartedBuilds() ([]Build, error) {
@@ -119,10 +182,10 @@ func (f *buildFactory) GetAllStartedBuilds() ([]Build, error) {
 		"b.status": BuildStatusStarted,
 	})
 
-	return getBuilds(query, f.conn, f.lockFactory)
+	return getBuilds(query, f.conn, f.lockFactory, f.stores)
 }
 
-func getBuilds(buildsQuery sq.SelectBuilder, conn Conn, lockFactory lock.LockFactory) ([]Build, error) {
+func getBuilds(buildsQuery sq.SelectBuilder, conn Conn, lockFactory lock.LockFactory, stores BuildStores) ([]Build, error) {
 	rows, err := buildsQuery.RunWith(conn).Query()
 	if err != nil {
 		return nil, err
@@ -133,7 +196,7 @@ func getBuilds(buildsQuery sq.SelectBuilder, conn Conn, lockFactory lock.LockFac
 	bs := []Build{}
 
 	for rows.Next() {
-		b := &build{conn: conn, lockFactory: lockFactory}
+		b := &build{conn: conn, lockFactory: lockFactory, stores: stores}
 		err := scanBuild(b, rows, conn.EncryptionStrategy())
 		if err != nil {
 			return nil, err
@@ -145,7 +208,7 @@ func getBuilds(buildsQuery sq.SelectBuilder, conn Conn, lockFactory lock.LockFac
 	return bs, nil
 }
 
-func getBuildsWithDates(buildsQuery, minMaxIdQuery sq.SelectBuilder, page Page, conn Conn, lockFactory lock.LockFactory) ([]Build, Pagination, error) {
+func getBuildsWithDates(buildsQuery, minMaxIdQuery sq.SelectBuilder, page Page, conn Conn, lockFactory lock.LockFactory, stores BuildStores) ([]Build, Pagination, error) {
 	var newPage = Page{Limit: page.Limit}
 
 	if page.Since != 0 {
@@ -168,7 +231,7 @@ func getBuildsWithDates(buildsQuery, minMaxIdQuery sq.SelectBuilder, page Page,
 		defer sinceRow.Close()
 
 		for sinceRow.Next() {
-			build := &build{conn: conn, lockFactory: lockFactory}
+			build := &build{conn: conn, lockFactory: lockFactory, stores: stores}
 			err = scanBuild(build, sinceRow, conn.EncryptionStrategy())
 			if err != nil {
 				return nil, Pagination{}, err
@@ -200,7 +263,7 @@ func getBuildsWithDates(buildsQuery, minMaxIdQuery sq.SelectBuilder, page Page,
 
 		defer untilRow.Close()
 		for untilRow.Next() {
-			build := &build{conn: conn, lockFactory: lockFactory}
+			build := &build{conn: conn, lockFactory: lockFactory, stores: stores}
 			err = scanBuild(build, untilRow, conn.EncryptionStrategy())
 			if err != nil {
 				return nil, Pagination{}, err
@@ -216,10 +279,10 @@ func getBuildsWithDates(buildsQuery, minMaxIdQuery sq.SelectBuilder, page Page,
 		}
 	}
 
-	return getBuildsWithPagination(buildsQuery, minMaxIdQuery, newPage, conn, lockFactory)
+	return getBuildsWithPagination(buildsQuery, minMaxIdQuery, newPage, conn, lockFactory, stores)
 }
 
-func getBuildsWithPagination(buildsQuery, minMaxIdQuery sq.SelectBuilder, page Page, conn Conn, lockFactory lock.LockFactory) ([]Build, Pagination, error) {
+func getBuildsWithPagination(buildsQuery, minMaxIdQuery sq.SelectBuilder, page Page, conn Conn, lockFactory lock.LockFactory, stores BuildStores) ([]Build, Pagination, error) {
 	var (
 		rows    *sql.Rows
 		err     error
@@ -262,7 +325,7 @@ func getBuildsWithPagination(buildsQuery, minMaxIdQuery sq.SelectBuilder, page P
 
 	builds := make([]Build, 0)
 	for rows.Next() {
-		build := &build{conn: conn, lockFactory: lockFactory}
+		build := &build{conn: conn, lockFactory: lockFactory, stores: stores}
 		err = scanBuild(build, rows, conn.EncryptionStrategy())
 		if err != nil {
 			return nil, Pagination{}, err
@@ -67,7 +67,7 @@ var _ = Describe("BuildFactory", func() {
 			DescribeTable("completed and past the grace period",
 				func(status db.BuildStatus, matcher types.GomegaMatcher) {
 					//set grace period to 0 for this test
-					buildFactory = db.NewBuildFactory(dbConn, lockFactory, 0)
+					buildFactory = db.NewBuildFactory(dbConn, lockFactory, db.BuildStores{}, 0)
 					b, err := defaultTeam.CreateOneOffBuild()
 					Expect(err).NotTo(HaveOccurred())
 
@@ -121,7 +121,7 @@ var _ = Describe("BuildFactory", func() {
 							Name: "some-other-job",
 						},
 					},
-				}, db.ConfigVersion(0), db.PipelineUnpaused)
+				}, db.ConfigVersion(0), db.PipelineUnpaused, 0)
 				Expect(err).NotTo(HaveOccurred())
 
 				j, found, err := p.Job("some-other-job")
@@ -222,7 +222,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			config := atc.Config{Jobs: atc.JobConfigs{{Name: "some-job"}}}
-			privatePipeline, _, err := team.SavePipeline("private-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused)
+			privatePipeline, _, err := team.SavePipeline("private-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 			Expect(err).NotTo(HaveOccurred())
 
 			privateJob, found, err := privatePipeline.Job("some-job")
@@ -232,7 +232,7 @@ var _ = Describe("BuildFactory", func() {
 			build2, err = privateJob.CreateBuild()
 			Expect(err).NotTo(HaveOccurred())
 
-			publicPipeline, _, err := team.SavePipeline("public-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused)
+			publicPipeline, _, err := team.SavePipeline("public-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 			Expect(err).NotTo(HaveOccurred())
 			err = publicPipeline.Expose()
 			Expect(err).NotTo(HaveOccurred())
@@ -269,7 +269,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			config := atc.Config{Jobs: atc.JobConfigs{{Name: "some-job"}}}
-			privatePipeline, _, err := team.SavePipeline("private-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused)
+			privatePipeline, _, err := team.SavePipeline("private-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 			Expect(err).NotTo(HaveOccurred())
 
 			privateJob, found, err := privatePipeline.Job("some-job")
@@ -279,7 +279,7 @@ var _ = Describe("BuildFactory", func() {
 			_, err = privateJob.CreateBuild()
 			Expect(err).NotTo(HaveOccurred())
 
-			publicPipeline, _, err := team.SavePipeline("public-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused)
+			publicPipeline, _, err := team.SavePipeline("public-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 			Expect(err).NotTo(HaveOccurred())
 			err = publicPipeline.Expose()
 			Expect(err).NotTo(HaveOccurred())
@@ -311,7 +311,7 @@ var _ = Describe("BuildFactory", func() {
 						Name: "some-job",
 					},
 				},
-			}, db.ConfigVersion(0), db.PipelineUnpaused)
+			}, db.ConfigVersion(0), db.PipelineUnpaused, 0)
 			Expect(err).NotTo(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -366,7 +366,7 @@ var _ = Describe("BuildFactory", func() {
 						Name: "some-job",
 					},
 				},
-			}, db.ConfigVersion(0), db.PipelineUnpaused)
+			}, db.ConfigVersion(0), db.PipelineUnpaused, 0)
 			Expect(err).NotTo(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
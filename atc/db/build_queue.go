@@ -0,0 +1,201 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc/db/lock"
+)
+
+//go:generate counterfeiter . BuildQueue
+
+// A BuildQueue holds the pending builds of every pipeline in the cluster which
+// are ready to start but are waiting for their turn.
+type BuildQueue interface {
+	// Admit adds the build to the queue, or records that it is still waiting
+	// if it is already queued, and asks the policy whether it may start given
+	// the queued and running builds. If so, the build is dequeued and marked
+	// as scheduled.
+	//
+	// Admission is serialized across ATCs and done in one transaction, so
+	// that the policy always sees every build admitted before.
+	Admit(buildID int, priority int, staleness time.Duration, policy AdmissionPolicy) (bool, error)
+
+	// QueuedBuilds returns the queued builds which are still pending and have
+	// been seen waiting within the staleness window, oldest first.
+	QueuedBuilds(staleness time.Duration) ([]QueuedBuild, error)
+
+	// ActiveBuildsByTeam counts each team's builds which have been scheduled
+	// but have not yet completed.
+	ActiveBuildsByTeam() (map[string]int, error)
+}
+
+// An AdmissionPolicy decides whether the build may start, given the queued
+// builds and the number of running builds of each team.
+type AdmissionPolicy func(buildID int, queued []QueuedBuild, active map[string]int) bool
+
+type QueuedBuild struct {
+	BuildID      int
+	BuildName    string
+	TeamName     string
+	PipelineName string
+	JobName      string
+	Priority     int
+	QueueTime    time.Time
+}
+
+type buildQueue struct {
+	conn Conn
+}
+
+func NewBuildQueue(conn Conn) BuildQueue {
+	return &buildQueue{
+		conn: conn,
+	}
+}
+
+func (q *buildQueue) Admit(buildID int, priority int, staleness time.Duration, policy AdmissionPolicy) (bool, error) {
+	tx, err := q.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	_, err = tx.Exec(`SELECT pg_advisory_xact_lock($1)`, lock.LockTypeBuildQueue)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = psql.Insert("build_queue").
+		Columns("build_id", "priority").
+		Values(buildID, priority).
+		Suffix("ON CONFLICT (build_id) DO UPDATE SET priority = EXCLUDED.priority, heartbeat_time = now()").
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	queued, err := queuedBuilds(tx, staleness)
+	if err != nil {
+		return false, err
+	}
+
+	active, err := activeBuildsByTeam(tx)
+	if err != nil {
+		return false, err
+	}
+
+	if !policy(buildID, queued, active) {
+		return false, tx.Commit()
+	}
+
+	_, err = psql.Delete("build_queue").
+		Where(sq.Eq{"build_id": buildID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	// the build counts as running for the next admission as soon as the lock
+	// is released
+	_, err = psql.Update("builds").
+		Set("scheduled", true).
+		Where(sq.Eq{"id": buildID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (q *buildQueue) QueuedBuilds(staleness time.Duration) ([]QueuedBuild, error) {
+	return queuedBuilds(q.conn, staleness)
+}
+
+func (q *buildQueue) ActiveBuildsByTeam() (map[string]int, error) {
+	return activeBuildsByTeam(q.conn)
+}
+
+func queuedBuilds(runner sq.BaseRunner, staleness time.Duration) ([]QueuedBuild, error) {
+	rows, err := psql.Select("q.build_id, b.name, t.name, p.name, j.name, q.priority, q.queue_time").
+		From("build_queue q").
+		Join("builds b ON b.id = q.build_id").
+		Join("teams t ON t.id = b.team_id").
+		LeftJoin("pipelines p ON p.id = b.pipeline_id").
+		LeftJoin("jobs j ON j.id = b.job_id").
+		Where(sq.Eq{
+			"b.status":    BuildStatusPending,
+			"b.scheduled": false,
+		}).
+		Where(sq.Expr("q.heartbeat_time > now() - (? * interval '1 millisecond')", staleness.Nanoseconds()/int64(time.Millisecond))).
+		OrderBy("q.queue_time ASC", "q.build_id ASC").
+		RunWith(runner).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	queued := []QueuedBuild{}
+	for rows.Next() {
+		var build QueuedBuild
+		var pipelineName, jobName sql.NullString
+
+		err = rows.Scan(&build.BuildID, &build.BuildName, &build.TeamName, &pipelineName, &jobName, &build.Priority, &build.QueueTime)
+		if err != nil {
+			return nil, err
+		}
+
+		build.PipelineName = pipelineName.String
+		build.JobName = jobName.String
+
+		queued = append(queued, build)
+	}
+
+	return queued, nil
+}
+
+func activeBuildsByTeam(runner sq.BaseRunner) (map[string]int, error) {
+	rows, err := psql.Select("t.name, COUNT(b.id)").
+		From("builds b").
+		Join("teams t ON t.id = b.team_id").
+		Where(sq.Eq{
+			"b.scheduled": true,
+			"b.completed": false,
+		}).
+		GroupBy("t.name").
+		RunWith(runner).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	active := map[string]int{}
+	for rows.Next() {
+		var teamName string
+		var count int
+
+		err = rows.Scan(&teamName, &count)
+		if err != nil {
+			return nil, err
+		}
+
+		active[teamName] = count
+	}
+
+	return active, nil
+}
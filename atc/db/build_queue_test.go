@@ -0,0 +1,223 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildQueue", func() {
+	var buildQueue db.BuildQueue
+
+	BeforeEach(func() {
+		buildQueue = db.NewBuildQueue(dbConn)
+	})
+
+	keepQueued := func(int, []db.QueuedBuild, map[string]int) bool { return false }
+
+	Describe("Admit", func() {
+		var (
+			build      db.Build
+			otherBuild db.Build
+		)
+
+		BeforeEach(func() {
+			var err error
+			build, err = defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			otherBuild, err = defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			admitted, err := buildQueue.Admit(otherBuild.ID(), 0, time.Minute, keepQueued)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(admitted).To(BeFalse())
+		})
+
+		It("asks the policy with the build queued alongside the others", func() {
+			var (
+				policyBuildID int
+				policyQueued  []db.QueuedBuild
+				policyActive  map[string]int
+			)
+
+			_, err := buildQueue.Admit(build.ID(), 10, time.Minute, func(buildID int, queued []db.QueuedBuild, active map[string]int) bool {
+				policyBuildID = buildID
+				policyQueued = queued
+				policyActive = active
+				return false
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(policyBuildID).To(Equal(build.ID()))
+			Expect(policyQueued).To(HaveLen(2))
+			Expect(policyQueued[0].BuildID).To(Equal(otherBuild.ID()))
+			Expect(policyQueued[1].BuildID).To(Equal(build.ID()))
+			Expect(policyQueued[1].Priority).To(Equal(10))
+			Expect(policyActive).To(BeEmpty())
+		})
+
+		Context("when the policy admits the build", func() {
+			var admitted bool
+
+			BeforeEach(func() {
+				var err error
+				admitted, err = buildQueue.Admit(build.ID(), 0, time.Minute, func(int, []db.QueuedBuild, map[string]int) bool { return true })
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("dequeues the build and marks it as scheduled", func() {
+				Expect(admitted).To(BeTrue())
+
+				queued, err := buildQueue.QueuedBuilds(time.Minute)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(queued).To(HaveLen(1))
+				Expect(queued[0].BuildID).To(Equal(otherBuild.ID()))
+
+				found, err := build.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(build.IsScheduled()).To(BeTrue())
+			})
+
+			It("counts the build as active for the next admission", func() {
+				var policyActive map[string]int
+				_, err := buildQueue.Admit(otherBuild.ID(), 0, time.Minute, func(_ int, _ []db.QueuedBuild, active map[string]int) bool {
+					policyActive = active
+					return false
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(policyActive).To(Equal(map[string]int{defaultTeam.Name(): 1}))
+			})
+		})
+
+		Context("when the policy keeps the build queued", func() {
+			It("leaves the build pending", func() {
+				admitted, err := buildQueue.Admit(build.ID(), 0, time.Minute, keepQueued)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(admitted).To(BeFalse())
+
+				queued, err := buildQueue.QueuedBuilds(time.Minute)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(queued).To(HaveLen(2))
+
+				found, err := build.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(build.IsScheduled()).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("QueuedBuilds", func() {
+		var (
+			firstBuild  db.Build
+			secondBuild db.Build
+		)
+
+		BeforeEach(func() {
+			var err error
+			firstBuild, err = defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			secondBuild, err = defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = buildQueue.Admit(firstBuild.ID(), 0, time.Minute, keepQueued)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = buildQueue.Admit(secondBuild.ID(), 10, time.Minute, keepQueued)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the queued builds oldest first", func() {
+			queued, err := buildQueue.QueuedBuilds(time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(queued).To(HaveLen(2))
+
+			Expect(queued[0].BuildID).To(Equal(firstBuild.ID()))
+			Expect(queued[0].BuildName).To(Equal(firstBuild.Name()))
+			Expect(queued[0].TeamName).To(Equal(defaultTeam.Name()))
+			Expect(queued[0].PipelineName).To(Equal(defaultPipeline.Name()))
+			Expect(queued[0].JobName).To(Equal(defaultJob.Name()))
+			Expect(queued[0].Priority).To(Equal(0))
+			Expect(queued[0].QueueTime).ToNot(BeZero())
+
+			Expect(queued[1].BuildID).To(Equal(secondBuild.ID()))
+			Expect(queued[1].Priority).To(Equal(10))
+		})
+
+		It("keeps a build's place when it is queued again", func() {
+			_, err := buildQueue.Admit(firstBuild.ID(), 20, time.Minute, keepQueued)
+			Expect(err).ToNot(HaveOccurred())
+
+			queued, err := buildQueue.QueuedBuilds(time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(queued).To(HaveLen(2))
+			Expect(queued[0].BuildID).To(Equal(firstBuild.ID()))
+			Expect(queued[0].Priority).To(Equal(20))
+		})
+
+		It("does not return admitted builds", func() {
+			_, err := buildQueue.Admit(firstBuild.ID(), 0, time.Minute, func(int, []db.QueuedBuild, map[string]int) bool { return true })
+			Expect(err).ToNot(HaveOccurred())
+
+			queued, err := buildQueue.QueuedBuilds(time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(queued).To(HaveLen(1))
+			Expect(queued[0].BuildID).To(Equal(secondBuild.ID()))
+		})
+
+		It("does not return builds which are no longer pending", func() {
+			scheduled, err := firstBuild.Schedule()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(scheduled).To(BeTrue())
+
+			err = secondBuild.Finish(db.BuildStatusAborted)
+			Expect(err).ToNot(HaveOccurred())
+
+			queued, err := buildQueue.QueuedBuilds(time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(queued).To(BeEmpty())
+		})
+
+		It("does not return builds which have not been seen within the staleness window", func() {
+			_, err := dbConn.Exec(`UPDATE build_queue SET heartbeat_time = now() - interval '2 minutes' WHERE build_id = $1`, firstBuild.ID())
+			Expect(err).ToNot(HaveOccurred())
+
+			queued, err := buildQueue.QueuedBuilds(time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(queued).To(HaveLen(1))
+			Expect(queued[0].BuildID).To(Equal(secondBuild.ID()))
+		})
+	})
+
+	Describe("ActiveBuildsByTeam", func() {
+		It("counts the scheduled builds which have not completed", func() {
+			scheduledBuild, err := defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = scheduledBuild.Schedule()
+			Expect(err).ToNot(HaveOccurred())
+
+			finishedBuild, err := defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = finishedBuild.Schedule()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = finishedBuild.Finish(db.BuildStatusSucceeded)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			active, err := buildQueue.ActiveBuildsByTeam()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(active).To(Equal(map[string]int{defaultTeam.Name(): 1}))
+		})
+	})
+})
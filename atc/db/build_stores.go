@@ -0,0 +1,15 @@
+package db
+
+// BuildStores are the stores in which builds keep data outside of the
+// database. They are given to the factories, which pass them on to every
+// build, job, pipeline and team they construct. Either store may be nil if
+// it has not been configured.
+type BuildStores struct {
+	// Events is where the events of completed builds are archived. Events are
+	// kept in the database if it is nil.
+	Events BuildEventStore
+
+	// Artifacts is where builds persist artifacts. Builds cannot persist
+	// artifacts if it is nil.
+	Artifacts BuildArtifactStore
+}
@@ -4,11 +4,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/algorithm"
+	"github.com/concourse/concourse/atc/db/blobstore"
+	"github.com/concourse/concourse/atc/db/eventstore"
 	"github.com/concourse/concourse/atc/event"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -263,6 +268,96 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("ArchiveEvents", func() {
+		var (
+			storeDir string
+			build    db.Build
+		)
+
+		BeforeEach(func() {
+			var err error
+			storeDir, err = ioutil.TempDir("", "build-events")
+			Expect(err).NotTo(HaveOccurred())
+
+			eventStore := eventstore.New(blobstore.NewFileStore(storeDir))
+			storeTeamFactory := db.NewTeamFactory(dbConn, lockFactory, db.BuildStores{Events: eventStore})
+
+			storeTeam, found, err := storeTeamFactory.FindTeam(team.Name())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			build, err = storeTeam.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(build.SaveEvent(event.Log{Payload: "some "})).To(Succeed())
+			Expect(build.SaveEvent(event.Log{Payload: "log"})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(storeDir)).To(Succeed())
+		})
+
+		Context("when the build is running", func() {
+			It("errors", func() {
+				Expect(build.ArchiveEvents()).To(Equal(db.ErrBuildStillRunning))
+			})
+		})
+
+		Context("when the build has completed", func() {
+			BeforeEach(func() {
+				Expect(build.Finish(db.BuildStatusSucceeded)).To(Succeed())
+
+				found, err := build.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			It("moves the events to the store", func() {
+				Expect(build.ArchiveEvents()).To(Succeed())
+
+				var count int
+				err := dbConn.QueryRow(`SELECT COUNT(*) FROM build_events WHERE build_id = $1`, build.ID()).Scan(&count)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(count).To(BeZero())
+
+				_, err = os.Stat(filepath.Join(storeDir, fmt.Sprintf("%d.json", build.ID())))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("streams the events back from the store", func() {
+				Expect(build.ArchiveEvents()).To(Succeed())
+
+				events, err := build.Events(1)
+				Expect(err).NotTo(HaveOccurred())
+
+				defer db.Close(events)
+
+				Expect(events.Next()).To(Equal(envelope(event.Log{
+					Payload: "log",
+				})))
+
+				Expect(events.Next()).To(Equal(envelope(event.Status{
+					Status: atc.StatusSucceeded,
+					Time:   build.EndTime().Unix(),
+				})))
+
+				_, err = events.Next()
+				Expect(err).To(Equal(db.ErrEndOfBuildEventStream))
+			})
+
+			It("is no longer archivable", func() {
+				Expect(build.ArchiveEvents()).To(Succeed())
+
+				builds, err := buildFactory.GetArchivableBuilds(100)
+				Expect(err).NotTo(HaveOccurred())
+
+				for _, b := range builds {
+					Expect(b.ID()).ToNot(Equal(build.ID()))
+				}
+			})
+		})
+	})
+
 	Describe("SaveEvent", func() {
 		It("saves and propagates events correctly", func() {
 			build, err := team.CreateOneOffBuild()
@@ -368,7 +463,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -505,7 +600,7 @@ var _ = Describe("Build", func() {
 				},
 			}
 
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -607,7 +702,7 @@ var _ = Describe("Build", func() {
 							Name: "some-job",
 						},
 					},
-				}, db.ConfigVersion(1), db.PipelineUnpaused)
+				}, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 				Expect(err).ToNot(HaveOccurred())
 
 				job, found, err := createdPipeline.Job("some-job")
@@ -714,7 +809,7 @@ var _ = Describe("Build", func() {
 							Name: "some-job",
 						},
 					},
-				}, db.ConfigVersion(1), db.PipelineUnpaused)
+				}, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -908,7 +1003,7 @@ var _ = Describe("Build", func() {
 						},
 					}
 
-					pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(2), db.PipelineUnpaused)
+					pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(2), db.PipelineUnpaused, 0)
 					Expect(err).ToNot(HaveOccurred())
 
 					setupTx, err := dbConn.Begin()
@@ -996,7 +1091,7 @@ var _ = Describe("Build", func() {
 							Name: "some-job",
 						},
 					},
-				}, db.ConfigVersion(1), db.PipelineUnpaused)
+				}, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 				Expect(err).ToNot(HaveOccurred())
 
 				job, found, err := pipeline.Job("some-job")
@@ -1072,7 +1167,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1177,6 +1272,150 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("RerunInputs", func() {
+		var originalBuild db.Build
+		var rerunBuild db.Build
+		var resource db.Resource
+
+		BeforeEach(func() {
+			pipelineConfig := atc.Config{
+				Jobs: atc.JobConfigs{
+					{
+						Name: "some-job",
+					},
+				},
+				Resources: atc.ResourceConfigs{
+					{
+						Name:   "some-resource",
+						Type:   "some-type",
+						Source: atc.Source{"some": "source"},
+					},
+				},
+			}
+
+			pipeline, _, err := team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused, 0)
+			Expect(err).ToNot(HaveOccurred())
+
+			job, found, err := pipeline.Job("some-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			originalBuild, err = job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			setupTx, err := dbConn.Begin()
+			Expect(err).ToNot(HaveOccurred())
+
+			brt := db.BaseResourceType{
+				Name: "some-type",
+			}
+			_, err = brt.FindOrCreate(setupTx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(setupTx.Commit()).To(Succeed())
+
+			resource, found, err = pipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			resourceConfig, err := resource.SetResourceConfig(logger, atc.Source{"some": "source"}, creds.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = resourceConfig.SaveVersions([]atc.Version{{"some": "version"}, {"some": "newer-version"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = originalBuild.UseInputs([]db.BuildInput{
+				{
+					Name:       "some-input",
+					ResourceID: resource.ID(),
+					Version:    atc.Version{"some": "version"},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			rerunBuild, err = job.RerunBuild(originalBuild)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("links the rerun to the original build", func() {
+			Expect(rerunBuild.RerunOf()).To(Equal(originalBuild.ID()))
+			Expect(rerunBuild.RerunOfName()).To(Equal(originalBuild.Name()))
+			Expect(rerunBuild.IsManuallyTriggered()).To(BeTrue())
+			Expect(rerunBuild.Status()).To(Equal(db.BuildStatusPending))
+		})
+
+		It("returns the inputs of the build itself", func() {
+			inputs, err := originalBuild.Inputs()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(inputs).To(Equal([]db.BuildInput{
+				{
+					Name:       "some-input",
+					ResourceID: resource.ID(),
+					Version:    atc.Version{"some": "version"},
+				},
+			}))
+		})
+
+		It("returns the inputs of the original build", func() {
+			inputs, err := rerunBuild.RerunInputs()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(inputs).To(Equal([]db.BuildInput{
+				{
+					Name:       "some-input",
+					ResourceID: resource.ID(),
+					Version:    atc.Version{"some": "version"},
+				},
+			}))
+		})
+
+		Context("when the resource's config has changed since the build ran", func() {
+			BeforeEach(func() {
+				_, err := resource.SetResourceConfig(logger, atc.Source{"some": "other-source"}, creds.VersionedResourceTypes{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("still returns the versions used by the original build", func() {
+				inputs, err := rerunBuild.RerunInputs()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(inputs).To(Equal([]db.BuildInput{
+					{
+						Name:       "some-input",
+						ResourceID: resource.ID(),
+						Version:    atc.Version{"some": "version"},
+					},
+				}))
+			})
+		})
+
+		Context("when the version of an input was not recorded with the build", func() {
+			BeforeEach(func() {
+				_, err := dbConn.Exec(`UPDATE build_resource_config_version_inputs SET version = NULL WHERE build_id = $1`, originalBuild.ID())
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns an error naming the input", func() {
+				_, err := rerunBuild.RerunInputs()
+				Expect(err).To(Equal(db.BuildInputsNotFoundError{Names: []string{"some-input"}}))
+			})
+		})
+
+		Context("when the original build is deleted", func() {
+			BeforeEach(func() {
+				deleted, err := originalBuild.Delete()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deleted).To(BeTrue())
+
+				found, err := rerunBuild.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			It("is no longer a rerun", func() {
+				Expect(rerunBuild.RerunOf()).To(BeZero())
+				Expect(rerunBuild.RerunOfName()).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("FinishWithError", func() {
 		var cause error
 		var build db.Build
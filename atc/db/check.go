@@ -0,0 +1,219 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/lib/pq"
+)
+
+type CheckStatus string
+
+const (
+	CheckStatusPending   CheckStatus = "pending"
+	CheckStatusStarted   CheckStatus = "started"
+	CheckStatusSucceeded CheckStatus = "succeeded"
+	CheckStatusErrored   CheckStatus = "errored"
+)
+
+//go:generate counterfeiter . Check
+
+// A Check is a queued check of a resource or a resource type.
+type Check interface {
+	ID() int
+	TeamID() int
+	TeamName() string
+	PipelineID() int
+	PipelineName() string
+
+	// ResourceID is zero if the check is of a resource type.
+	ResourceID() int
+	// ResourceTypeID is zero if the check is of a resource.
+	ResourceTypeID() int
+	// Name is the name of the resource or resource type being checked.
+	Name() string
+
+	FromVersion() atc.Version
+	ManuallyTriggered() bool
+
+	Status() CheckStatus
+	CreateTime() time.Time
+	StartTime() time.Time
+	EndTime() time.Time
+	CheckError() error
+
+	Pipeline() (Pipeline, bool, error)
+	Finish(error) error
+}
+
+var checksQuery = psql.Select(`
+		c.id,
+		c.resource_id,
+		c.resource_type_id,
+		COALESCE(r.name, rt.name),
+		p.id,
+		p.name,
+		t.id,
+		t.name,
+		c.from_version,
+		c.manually_triggered,
+		c.status,
+		c.create_time,
+		c.start_time,
+		c.end_time,
+		c.check_error
+	`).
+	From("checks c").
+	LeftJoin("resources r ON r.id = c.resource_id").
+	LeftJoin("resource_types rt ON rt.id = c.resource_type_id").
+	Join("pipelines p ON p.id = COALESCE(r.pipeline_id, rt.pipeline_id)").
+	Join("teams t ON t.id = p.team_id")
+
+type check struct {
+	id             int
+	teamID         int
+	teamName       string
+	pipelineID     int
+	pipelineName   string
+	resourceID     int
+	resourceTypeID int
+	name           string
+
+	fromVersion       atc.Version
+	manuallyTriggered bool
+
+	status     CheckStatus
+	createTime time.Time
+	startTime  time.Time
+	endTime    time.Time
+	checkError error
+
+	conn        Conn
+	lockFactory lock.LockFactory
+	stores      BuildStores
+}
+
+func (c *check) ID() int                  { return c.id }
+func (c *check) TeamID() int              { return c.teamID }
+func (c *check) TeamName() string         { return c.teamName }
+func (c *check) PipelineID() int          { return c.pipelineID }
+func (c *check) PipelineName() string     { return c.pipelineName }
+func (c *check) ResourceID() int          { return c.resourceID }
+func (c *check) ResourceTypeID() int      { return c.resourceTypeID }
+func (c *check) Name() string             { return c.name }
+func (c *check) FromVersion() atc.Version { return c.fromVersion }
+func (c *check) ManuallyTriggered() bool  { return c.manuallyTriggered }
+func (c *check) Status() CheckStatus      { return c.status }
+func (c *check) CreateTime() time.Time    { return c.createTime }
+func (c *check) StartTime() time.Time     { return c.startTime }
+func (c *check) EndTime() time.Time       { return c.endTime }
+func (c *check) CheckError() error        { return c.checkError }
+
+func (c *check) Pipeline() (Pipeline, bool, error) {
+	pipeline := newPipeline(c.conn, c.lockFactory, c.stores)
+
+	row := pipelinesQuery.
+		Where(sq.Eq{"p.id": c.pipelineID}).
+		RunWith(c.conn).
+		QueryRow()
+
+	err := scanPipeline(pipeline, row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return pipeline, true, nil
+}
+
+// Finish records the outcome of the check. The check is errored if the given
+// error is not nil.
+func (c *check) Finish(cause error) error {
+	status := CheckStatusSucceeded
+
+	var checkError sql.NullString
+	if cause != nil {
+		status = CheckStatusErrored
+		checkError = sql.NullString{String: cause.Error(), Valid: true}
+	}
+
+	var endTime time.Time
+	err := psql.Update("checks").
+		Set("status", status).
+		Set("end_time", sq.Expr("now()")).
+		Set("check_error", checkError).
+		Where(sq.Eq{"id": c.id}).
+		Suffix("RETURNING end_time").
+		RunWith(c.conn).
+		QueryRow().
+		Scan(&endTime)
+	if err != nil {
+		return err
+	}
+
+	c.status = status
+	c.endTime = endTime
+	c.checkError = cause
+
+	return nil
+}
+
+func scanCheck(c *check, row scannable) error {
+	var (
+		resourceID, resourceTypeID sql.NullInt64
+		fromVersion                []byte
+		startTime, endTime         pq.NullTime
+		checkError                 sql.NullString
+		status                     string
+	)
+
+	err := row.Scan(
+		&c.id,
+		&resourceID,
+		&resourceTypeID,
+		&c.name,
+		&c.pipelineID,
+		&c.pipelineName,
+		&c.teamID,
+		&c.teamName,
+		&fromVersion,
+		&c.manuallyTriggered,
+		&status,
+		&c.createTime,
+		&startTime,
+		&endTime,
+		&checkError,
+	)
+	if err != nil {
+		return err
+	}
+
+	c.resourceID = int(resourceID.Int64)
+	c.resourceTypeID = int(resourceTypeID.Int64)
+	c.status = CheckStatus(status)
+	c.startTime = startTime.Time
+	c.endTime = endTime.Time
+
+	if fromVersion != nil {
+		err = json.Unmarshal(fromVersion, &c.fromVersion)
+		if err != nil {
+			return err
+		}
+	}
+
+	if checkError.Valid {
+		c.checkError = errors.New(checkError.String)
+	} else {
+		c.checkError = nil
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/lock"
+)
+
+//go:generate counterfeiter . CheckFactory
+
+// A CheckFactory manages the queue of checks shared by every ATC in the
+// cluster.
+type CheckFactory interface {
+	// CreateResourceCheck queues a check of the resource, unless one is
+	// already waiting in the queue. It returns whether a check was queued.
+	CreateResourceCheck(resourceID int, fromVersion atc.Version, manuallyTriggered bool) (bool, error)
+
+	// CreateResourceTypeCheck queues a check of the resource type, unless one
+	// is already waiting in the queue. It returns whether a check was queued.
+	CreateResourceTypeCheck(resourceTypeID int, fromVersion atc.Version, manuallyTriggered bool) (bool, error)
+
+	// AcquireCheck starts the oldest check waiting in the queue. If limit is
+	// non-zero, no check is started if limit checks have already been started
+	// within the preceding window, across all ATCs.
+	AcquireCheck(window time.Duration, limit int) (Check, bool, error)
+
+	PendingChecksCount() (int, error)
+
+	// ErrorStaleChecks errors the checks which were started longer ago than
+	// the timeout but never finished, e.g. because their ATC went away.
+	ErrorStaleChecks(timeout time.Duration) error
+
+	// CleanupChecks removes finished checks, and with them the history of
+	// resource checks, which ended longer ago than the retention period.
+	CleanupChecks(retention time.Duration) error
+}
+
+type checkFactory struct {
+	conn        Conn
+	lockFactory lock.LockFactory
+	stores      BuildStores
+}
+
+func NewCheckFactory(conn Conn, lockFactory lock.LockFactory, stores BuildStores) CheckFactory {
+	return &checkFactory{
+		conn:        conn,
+		lockFactory: lockFactory,
+		stores:      stores,
+	}
+}
+
+func (f *checkFactory) CreateResourceCheck(resourceID int, fromVersion atc.Version, manuallyTriggered bool) (bool, error) {
+	return f.createCheck("resource_id", resourceID, fromVersion, manuallyTriggered)
+}
+
+func (f *checkFactory) CreateResourceTypeCheck(resourceTypeID int, fromVersion atc.Version, manuallyTriggered bool) (bool, error) {
+	return f.createCheck("resource_type_id", resourceTypeID, fromVersion, manuallyTriggered)
+}
+
+func (f *checkFactory) createCheck(column string, id int, fromVersion atc.Version, manuallyTriggered bool) (bool, error) {
+	var fromVersionJSON interface{}
+	if fromVersion != nil {
+		payload, err := json.Marshal(fromVersion)
+		if err != nil {
+			return false, err
+		}
+
+		fromVersionJSON = string(payload)
+	}
+
+	result, err := psql.Insert("checks").
+		Columns(column, "from_version", "manually_triggered").
+		Values(id, fromVersionJSON, manuallyTriggered).
+		Suffix("ON CONFLICT DO NOTHING").
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected == 1, nil
+}
+
+func (f *checkFactory) AcquireCheck(window time.Duration, limit int) (Check, bool, error) {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer Rollback(tx)
+
+	if limit > 0 {
+		// serialize acquiring checks across ATCs so that the limit holds
+		_, err = tx.Exec(`SELECT pg_advisory_xact_lock($1)`, lock.LockTypeCheckQueue)
+		if err != nil {
+			return nil, false, err
+		}
+
+		var started int
+		err = psql.Select("COUNT(*)").
+			From("checks").
+			Where(sq.Expr("start_time > now() - (? * interval '1 millisecond')", window.Nanoseconds()/int64(time.Millisecond))).
+			RunWith(tx).
+			QueryRow().
+			Scan(&started)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if started >= limit {
+			return nil, false, nil
+		}
+	}
+
+	var id int
+	err = tx.QueryRow(`
+		UPDATE checks
+		SET status = $1, start_time = now()
+		WHERE id = (
+			SELECT id
+			FROM checks
+			WHERE status = $2
+			ORDER BY id ASC
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id
+	`, CheckStatusStarted, CheckStatusPending).Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	check := &check{
+		conn:        f.conn,
+		lockFactory: f.lockFactory,
+		stores:      f.stores,
+	}
+
+	row := checksQuery.
+		Where(sq.Eq{"c.id": id}).
+		RunWith(tx).
+		QueryRow()
+
+	err = scanCheck(check, row)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, false, err
+	}
+
+	return check, true, nil
+}
+
+func (f *checkFactory) PendingChecksCount() (int, error) {
+	var count int
+	err := psql.Select("COUNT(*)").
+		From("checks").
+		Where(sq.Eq{"status": CheckStatusPending}).
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (f *checkFactory) ErrorStaleChecks(timeout time.Duration) error {
+	_, err := psql.Update("checks").
+		Set("status", CheckStatusErrored).
+		Set("end_time", sq.Expr("now()")).
+		Set("check_error", fmt.Sprintf("check did not finish within %s", timeout)).
+		Where(sq.Eq{"status": CheckStatusStarted}).
+		Where(sq.Expr("start_time < now() - (? * interval '1 millisecond')", timeout.Nanoseconds()/int64(time.Millisecond))).
+		RunWith(f.conn).
+		Exec()
+	return err
+}
+
+func (f *checkFactory) CleanupChecks(retention time.Duration) error {
+	_, err := psql.Delete("checks").
+		Where(sq.Eq{"status": []CheckStatus{CheckStatusSucceeded, CheckStatusErrored}}).
+		Where(sq.Expr("end_time < now() - (? * interval '1 millisecond')", retention.Nanoseconds()/int64(time.Millisecond))).
+		RunWith(f.conn).
+		Exec()
+	return err
+}
@@ -0,0 +1,230 @@
+package db_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckFactory", func() {
+	var checkFactory db.CheckFactory
+
+	BeforeEach(func() {
+		checkFactory = db.NewCheckFactory(dbConn, lockFactory, db.BuildStores{})
+	})
+
+	Describe("CreateResourceCheck", func() {
+		It("queues a single check per resource", func() {
+			created, err := checkFactory.CreateResourceCheck(defaultResource.ID(), nil, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+
+			created, err = checkFactory.CreateResourceCheck(defaultResource.ID(), nil, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeFalse())
+
+			count, err := checkFactory.PendingChecksCount()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(1))
+		})
+
+		It("queues another check once the queued one has started", func() {
+			_, err := checkFactory.CreateResourceCheck(defaultResource.ID(), nil, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, found, err := checkFactory.AcquireCheck(0, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			created, err := checkFactory.CreateResourceCheck(defaultResource.ID(), nil, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+		})
+	})
+
+	Describe("AcquireCheck", func() {
+		Context("when there are no queued checks", func() {
+			It("does not find a check", func() {
+				_, found, err := checkFactory.AcquireCheck(0, 0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when checks are queued", func() {
+			BeforeEach(func() {
+				_, err := checkFactory.CreateResourceTypeCheck(defaultResourceType.ID(), nil, false)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = checkFactory.CreateResourceCheck(defaultResource.ID(), atc.Version{"some": "version"}, true)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("starts them in the order they were queued", func() {
+				check, found, err := checkFactory.AcquireCheck(0, 0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(check.ResourceTypeID()).To(Equal(defaultResourceType.ID()))
+				Expect(check.ResourceID()).To(BeZero())
+				Expect(check.Name()).To(Equal("some-type"))
+				Expect(check.PipelineID()).To(Equal(defaultPipeline.ID()))
+				Expect(check.TeamName()).To(Equal(defaultTeam.Name()))
+				Expect(check.Status()).To(Equal(db.CheckStatusStarted))
+				Expect(check.StartTime()).ToNot(BeZero())
+
+				check, found, err = checkFactory.AcquireCheck(0, 0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(check.ResourceID()).To(Equal(defaultResource.ID()))
+				Expect(check.Name()).To(Equal("some-resource"))
+				Expect(check.FromVersion()).To(Equal(atc.Version{"some": "version"}))
+				Expect(check.ManuallyTriggered()).To(BeTrue())
+
+				_, found, err = checkFactory.AcquireCheck(0, 0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			It("does not start more checks than the limit within the window", func() {
+				_, found, err := checkFactory.AcquireCheck(time.Minute, 1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				_, found, err = checkFactory.AcquireCheck(time.Minute, 1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+
+				count, err := checkFactory.PendingChecksCount()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(count).To(Equal(1))
+			})
+
+			It("can find the pipeline of the check", func() {
+				check, _, err := checkFactory.AcquireCheck(0, 0)
+				Expect(err).ToNot(HaveOccurred())
+
+				pipeline, found, err := check.Pipeline()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(pipeline.ID()).To(Equal(defaultPipeline.ID()))
+			})
+		})
+	})
+
+	Describe("Finish", func() {
+		var check db.Check
+
+		BeforeEach(func() {
+			_, err := checkFactory.CreateResourceCheck(defaultResource.ID(), nil, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			check, _, err = checkFactory.AcquireCheck(0, 0)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("succeeds the check", func() {
+			err := check.Finish(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(check.Status()).To(Equal(db.CheckStatusSucceeded))
+			Expect(check.EndTime()).ToNot(BeZero())
+			Expect(check.CheckError()).To(BeNil())
+		})
+
+		It("errors the check when given an error", func() {
+			err := check.Finish(errors.New("disaster"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(check.Status()).To(Equal(db.CheckStatusErrored))
+			Expect(check.CheckError()).To(MatchError("disaster"))
+		})
+	})
+
+	Describe("ErrorStaleChecks", func() {
+		var check db.Check
+
+		BeforeEach(func() {
+			_, err := checkFactory.CreateResourceCheck(defaultResource.ID(), nil, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			var acquired bool
+			check, acquired, err = checkFactory.AcquireCheck(0, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+
+			_, err = checkFactory.CreateResourceCheck(defaultResource.ID(), nil, false)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("leaves checks started within the timeout alone", func() {
+			err := checkFactory.ErrorStaleChecks(time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+
+			var status string
+			err = dbConn.QueryRow(`SELECT status FROM checks WHERE id = $1`, check.ID()).Scan(&status)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status).To(Equal(string(db.CheckStatusStarted)))
+		})
+
+		It("errors checks started before the timeout, but not queued ones", func() {
+			_, err := dbConn.Exec(`UPDATE checks SET start_time = now() - interval '2 hours' WHERE id = $1`, check.ID())
+			Expect(err).ToNot(HaveOccurred())
+
+			err = checkFactory.ErrorStaleChecks(time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+
+			var status, checkError string
+			err = dbConn.QueryRow(`SELECT status, check_error FROM checks WHERE id = $1`, check.ID()).Scan(&status, &checkError)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status).To(Equal(string(db.CheckStatusErrored)))
+			Expect(checkError).To(Equal("check did not finish within 1h0m0s"))
+
+			count, err := checkFactory.PendingChecksCount()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(1))
+		})
+	})
+
+	Describe("CleanupChecks", func() {
+		BeforeEach(func() {
+			_, err := checkFactory.CreateResourceCheck(defaultResource.ID(), nil, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			check, _, err := checkFactory.AcquireCheck(0, 0)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = check.Finish(nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = checkFactory.CreateResourceCheck(defaultResource.ID(), nil, false)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("keeps checks which ended within the retention period", func() {
+			err := checkFactory.CleanupChecks(time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+
+			var count int
+			err = dbConn.QueryRow(`SELECT COUNT(*) FROM checks`).Scan(&count)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(2))
+		})
+
+		It("removes finished checks but keeps queued ones", func() {
+			err := checkFactory.CleanupChecks(0)
+			Expect(err).ToNot(HaveOccurred())
+
+			var count int
+			err = dbConn.QueryRow(`SELECT COUNT(*) FROM checks`).Scan(&count)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(1))
+
+			count, err = checkFactory.PendingChecksCount()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(1))
+		})
+	})
+})
@@ -17,6 +17,10 @@ type ContainerRepository interface {
 	RemoveDestroyingContainers(workerName string, currentHandles []string) (int, error)
 	UpdateContainersMissingSince(workerName string, handles []string) error
 	RemoveMissingContainers(time.Duration) (int, error)
+
+	// CountContainersByTeam returns the number of containers owned by each
+	// team, including teams which own none.
+	CountContainersByTeam() (map[string]int, error)
 }
 
 type containerRepository struct {
@@ -362,3 +366,38 @@ func (repository *containerRepository) DestroyFailedContainers() (int, error) {
 
 	return int(failedContainersLen), nil
 }
+
+func (repository *containerRepository) CountContainersByTeam() (map[string]int, error) {
+	return countByTeam(repository.conn, "containers")
+}
+
+func countByTeam(conn Conn, table string) (map[string]int, error) {
+	rows, err := psql.Select("t.name", "COUNT(o.id)").
+		From("teams t").
+		LeftJoin(table + " o ON o.team_id = t.id").
+		GroupBy("t.name").
+		RunWith(conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	counts := map[string]int{}
+	for rows.Next() {
+		var (
+			teamName string
+			count    int
+		)
+
+		err = rows.Scan(&teamName, &count)
+		if err != nil {
+			return nil, err
+		}
+
+		counts[teamName] = count
+	}
+
+	return counts, nil
+}
@@ -512,6 +512,34 @@ var _ = Describe("ContainerRepository", func() {
 		})
 	})
 
+	Describe("CountContainersByTeam", func() {
+		var otherTeam db.Team
+
+		BeforeEach(func() {
+			var err error
+			otherTeam, err = teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
+			Expect(err).ToNot(HaveOccurred())
+
+			build, err := defaultTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, planID := range []atc.PlanID{"some-plan", "some-other-plan"} {
+				_, err = defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(build.ID(), planID, defaultTeam.ID()), db.ContainerMetadata{
+					Type:     "task",
+					StepName: "some-task",
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		It("returns the number of containers owned by every team", func() {
+			counts, err := containerRepository.CountContainersByTeam()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(counts).To(HaveKeyWithValue(defaultTeam.Name(), 2))
+			Expect(counts).To(HaveKeyWithValue(otherTeam.Name(), 0))
+		})
+	})
+
 	Describe("FindDestroyingContainers", func() {
 		var failedErr error
 		var destroyingContainers []string
@@ -92,10 +92,10 @@ var _ = BeforeEach(func() {
 
 	lockFactory = lock.NewLockFactory(postgresRunner.OpenSingleton(), metric.LogLockAcquired, metric.LogLockReleased)
 
-	buildFactory = db.NewBuildFactory(dbConn, lockFactory, 5*time.Minute)
+	buildFactory = db.NewBuildFactory(dbConn, lockFactory, db.BuildStores{}, 5*time.Minute)
 	volumeRepository = db.NewVolumeRepository(dbConn)
 	containerRepository = db.NewContainerRepository(dbConn)
-	teamFactory = db.NewTeamFactory(dbConn, lockFactory)
+	teamFactory = db.NewTeamFactory(dbConn, lockFactory, db.BuildStores{})
 	workerFactory = db.NewWorkerFactory(dbConn)
 	workerLifecycle = db.NewWorkerLifecycle(dbConn)
 	resourceConfigCheckSessionLifecycle = db.NewResourceConfigCheckSessionLifecycle(dbConn)
@@ -162,7 +162,7 @@ var _ = BeforeEach(func() {
 				},
 			},
 		},
-	}, db.ConfigVersion(0), db.PipelineUnpaused)
+	}, db.ConfigVersion(0), db.PipelineUnpaused, 0)
 	Expect(err).NotTo(HaveOccurred())
 
 	var found bool
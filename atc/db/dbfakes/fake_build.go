@@ -3,6 +3,7 @@ package dbfakes
 
 import (
 	json "encoding/json"
+	io "io"
 	sync "sync"
 	time "time"
 
@@ -42,6 +43,108 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	ApprovalStub        func(atc.PlanID) (db.BuildApproval, bool, error)
+	approvalMutex       sync.RWMutex
+	approvalArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	approvalReturns struct {
+		result1 db.BuildApproval
+		result2 bool
+		result3 error
+	}
+	approvalReturnsOnCall map[int]struct {
+		result1 db.BuildApproval
+		result2 bool
+		result3 error
+	}
+	ApprovalNotifierStub        func(atc.PlanID) (db.Notifier, error)
+	approvalNotifierMutex       sync.RWMutex
+	approvalNotifierArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	approvalNotifierReturns struct {
+		result1 db.Notifier
+		result2 error
+	}
+	approvalNotifierReturnsOnCall map[int]struct {
+		result1 db.Notifier
+		result2 error
+	}
+	ApprovalsStub        func() ([]db.BuildApproval, error)
+	approvalsMutex       sync.RWMutex
+	approvalsArgsForCall []struct {
+	}
+	approvalsReturns struct {
+		result1 []db.BuildApproval
+		result2 error
+	}
+	approvalsReturnsOnCall map[int]struct {
+		result1 []db.BuildApproval
+		result2 error
+	}
+	ArchiveEventsStub        func() error
+	archiveEventsMutex       sync.RWMutex
+	archiveEventsArgsForCall []struct {
+	}
+	archiveEventsReturns struct {
+		result1 error
+	}
+	archiveEventsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ArtifactStub        func(string) (io.ReadCloser, bool, error)
+	artifactMutex       sync.RWMutex
+	artifactArgsForCall []struct {
+		arg1 string
+	}
+	artifactReturns struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
+	artifactReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
+	ArtifactsStub        func() ([]db.BuildArtifact, error)
+	artifactsMutex       sync.RWMutex
+	artifactsArgsForCall []struct {
+	}
+	artifactsReturns struct {
+		result1 []db.BuildArtifact
+		result2 error
+	}
+	artifactsReturnsOnCall map[int]struct {
+		result1 []db.BuildArtifact
+		result2 error
+	}
+	CreateTimeStub        func() time.Time
+	createTimeMutex       sync.RWMutex
+	createTimeArgsForCall []struct {
+	}
+	createTimeReturns struct {
+		result1 time.Time
+	}
+	createTimeReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
+	DecideApprovalStub        func(atc.PlanID, atc.BuildApprovalStatus, string) (bool, error)
+	decideApprovalMutex       sync.RWMutex
+	decideApprovalArgsForCall []struct {
+		arg1 atc.PlanID
+		arg2 atc.BuildApprovalStatus
+		arg3 string
+	}
+	decideApprovalReturns struct {
+		result1 bool
+		result2 error
+	}
+	decideApprovalReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	DeleteStub        func() (bool, error)
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -129,6 +232,18 @@ type FakeBuild struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	InputsStub        func() ([]db.BuildInput, error)
+	inputsMutex       sync.RWMutex
+	inputsArgsForCall []struct {
+	}
+	inputsReturns struct {
+		result1 []db.BuildInput
+		result2 error
+	}
+	inputsReturnsOnCall map[int]struct {
+		result1 []db.BuildInput
+		result2 error
+	}
 	InterceptibleStub        func() (bool, error)
 	interceptibleMutex       sync.RWMutex
 	interceptibleArgsForCall []struct {
@@ -301,6 +416,53 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	RequestApprovalStub        func(atc.PlanID, string, string) (db.BuildApproval, error)
+	requestApprovalMutex       sync.RWMutex
+	requestApprovalArgsForCall []struct {
+		arg1 atc.PlanID
+		arg2 string
+		arg3 string
+	}
+	requestApprovalReturns struct {
+		result1 db.BuildApproval
+		result2 error
+	}
+	requestApprovalReturnsOnCall map[int]struct {
+		result1 db.BuildApproval
+		result2 error
+	}
+	RerunInputsStub        func() ([]db.BuildInput, error)
+	rerunInputsMutex       sync.RWMutex
+	rerunInputsArgsForCall []struct {
+	}
+	rerunInputsReturns struct {
+		result1 []db.BuildInput
+		result2 error
+	}
+	rerunInputsReturnsOnCall map[int]struct {
+		result1 []db.BuildInput
+		result2 error
+	}
+	RerunOfStub        func() int
+	rerunOfMutex       sync.RWMutex
+	rerunOfArgsForCall []struct {
+	}
+	rerunOfReturns struct {
+		result1 int
+	}
+	rerunOfReturnsOnCall map[int]struct {
+		result1 int
+	}
+	RerunOfNameStub        func() string
+	rerunOfNameMutex       sync.RWMutex
+	rerunOfNameArgsForCall []struct {
+	}
+	rerunOfNameReturns struct {
+		result1 string
+	}
+	rerunOfNameReturnsOnCall map[int]struct {
+		result1 string
+	}
 	ResourcesStub        func() ([]db.BuildInput, []db.BuildOutput, error)
 	resourcesMutex       sync.RWMutex
 	resourcesArgsForCall []struct {
@@ -315,6 +477,18 @@ type FakeBuild struct {
 		result2 []db.BuildOutput
 		result3 error
 	}
+	SaveArtifactStub        func(string, io.Reader) error
+	saveArtifactMutex       sync.RWMutex
+	saveArtifactArgsForCall []struct {
+		arg1 string
+		arg2 io.Reader
+	}
+	saveArtifactReturns struct {
+		result1 error
+	}
+	saveArtifactReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SaveEventStub        func(atc.Event) error
 	saveEventMutex       sync.RWMutex
 	saveEventArgsForCall []struct {
@@ -602,6 +776,480 @@ func (fake *FakeBuild) AcquireTrackingLockReturnsOnCall(i int, result1 lock.Lock
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) Approval(arg1 atc.PlanID) (db.BuildApproval, bool, error) {
+	fake.approvalMutex.Lock()
+	ret, specificReturn := fake.approvalReturnsOnCall[len(fake.approvalArgsForCall)]
+	fake.approvalArgsForCall = append(fake.approvalArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	fake.recordInvocation("Approval", []interface{}{arg1})
+	fake.approvalMutex.Unlock()
+	if fake.ApprovalStub != nil {
+		return fake.ApprovalStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.approvalReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuild) ApprovalCallCount() int {
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
+	return len(fake.approvalArgsForCall)
+}
+
+func (fake *FakeBuild) ApprovalCalls(stub func(atc.PlanID) (db.BuildApproval, bool, error)) {
+	fake.approvalMutex.Lock()
+	defer fake.approvalMutex.Unlock()
+	fake.ApprovalStub = stub
+}
+
+func (fake *FakeBuild) ApprovalArgsForCall(i int) atc.PlanID {
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
+	argsForCall := fake.approvalArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) ApprovalReturns(result1 db.BuildApproval, result2 bool, result3 error) {
+	fake.approvalMutex.Lock()
+	defer fake.approvalMutex.Unlock()
+	fake.ApprovalStub = nil
+	fake.approvalReturns = struct {
+		result1 db.BuildApproval
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) ApprovalReturnsOnCall(i int, result1 db.BuildApproval, result2 bool, result3 error) {
+	fake.approvalMutex.Lock()
+	defer fake.approvalMutex.Unlock()
+	fake.ApprovalStub = nil
+	if fake.approvalReturnsOnCall == nil {
+		fake.approvalReturnsOnCall = make(map[int]struct {
+			result1 db.BuildApproval
+			result2 bool
+			result3 error
+		})
+	}
+	fake.approvalReturnsOnCall[i] = struct {
+		result1 db.BuildApproval
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) ApprovalNotifier(arg1 atc.PlanID) (db.Notifier, error) {
+	fake.approvalNotifierMutex.Lock()
+	ret, specificReturn := fake.approvalNotifierReturnsOnCall[len(fake.approvalNotifierArgsForCall)]
+	fake.approvalNotifierArgsForCall = append(fake.approvalNotifierArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	fake.recordInvocation("ApprovalNotifier", []interface{}{arg1})
+	fake.approvalNotifierMutex.Unlock()
+	if fake.ApprovalNotifierStub != nil {
+		return fake.ApprovalNotifierStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.approvalNotifierReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) ApprovalNotifierCallCount() int {
+	fake.approvalNotifierMutex.RLock()
+	defer fake.approvalNotifierMutex.RUnlock()
+	return len(fake.approvalNotifierArgsForCall)
+}
+
+func (fake *FakeBuild) ApprovalNotifierCalls(stub func(atc.PlanID) (db.Notifier, error)) {
+	fake.approvalNotifierMutex.Lock()
+	defer fake.approvalNotifierMutex.Unlock()
+	fake.ApprovalNotifierStub = stub
+}
+
+func (fake *FakeBuild) ApprovalNotifierArgsForCall(i int) atc.PlanID {
+	fake.approvalNotifierMutex.RLock()
+	defer fake.approvalNotifierMutex.RUnlock()
+	argsForCall := fake.approvalNotifierArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) ApprovalNotifierReturns(result1 db.Notifier, result2 error) {
+	fake.approvalNotifierMutex.Lock()
+	defer fake.approvalNotifierMutex.Unlock()
+	fake.ApprovalNotifierStub = nil
+	fake.approvalNotifierReturns = struct {
+		result1 db.Notifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ApprovalNotifierReturnsOnCall(i int, result1 db.Notifier, result2 error) {
+	fake.approvalNotifierMutex.Lock()
+	defer fake.approvalNotifierMutex.Unlock()
+	fake.ApprovalNotifierStub = nil
+	if fake.approvalNotifierReturnsOnCall == nil {
+		fake.approvalNotifierReturnsOnCall = make(map[int]struct {
+			result1 db.Notifier
+			result2 error
+		})
+	}
+	fake.approvalNotifierReturnsOnCall[i] = struct {
+		result1 db.Notifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) Approvals() ([]db.BuildApproval, error) {
+	fake.approvalsMutex.Lock()
+	ret, specificReturn := fake.approvalsReturnsOnCall[len(fake.approvalsArgsForCall)]
+	fake.approvalsArgsForCall = append(fake.approvalsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Approvals", []interface{}{})
+	fake.approvalsMutex.Unlock()
+	if fake.ApprovalsStub != nil {
+		return fake.ApprovalsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.approvalsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) ApprovalsCallCount() int {
+	fake.approvalsMutex.RLock()
+	defer fake.approvalsMutex.RUnlock()
+	return len(fake.approvalsArgsForCall)
+}
+
+func (fake *FakeBuild) ApprovalsCalls(stub func() ([]db.BuildApproval, error)) {
+	fake.approvalsMutex.Lock()
+	defer fake.approvalsMutex.Unlock()
+	fake.ApprovalsStub = stub
+}
+
+func (fake *FakeBuild) ApprovalsReturns(result1 []db.BuildApproval, result2 error) {
+	fake.approvalsMutex.Lock()
+	defer fake.approvalsMutex.Unlock()
+	fake.ApprovalsStub = nil
+	fake.approvalsReturns = struct {
+		result1 []db.BuildApproval
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ApprovalsReturnsOnCall(i int, result1 []db.BuildApproval, result2 error) {
+	fake.approvalsMutex.Lock()
+	defer fake.approvalsMutex.Unlock()
+	fake.ApprovalsStub = nil
+	if fake.approvalsReturnsOnCall == nil {
+		fake.approvalsReturnsOnCall = make(map[int]struct {
+			result1 []db.BuildApproval
+			result2 error
+		})
+	}
+	fake.approvalsReturnsOnCall[i] = struct {
+		result1 []db.BuildApproval
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ArchiveEvents() error {
+	fake.archiveEventsMutex.Lock()
+	ret, specificReturn := fake.archiveEventsReturnsOnCall[len(fake.archiveEventsArgsForCall)]
+	fake.archiveEventsArgsForCall = append(fake.archiveEventsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ArchiveEvents", []interface{}{})
+	fake.archiveEventsMutex.Unlock()
+	if fake.ArchiveEventsStub != nil {
+		return fake.ArchiveEventsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.archiveEventsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) ArchiveEventsCallCount() int {
+	fake.archiveEventsMutex.RLock()
+	defer fake.archiveEventsMutex.RUnlock()
+	return len(fake.archiveEventsArgsForCall)
+}
+
+func (fake *FakeBuild) ArchiveEventsCalls(stub func() error) {
+	fake.archiveEventsMutex.Lock()
+	defer fake.archiveEventsMutex.Unlock()
+	fake.ArchiveEventsStub = stub
+}
+
+func (fake *FakeBuild) ArchiveEventsReturns(result1 error) {
+	fake.archiveEventsMutex.Lock()
+	defer fake.archiveEventsMutex.Unlock()
+	fake.ArchiveEventsStub = nil
+	fake.archiveEventsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) ArchiveEventsReturnsOnCall(i int, result1 error) {
+	fake.archiveEventsMutex.Lock()
+	defer fake.archiveEventsMutex.Unlock()
+	fake.ArchiveEventsStub = nil
+	if fake.archiveEventsReturnsOnCall == nil {
+		fake.archiveEventsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.archiveEventsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) Artifact(arg1 string) (io.ReadCloser, bool, error) {
+	fake.artifactMutex.Lock()
+	ret, specificReturn := fake.artifactReturnsOnCall[len(fake.artifactArgsForCall)]
+	fake.artifactArgsForCall = append(fake.artifactArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("Artifact", []interface{}{arg1})
+	fake.artifactMutex.Unlock()
+	if fake.ArtifactStub != nil {
+		return fake.ArtifactStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.artifactReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuild) ArtifactCallCount() int {
+	fake.artifactMutex.RLock()
+	defer fake.artifactMutex.RUnlock()
+	return len(fake.artifactArgsForCall)
+}
+
+func (fake *FakeBuild) ArtifactCalls(stub func(string) (io.ReadCloser, bool, error)) {
+	fake.artifactMutex.Lock()
+	defer fake.artifactMutex.Unlock()
+	fake.ArtifactStub = stub
+}
+
+func (fake *FakeBuild) ArtifactArgsForCall(i int) string {
+	fake.artifactMutex.RLock()
+	defer fake.artifactMutex.RUnlock()
+	argsForCall := fake.artifactArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) ArtifactReturns(result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.artifactMutex.Lock()
+	defer fake.artifactMutex.Unlock()
+	fake.ArtifactStub = nil
+	fake.artifactReturns = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) ArtifactReturnsOnCall(i int, result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.artifactMutex.Lock()
+	defer fake.artifactMutex.Unlock()
+	fake.ArtifactStub = nil
+	if fake.artifactReturnsOnCall == nil {
+		fake.artifactReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 bool
+			result3 error
+		})
+	}
+	fake.artifactReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) Artifacts() ([]db.BuildArtifact, error) {
+	fake.artifactsMutex.Lock()
+	ret, specificReturn := fake.artifactsReturnsOnCall[len(fake.artifactsArgsForCall)]
+	fake.artifactsArgsForCall = append(fake.artifactsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Artifacts", []interface{}{})
+	fake.artifactsMutex.Unlock()
+	if fake.ArtifactsStub != nil {
+		return fake.ArtifactsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.artifactsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) ArtifactsCallCount() int {
+	fake.artifactsMutex.RLock()
+	defer fake.artifactsMutex.RUnlock()
+	return len(fake.artifactsArgsForCall)
+}
+
+func (fake *FakeBuild) ArtifactsCalls(stub func() ([]db.BuildArtifact, error)) {
+	fake.artifactsMutex.Lock()
+	defer fake.artifactsMutex.Unlock()
+	fake.ArtifactsStub = stub
+}
+
+func (fake *FakeBuild) ArtifactsReturns(result1 []db.BuildArtifact, result2 error) {
+	fake.artifactsMutex.Lock()
+	defer fake.artifactsMutex.Unlock()
+	fake.ArtifactsStub = nil
+	fake.artifactsReturns = struct {
+		result1 []db.BuildArtifact
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ArtifactsReturnsOnCall(i int, result1 []db.BuildArtifact, result2 error) {
+	fake.artifactsMutex.Lock()
+	defer fake.artifactsMutex.Unlock()
+	fake.ArtifactsStub = nil
+	if fake.artifactsReturnsOnCall == nil {
+		fake.artifactsReturnsOnCall = make(map[int]struct {
+			result1 []db.BuildArtifact
+			result2 error
+		})
+	}
+	fake.artifactsReturnsOnCall[i] = struct {
+		result1 []db.BuildArtifact
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) CreateTime() time.Time {
+	fake.createTimeMutex.Lock()
+	ret, specificReturn := fake.createTimeReturnsOnCall[len(fake.createTimeArgsForCall)]
+	fake.createTimeArgsForCall = append(fake.createTimeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CreateTime", []interface{}{})
+	fake.createTimeMutex.Unlock()
+	if fake.CreateTimeStub != nil {
+		return fake.CreateTimeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.createTimeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) CreateTimeCallCount() int {
+	fake.createTimeMutex.RLock()
+	defer fake.createTimeMutex.RUnlock()
+	return len(fake.createTimeArgsForCall)
+}
+
+func (fake *FakeBuild) CreateTimeCalls(stub func() time.Time) {
+	fake.createTimeMutex.Lock()
+	defer fake.createTimeMutex.Unlock()
+	fake.CreateTimeStub = stub
+}
+
+func (fake *FakeBuild) CreateTimeReturns(result1 time.Time) {
+	fake.createTimeMutex.Lock()
+	defer fake.createTimeMutex.Unlock()
+	fake.CreateTimeStub = nil
+	fake.createTimeReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeBuild) CreateTimeReturnsOnCall(i int, result1 time.Time) {
+	fake.createTimeMutex.Lock()
+	defer fake.createTimeMutex.Unlock()
+	fake.CreateTimeStub = nil
+	if fake.createTimeReturnsOnCall == nil {
+		fake.createTimeReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.createTimeReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeBuild) DecideApproval(arg1 atc.PlanID, arg2 atc.BuildApprovalStatus, arg3 string) (bool, error) {
+	fake.decideApprovalMutex.Lock()
+	ret, specificReturn := fake.decideApprovalReturnsOnCall[len(fake.decideApprovalArgsForCall)]
+	fake.decideApprovalArgsForCall = append(fake.decideApprovalArgsForCall, struct {
+		arg1 atc.PlanID
+		arg2 atc.BuildApprovalStatus
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DecideApproval", []interface{}{arg1, arg2, arg3})
+	fake.decideApprovalMutex.Unlock()
+	if fake.DecideApprovalStub != nil {
+		return fake.DecideApprovalStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.decideApprovalReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) DecideApprovalCallCount() int {
+	fake.decideApprovalMutex.RLock()
+	defer fake.decideApprovalMutex.RUnlock()
+	return len(fake.decideApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) DecideApprovalCalls(stub func(atc.PlanID, atc.BuildApprovalStatus, string) (bool, error)) {
+	fake.decideApprovalMutex.Lock()
+	defer fake.decideApprovalMutex.Unlock()
+	fake.DecideApprovalStub = stub
+}
+
+func (fake *FakeBuild) DecideApprovalArgsForCall(i int) (atc.PlanID, atc.BuildApprovalStatus, string) {
+	fake.decideApprovalMutex.RLock()
+	defer fake.decideApprovalMutex.RUnlock()
+	argsForCall := fake.decideApprovalArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBuild) DecideApprovalReturns(result1 bool, result2 error) {
+	fake.decideApprovalMutex.Lock()
+	defer fake.decideApprovalMutex.Unlock()
+	fake.DecideApprovalStub = nil
+	fake.decideApprovalReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) DecideApprovalReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.decideApprovalMutex.Lock()
+	defer fake.decideApprovalMutex.Unlock()
+	fake.DecideApprovalStub = nil
+	if fake.decideApprovalReturnsOnCall == nil {
+		fake.decideApprovalReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.decideApprovalReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) Delete() (bool, error) {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -1048,6 +1696,61 @@ func (fake *FakeBuild) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeBuild) Inputs() ([]db.BuildInput, error) {
+	fake.inputsMutex.Lock()
+	ret, specificReturn := fake.inputsReturnsOnCall[len(fake.inputsArgsForCall)]
+	fake.inputsArgsForCall = append(fake.inputsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Inputs", []interface{}{})
+	fake.inputsMutex.Unlock()
+	if fake.InputsStub != nil {
+		return fake.InputsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.inputsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) InputsCallCount() int {
+	fake.inputsMutex.RLock()
+	defer fake.inputsMutex.RUnlock()
+	return len(fake.inputsArgsForCall)
+}
+
+func (fake *FakeBuild) InputsCalls(stub func() ([]db.BuildInput, error)) {
+	fake.inputsMutex.Lock()
+	defer fake.inputsMutex.Unlock()
+	fake.InputsStub = stub
+}
+
+func (fake *FakeBuild) InputsReturns(result1 []db.BuildInput, result2 error) {
+	fake.inputsMutex.Lock()
+	defer fake.inputsMutex.Unlock()
+	fake.InputsStub = nil
+	fake.inputsReturns = struct {
+		result1 []db.BuildInput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) InputsReturnsOnCall(i int, result1 []db.BuildInput, result2 error) {
+	fake.inputsMutex.Lock()
+	defer fake.inputsMutex.Unlock()
+	fake.InputsStub = nil
+	if fake.inputsReturnsOnCall == nil {
+		fake.inputsReturnsOnCall = make(map[int]struct {
+			result1 []db.BuildInput
+			result2 error
+		})
+	}
+	fake.inputsReturnsOnCall[i] = struct {
+		result1 []db.BuildInput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) Interceptible() (bool, error) {
 	fake.interceptibleMutex.Lock()
 	ret, specificReturn := fake.interceptibleReturnsOnCall[len(fake.interceptibleArgsForCall)]
@@ -1898,6 +2601,230 @@ func (fake *FakeBuild) ReloadReturnsOnCall(i int, result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) RequestApproval(arg1 atc.PlanID, arg2 string, arg3 string) (db.BuildApproval, error) {
+	fake.requestApprovalMutex.Lock()
+	ret, specificReturn := fake.requestApprovalReturnsOnCall[len(fake.requestApprovalArgsForCall)]
+	fake.requestApprovalArgsForCall = append(fake.requestApprovalArgsForCall, struct {
+		arg1 atc.PlanID
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RequestApproval", []interface{}{arg1, arg2, arg3})
+	fake.requestApprovalMutex.Unlock()
+	if fake.RequestApprovalStub != nil {
+		return fake.RequestApprovalStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.requestApprovalReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) RequestApprovalCallCount() int {
+	fake.requestApprovalMutex.RLock()
+	defer fake.requestApprovalMutex.RUnlock()
+	return len(fake.requestApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) RequestApprovalCalls(stub func(atc.PlanID, string, string) (db.BuildApproval, error)) {
+	fake.requestApprovalMutex.Lock()
+	defer fake.requestApprovalMutex.Unlock()
+	fake.RequestApprovalStub = stub
+}
+
+func (fake *FakeBuild) RequestApprovalArgsForCall(i int) (atc.PlanID, string, string) {
+	fake.requestApprovalMutex.RLock()
+	defer fake.requestApprovalMutex.RUnlock()
+	argsForCall := fake.requestApprovalArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBuild) RequestApprovalReturns(result1 db.BuildApproval, result2 error) {
+	fake.requestApprovalMutex.Lock()
+	defer fake.requestApprovalMutex.Unlock()
+	fake.RequestApprovalStub = nil
+	fake.requestApprovalReturns = struct {
+		result1 db.BuildApproval
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) RequestApprovalReturnsOnCall(i int, result1 db.BuildApproval, result2 error) {
+	fake.requestApprovalMutex.Lock()
+	defer fake.requestApprovalMutex.Unlock()
+	fake.RequestApprovalStub = nil
+	if fake.requestApprovalReturnsOnCall == nil {
+		fake.requestApprovalReturnsOnCall = make(map[int]struct {
+			result1 db.BuildApproval
+			result2 error
+		})
+	}
+	fake.requestApprovalReturnsOnCall[i] = struct {
+		result1 db.BuildApproval
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) RerunInputs() ([]db.BuildInput, error) {
+	fake.rerunInputsMutex.Lock()
+	ret, specificReturn := fake.rerunInputsReturnsOnCall[len(fake.rerunInputsArgsForCall)]
+	fake.rerunInputsArgsForCall = append(fake.rerunInputsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RerunInputs", []interface{}{})
+	fake.rerunInputsMutex.Unlock()
+	if fake.RerunInputsStub != nil {
+		return fake.RerunInputsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.rerunInputsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) RerunInputsCallCount() int {
+	fake.rerunInputsMutex.RLock()
+	defer fake.rerunInputsMutex.RUnlock()
+	return len(fake.rerunInputsArgsForCall)
+}
+
+func (fake *FakeBuild) RerunInputsCalls(stub func() ([]db.BuildInput, error)) {
+	fake.rerunInputsMutex.Lock()
+	defer fake.rerunInputsMutex.Unlock()
+	fake.RerunInputsStub = stub
+}
+
+func (fake *FakeBuild) RerunInputsReturns(result1 []db.BuildInput, result2 error) {
+	fake.rerunInputsMutex.Lock()
+	defer fake.rerunInputsMutex.Unlock()
+	fake.RerunInputsStub = nil
+	fake.rerunInputsReturns = struct {
+		result1 []db.BuildInput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) RerunInputsReturnsOnCall(i int, result1 []db.BuildInput, result2 error) {
+	fake.rerunInputsMutex.Lock()
+	defer fake.rerunInputsMutex.Unlock()
+	fake.RerunInputsStub = nil
+	if fake.rerunInputsReturnsOnCall == nil {
+		fake.rerunInputsReturnsOnCall = make(map[int]struct {
+			result1 []db.BuildInput
+			result2 error
+		})
+	}
+	fake.rerunInputsReturnsOnCall[i] = struct {
+		result1 []db.BuildInput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) RerunOf() int {
+	fake.rerunOfMutex.Lock()
+	ret, specificReturn := fake.rerunOfReturnsOnCall[len(fake.rerunOfArgsForCall)]
+	fake.rerunOfArgsForCall = append(fake.rerunOfArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RerunOf", []interface{}{})
+	fake.rerunOfMutex.Unlock()
+	if fake.RerunOfStub != nil {
+		return fake.RerunOfStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.rerunOfReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) RerunOfCallCount() int {
+	fake.rerunOfMutex.RLock()
+	defer fake.rerunOfMutex.RUnlock()
+	return len(fake.rerunOfArgsForCall)
+}
+
+func (fake *FakeBuild) RerunOfCalls(stub func() int) {
+	fake.rerunOfMutex.Lock()
+	defer fake.rerunOfMutex.Unlock()
+	fake.RerunOfStub = stub
+}
+
+func (fake *FakeBuild) RerunOfReturns(result1 int) {
+	fake.rerunOfMutex.Lock()
+	defer fake.rerunOfMutex.Unlock()
+	fake.RerunOfStub = nil
+	fake.rerunOfReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) RerunOfReturnsOnCall(i int, result1 int) {
+	fake.rerunOfMutex.Lock()
+	defer fake.rerunOfMutex.Unlock()
+	fake.RerunOfStub = nil
+	if fake.rerunOfReturnsOnCall == nil {
+		fake.rerunOfReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.rerunOfReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) RerunOfName() string {
+	fake.rerunOfNameMutex.Lock()
+	ret, specificReturn := fake.rerunOfNameReturnsOnCall[len(fake.rerunOfNameArgsForCall)]
+	fake.rerunOfNameArgsForCall = append(fake.rerunOfNameArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RerunOfName", []interface{}{})
+	fake.rerunOfNameMutex.Unlock()
+	if fake.RerunOfNameStub != nil {
+		return fake.RerunOfNameStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.rerunOfNameReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) RerunOfNameCallCount() int {
+	fake.rerunOfNameMutex.RLock()
+	defer fake.rerunOfNameMutex.RUnlock()
+	return len(fake.rerunOfNameArgsForCall)
+}
+
+func (fake *FakeBuild) RerunOfNameCalls(stub func() string) {
+	fake.rerunOfNameMutex.Lock()
+	defer fake.rerunOfNameMutex.Unlock()
+	fake.RerunOfNameStub = stub
+}
+
+func (fake *FakeBuild) RerunOfNameReturns(result1 string) {
+	fake.rerunOfNameMutex.Lock()
+	defer fake.rerunOfNameMutex.Unlock()
+	fake.RerunOfNameStub = nil
+	fake.rerunOfNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) RerunOfNameReturnsOnCall(i int, result1 string) {
+	fake.rerunOfNameMutex.Lock()
+	defer fake.rerunOfNameMutex.Unlock()
+	fake.RerunOfNameStub = nil
+	if fake.rerunOfNameReturnsOnCall == nil {
+		fake.rerunOfNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.rerunOfNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeBuild) Resources() ([]db.BuildInput, []db.BuildOutput, error) {
 	fake.resourcesMutex.Lock()
 	ret, specificReturn := fake.resourcesReturnsOnCall[len(fake.resourcesArgsForCall)]
@@ -1956,6 +2883,67 @@ func (fake *FakeBuild) ResourcesReturnsOnCall(i int, result1 []db.BuildInput, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) SaveArtifact(arg1 string, arg2 io.Reader) error {
+	fake.saveArtifactMutex.Lock()
+	ret, specificReturn := fake.saveArtifactReturnsOnCall[len(fake.saveArtifactArgsForCall)]
+	fake.saveArtifactArgsForCall = append(fake.saveArtifactArgsForCall, struct {
+		arg1 string
+		arg2 io.Reader
+	}{arg1, arg2})
+	fake.recordInvocation("SaveArtifact", []interface{}{arg1, arg2})
+	fake.saveArtifactMutex.Unlock()
+	if fake.SaveArtifactStub != nil {
+		return fake.SaveArtifactStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveArtifactReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SaveArtifactCallCount() int {
+	fake.saveArtifactMutex.RLock()
+	defer fake.saveArtifactMutex.RUnlock()
+	return len(fake.saveArtifactArgsForCall)
+}
+
+func (fake *FakeBuild) SaveArtifactCalls(stub func(string, io.Reader) error) {
+	fake.saveArtifactMutex.Lock()
+	defer fake.saveArtifactMutex.Unlock()
+	fake.SaveArtifactStub = stub
+}
+
+func (fake *FakeBuild) SaveArtifactArgsForCall(i int) (string, io.Reader) {
+	fake.saveArtifactMutex.RLock()
+	defer fake.saveArtifactMutex.RUnlock()
+	argsForCall := fake.saveArtifactArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuild) SaveArtifactReturns(result1 error) {
+	fake.saveArtifactMutex.Lock()
+	defer fake.saveArtifactMutex.Unlock()
+	fake.SaveArtifactStub = nil
+	fake.saveArtifactReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveArtifactReturnsOnCall(i int, result1 error) {
+	fake.saveArtifactMutex.Lock()
+	defer fake.saveArtifactMutex.Unlock()
+	fake.SaveArtifactStub = nil
+	if fake.saveArtifactReturnsOnCall == nil {
+		fake.saveArtifactReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveArtifactReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) SaveEvent(arg1 atc.Event) error {
 	fake.saveEventMutex.Lock()
 	ret, specificReturn := fake.saveEventReturnsOnCall[len(fake.saveEventArgsForCall)]
@@ -2775,6 +3763,22 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.abortNotifierMutex.RUnlock()
 	fake.acquireTrackingLockMutex.RLock()
 	defer fake.acquireTrackingLockMutex.RUnlock()
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
+	fake.approvalNotifierMutex.RLock()
+	defer fake.approvalNotifierMutex.RUnlock()
+	fake.approvalsMutex.RLock()
+	defer fake.approvalsMutex.RUnlock()
+	fake.archiveEventsMutex.RLock()
+	defer fake.archiveEventsMutex.RUnlock()
+	fake.artifactMutex.RLock()
+	defer fake.artifactMutex.RUnlock()
+	fake.artifactsMutex.RLock()
+	defer fake.artifactsMutex.RUnlock()
+	fake.createTimeMutex.RLock()
+	defer fake.createTimeMutex.RUnlock()
+	fake.decideApprovalMutex.RLock()
+	defer fake.decideApprovalMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
 	fake.endTimeMutex.RLock()
@@ -2791,6 +3795,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.finishWithErrorMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.inputsMutex.RLock()
+	defer fake.inputsMutex.RUnlock()
 	fake.interceptibleMutex.RLock()
 	defer fake.interceptibleMutex.RUnlock()
 	fake.isDrainedMutex.RLock()
@@ -2823,8 +3829,18 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.reapTimeMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.requestApprovalMutex.RLock()
+	defer fake.requestApprovalMutex.RUnlock()
+	fake.rerunInputsMutex.RLock()
+	defer fake.rerunInputsMutex.RUnlock()
+	fake.rerunOfMutex.RLock()
+	defer fake.rerunOfMutex.RUnlock()
+	fake.rerunOfNameMutex.RLock()
+	defer fake.rerunOfNameMutex.RUnlock()
 	fake.resourcesMutex.RLock()
 	defer fake.resourcesMutex.RUnlock()
+	fake.saveArtifactMutex.RLock()
+	defer fake.saveArtifactMutex.RUnlock()
 	fake.saveEventMutex.RLock()
 	defer fake.saveEventMutex.RUnlock()
 	fake.saveImageResourceVersionMutex.RLock()
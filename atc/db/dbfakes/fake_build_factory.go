@@ -23,6 +23,17 @@ type FakeBuildFactory struct {
 		result2 bool
 		result3 error
 	}
+	DeleteEventArchivesStub        func([]int) error
+	deleteEventArchivesMutex       sync.RWMutex
+	deleteEventArchivesArgsForCall []struct {
+		arg1 []int
+	}
+	deleteEventArchivesReturns struct {
+		result1 error
+	}
+	deleteEventArchivesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetAllStartedBuildsStub        func() ([]db.Build, error)
 	getAllStartedBuildsMutex       sync.RWMutex
 	getAllStartedBuildsArgsForCall []struct {
@@ -35,6 +46,19 @@ type FakeBuildFactory struct {
 		result1 []db.Build
 		result2 error
 	}
+	GetArchivableBuildsStub        func(int) ([]db.Build, error)
+	getArchivableBuildsMutex       sync.RWMutex
+	getArchivableBuildsArgsForCall []struct {
+		arg1 int
+	}
+	getArchivableBuildsReturns struct {
+		result1 []db.Build
+		result2 error
+	}
+	getArchivableBuildsReturnsOnCall map[int]struct {
+		result1 []db.Build
+		result2 error
+	}
 	GetDrainableBuildsStub        func() ([]db.Build, error)
 	getDrainableBuildsMutex       sync.RWMutex
 	getDrainableBuildsArgsForCall []struct {
@@ -57,6 +81,18 @@ type FakeBuildFactory struct {
 	markNonInterceptibleBuildsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	OrphanedEventArchivesStub        func() ([]int, error)
+	orphanedEventArchivesMutex       sync.RWMutex
+	orphanedEventArchivesArgsForCall []struct {
+	}
+	orphanedEventArchivesReturns struct {
+		result1 []int
+		result2 error
+	}
+	orphanedEventArchivesReturnsOnCall map[int]struct {
+		result1 []int
+		result2 error
+	}
 	PublicBuildsStub        func(db.Page) ([]db.Build, db.Pagination, error)
 	publicBuildsMutex       sync.RWMutex
 	publicBuildsArgsForCall []struct {
@@ -174,6 +210,71 @@ func (fake *FakeBuildFactory) BuildReturnsOnCall(i int, result1 db.Build, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuildFactory) DeleteEventArchives(arg1 []int) error {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.deleteEventArchivesMutex.Lock()
+	ret, specificReturn := fake.deleteEventArchivesReturnsOnCall[len(fake.deleteEventArchivesArgsForCall)]
+	fake.deleteEventArchivesArgsForCall = append(fake.deleteEventArchivesArgsForCall, struct {
+		arg1 []int
+	}{arg1Copy})
+	fake.recordInvocation("DeleteEventArchives", []interface{}{arg1Copy})
+	fake.deleteEventArchivesMutex.Unlock()
+	if fake.DeleteEventArchivesStub != nil {
+		return fake.DeleteEventArchivesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deleteEventArchivesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildFactory) DeleteEventArchivesCallCount() int {
+	fake.deleteEventArchivesMutex.RLock()
+	defer fake.deleteEventArchivesMutex.RUnlock()
+	return len(fake.deleteEventArchivesArgsForCall)
+}
+
+func (fake *FakeBuildFactory) DeleteEventArchivesCalls(stub func([]int) error) {
+	fake.deleteEventArchivesMutex.Lock()
+	defer fake.deleteEventArchivesMutex.Unlock()
+	fake.DeleteEventArchivesStub = stub
+}
+
+func (fake *FakeBuildFactory) DeleteEventArchivesArgsForCall(i int) []int {
+	fake.deleteEventArchivesMutex.RLock()
+	defer fake.deleteEventArchivesMutex.RUnlock()
+	argsForCall := fake.deleteEventArchivesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildFactory) DeleteEventArchivesReturns(result1 error) {
+	fake.deleteEventArchivesMutex.Lock()
+	defer fake.deleteEventArchivesMutex.Unlock()
+	fake.DeleteEventArchivesStub = nil
+	fake.deleteEventArchivesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuildFactory) DeleteEventArchivesReturnsOnCall(i int, result1 error) {
+	fake.deleteEventArchivesMutex.Lock()
+	defer fake.deleteEventArchivesMutex.Unlock()
+	fake.DeleteEventArchivesStub = nil
+	if fake.deleteEventArchivesReturnsOnCall == nil {
+		fake.deleteEventArchivesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteEventArchivesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuildFactory) GetAllStartedBuilds() ([]db.Build, error) {
 	fake.getAllStartedBuildsMutex.Lock()
 	ret, specificReturn := fake.getAllStartedBuildsReturnsOnCall[len(fake.getAllStartedBuildsArgsForCall)]
@@ -229,6 +330,69 @@ func (fake *FakeBuildFactory) GetAllStartedBuildsReturnsOnCall(i int, result1 []
 	}{result1, result2}
 }
 
+func (fake *FakeBuildFactory) GetArchivableBuilds(arg1 int) ([]db.Build, error) {
+	fake.getArchivableBuildsMutex.Lock()
+	ret, specificReturn := fake.getArchivableBuildsReturnsOnCall[len(fake.getArchivableBuildsArgsForCall)]
+	fake.getArchivableBuildsArgsForCall = append(fake.getArchivableBuildsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("GetArchivableBuilds", []interface{}{arg1})
+	fake.getArchivableBuildsMutex.Unlock()
+	if fake.GetArchivableBuildsStub != nil {
+		return fake.GetArchivableBuildsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getArchivableBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildFactory) GetArchivableBuildsCallCount() int {
+	fake.getArchivableBuildsMutex.RLock()
+	defer fake.getArchivableBuildsMutex.RUnlock()
+	return len(fake.getArchivableBuildsArgsForCall)
+}
+
+func (fake *FakeBuildFactory) GetArchivableBuildsCalls(stub func(int) ([]db.Build, error)) {
+	fake.getArchivableBuildsMutex.Lock()
+	defer fake.getArchivableBuildsMutex.Unlock()
+	fake.GetArchivableBuildsStub = stub
+}
+
+func (fake *FakeBuildFactory) GetArchivableBuildsArgsForCall(i int) int {
+	fake.getArchivableBuildsMutex.RLock()
+	defer fake.getArchivableBuildsMutex.RUnlock()
+	argsForCall := fake.getArchivableBuildsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildFactory) GetArchivableBuildsReturns(result1 []db.Build, result2 error) {
+	fake.getArchivableBuildsMutex.Lock()
+	defer fake.getArchivableBuildsMutex.Unlock()
+	fake.GetArchivableBuildsStub = nil
+	fake.getArchivableBuildsReturns = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildFactory) GetArchivableBuildsReturnsOnCall(i int, result1 []db.Build, result2 error) {
+	fake.getArchivableBuildsMutex.Lock()
+	defer fake.getArchivableBuildsMutex.Unlock()
+	fake.GetArchivableBuildsStub = nil
+	if fake.getArchivableBuildsReturnsOnCall == nil {
+		fake.getArchivableBuildsReturnsOnCall = make(map[int]struct {
+			result1 []db.Build
+			result2 error
+		})
+	}
+	fake.getArchivableBuildsReturnsOnCall[i] = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuildFactory) GetDrainableBuilds() ([]db.Build, error) {
 	fake.getDrainableBuildsMutex.Lock()
 	ret, specificReturn := fake.getDrainableBuildsReturnsOnCall[len(fake.getDrainableBuildsArgsForCall)]
@@ -336,6 +500,61 @@ func (fake *FakeBuildFactory) MarkNonInterceptibleBuildsReturnsOnCall(i int, res
 	}{result1}
 }
 
+func (fake *FakeBuildFactory) OrphanedEventArchives() ([]int, error) {
+	fake.orphanedEventArchivesMutex.Lock()
+	ret, specificReturn := fake.orphanedEventArchivesReturnsOnCall[len(fake.orphanedEventArchivesArgsForCall)]
+	fake.orphanedEventArchivesArgsForCall = append(fake.orphanedEventArchivesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("OrphanedEventArchives", []interface{}{})
+	fake.orphanedEventArchivesMutex.Unlock()
+	if fake.OrphanedEventArchivesStub != nil {
+		return fake.OrphanedEventArchivesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.orphanedEventArchivesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildFactory) OrphanedEventArchivesCallCount() int {
+	fake.orphanedEventArchivesMutex.RLock()
+	defer fake.orphanedEventArchivesMutex.RUnlock()
+	return len(fake.orphanedEventArchivesArgsForCall)
+}
+
+func (fake *FakeBuildFactory) OrphanedEventArchivesCalls(stub func() ([]int, error)) {
+	fake.orphanedEventArchivesMutex.Lock()
+	defer fake.orphanedEventArchivesMutex.Unlock()
+	fake.OrphanedEventArchivesStub = stub
+}
+
+func (fake *FakeBuildFactory) OrphanedEventArchivesReturns(result1 []int, result2 error) {
+	fake.orphanedEventArchivesMutex.Lock()
+	defer fake.orphanedEventArchivesMutex.Unlock()
+	fake.OrphanedEventArchivesStub = nil
+	fake.orphanedEventArchivesReturns = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildFactory) OrphanedEventArchivesReturnsOnCall(i int, result1 []int, result2 error) {
+	fake.orphanedEventArchivesMutex.Lock()
+	defer fake.orphanedEventArchivesMutex.Unlock()
+	fake.OrphanedEventArchivesStub = nil
+	if fake.orphanedEventArchivesReturnsOnCall == nil {
+		fake.orphanedEventArchivesReturnsOnCall = make(map[int]struct {
+			result1 []int
+			result2 error
+		})
+	}
+	fake.orphanedEventArchivesReturnsOnCall[i] = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuildFactory) PublicBuilds(arg1 db.Page) ([]db.Build, db.Pagination, error) {
 	fake.publicBuildsMutex.Lock()
 	ret, specificReturn := fake.publicBuildsReturnsOnCall[len(fake.publicBuildsArgsForCall)]
@@ -551,12 +770,18 @@ func (fake *FakeBuildFactory) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.buildMutex.RLock()
 	defer fake.buildMutex.RUnlock()
+	fake.deleteEventArchivesMutex.RLock()
+	defer fake.deleteEventArchivesMutex.RUnlock()
 	fake.getAllStartedBuildsMutex.RLock()
 	defer fake.getAllStartedBuildsMutex.RUnlock()
+	fake.getArchivableBuildsMutex.RLock()
+	defer fake.getArchivableBuildsMutex.RUnlock()
 	fake.getDrainableBuildsMutex.RLock()
 	defer fake.getDrainableBuildsMutex.RUnlock()
 	fake.markNonInterceptibleBuildsMutex.RLock()
 	defer fake.markNonInterceptibleBuildsMutex.RUnlock()
+	fake.orphanedEventArchivesMutex.RLock()
+	defer fake.orphanedEventArchivesMutex.RUnlock()
 	fake.publicBuildsMutex.RLock()
 	defer fake.publicBuildsMutex.RUnlock()
 	fake.visibleBuildsMutex.RLock()
@@ -0,0 +1,269 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	sync "sync"
+	time "time"
+
+	db "github.com/concourse/concourse/atc/db"
+)
+
+type FakeBuildQueue struct {
+	ActiveBuildsByTeamStub        func() (map[string]int, error)
+	activeBuildsByTeamMutex       sync.RWMutex
+	activeBuildsByTeamArgsForCall []struct {
+	}
+	activeBuildsByTeamReturns struct {
+		result1 map[string]int
+		result2 error
+	}
+	activeBuildsByTeamReturnsOnCall map[int]struct {
+		result1 map[string]int
+		result2 error
+	}
+	AdmitStub        func(int, int, time.Duration, db.AdmissionPolicy) (bool, error)
+	admitMutex       sync.RWMutex
+	admitArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 time.Duration
+		arg4 db.AdmissionPolicy
+	}
+	admitReturns struct {
+		result1 bool
+		result2 error
+	}
+	admitReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	QueuedBuildsStub        func(time.Duration) ([]db.QueuedBuild, error)
+	queuedBuildsMutex       sync.RWMutex
+	queuedBuildsArgsForCall []struct {
+		arg1 time.Duration
+	}
+	queuedBuildsReturns struct {
+		result1 []db.QueuedBuild
+		result2 error
+	}
+	queuedBuildsReturnsOnCall map[int]struct {
+		result1 []db.QueuedBuild
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeBuildQueue) ActiveBuildsByTeam() (map[string]int, error) {
+	fake.activeBuildsByTeamMutex.Lock()
+	ret, specificReturn := fake.activeBuildsByTeamReturnsOnCall[len(fake.activeBuildsByTeamArgsForCall)]
+	fake.activeBuildsByTeamArgsForCall = append(fake.activeBuildsByTeamArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ActiveBuildsByTeam", []interface{}{})
+	fake.activeBuildsByTeamMutex.Unlock()
+	if fake.ActiveBuildsByTeamStub != nil {
+		return fake.ActiveBuildsByTeamStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.activeBuildsByTeamReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildQueue) ActiveBuildsByTeamCallCount() int {
+	fake.activeBuildsByTeamMutex.RLock()
+	defer fake.activeBuildsByTeamMutex.RUnlock()
+	return len(fake.activeBuildsByTeamArgsForCall)
+}
+
+func (fake *FakeBuildQueue) ActiveBuildsByTeamCalls(stub func() (map[string]int, error)) {
+	fake.activeBuildsByTeamMutex.Lock()
+	defer fake.activeBuildsByTeamMutex.Unlock()
+	fake.ActiveBuildsByTeamStub = stub
+}
+
+func (fake *FakeBuildQueue) ActiveBuildsByTeamReturns(result1 map[string]int, result2 error) {
+	fake.activeBuildsByTeamMutex.Lock()
+	defer fake.activeBuildsByTeamMutex.Unlock()
+	fake.ActiveBuildsByTeamStub = nil
+	fake.activeBuildsByTeamReturns = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildQueue) ActiveBuildsByTeamReturnsOnCall(i int, result1 map[string]int, result2 error) {
+	fake.activeBuildsByTeamMutex.Lock()
+	defer fake.activeBuildsByTeamMutex.Unlock()
+	fake.ActiveBuildsByTeamStub = nil
+	if fake.activeBuildsByTeamReturnsOnCall == nil {
+		fake.activeBuildsByTeamReturnsOnCall = make(map[int]struct {
+			result1 map[string]int
+			result2 error
+		})
+	}
+	fake.activeBuildsByTeamReturnsOnCall[i] = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildQueue) Admit(arg1 int, arg2 int, arg3 time.Duration, arg4 db.AdmissionPolicy) (bool, error) {
+	fake.admitMutex.Lock()
+	ret, specificReturn := fake.admitReturnsOnCall[len(fake.admitArgsForCall)]
+	fake.admitArgsForCall = append(fake.admitArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 time.Duration
+		arg4 db.AdmissionPolicy
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("Admit", []interface{}{arg1, arg2, arg3, arg4})
+	fake.admitMutex.Unlock()
+	if fake.AdmitStub != nil {
+		return fake.AdmitStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.admitReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildQueue) AdmitCallCount() int {
+	fake.admitMutex.RLock()
+	defer fake.admitMutex.RUnlock()
+	return len(fake.admitArgsForCall)
+}
+
+func (fake *FakeBuildQueue) AdmitCalls(stub func(int, int, time.Duration, db.AdmissionPolicy) (bool, error)) {
+	fake.admitMutex.Lock()
+	defer fake.admitMutex.Unlock()
+	fake.AdmitStub = stub
+}
+
+func (fake *FakeBuildQueue) AdmitArgsForCall(i int) (int, int, time.Duration, db.AdmissionPolicy) {
+	fake.admitMutex.RLock()
+	defer fake.admitMutex.RUnlock()
+	argsForCall := fake.admitArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeBuildQueue) AdmitReturns(result1 bool, result2 error) {
+	fake.admitMutex.Lock()
+	defer fake.admitMutex.Unlock()
+	fake.AdmitStub = nil
+	fake.admitReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildQueue) AdmitReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.admitMutex.Lock()
+	defer fake.admitMutex.Unlock()
+	fake.AdmitStub = nil
+	if fake.admitReturnsOnCall == nil {
+		fake.admitReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.admitReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildQueue) QueuedBuilds(arg1 time.Duration) ([]db.QueuedBuild, error) {
+	fake.queuedBuildsMutex.Lock()
+	ret, specificReturn := fake.queuedBuildsReturnsOnCall[len(fake.queuedBuildsArgsForCall)]
+	fake.queuedBuildsArgsForCall = append(fake.queuedBuildsArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	fake.recordInvocation("QueuedBuilds", []interface{}{arg1})
+	fake.queuedBuildsMutex.Unlock()
+	if fake.QueuedBuildsStub != nil {
+		return fake.QueuedBuildsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.queuedBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildQueue) QueuedBuildsCallCount() int {
+	fake.queuedBuildsMutex.RLock()
+	defer fake.queuedBuildsMutex.RUnlock()
+	return len(fake.queuedBuildsArgsForCall)
+}
+
+func (fake *FakeBuildQueue) QueuedBuildsCalls(stub func(time.Duration) ([]db.QueuedBuild, error)) {
+	fake.queuedBuildsMutex.Lock()
+	defer fake.queuedBuildsMutex.Unlock()
+	fake.QueuedBuildsStub = stub
+}
+
+func (fake *FakeBuildQueue) QueuedBuildsArgsForCall(i int) time.Duration {
+	fake.queuedBuildsMutex.RLock()
+	defer fake.queuedBuildsMutex.RUnlock()
+	argsForCall := fake.queuedBuildsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildQueue) QueuedBuildsReturns(result1 []db.QueuedBuild, result2 error) {
+	fake.queuedBuildsMutex.Lock()
+	defer fake.queuedBuildsMutex.Unlock()
+	fake.QueuedBuildsStub = nil
+	fake.queuedBuildsReturns = struct {
+		result1 []db.QueuedBuild
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildQueue) QueuedBuildsReturnsOnCall(i int, result1 []db.QueuedBuild, result2 error) {
+	fake.queuedBuildsMutex.Lock()
+	defer fake.queuedBuildsMutex.Unlock()
+	fake.QueuedBuildsStub = nil
+	if fake.queuedBuildsReturnsOnCall == nil {
+		fake.queuedBuildsReturnsOnCall = make(map[int]struct {
+			result1 []db.QueuedBuild
+			result2 error
+		})
+	}
+	fake.queuedBuildsReturnsOnCall[i] = struct {
+		result1 []db.QueuedBuild
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildQueue) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.activeBuildsByTeamMutex.RLock()
+	defer fake.activeBuildsByTeamMutex.RUnlock()
+	fake.admitMutex.RLock()
+	defer fake.admitMutex.RUnlock()
+	fake.queuedBuildsMutex.RLock()
+	defer fake.queuedBuildsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeBuildQueue) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.BuildQueue = new(FakeBuildQueue)
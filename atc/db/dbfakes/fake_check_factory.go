@@ -0,0 +1,503 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	sync "sync"
+	time "time"
+
+	atc "github.com/concourse/concourse/atc"
+	db "github.com/concourse/concourse/atc/db"
+)
+
+type FakeCheckFactory struct {
+	AcquireCheckStub        func(time.Duration, int) (db.Check, bool, error)
+	acquireCheckMutex       sync.RWMutex
+	acquireCheckArgsForCall []struct {
+		arg1 time.Duration
+		arg2 int
+	}
+	acquireCheckReturns struct {
+		result1 db.Check
+		result2 bool
+		result3 error
+	}
+	acquireCheckReturnsOnCall map[int]struct {
+		result1 db.Check
+		result2 bool
+		result3 error
+	}
+	CleanupChecksStub        func(time.Duration) error
+	cleanupChecksMutex       sync.RWMutex
+	cleanupChecksArgsForCall []struct {
+		arg1 time.Duration
+	}
+	cleanupChecksReturns struct {
+		result1 error
+	}
+	cleanupChecksReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateResourceCheckStub        func(int, atc.Version, bool) (bool, error)
+	createResourceCheckMutex       sync.RWMutex
+	createResourceCheckArgsForCall []struct {
+		arg1 int
+		arg2 atc.Version
+		arg3 bool
+	}
+	createResourceCheckReturns struct {
+		result1 bool
+		result2 error
+	}
+	createResourceCheckReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	CreateResourceTypeCheckStub        func(int, atc.Version, bool) (bool, error)
+	createResourceTypeCheckMutex       sync.RWMutex
+	createResourceTypeCheckArgsForCall []struct {
+		arg1 int
+		arg2 atc.Version
+		arg3 bool
+	}
+	createResourceTypeCheckReturns struct {
+		result1 bool
+		result2 error
+	}
+	createResourceTypeCheckReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	ErrorStaleChecksStub        func(time.Duration) error
+	errorStaleChecksMutex       sync.RWMutex
+	errorStaleChecksArgsForCall []struct {
+		arg1 time.Duration
+	}
+	errorStaleChecksReturns struct {
+		result1 error
+	}
+	errorStaleChecksReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PendingChecksCountStub        func() (int, error)
+	pendingChecksCountMutex       sync.RWMutex
+	pendingChecksCountArgsForCall []struct {
+	}
+	pendingChecksCountReturns struct {
+		result1 int
+		result2 error
+	}
+	pendingChecksCountReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCheckFactory) AcquireCheck(arg1 time.Duration, arg2 int) (db.Check, bool, error) {
+	fake.acquireCheckMutex.Lock()
+	ret, specificReturn := fake.acquireCheckReturnsOnCall[len(fake.acquireCheckArgsForCall)]
+	fake.acquireCheckArgsForCall = append(fake.acquireCheckArgsForCall, struct {
+		arg1 time.Duration
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("AcquireCheck", []interface{}{arg1, arg2})
+	fake.acquireCheckMutex.Unlock()
+	if fake.AcquireCheckStub != nil {
+		return fake.AcquireCheckStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.acquireCheckReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCheckFactory) AcquireCheckCallCount() int {
+	fake.acquireCheckMutex.RLock()
+	defer fake.acquireCheckMutex.RUnlock()
+	return len(fake.acquireCheckArgsForCall)
+}
+
+func (fake *FakeCheckFactory) AcquireCheckCalls(stub func(time.Duration, int) (db.Check, bool, error)) {
+	fake.acquireCheckMutex.Lock()
+	defer fake.acquireCheckMutex.Unlock()
+	fake.AcquireCheckStub = stub
+}
+
+func (fake *FakeCheckFactory) AcquireCheckArgsForCall(i int) (time.Duration, int) {
+	fake.acquireCheckMutex.RLock()
+	defer fake.acquireCheckMutex.RUnlock()
+	argsForCall := fake.acquireCheckArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCheckFactory) AcquireCheckReturns(result1 db.Check, result2 bool, result3 error) {
+	fake.acquireCheckMutex.Lock()
+	defer fake.acquireCheckMutex.Unlock()
+	fake.AcquireCheckStub = nil
+	fake.acquireCheckReturns = struct {
+		result1 db.Check
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCheckFactory) AcquireCheckReturnsOnCall(i int, result1 db.Check, result2 bool, result3 error) {
+	fake.acquireCheckMutex.Lock()
+	defer fake.acquireCheckMutex.Unlock()
+	fake.AcquireCheckStub = nil
+	if fake.acquireCheckReturnsOnCall == nil {
+		fake.acquireCheckReturnsOnCall = make(map[int]struct {
+			result1 db.Check
+			result2 bool
+			result3 error
+		})
+	}
+	fake.acquireCheckReturnsOnCall[i] = struct {
+		result1 db.Check
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCheckFactory) CleanupChecks(arg1 time.Duration) error {
+	fake.cleanupChecksMutex.Lock()
+	ret, specificReturn := fake.cleanupChecksReturnsOnCall[len(fake.cleanupChecksArgsForCall)]
+	fake.cleanupChecksArgsForCall = append(fake.cleanupChecksArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	fake.recordInvocation("CleanupChecks", []interface{}{arg1})
+	fake.cleanupChecksMutex.Unlock()
+	if fake.CleanupChecksStub != nil {
+		return fake.CleanupChecksStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cleanupChecksReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheckFactory) CleanupChecksCallCount() int {
+	fake.cleanupChecksMutex.RLock()
+	defer fake.cleanupChecksMutex.RUnlock()
+	return len(fake.cleanupChecksArgsForCall)
+}
+
+func (fake *FakeCheckFactory) CleanupChecksCalls(stub func(time.Duration) error) {
+	fake.cleanupChecksMutex.Lock()
+	defer fake.cleanupChecksMutex.Unlock()
+	fake.CleanupChecksStub = stub
+}
+
+func (fake *FakeCheckFactory) CleanupChecksArgsForCall(i int) time.Duration {
+	fake.cleanupChecksMutex.RLock()
+	defer fake.cleanupChecksMutex.RUnlock()
+	argsForCall := fake.cleanupChecksArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCheckFactory) CleanupChecksReturns(result1 error) {
+	fake.cleanupChecksMutex.Lock()
+	defer fake.cleanupChecksMutex.Unlock()
+	fake.CleanupChecksStub = nil
+	fake.cleanupChecksReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCheckFactory) CleanupChecksReturnsOnCall(i int, result1 error) {
+	fake.cleanupChecksMutex.Lock()
+	defer fake.cleanupChecksMutex.Unlock()
+	fake.CleanupChecksStub = nil
+	if fake.cleanupChecksReturnsOnCall == nil {
+		fake.cleanupChecksReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cleanupChecksReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCheckFactory) CreateResourceCheck(arg1 int, arg2 atc.Version, arg3 bool) (bool, error) {
+	fake.createResourceCheckMutex.Lock()
+	ret, specificReturn := fake.createResourceCheckReturnsOnCall[len(fake.createResourceCheckArgsForCall)]
+	fake.createResourceCheckArgsForCall = append(fake.createResourceCheckArgsForCall, struct {
+		arg1 int
+		arg2 atc.Version
+		arg3 bool
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CreateResourceCheck", []interface{}{arg1, arg2, arg3})
+	fake.createResourceCheckMutex.Unlock()
+	if fake.CreateResourceCheckStub != nil {
+		return fake.CreateResourceCheckStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createResourceCheckReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCheckFactory) CreateResourceCheckCallCount() int {
+	fake.createResourceCheckMutex.RLock()
+	defer fake.createResourceCheckMutex.RUnlock()
+	return len(fake.createResourceCheckArgsForCall)
+}
+
+func (fake *FakeCheckFactory) CreateResourceCheckCalls(stub func(int, atc.Version, bool) (bool, error)) {
+	fake.createResourceCheckMutex.Lock()
+	defer fake.createResourceCheckMutex.Unlock()
+	fake.CreateResourceCheckStub = stub
+}
+
+func (fake *FakeCheckFactory) CreateResourceCheckArgsForCall(i int) (int, atc.Version, bool) {
+	fake.createResourceCheckMutex.RLock()
+	defer fake.createResourceCheckMutex.RUnlock()
+	argsForCall := fake.createResourceCheckArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCheckFactory) CreateResourceCheckReturns(result1 bool, result2 error) {
+	fake.createResourceCheckMutex.Lock()
+	defer fake.createResourceCheckMutex.Unlock()
+	fake.CreateResourceCheckStub = nil
+	fake.createResourceCheckReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCheckFactory) CreateResourceCheckReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.createResourceCheckMutex.Lock()
+	defer fake.createResourceCheckMutex.Unlock()
+	fake.CreateResourceCheckStub = nil
+	if fake.createResourceCheckReturnsOnCall == nil {
+		fake.createResourceCheckReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.createResourceCheckReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCheckFactory) CreateResourceTypeCheck(arg1 int, arg2 atc.Version, arg3 bool) (bool, error) {
+	fake.createResourceTypeCheckMutex.Lock()
+	ret, specificReturn := fake.createResourceTypeCheckReturnsOnCall[len(fake.createResourceTypeCheckArgsForCall)]
+	fake.createResourceTypeCheckArgsForCall = append(fake.createResourceTypeCheckArgsForCall, struct {
+		arg1 int
+		arg2 atc.Version
+		arg3 bool
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CreateResourceTypeCheck", []interface{}{arg1, arg2, arg3})
+	fake.createResourceTypeCheckMutex.Unlock()
+	if fake.CreateResourceTypeCheckStub != nil {
+		return fake.CreateResourceTypeCheckStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createResourceTypeCheckReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCheckFactory) CreateResourceTypeCheckCallCount() int {
+	fake.createResourceTypeCheckMutex.RLock()
+	defer fake.createResourceTypeCheckMutex.RUnlock()
+	return len(fake.createResourceTypeCheckArgsForCall)
+}
+
+func (fake *FakeCheckFactory) CreateResourceTypeCheckCalls(stub func(int, atc.Version, bool) (bool, error)) {
+	fake.createResourceTypeCheckMutex.Lock()
+	defer fake.createResourceTypeCheckMutex.Unlock()
+	fake.CreateResourceTypeCheckStub = stub
+}
+
+func (fake *FakeCheckFactory) CreateResourceTypeCheckArgsForCall(i int) (int, atc.Version, bool) {
+	fake.createResourceTypeCheckMutex.RLock()
+	defer fake.createResourceTypeCheckMutex.RUnlock()
+	argsForCall := fake.createResourceTypeCheckArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCheckFactory) CreateResourceTypeCheckReturns(result1 bool, result2 error) {
+	fake.createResourceTypeCheckMutex.Lock()
+	defer fake.createResourceTypeCheckMutex.Unlock()
+	fake.CreateResourceTypeCheckStub = nil
+	fake.createResourceTypeCheckReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCheckFactory) CreateResourceTypeCheckReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.createResourceTypeCheckMutex.Lock()
+	defer fake.createResourceTypeCheckMutex.Unlock()
+	fake.CreateResourceTypeCheckStub = nil
+	if fake.createResourceTypeCheckReturnsOnCall == nil {
+		fake.createResourceTypeCheckReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.createResourceTypeCheckReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCheckFactory) ErrorStaleChecks(arg1 time.Duration) error {
+	fake.errorStaleChecksMutex.Lock()
+	ret, specificReturn := fake.errorStaleChecksReturnsOnCall[len(fake.errorStaleChecksArgsForCall)]
+	fake.errorStaleChecksArgsForCall = append(fake.errorStaleChecksArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	fake.recordInvocation("ErrorStaleChecks", []interface{}{arg1})
+	fake.errorStaleChecksMutex.Unlock()
+	if fake.ErrorStaleChecksStub != nil {
+		return fake.ErrorStaleChecksStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.errorStaleChecksReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheckFactory) ErrorStaleChecksCallCount() int {
+	fake.errorStaleChecksMutex.RLock()
+	defer fake.errorStaleChecksMutex.RUnlock()
+	return len(fake.errorStaleChecksArgsForCall)
+}
+
+func (fake *FakeCheckFactory) ErrorStaleChecksCalls(stub func(time.Duration) error) {
+	fake.errorStaleChecksMutex.Lock()
+	defer fake.errorStaleChecksMutex.Unlock()
+	fake.ErrorStaleChecksStub = stub
+}
+
+func (fake *FakeCheckFactory) ErrorStaleChecksArgsForCall(i int) time.Duration {
+	fake.errorStaleChecksMutex.RLock()
+	defer fake.errorStaleChecksMutex.RUnlock()
+	argsForCall := fake.errorStaleChecksArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCheckFactory) ErrorStaleChecksReturns(result1 error) {
+	fake.errorStaleChecksMutex.Lock()
+	defer fake.errorStaleChecksMutex.Unlock()
+	fake.ErrorStaleChecksStub = nil
+	fake.errorStaleChecksReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCheckFactory) ErrorStaleChecksReturnsOnCall(i int, result1 error) {
+	fake.errorStaleChecksMutex.Lock()
+	defer fake.errorStaleChecksMutex.Unlock()
+	fake.ErrorStaleChecksStub = nil
+	if fake.errorStaleChecksReturnsOnCall == nil {
+		fake.errorStaleChecksReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.errorStaleChecksReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCheckFactory) PendingChecksCount() (int, error) {
+	fake.pendingChecksCountMutex.Lock()
+	ret, specificReturn := fake.pendingChecksCountReturnsOnCall[len(fake.pendingChecksCountArgsForCall)]
+	fake.pendingChecksCountArgsForCall = append(fake.pendingChecksCountArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PendingChecksCount", []interface{}{})
+	fake.pendingChecksCountMutex.Unlock()
+	if fake.PendingChecksCountStub != nil {
+		return fake.PendingChecksCountStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pendingChecksCountReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCheckFactory) PendingChecksCountCallCount() int {
+	fake.pendingChecksCountMutex.RLock()
+	defer fake.pendingChecksCountMutex.RUnlock()
+	return len(fake.pendingChecksCountArgsForCall)
+}
+
+func (fake *FakeCheckFactory) PendingChecksCountCalls(stub func() (int, error)) {
+	fake.pendingChecksCountMutex.Lock()
+	defer fake.pendingChecksCountMutex.Unlock()
+	fake.PendingChecksCountStub = stub
+}
+
+func (fake *FakeCheckFactory) PendingChecksCountReturns(result1 int, result2 error) {
+	fake.pendingChecksCountMutex.Lock()
+	defer fake.pendingChecksCountMutex.Unlock()
+	fake.PendingChecksCountStub = nil
+	fake.pendingChecksCountReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCheckFactory) PendingChecksCountReturnsOnCall(i int, result1 int, result2 error) {
+	fake.pendingChecksCountMutex.Lock()
+	defer fake.pendingChecksCountMutex.Unlock()
+	fake.PendingChecksCountStub = nil
+	if fake.pendingChecksCountReturnsOnCall == nil {
+		fake.pendingChecksCountReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.pendingChecksCountReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCheckFactory) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.acquireCheckMutex.RLock()
+	defer fake.acquireCheckMutex.RUnlock()
+	fake.cleanupChecksMutex.RLock()
+	defer fake.cleanupChecksMutex.RUnlock()
+	fake.createResourceCheckMutex.RLock()
+	defer fake.createResourceCheckMutex.RUnlock()
+	fake.createResourceTypeCheckMutex.RLock()
+	defer fake.createResourceTypeCheckMutex.RUnlock()
+	fake.errorStaleChecksMutex.RLock()
+	defer fake.errorStaleChecksMutex.RUnlock()
+	fake.pendingChecksCountMutex.RLock()
+	defer fake.pendingChecksCountMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCheckFactory) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.CheckFactory = new(FakeCheckFactory)
@@ -9,6 +9,18 @@ import (
 )
 
 type FakeContainerRepository struct {
+	CountContainersByTeamStub        func() (map[string]int, error)
+	countContainersByTeamMutex       sync.RWMutex
+	countContainersByTeamArgsForCall []struct {
+	}
+	countContainersByTeamReturns struct {
+		result1 map[string]int
+		result2 error
+	}
+	countContainersByTeamReturnsOnCall map[int]struct {
+		result1 map[string]int
+		result2 error
+	}
 	DestroyFailedContainersStub        func() (int, error)
 	destroyFailedContainersMutex       sync.RWMutex
 	destroyFailedContainersArgsForCall []struct {
@@ -93,6 +105,61 @@ type FakeContainerRepository struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeContainerRepository) CountContainersByTeam() (map[string]int, error) {
+	fake.countContainersByTeamMutex.Lock()
+	ret, specificReturn := fake.countContainersByTeamReturnsOnCall[len(fake.countContainersByTeamArgsForCall)]
+	fake.countContainersByTeamArgsForCall = append(fake.countContainersByTeamArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CountContainersByTeam", []interface{}{})
+	fake.countContainersByTeamMutex.Unlock()
+	if fake.CountContainersByTeamStub != nil {
+		return fake.CountContainersByTeamStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.countContainersByTeamReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContainerRepository) CountContainersByTeamCallCount() int {
+	fake.countContainersByTeamMutex.RLock()
+	defer fake.countContainersByTeamMutex.RUnlock()
+	return len(fake.countContainersByTeamArgsForCall)
+}
+
+func (fake *FakeContainerRepository) CountContainersByTeamCalls(stub func() (map[string]int, error)) {
+	fake.countContainersByTeamMutex.Lock()
+	defer fake.countContainersByTeamMutex.Unlock()
+	fake.CountContainersByTeamStub = stub
+}
+
+func (fake *FakeContainerRepository) CountContainersByTeamReturns(result1 map[string]int, result2 error) {
+	fake.countContainersByTeamMutex.Lock()
+	defer fake.countContainersByTeamMutex.Unlock()
+	fake.CountContainersByTeamStub = nil
+	fake.countContainersByTeamReturns = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContainerRepository) CountContainersByTeamReturnsOnCall(i int, result1 map[string]int, result2 error) {
+	fake.countContainersByTeamMutex.Lock()
+	defer fake.countContainersByTeamMutex.Unlock()
+	fake.CountContainersByTeamStub = nil
+	if fake.countContainersByTeamReturnsOnCall == nil {
+		fake.countContainersByTeamReturnsOnCall = make(map[int]struct {
+			result1 map[string]int
+			result2 error
+		})
+	}
+	fake.countContainersByTeamReturnsOnCall[i] = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeContainerRepository) DestroyFailedContainers() (int, error) {
 	fake.destroyFailedContainersMutex.Lock()
 	ret, specificReturn := fake.destroyFailedContainersReturnsOnCall[len(fake.destroyFailedContainersArgsForCall)]
@@ -473,6 +540,8 @@ func (fake *FakeContainerRepository) UpdateContainersMissingSinceReturnsOnCall(i
 func (fake *FakeContainerRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.countContainersByTeamMutex.RLock()
+	defer fake.countContainersByTeamMutex.RUnlock()
 	fake.destroyFailedContainersMutex.RLock()
 	defer fake.destroyFailedContainersMutex.RUnlock()
 	fake.findDestroyingContainersMutex.RLock()
@@ -211,6 +211,26 @@ type FakeJob struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	InputResolutionErrorsStub        func() map[string]string
+	inputResolutionErrorsMutex       sync.RWMutex
+	inputResolutionErrorsArgsForCall []struct {
+	}
+	inputResolutionErrorsReturns struct {
+		result1 map[string]string
+	}
+	inputResolutionErrorsReturnsOnCall map[int]struct {
+		result1 map[string]string
+	}
+	MaxInFlightReachedStub        func() bool
+	maxInFlightReachedMutex       sync.RWMutex
+	maxInFlightReachedArgsForCall []struct {
+	}
+	maxInFlightReachedReturns struct {
+		result1 bool
+	}
+	maxInFlightReachedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -273,6 +293,19 @@ type FakeJob struct {
 		result1 bool
 		result2 error
 	}
+	RerunBuildStub        func(db.Build) (db.Build, error)
+	rerunBuildMutex       sync.RWMutex
+	rerunBuildArgsForCall []struct {
+		arg1 db.Build
+	}
+	rerunBuildReturns struct {
+		result1 db.Build
+		result2 error
+	}
+	rerunBuildReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 error
+	}
 	SaveIndependentInputMappingStub        func(algorithm.InputMapping) error
 	saveIndependentInputMappingMutex       sync.RWMutex
 	saveIndependentInputMappingArgsForCall []struct {
@@ -284,6 +317,17 @@ type FakeJob struct {
 	saveIndependentInputMappingReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SaveInputResolutionErrorsStub        func(map[string]string) error
+	saveInputResolutionErrorsMutex       sync.RWMutex
+	saveInputResolutionErrorsArgsForCall []struct {
+		arg1 map[string]string
+	}
+	saveInputResolutionErrorsReturns struct {
+		result1 error
+	}
+	saveInputResolutionErrorsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SaveNextInputMappingStub        func(algorithm.InputMapping) error
 	saveNextInputMappingMutex       sync.RWMutex
 	saveNextInputMappingArgsForCall []struct {
@@ -1303,6 +1347,110 @@ func (fake *FakeJob) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeJob) InputResolutionErrors() map[string]string {
+	fake.inputResolutionErrorsMutex.Lock()
+	ret, specificReturn := fake.inputResolutionErrorsReturnsOnCall[len(fake.inputResolutionErrorsArgsForCall)]
+	fake.inputResolutionErrorsArgsForCall = append(fake.inputResolutionErrorsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("InputResolutionErrors", []interface{}{})
+	fake.inputResolutionErrorsMutex.Unlock()
+	if fake.InputResolutionErrorsStub != nil {
+		return fake.InputResolutionErrorsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.inputResolutionErrorsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) InputResolutionErrorsCallCount() int {
+	fake.inputResolutionErrorsMutex.RLock()
+	defer fake.inputResolutionErrorsMutex.RUnlock()
+	return len(fake.inputResolutionErrorsArgsForCall)
+}
+
+func (fake *FakeJob) InputResolutionErrorsCalls(stub func() map[string]string) {
+	fake.inputResolutionErrorsMutex.Lock()
+	defer fake.inputResolutionErrorsMutex.Unlock()
+	fake.InputResolutionErrorsStub = stub
+}
+
+func (fake *FakeJob) InputResolutionErrorsReturns(result1 map[string]string) {
+	fake.inputResolutionErrorsMutex.Lock()
+	defer fake.inputResolutionErrorsMutex.Unlock()
+	fake.InputResolutionErrorsStub = nil
+	fake.inputResolutionErrorsReturns = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeJob) InputResolutionErrorsReturnsOnCall(i int, result1 map[string]string) {
+	fake.inputResolutionErrorsMutex.Lock()
+	defer fake.inputResolutionErrorsMutex.Unlock()
+	fake.InputResolutionErrorsStub = nil
+	if fake.inputResolutionErrorsReturnsOnCall == nil {
+		fake.inputResolutionErrorsReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+		})
+	}
+	fake.inputResolutionErrorsReturnsOnCall[i] = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeJob) MaxInFlightReached() bool {
+	fake.maxInFlightReachedMutex.Lock()
+	ret, specificReturn := fake.maxInFlightReachedReturnsOnCall[len(fake.maxInFlightReachedArgsForCall)]
+	fake.maxInFlightReachedArgsForCall = append(fake.maxInFlightReachedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MaxInFlightReached", []interface{}{})
+	fake.maxInFlightReachedMutex.Unlock()
+	if fake.MaxInFlightReachedStub != nil {
+		return fake.MaxInFlightReachedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.maxInFlightReachedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) MaxInFlightReachedCallCount() int {
+	fake.maxInFlightReachedMutex.RLock()
+	defer fake.maxInFlightReachedMutex.RUnlock()
+	return len(fake.maxInFlightReachedArgsForCall)
+}
+
+func (fake *FakeJob) MaxInFlightReachedCalls(stub func() bool) {
+	fake.maxInFlightReachedMutex.Lock()
+	defer fake.maxInFlightReachedMutex.Unlock()
+	fake.MaxInFlightReachedStub = stub
+}
+
+func (fake *FakeJob) MaxInFlightReachedReturns(result1 bool) {
+	fake.maxInFlightReachedMutex.Lock()
+	defer fake.maxInFlightReachedMutex.Unlock()
+	fake.MaxInFlightReachedStub = nil
+	fake.maxInFlightReachedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeJob) MaxInFlightReachedReturnsOnCall(i int, result1 bool) {
+	fake.maxInFlightReachedMutex.Lock()
+	defer fake.maxInFlightReachedMutex.Unlock()
+	fake.MaxInFlightReachedStub = nil
+	if fake.maxInFlightReachedReturnsOnCall == nil {
+		fake.maxInFlightReachedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.maxInFlightReachedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeJob) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1618,6 +1766,69 @@ func (fake *FakeJob) ReloadReturnsOnCall(i int, result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeJob) RerunBuild(arg1 db.Build) (db.Build, error) {
+	fake.rerunBuildMutex.Lock()
+	ret, specificReturn := fake.rerunBuildReturnsOnCall[len(fake.rerunBuildArgsForCall)]
+	fake.rerunBuildArgsForCall = append(fake.rerunBuildArgsForCall, struct {
+		arg1 db.Build
+	}{arg1})
+	fake.recordInvocation("RerunBuild", []interface{}{arg1})
+	fake.rerunBuildMutex.Unlock()
+	if fake.RerunBuildStub != nil {
+		return fake.RerunBuildStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.rerunBuildReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) RerunBuildCallCount() int {
+	fake.rerunBuildMutex.RLock()
+	defer fake.rerunBuildMutex.RUnlock()
+	return len(fake.rerunBuildArgsForCall)
+}
+
+func (fake *FakeJob) RerunBuildCalls(stub func(db.Build) (db.Build, error)) {
+	fake.rerunBuildMutex.Lock()
+	defer fake.rerunBuildMutex.Unlock()
+	fake.RerunBuildStub = stub
+}
+
+func (fake *FakeJob) RerunBuildArgsForCall(i int) db.Build {
+	fake.rerunBuildMutex.RLock()
+	defer fake.rerunBuildMutex.RUnlock()
+	argsForCall := fake.rerunBuildArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) RerunBuildReturns(result1 db.Build, result2 error) {
+	fake.rerunBuildMutex.Lock()
+	defer fake.rerunBuildMutex.Unlock()
+	fake.RerunBuildStub = nil
+	fake.rerunBuildReturns = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) RerunBuildReturnsOnCall(i int, result1 db.Build, result2 error) {
+	fake.rerunBuildMutex.Lock()
+	defer fake.rerunBuildMutex.Unlock()
+	fake.RerunBuildStub = nil
+	if fake.rerunBuildReturnsOnCall == nil {
+		fake.rerunBuildReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 error
+		})
+	}
+	fake.rerunBuildReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) SaveIndependentInputMapping(arg1 algorithm.InputMapping) error {
 	fake.saveIndependentInputMappingMutex.Lock()
 	ret, specificReturn := fake.saveIndependentInputMappingReturnsOnCall[len(fake.saveIndependentInputMappingArgsForCall)]
@@ -1678,6 +1889,66 @@ func (fake *FakeJob) SaveIndependentInputMappingReturnsOnCall(i int, result1 err
 	}{result1}
 }
 
+func (fake *FakeJob) SaveInputResolutionErrors(arg1 map[string]string) error {
+	fake.saveInputResolutionErrorsMutex.Lock()
+	ret, specificReturn := fake.saveInputResolutionErrorsReturnsOnCall[len(fake.saveInputResolutionErrorsArgsForCall)]
+	fake.saveInputResolutionErrorsArgsForCall = append(fake.saveInputResolutionErrorsArgsForCall, struct {
+		arg1 map[string]string
+	}{arg1})
+	fake.recordInvocation("SaveInputResolutionErrors", []interface{}{arg1})
+	fake.saveInputResolutionErrorsMutex.Unlock()
+	if fake.SaveInputResolutionErrorsStub != nil {
+		return fake.SaveInputResolutionErrorsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveInputResolutionErrorsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) SaveInputResolutionErrorsCallCount() int {
+	fake.saveInputResolutionErrorsMutex.RLock()
+	defer fake.saveInputResolutionErrorsMutex.RUnlock()
+	return len(fake.saveInputResolutionErrorsArgsForCall)
+}
+
+func (fake *FakeJob) SaveInputResolutionErrorsCalls(stub func(map[string]string) error) {
+	fake.saveInputResolutionErrorsMutex.Lock()
+	defer fake.saveInputResolutionErrorsMutex.Unlock()
+	fake.SaveInputResolutionErrorsStub = stub
+}
+
+func (fake *FakeJob) SaveInputResolutionErrorsArgsForCall(i int) map[string]string {
+	fake.saveInputResolutionErrorsMutex.RLock()
+	defer fake.saveInputResolutionErrorsMutex.RUnlock()
+	argsForCall := fake.saveInputResolutionErrorsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) SaveInputResolutionErrorsReturns(result1 error) {
+	fake.saveInputResolutionErrorsMutex.Lock()
+	defer fake.saveInputResolutionErrorsMutex.Unlock()
+	fake.SaveInputResolutionErrorsStub = nil
+	fake.saveInputResolutionErrorsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeJob) SaveInputResolutionErrorsReturnsOnCall(i int, result1 error) {
+	fake.saveInputResolutionErrorsMutex.Lock()
+	defer fake.saveInputResolutionErrorsMutex.Unlock()
+	fake.SaveInputResolutionErrorsStub = nil
+	if fake.saveInputResolutionErrorsReturnsOnCall == nil {
+		fake.saveInputResolutionErrorsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveInputResolutionErrorsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeJob) SaveNextInputMapping(arg1 algorithm.InputMapping) error {
 	fake.saveNextInputMappingMutex.Lock()
 	ret, specificReturn := fake.saveNextInputMappingReturnsOnCall[len(fake.saveNextInputMappingArgsForCall)]
@@ -2101,6 +2372,10 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.getRunningBuildsBySerialGroupMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.inputResolutionErrorsMutex.RLock()
+	defer fake.inputResolutionErrorsMutex.RUnlock()
+	fake.maxInFlightReachedMutex.RLock()
+	defer fake.maxInFlightReachedMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.pauseMutex.RLock()
@@ -2113,8 +2388,12 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.pipelineNameMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.rerunBuildMutex.RLock()
+	defer fake.rerunBuildMutex.RUnlock()
 	fake.saveIndependentInputMappingMutex.RLock()
 	defer fake.saveIndependentInputMappingMutex.RUnlock()
+	fake.saveInputResolutionErrorsMutex.RLock()
+	defer fake.saveInputResolutionErrorsMutex.RUnlock()
 	fake.saveNextInputMappingMutex.RLock()
 	defer fake.saveNextInputMappingMutex.RUnlock()
 	fake.setMaxInFlightReachedMutex.RLock()
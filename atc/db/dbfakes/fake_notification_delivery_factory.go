@@ -0,0 +1,343 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	sync "sync"
+	time "time"
+
+	db "github.com/concourse/concourse/atc/db"
+)
+
+type FakeNotificationDeliveryFactory struct {
+	CleanupDeliveriesStub        func(time.Duration) error
+	cleanupDeliveriesMutex       sync.RWMutex
+	cleanupDeliveriesArgsForCall []struct {
+		arg1 time.Duration
+	}
+	cleanupDeliveriesReturns struct {
+		result1 error
+	}
+	cleanupDeliveriesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	MarkDeliveredStub        func(int, int) error
+	markDeliveredMutex       sync.RWMutex
+	markDeliveredArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	markDeliveredReturns struct {
+		result1 error
+	}
+	markDeliveredReturnsOnCall map[int]struct {
+		result1 error
+	}
+	MarkFailedStub        func(int, int, string, time.Time) error
+	markFailedMutex       sync.RWMutex
+	markFailedArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 string
+		arg4 time.Time
+	}
+	markFailedReturns struct {
+		result1 error
+	}
+	markFailedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PendingDeliveriesStub        func(int) ([]db.PendingNotificationDelivery, error)
+	pendingDeliveriesMutex       sync.RWMutex
+	pendingDeliveriesArgsForCall []struct {
+		arg1 int
+	}
+	pendingDeliveriesReturns struct {
+		result1 []db.PendingNotificationDelivery
+		result2 error
+	}
+	pendingDeliveriesReturnsOnCall map[int]struct {
+		result1 []db.PendingNotificationDelivery
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeNotificationDeliveryFactory) CleanupDeliveries(arg1 time.Duration) error {
+	fake.cleanupDeliveriesMutex.Lock()
+	ret, specificReturn := fake.cleanupDeliveriesReturnsOnCall[len(fake.cleanupDeliveriesArgsForCall)]
+	fake.cleanupDeliveriesArgsForCall = append(fake.cleanupDeliveriesArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	fake.recordInvocation("CleanupDeliveries", []interface{}{arg1})
+	fake.cleanupDeliveriesMutex.Unlock()
+	if fake.CleanupDeliveriesStub != nil {
+		return fake.CleanupDeliveriesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cleanupDeliveriesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeNotificationDeliveryFactory) CleanupDeliveriesCallCount() int {
+	fake.cleanupDeliveriesMutex.RLock()
+	defer fake.cleanupDeliveriesMutex.RUnlock()
+	return len(fake.cleanupDeliveriesArgsForCall)
+}
+
+func (fake *FakeNotificationDeliveryFactory) CleanupDeliveriesCalls(stub func(time.Duration) error) {
+	fake.cleanupDeliveriesMutex.Lock()
+	defer fake.cleanupDeliveriesMutex.Unlock()
+	fake.CleanupDeliveriesStub = stub
+}
+
+func (fake *FakeNotificationDeliveryFactory) CleanupDeliveriesArgsForCall(i int) time.Duration {
+	fake.cleanupDeliveriesMutex.RLock()
+	defer fake.cleanupDeliveriesMutex.RUnlock()
+	argsForCall := fake.cleanupDeliveriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNotificationDeliveryFactory) CleanupDeliveriesReturns(result1 error) {
+	fake.cleanupDeliveriesMutex.Lock()
+	defer fake.cleanupDeliveriesMutex.Unlock()
+	fake.CleanupDeliveriesStub = nil
+	fake.cleanupDeliveriesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotificationDeliveryFactory) CleanupDeliveriesReturnsOnCall(i int, result1 error) {
+	fake.cleanupDeliveriesMutex.Lock()
+	defer fake.cleanupDeliveriesMutex.Unlock()
+	fake.CleanupDeliveriesStub = nil
+	if fake.cleanupDeliveriesReturnsOnCall == nil {
+		fake.cleanupDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cleanupDeliveriesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotificationDeliveryFactory) MarkDelivered(arg1 int, arg2 int) error {
+	fake.markDeliveredMutex.Lock()
+	ret, specificReturn := fake.markDeliveredReturnsOnCall[len(fake.markDeliveredArgsForCall)]
+	fake.markDeliveredArgsForCall = append(fake.markDeliveredArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("MarkDelivered", []interface{}{arg1, arg2})
+	fake.markDeliveredMutex.Unlock()
+	if fake.MarkDeliveredStub != nil {
+		return fake.MarkDeliveredStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.markDeliveredReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeNotificationDeliveryFactory) MarkDeliveredCallCount() int {
+	fake.markDeliveredMutex.RLock()
+	defer fake.markDeliveredMutex.RUnlock()
+	return len(fake.markDeliveredArgsForCall)
+}
+
+func (fake *FakeNotificationDeliveryFactory) MarkDeliveredCalls(stub func(int, int) error) {
+	fake.markDeliveredMutex.Lock()
+	defer fake.markDeliveredMutex.Unlock()
+	fake.MarkDeliveredStub = stub
+}
+
+func (fake *FakeNotificationDeliveryFactory) MarkDeliveredArgsForCall(i int) (int, int) {
+	fake.markDeliveredMutex.RLock()
+	defer fake.markDeliveredMutex.RUnlock()
+	argsForCall := fake.markDeliveredArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNotificationDeliveryFactory) MarkDeliveredReturns(result1 error) {
+	fake.markDeliveredMutex.Lock()
+	defer fake.markDeliveredMutex.Unlock()
+	fake.MarkDeliveredStub = nil
+	fake.markDeliveredReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotificationDeliveryFactory) MarkDeliveredReturnsOnCall(i int, result1 error) {
+	fake.markDeliveredMutex.Lock()
+	defer fake.markDeliveredMutex.Unlock()
+	fake.MarkDeliveredStub = nil
+	if fake.markDeliveredReturnsOnCall == nil {
+		fake.markDeliveredReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.markDeliveredReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotificationDeliveryFactory) MarkFailed(arg1 int, arg2 int, arg3 string, arg4 time.Time) error {
+	fake.markFailedMutex.Lock()
+	ret, specificReturn := fake.markFailedReturnsOnCall[len(fake.markFailedArgsForCall)]
+	fake.markFailedArgsForCall = append(fake.markFailedArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 string
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("MarkFailed", []interface{}{arg1, arg2, arg3, arg4})
+	fake.markFailedMutex.Unlock()
+	if fake.MarkFailedStub != nil {
+		return fake.MarkFailedStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.markFailedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeNotificationDeliveryFactory) MarkFailedCallCount() int {
+	fake.markFailedMutex.RLock()
+	defer fake.markFailedMutex.RUnlock()
+	return len(fake.markFailedArgsForCall)
+}
+
+func (fake *FakeNotificationDeliveryFactory) MarkFailedCalls(stub func(int, int, string, time.Time) error) {
+	fake.markFailedMutex.Lock()
+	defer fake.markFailedMutex.Unlock()
+	fake.MarkFailedStub = stub
+}
+
+func (fake *FakeNotificationDeliveryFactory) MarkFailedArgsForCall(i int) (int, int, string, time.Time) {
+	fake.markFailedMutex.RLock()
+	defer fake.markFailedMutex.RUnlock()
+	argsForCall := fake.markFailedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeNotificationDeliveryFactory) MarkFailedReturns(result1 error) {
+	fake.markFailedMutex.Lock()
+	defer fake.markFailedMutex.Unlock()
+	fake.MarkFailedStub = nil
+	fake.markFailedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotificationDeliveryFactory) MarkFailedReturnsOnCall(i int, result1 error) {
+	fake.markFailedMutex.Lock()
+	defer fake.markFailedMutex.Unlock()
+	fake.MarkFailedStub = nil
+	if fake.markFailedReturnsOnCall == nil {
+		fake.markFailedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.markFailedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotificationDeliveryFactory) PendingDeliveries(arg1 int) ([]db.PendingNotificationDelivery, error) {
+	fake.pendingDeliveriesMutex.Lock()
+	ret, specificReturn := fake.pendingDeliveriesReturnsOnCall[len(fake.pendingDeliveriesArgsForCall)]
+	fake.pendingDeliveriesArgsForCall = append(fake.pendingDeliveriesArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("PendingDeliveries", []interface{}{arg1})
+	fake.pendingDeliveriesMutex.Unlock()
+	if fake.PendingDeliveriesStub != nil {
+		return fake.PendingDeliveriesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pendingDeliveriesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNotificationDeliveryFactory) PendingDeliveriesCallCount() int {
+	fake.pendingDeliveriesMutex.RLock()
+	defer fake.pendingDeliveriesMutex.RUnlock()
+	return len(fake.pendingDeliveriesArgsForCall)
+}
+
+func (fake *FakeNotificationDeliveryFactory) PendingDeliveriesCalls(stub func(int) ([]db.PendingNotificationDelivery, error)) {
+	fake.pendingDeliveriesMutex.Lock()
+	defer fake.pendingDeliveriesMutex.Unlock()
+	fake.PendingDeliveriesStub = stub
+}
+
+func (fake *FakeNotificationDeliveryFactory) PendingDeliveriesArgsForCall(i int) int {
+	fake.pendingDeliveriesMutex.RLock()
+	defer fake.pendingDeliveriesMutex.RUnlock()
+	argsForCall := fake.pendingDeliveriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNotificationDeliveryFactory) PendingDeliveriesReturns(result1 []db.PendingNotificationDelivery, result2 error) {
+	fake.pendingDeliveriesMutex.Lock()
+	defer fake.pendingDeliveriesMutex.Unlock()
+	fake.PendingDeliveriesStub = nil
+	fake.pendingDeliveriesReturns = struct {
+		result1 []db.PendingNotificationDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNotificationDeliveryFactory) PendingDeliveriesReturnsOnCall(i int, result1 []db.PendingNotificationDelivery, result2 error) {
+	fake.pendingDeliveriesMutex.Lock()
+	defer fake.pendingDeliveriesMutex.Unlock()
+	fake.PendingDeliveriesStub = nil
+	if fake.pendingDeliveriesReturnsOnCall == nil {
+		fake.pendingDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []db.PendingNotificationDelivery
+			result2 error
+		})
+	}
+	fake.pendingDeliveriesReturnsOnCall[i] = struct {
+		result1 []db.PendingNotificationDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNotificationDeliveryFactory) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cleanupDeliveriesMutex.RLock()
+	defer fake.cleanupDeliveriesMutex.RUnlock()
+	fake.markDeliveredMutex.RLock()
+	defer fake.markDeliveredMutex.RUnlock()
+	fake.markFailedMutex.RLock()
+	defer fake.markFailedMutex.RUnlock()
+	fake.pendingDeliveriesMutex.RLock()
+	defer fake.pendingDeliveriesMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeNotificationDeliveryFactory) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.NotificationDeliveryFactory = new(FakeNotificationDeliveryFactory)
@@ -118,6 +118,17 @@ type FakePipeline struct {
 		result1 db.Dashboard
 		result2 error
 	}
+	DeleteBuildArtifactsByBuildIDsStub        func([]int) error
+	deleteBuildArtifactsByBuildIDsMutex       sync.RWMutex
+	deleteBuildArtifactsByBuildIDsArgsForCall []struct {
+		arg1 []int
+	}
+	deleteBuildArtifactsByBuildIDsReturns struct {
+		result1 error
+	}
+	deleteBuildArtifactsByBuildIDsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteBuildEventsByBuildIDsStub        func([]int) error
 	deleteBuildEventsByBuildIDsMutex       sync.RWMutex
 	deleteBuildEventsByBuildIDsArgsForCall []struct {
@@ -268,6 +279,41 @@ type FakePipeline struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	NotificationDeliveriesStub        func(int) ([]db.NotificationDelivery, error)
+	notificationDeliveriesMutex       sync.RWMutex
+	notificationDeliveriesArgsForCall []struct {
+		arg1 int
+	}
+	notificationDeliveriesReturns struct {
+		result1 []db.NotificationDelivery
+		result2 error
+	}
+	notificationDeliveriesReturnsOnCall map[int]struct {
+		result1 []db.NotificationDelivery
+		result2 error
+	}
+	NotificationsStub        func() (atc.NotificationConfigs, error)
+	notificationsMutex       sync.RWMutex
+	notificationsArgsForCall []struct {
+	}
+	notificationsReturns struct {
+		result1 atc.NotificationConfigs
+		result2 error
+	}
+	notificationsReturnsOnCall map[int]struct {
+		result1 atc.NotificationConfigs
+		result2 error
+	}
+	ParentBuildIDStub        func() int
+	parentBuildIDMutex       sync.RWMutex
+	parentBuildIDArgsForCall []struct {
+	}
+	parentBuildIDReturns struct {
+		result1 int
+	}
+	parentBuildIDReturnsOnCall map[int]struct {
+		result1 int
+	}
 	PauseStub        func() error
 	pauseMutex       sync.RWMutex
 	pauseArgsForCall []struct {
@@ -420,6 +466,18 @@ type FakePipeline struct {
 	unpauseReturnsOnCall map[int]struct {
 		result1 error
 	}
+	VarSourcesStub        func() (atc.VarSourceConfigs, error)
+	varSourcesMutex       sync.RWMutex
+	varSourcesArgsForCall []struct {
+	}
+	varSourcesReturns struct {
+		result1 atc.VarSourceConfigs
+		result2 error
+	}
+	varSourcesReturnsOnCall map[int]struct {
+		result1 atc.VarSourceConfigs
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -903,6 +961,71 @@ func (fake *FakePipeline) DashboardReturnsOnCall(i int, result1 db.Dashboard, re
 	}{result1, result2}
 }
 
+func (fake *FakePipeline) DeleteBuildArtifactsByBuildIDs(arg1 []int) error {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.deleteBuildArtifactsByBuildIDsMutex.Lock()
+	ret, specificReturn := fake.deleteBuildArtifactsByBuildIDsReturnsOnCall[len(fake.deleteBuildArtifactsByBuildIDsArgsForCall)]
+	fake.deleteBuildArtifactsByBuildIDsArgsForCall = append(fake.deleteBuildArtifactsByBuildIDsArgsForCall, struct {
+		arg1 []int
+	}{arg1Copy})
+	fake.recordInvocation("DeleteBuildArtifactsByBuildIDs", []interface{}{arg1Copy})
+	fake.deleteBuildArtifactsByBuildIDsMutex.Unlock()
+	if fake.DeleteBuildArtifactsByBuildIDsStub != nil {
+		return fake.DeleteBuildArtifactsByBuildIDsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deleteBuildArtifactsByBuildIDsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) DeleteBuildArtifactsByBuildIDsCallCount() int {
+	fake.deleteBuildArtifactsByBuildIDsMutex.RLock()
+	defer fake.deleteBuildArtifactsByBuildIDsMutex.RUnlock()
+	return len(fake.deleteBuildArtifactsByBuildIDsArgsForCall)
+}
+
+func (fake *FakePipeline) DeleteBuildArtifactsByBuildIDsCalls(stub func([]int) error) {
+	fake.deleteBuildArtifactsByBuildIDsMutex.Lock()
+	defer fake.deleteBuildArtifactsByBuildIDsMutex.Unlock()
+	fake.DeleteBuildArtifactsByBuildIDsStub = stub
+}
+
+func (fake *FakePipeline) DeleteBuildArtifactsByBuildIDsArgsForCall(i int) []int {
+	fake.deleteBuildArtifactsByBuildIDsMutex.RLock()
+	defer fake.deleteBuildArtifactsByBuildIDsMutex.RUnlock()
+	argsForCall := fake.deleteBuildArtifactsByBuildIDsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipeline) DeleteBuildArtifactsByBuildIDsReturns(result1 error) {
+	fake.deleteBuildArtifactsByBuildIDsMutex.Lock()
+	defer fake.deleteBuildArtifactsByBuildIDsMutex.Unlock()
+	fake.DeleteBuildArtifactsByBuildIDsStub = nil
+	fake.deleteBuildArtifactsByBuildIDsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePipeline) DeleteBuildArtifactsByBuildIDsReturnsOnCall(i int, result1 error) {
+	fake.deleteBuildArtifactsByBuildIDsMutex.Lock()
+	defer fake.deleteBuildArtifactsByBuildIDsMutex.Unlock()
+	fake.DeleteBuildArtifactsByBuildIDsStub = nil
+	if fake.deleteBuildArtifactsByBuildIDsReturnsOnCall == nil {
+		fake.deleteBuildArtifactsByBuildIDsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteBuildArtifactsByBuildIDsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakePipeline) DeleteBuildEventsByBuildIDs(arg1 []int) error {
 	var arg1Copy []int
 	if arg1 != nil {
@@ -1639,6 +1762,176 @@ func (fake *FakePipeline) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakePipeline) NotificationDeliveries(arg1 int) ([]db.NotificationDelivery, error) {
+	fake.notificationDeliveriesMutex.Lock()
+	ret, specificReturn := fake.notificationDeliveriesReturnsOnCall[len(fake.notificationDeliveriesArgsForCall)]
+	fake.notificationDeliveriesArgsForCall = append(fake.notificationDeliveriesArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("NotificationDeliveries", []interface{}{arg1})
+	fake.notificationDeliveriesMutex.Unlock()
+	if fake.NotificationDeliveriesStub != nil {
+		return fake.NotificationDeliveriesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.notificationDeliveriesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) NotificationDeliveriesCallCount() int {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	return len(fake.notificationDeliveriesArgsForCall)
+}
+
+func (fake *FakePipeline) NotificationDeliveriesCalls(stub func(int) ([]db.NotificationDelivery, error)) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = stub
+}
+
+func (fake *FakePipeline) NotificationDeliveriesArgsForCall(i int) int {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	argsForCall := fake.notificationDeliveriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipeline) NotificationDeliveriesReturns(result1 []db.NotificationDelivery, result2 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	fake.notificationDeliveriesReturns = struct {
+		result1 []db.NotificationDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) NotificationDeliveriesReturnsOnCall(i int, result1 []db.NotificationDelivery, result2 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	if fake.notificationDeliveriesReturnsOnCall == nil {
+		fake.notificationDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []db.NotificationDelivery
+			result2 error
+		})
+	}
+	fake.notificationDeliveriesReturnsOnCall[i] = struct {
+		result1 []db.NotificationDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) Notifications() (atc.NotificationConfigs, error) {
+	fake.notificationsMutex.Lock()
+	ret, specificReturn := fake.notificationsReturnsOnCall[len(fake.notificationsArgsForCall)]
+	fake.notificationsArgsForCall = append(fake.notificationsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Notifications", []interface{}{})
+	fake.notificationsMutex.Unlock()
+	if fake.NotificationsStub != nil {
+		return fake.NotificationsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.notificationsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) NotificationsCallCount() int {
+	fake.notificationsMutex.RLock()
+	defer fake.notificationsMutex.RUnlock()
+	return len(fake.notificationsArgsForCall)
+}
+
+func (fake *FakePipeline) NotificationsCalls(stub func() (atc.NotificationConfigs, error)) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = stub
+}
+
+func (fake *FakePipeline) NotificationsReturns(result1 atc.NotificationConfigs, result2 error) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = nil
+	fake.notificationsReturns = struct {
+		result1 atc.NotificationConfigs
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) NotificationsReturnsOnCall(i int, result1 atc.NotificationConfigs, result2 error) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = nil
+	if fake.notificationsReturnsOnCall == nil {
+		fake.notificationsReturnsOnCall = make(map[int]struct {
+			result1 atc.NotificationConfigs
+			result2 error
+		})
+	}
+	fake.notificationsReturnsOnCall[i] = struct {
+		result1 atc.NotificationConfigs
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) ParentBuildID() int {
+	fake.parentBuildIDMutex.Lock()
+	ret, specificReturn := fake.parentBuildIDReturnsOnCall[len(fake.parentBuildIDArgsForCall)]
+	fake.parentBuildIDArgsForCall = append(fake.parentBuildIDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ParentBuildID", []interface{}{})
+	fake.parentBuildIDMutex.Unlock()
+	if fake.ParentBuildIDStub != nil {
+		return fake.ParentBuildIDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.parentBuildIDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) ParentBuildIDCallCount() int {
+	fake.parentBuildIDMutex.RLock()
+	defer fake.parentBuildIDMutex.RUnlock()
+	return len(fake.parentBuildIDArgsForCall)
+}
+
+func (fake *FakePipeline) ParentBuildIDCalls(stub func() int) {
+	fake.parentBuildIDMutex.Lock()
+	defer fake.parentBuildIDMutex.Unlock()
+	fake.ParentBuildIDStub = stub
+}
+
+func (fake *FakePipeline) ParentBuildIDReturns(result1 int) {
+	fake.parentBuildIDMutex.Lock()
+	defer fake.parentBuildIDMutex.Unlock()
+	fake.ParentBuildIDStub = nil
+	fake.parentBuildIDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakePipeline) ParentBuildIDReturnsOnCall(i int, result1 int) {
+	fake.parentBuildIDMutex.Lock()
+	defer fake.parentBuildIDMutex.Unlock()
+	fake.ParentBuildIDStub = nil
+	if fake.parentBuildIDReturnsOnCall == nil {
+		fake.parentBuildIDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.parentBuildIDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakePipeline) Pause() error {
 	fake.pauseMutex.Lock()
 	ret, specificReturn := fake.pauseReturnsOnCall[len(fake.pauseArgsForCall)]
@@ -2374,6 +2667,61 @@ func (fake *FakePipeline) UnpauseReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakePipeline) VarSources() (atc.VarSourceConfigs, error) {
+	fake.varSourcesMutex.Lock()
+	ret, specificReturn := fake.varSourcesReturnsOnCall[len(fake.varSourcesArgsForCall)]
+	fake.varSourcesArgsForCall = append(fake.varSourcesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("VarSources", []interface{}{})
+	fake.varSourcesMutex.Unlock()
+	if fake.VarSourcesStub != nil {
+		return fake.VarSourcesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.varSourcesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) VarSourcesCallCount() int {
+	fake.varSourcesMutex.RLock()
+	defer fake.varSourcesMutex.RUnlock()
+	return len(fake.varSourcesArgsForCall)
+}
+
+func (fake *FakePipeline) VarSourcesCalls(stub func() (atc.VarSourceConfigs, error)) {
+	fake.varSourcesMutex.Lock()
+	defer fake.varSourcesMutex.Unlock()
+	fake.VarSourcesStub = stub
+}
+
+func (fake *FakePipeline) VarSourcesReturns(result1 atc.VarSourceConfigs, result2 error) {
+	fake.varSourcesMutex.Lock()
+	defer fake.varSourcesMutex.Unlock()
+	fake.VarSourcesStub = nil
+	fake.varSourcesReturns = struct {
+		result1 atc.VarSourceConfigs
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) VarSourcesReturnsOnCall(i int, result1 atc.VarSourceConfigs, result2 error) {
+	fake.varSourcesMutex.Lock()
+	defer fake.varSourcesMutex.Unlock()
+	fake.VarSourcesStub = nil
+	if fake.varSourcesReturnsOnCall == nil {
+		fake.varSourcesReturnsOnCall = make(map[int]struct {
+			result1 atc.VarSourceConfigs
+			result2 error
+		})
+	}
+	fake.varSourcesReturnsOnCall[i] = struct {
+		result1 atc.VarSourceConfigs
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -2393,6 +2741,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.createOneOffBuildMutex.RUnlock()
 	fake.dashboardMutex.RLock()
 	defer fake.dashboardMutex.RUnlock()
+	fake.deleteBuildArtifactsByBuildIDsMutex.RLock()
+	defer fake.deleteBuildArtifactsByBuildIDsMutex.RUnlock()
 	fake.deleteBuildEventsByBuildIDsMutex.RLock()
 	defer fake.deleteBuildEventsByBuildIDsMutex.RUnlock()
 	fake.destroyMutex.RLock()
@@ -2419,6 +2769,12 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.loadVersionsDBMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	fake.notificationsMutex.RLock()
+	defer fake.notificationsMutex.RUnlock()
+	fake.parentBuildIDMutex.RLock()
+	defer fake.parentBuildIDMutex.RUnlock()
 	fake.pauseMutex.RLock()
 	defer fake.pauseMutex.RUnlock()
 	fake.pausedMutex.RLock()
@@ -2445,6 +2801,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.teamNameMutex.RUnlock()
 	fake.unpauseMutex.RLock()
 	defer fake.unpauseMutex.RUnlock()
+	fake.varSourcesMutex.RLock()
+	defer fake.varSourcesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
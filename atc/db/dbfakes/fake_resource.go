@@ -42,6 +42,19 @@ type FakeResource struct {
 	checkEveryReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CheckHistoryStub        func(int) ([]db.CheckHistoryEntry, error)
+	checkHistoryMutex       sync.RWMutex
+	checkHistoryArgsForCall []struct {
+		arg1 int
+	}
+	checkHistoryReturns struct {
+		result1 []db.CheckHistoryEntry
+		result2 error
+	}
+	checkHistoryReturnsOnCall map[int]struct {
+		result1 []db.CheckHistoryEntry
+		result2 error
+	}
 	CheckTimeoutStub        func() string
 	checkTimeoutMutex       sync.RWMutex
 	checkTimeoutArgsForCall []struct {
@@ -212,6 +225,17 @@ type FakeResource struct {
 		result2 bool
 		result3 error
 	}
+	SaveCheckHistoryEntryStub        func(db.CheckHistoryEntry) error
+	saveCheckHistoryEntryMutex       sync.RWMutex
+	saveCheckHistoryEntryArgsForCall []struct {
+		arg1 db.CheckHistoryEntry
+	}
+	saveCheckHistoryEntryReturns struct {
+		result1 error
+	}
+	saveCheckHistoryEntryReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetCheckErrorStub        func(error) error
 	setCheckErrorMutex       sync.RWMutex
 	setCheckErrorArgsForCall []struct {
@@ -475,6 +499,69 @@ func (fake *FakeResource) CheckEveryReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeResource) CheckHistory(arg1 int) ([]db.CheckHistoryEntry, error) {
+	fake.checkHistoryMutex.Lock()
+	ret, specificReturn := fake.checkHistoryReturnsOnCall[len(fake.checkHistoryArgsForCall)]
+	fake.checkHistoryArgsForCall = append(fake.checkHistoryArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("CheckHistory", []interface{}{arg1})
+	fake.checkHistoryMutex.Unlock()
+	if fake.CheckHistoryStub != nil {
+		return fake.CheckHistoryStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.checkHistoryReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) CheckHistoryCallCount() int {
+	fake.checkHistoryMutex.RLock()
+	defer fake.checkHistoryMutex.RUnlock()
+	return len(fake.checkHistoryArgsForCall)
+}
+
+func (fake *FakeResource) CheckHistoryCalls(stub func(int) ([]db.CheckHistoryEntry, error)) {
+	fake.checkHistoryMutex.Lock()
+	defer fake.checkHistoryMutex.Unlock()
+	fake.CheckHistoryStub = stub
+}
+
+func (fake *FakeResource) CheckHistoryArgsForCall(i int) int {
+	fake.checkHistoryMutex.RLock()
+	defer fake.checkHistoryMutex.RUnlock()
+	argsForCall := fake.checkHistoryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) CheckHistoryReturns(result1 []db.CheckHistoryEntry, result2 error) {
+	fake.checkHistoryMutex.Lock()
+	defer fake.checkHistoryMutex.Unlock()
+	fake.CheckHistoryStub = nil
+	fake.checkHistoryReturns = struct {
+		result1 []db.CheckHistoryEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) CheckHistoryReturnsOnCall(i int, result1 []db.CheckHistoryEntry, result2 error) {
+	fake.checkHistoryMutex.Lock()
+	defer fake.checkHistoryMutex.Unlock()
+	fake.CheckHistoryStub = nil
+	if fake.checkHistoryReturnsOnCall == nil {
+		fake.checkHistoryReturnsOnCall = make(map[int]struct {
+			result1 []db.CheckHistoryEntry
+			result2 error
+		})
+	}
+	fake.checkHistoryReturnsOnCall[i] = struct {
+		result1 []db.CheckHistoryEntry
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResource) CheckTimeout() string {
 	fake.checkTimeoutMutex.Lock()
 	ret, specificReturn := fake.checkTimeoutReturnsOnCall[len(fake.checkTimeoutArgsForCall)]
@@ -1348,6 +1435,66 @@ func (fake *FakeResource) ResourceConfigVersionIDReturnsOnCall(i int, result1 in
 	}{result1, result2, result3}
 }
 
+func (fake *FakeResource) SaveCheckHistoryEntry(arg1 db.CheckHistoryEntry) error {
+	fake.saveCheckHistoryEntryMutex.Lock()
+	ret, specificReturn := fake.saveCheckHistoryEntryReturnsOnCall[len(fake.saveCheckHistoryEntryArgsForCall)]
+	fake.saveCheckHistoryEntryArgsForCall = append(fake.saveCheckHistoryEntryArgsForCall, struct {
+		arg1 db.CheckHistoryEntry
+	}{arg1})
+	fake.recordInvocation("SaveCheckHistoryEntry", []interface{}{arg1})
+	fake.saveCheckHistoryEntryMutex.Unlock()
+	if fake.SaveCheckHistoryEntryStub != nil {
+		return fake.SaveCheckHistoryEntryStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveCheckHistoryEntryReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) SaveCheckHistoryEntryCallCount() int {
+	fake.saveCheckHistoryEntryMutex.RLock()
+	defer fake.saveCheckHistoryEntryMutex.RUnlock()
+	return len(fake.saveCheckHistoryEntryArgsForCall)
+}
+
+func (fake *FakeResource) SaveCheckHistoryEntryCalls(stub func(db.CheckHistoryEntry) error) {
+	fake.saveCheckHistoryEntryMutex.Lock()
+	defer fake.saveCheckHistoryEntryMutex.Unlock()
+	fake.SaveCheckHistoryEntryStub = stub
+}
+
+func (fake *FakeResource) SaveCheckHistoryEntryArgsForCall(i int) db.CheckHistoryEntry {
+	fake.saveCheckHistoryEntryMutex.RLock()
+	defer fake.saveCheckHistoryEntryMutex.RUnlock()
+	argsForCall := fake.saveCheckHistoryEntryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) SaveCheckHistoryEntryReturns(result1 error) {
+	fake.saveCheckHistoryEntryMutex.Lock()
+	defer fake.saveCheckHistoryEntryMutex.Unlock()
+	fake.SaveCheckHistoryEntryStub = nil
+	fake.saveCheckHistoryEntryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResource) SaveCheckHistoryEntryReturnsOnCall(i int, result1 error) {
+	fake.saveCheckHistoryEntryMutex.Lock()
+	defer fake.saveCheckHistoryEntryMutex.Unlock()
+	fake.SaveCheckHistoryEntryStub = nil
+	if fake.saveCheckHistoryEntryReturnsOnCall == nil {
+		fake.saveCheckHistoryEntryReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveCheckHistoryEntryReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeResource) SetCheckError(arg1 error) error {
 	fake.setCheckErrorMutex.Lock()
 	ret, specificReturn := fake.setCheckErrorReturnsOnCall[len(fake.setCheckErrorArgsForCall)]
@@ -1863,6 +2010,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.checkErrorMutex.RUnlock()
 	fake.checkEveryMutex.RLock()
 	defer fake.checkEveryMutex.RUnlock()
+	fake.checkHistoryMutex.RLock()
+	defer fake.checkHistoryMutex.RUnlock()
 	fake.checkTimeoutMutex.RLock()
 	defer fake.checkTimeoutMutex.RUnlock()
 	fake.configPinnedVersionMutex.RLock()
@@ -1895,6 +2044,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.resourceConfigIDMutex.RUnlock()
 	fake.resourceConfigVersionIDMutex.RLock()
 	defer fake.resourceConfigVersionIDMutex.RUnlock()
+	fake.saveCheckHistoryEntryMutex.RLock()
+	defer fake.saveCheckHistoryEntryMutex.RUnlock()
 	fake.setCheckErrorMutex.RLock()
 	defer fake.setCheckErrorMutex.RUnlock()
 	fake.setResourceConfigMutex.RLock()
@@ -3,6 +3,7 @@ package dbfakes
 
 import (
 	sync "sync"
+	time "time"
 
 	lager "code.cloudfoundry.org/lager"
 	atc "github.com/concourse/concourse/atc"
@@ -41,6 +42,16 @@ type FakeResourceType struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	LastCheckedStub        func() time.Time
+	lastCheckedMutex       sync.RWMutex
+	lastCheckedArgsForCall []struct {
+	}
+	lastCheckedReturns struct {
+		result1 time.Time
+	}
+	lastCheckedReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -319,6 +330,58 @@ func (fake *FakeResourceType) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeResourceType) LastChecked() time.Time {
+	fake.lastCheckedMutex.Lock()
+	ret, specificReturn := fake.lastCheckedReturnsOnCall[len(fake.lastCheckedArgsForCall)]
+	fake.lastCheckedArgsForCall = append(fake.lastCheckedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LastChecked", []interface{}{})
+	fake.lastCheckedMutex.Unlock()
+	if fake.LastCheckedStub != nil {
+		return fake.LastCheckedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.lastCheckedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceType) LastCheckedCallCount() int {
+	fake.lastCheckedMutex.RLock()
+	defer fake.lastCheckedMutex.RUnlock()
+	return len(fake.lastCheckedArgsForCall)
+}
+
+func (fake *FakeResourceType) LastCheckedCalls(stub func() time.Time) {
+	fake.lastCheckedMutex.Lock()
+	defer fake.lastCheckedMutex.Unlock()
+	fake.LastCheckedStub = stub
+}
+
+func (fake *FakeResourceType) LastCheckedReturns(result1 time.Time) {
+	fake.lastCheckedMutex.Lock()
+	defer fake.lastCheckedMutex.Unlock()
+	fake.LastCheckedStub = nil
+	fake.lastCheckedReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeResourceType) LastCheckedReturnsOnCall(i int, result1 time.Time) {
+	fake.lastCheckedMutex.Lock()
+	defer fake.lastCheckedMutex.Unlock()
+	fake.LastCheckedStub = nil
+	if fake.lastCheckedReturnsOnCall == nil {
+		fake.lastCheckedReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.lastCheckedReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeResourceType) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -924,6 +987,8 @@ func (fake *FakeResourceType) Invocations() map[string][][]interface{} {
 	defer fake.checkEveryMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.lastCheckedMutex.RLock()
+	defer fake.lastCheckedMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.paramsMutex.RLock()
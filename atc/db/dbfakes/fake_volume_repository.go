@@ -9,6 +9,18 @@ import (
 )
 
 type FakeVolumeRepository struct {
+	CountVolumesByTeamStub        func() (map[string]int, error)
+	countVolumesByTeamMutex       sync.RWMutex
+	countVolumesByTeamArgsForCall []struct {
+	}
+	countVolumesByTeamReturns struct {
+		result1 map[string]int
+		result2 error
+	}
+	countVolumesByTeamReturnsOnCall map[int]struct {
+		result1 map[string]int
+		result2 error
+	}
 	CreateBaseResourceTypeVolumeStub        func(*db.UsedWorkerBaseResourceType) (db.CreatingVolume, error)
 	createBaseResourceTypeVolumeMutex       sync.RWMutex
 	createBaseResourceTypeVolumeArgsForCall []struct {
@@ -268,6 +280,61 @@ type FakeVolumeRepository struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeVolumeRepository) CountVolumesByTeam() (map[string]int, error) {
+	fake.countVolumesByTeamMutex.Lock()
+	ret, specificReturn := fake.countVolumesByTeamReturnsOnCall[len(fake.countVolumesByTeamArgsForCall)]
+	fake.countVolumesByTeamArgsForCall = append(fake.countVolumesByTeamArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CountVolumesByTeam", []interface{}{})
+	fake.countVolumesByTeamMutex.Unlock()
+	if fake.CountVolumesByTeamStub != nil {
+		return fake.CountVolumesByTeamStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.countVolumesByTeamReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeVolumeRepository) CountVolumesByTeamCallCount() int {
+	fake.countVolumesByTeamMutex.RLock()
+	defer fake.countVolumesByTeamMutex.RUnlock()
+	return len(fake.countVolumesByTeamArgsForCall)
+}
+
+func (fake *FakeVolumeRepository) CountVolumesByTeamCalls(stub func() (map[string]int, error)) {
+	fake.countVolumesByTeamMutex.Lock()
+	defer fake.countVolumesByTeamMutex.Unlock()
+	fake.CountVolumesByTeamStub = stub
+}
+
+func (fake *FakeVolumeRepository) CountVolumesByTeamReturns(result1 map[string]int, result2 error) {
+	fake.countVolumesByTeamMutex.Lock()
+	defer fake.countVolumesByTeamMutex.Unlock()
+	fake.CountVolumesByTeamStub = nil
+	fake.countVolumesByTeamReturns = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVolumeRepository) CountVolumesByTeamReturnsOnCall(i int, result1 map[string]int, result2 error) {
+	fake.countVolumesByTeamMutex.Lock()
+	defer fake.countVolumesByTeamMutex.Unlock()
+	fake.CountVolumesByTeamStub = nil
+	if fake.countVolumesByTeamReturnsOnCall == nil {
+		fake.countVolumesByTeamReturnsOnCall = make(map[int]struct {
+			result1 map[string]int
+			result2 error
+		})
+	}
+	fake.countVolumesByTeamReturnsOnCall[i] = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeVolumeRepository) CreateBaseResourceTypeVolume(arg1 *db.UsedWorkerBaseResourceType) (db.CreatingVolume, error) {
 	fake.createBaseResourceTypeVolumeMutex.Lock()
 	ret, specificReturn := fake.createBaseResourceTypeVolumeReturnsOnCall[len(fake.createBaseResourceTypeVolumeArgsForCall)]
@@ -1427,6 +1494,8 @@ func (fake *FakeVolumeRepository) UpdateVolumesMissingSinceReturnsOnCall(i int,
 func (fake *FakeVolumeRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.countVolumesByTeamMutex.RLock()
+	defer fake.countVolumesByTeamMutex.RUnlock()
 	fake.createBaseResourceTypeVolumeMutex.RLock()
 	defer fake.createBaseResourceTypeVolumeMutex.RUnlock()
 	fake.createContainerVolumeMutex.RLock()
@@ -109,6 +109,26 @@ type FakeWorker struct {
 		result2 db.CreatedContainer
 		result3 error
 	}
+	FreeDiskStub        func() int64
+	freeDiskMutex       sync.RWMutex
+	freeDiskArgsForCall []struct {
+	}
+	freeDiskReturns struct {
+		result1 int64
+	}
+	freeDiskReturnsOnCall map[int]struct {
+		result1 int64
+	}
+	FreeMemoryStub        func() int64
+	freeMemoryMutex       sync.RWMutex
+	freeMemoryArgsForCall []struct {
+	}
+	freeMemoryReturns struct {
+		result1 int64
+	}
+	freeMemoryReturnsOnCall map[int]struct {
+		result1 int64
+	}
 	GardenAddrStub        func() *string
 	gardenAddrMutex       sync.RWMutex
 	gardenAddrArgsForCall []struct {
@@ -149,6 +169,16 @@ type FakeWorker struct {
 	landReturnsOnCall map[int]struct {
 		result1 error
 	}
+	MaxContainersStub        func() int
+	maxContainersMutex       sync.RWMutex
+	maxContainersArgsForCall []struct {
+	}
+	maxContainersReturns struct {
+		result1 int
+	}
+	maxContainersReturnsOnCall map[int]struct {
+		result1 int
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -793,6 +823,110 @@ func (fake *FakeWorker) FindContainerOnWorkerReturnsOnCall(i int, result1 db.Cre
 	}{result1, result2, result3}
 }
 
+func (fake *FakeWorker) FreeDisk() int64 {
+	fake.freeDiskMutex.Lock()
+	ret, specificReturn := fake.freeDiskReturnsOnCall[len(fake.freeDiskArgsForCall)]
+	fake.freeDiskArgsForCall = append(fake.freeDiskArgsForCall, struct {
+	}{})
+	fake.recordInvocation("FreeDisk", []interface{}{})
+	fake.freeDiskMutex.Unlock()
+	if fake.FreeDiskStub != nil {
+		return fake.FreeDiskStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.freeDiskReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) FreeDiskCallCount() int {
+	fake.freeDiskMutex.RLock()
+	defer fake.freeDiskMutex.RUnlock()
+	return len(fake.freeDiskArgsForCall)
+}
+
+func (fake *FakeWorker) FreeDiskCalls(stub func() int64) {
+	fake.freeDiskMutex.Lock()
+	defer fake.freeDiskMutex.Unlock()
+	fake.FreeDiskStub = stub
+}
+
+func (fake *FakeWorker) FreeDiskReturns(result1 int64) {
+	fake.freeDiskMutex.Lock()
+	defer fake.freeDiskMutex.Unlock()
+	fake.FreeDiskStub = nil
+	fake.freeDiskReturns = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakeWorker) FreeDiskReturnsOnCall(i int, result1 int64) {
+	fake.freeDiskMutex.Lock()
+	defer fake.freeDiskMutex.Unlock()
+	fake.FreeDiskStub = nil
+	if fake.freeDiskReturnsOnCall == nil {
+		fake.freeDiskReturnsOnCall = make(map[int]struct {
+			result1 int64
+		})
+	}
+	fake.freeDiskReturnsOnCall[i] = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakeWorker) FreeMemory() int64 {
+	fake.freeMemoryMutex.Lock()
+	ret, specificReturn := fake.freeMemoryReturnsOnCall[len(fake.freeMemoryArgsForCall)]
+	fake.freeMemoryArgsForCall = append(fake.freeMemoryArgsForCall, struct {
+	}{})
+	fake.recordInvocation("FreeMemory", []interface{}{})
+	fake.freeMemoryMutex.Unlock()
+	if fake.FreeMemoryStub != nil {
+		return fake.FreeMemoryStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.freeMemoryReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) FreeMemoryCallCount() int {
+	fake.freeMemoryMutex.RLock()
+	defer fake.freeMemoryMutex.RUnlock()
+	return len(fake.freeMemoryArgsForCall)
+}
+
+func (fake *FakeWorker) FreeMemoryCalls(stub func() int64) {
+	fake.freeMemoryMutex.Lock()
+	defer fake.freeMemoryMutex.Unlock()
+	fake.FreeMemoryStub = stub
+}
+
+func (fake *FakeWorker) FreeMemoryReturns(result1 int64) {
+	fake.freeMemoryMutex.Lock()
+	defer fake.freeMemoryMutex.Unlock()
+	fake.FreeMemoryStub = nil
+	fake.freeMemoryReturns = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakeWorker) FreeMemoryReturnsOnCall(i int, result1 int64) {
+	fake.freeMemoryMutex.Lock()
+	defer fake.freeMemoryMutex.Unlock()
+	fake.FreeMemoryStub = nil
+	if fake.freeMemoryReturnsOnCall == nil {
+		fake.freeMemoryReturnsOnCall = make(map[int]struct {
+			result1 int64
+		})
+	}
+	fake.freeMemoryReturnsOnCall[i] = struct {
+		result1 int64
+	}{result1}
+}
+
 func (fake *FakeWorker) GardenAddr() *string {
 	fake.gardenAddrMutex.Lock()
 	ret, specificReturn := fake.gardenAddrReturnsOnCall[len(fake.gardenAddrArgsForCall)]
@@ -1001,6 +1135,58 @@ func (fake *FakeWorker) LandReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) MaxContainers() int {
+	fake.maxContainersMutex.Lock()
+	ret, specificReturn := fake.maxContainersReturnsOnCall[len(fake.maxContainersArgsForCall)]
+	fake.maxContainersArgsForCall = append(fake.maxContainersArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MaxContainers", []interface{}{})
+	fake.maxContainersMutex.Unlock()
+	if fake.MaxContainersStub != nil {
+		return fake.MaxContainersStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.maxContainersReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) MaxContainersCallCount() int {
+	fake.maxContainersMutex.RLock()
+	defer fake.maxContainersMutex.RUnlock()
+	return len(fake.maxContainersArgsForCall)
+}
+
+func (fake *FakeWorker) MaxContainersCalls(stub func() int) {
+	fake.maxContainersMutex.Lock()
+	defer fake.maxContainersMutex.Unlock()
+	fake.MaxContainersStub = stub
+}
+
+func (fake *FakeWorker) MaxContainersReturns(result1 int) {
+	fake.maxContainersMutex.Lock()
+	defer fake.maxContainersMutex.Unlock()
+	fake.MaxContainersStub = nil
+	fake.maxContainersReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeWorker) MaxContainersReturnsOnCall(i int, result1 int) {
+	fake.maxContainersMutex.Lock()
+	defer fake.maxContainersMutex.Unlock()
+	fake.MaxContainersStub = nil
+	if fake.maxContainersReturnsOnCall == nil {
+		fake.maxContainersReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.maxContainersReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeWorker) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1759,6 +1945,10 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.expiresAtMutex.RUnlock()
 	fake.findContainerOnWorkerMutex.RLock()
 	defer fake.findContainerOnWorkerMutex.RUnlock()
+	fake.freeDiskMutex.RLock()
+	defer fake.freeDiskMutex.RUnlock()
+	fake.freeMemoryMutex.RLock()
+	defer fake.freeMemoryMutex.RUnlock()
 	fake.gardenAddrMutex.RLock()
 	defer fake.gardenAddrMutex.RUnlock()
 	fake.hTTPProxyURLMutex.RLock()
@@ -1767,6 +1957,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.hTTPSProxyURLMutex.RUnlock()
 	fake.landMutex.RLock()
 	defer fake.landMutex.RUnlock()
+	fake.maxContainersMutex.RLock()
+	defer fake.maxContainersMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.noProxyMutex.RLock()
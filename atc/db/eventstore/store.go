@@ -0,0 +1,37 @@
+package eventstore
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/concourse/concourse/atc/db/blobstore"
+)
+
+// Store stores the events of each completed build as a single blob, keyed
+// by the build's ID.
+type Store struct {
+	blobs blobstore.Store
+}
+
+func New(blobs blobstore.Store) *Store {
+	return &Store{
+		blobs: blobs,
+	}
+}
+
+func (store *Store) Put(buildID int, events io.Reader) error {
+	return store.blobs.Put(key(buildID), "application/json", events)
+}
+
+func (store *Store) Get(buildID int) (io.ReadCloser, error) {
+	return store.blobs.Get(key(buildID))
+}
+
+// Delete removes the build's events, if any have been stored.
+func (store *Store) Delete(buildID int) error {
+	return store.blobs.Delete(key(buildID))
+}
+
+func key(buildID int) string {
+	return fmt.Sprintf("%d.json", buildID)
+}
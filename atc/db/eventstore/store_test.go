@@ -0,0 +1,66 @@
+package eventstore_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/concourse/atc/db/blobstore"
+	"github.com/concourse/concourse/atc/db/eventstore"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Store", func() {
+	var (
+		dir   string
+		store *eventstore.Store
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "event-store")
+		Expect(err).ToNot(HaveOccurred())
+
+		store = eventstore.New(blobstore.NewFileStore(dir))
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("stores and returns the build's events", func() {
+		err := store.Put(42, strings.NewReader("some-events"))
+		Expect(err).ToNot(HaveOccurred())
+
+		events, err := store.Get(42)
+		Expect(err).ToNot(HaveOccurred())
+
+		defer events.Close()
+
+		contents, err := ioutil.ReadAll(events)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(contents)).To(Equal("some-events"))
+	})
+
+	It("stores the build's events under the build's ID", func() {
+		err := store.Put(42, strings.NewReader("some-events"))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = os.Stat(filepath.Join(dir, "42.json"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("deletes the build's events", func() {
+		err := store.Put(42, strings.NewReader("some-events"))
+		Expect(err).ToNot(HaveOccurred())
+
+		err = store.Delete(42)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = os.Stat(filepath.Join(dir, "42.json"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+})
@@ -24,6 +24,8 @@ type Job interface {
 	TeamName() string
 	Config() atc.JobConfig
 	Tags() []string
+	MaxInFlightReached() bool
+	InputResolutionErrors() map[string]string
 
 	Reload() (bool, error)
 
@@ -31,6 +33,7 @@ type Job interface {
 	Unpause() error
 
 	CreateBuild() (Build, error)
+	RerunBuild(Build) (Build, error)
 	Builds(page Page) ([]Build, Pagination, error)
 	BuildsWithTime(page Page) ([]Build, Pagination, error)
 	Build(name string) (Build, bool, error)
@@ -45,6 +48,8 @@ type Job interface {
 	SaveIndependentInputMapping(inputMapping algorithm.InputMapping) error
 	DeleteNextInputMapping() error
 
+	SaveInputResolutionErrors(map[string]string) error
+
 	SetMaxInFlightReached(bool) error
 	GetRunningBuildsBySerialGroup(serialGroups []string) ([]Build, error)
 	GetNextPendingBuildBySerialGroup(serialGroups []string) (Build, bool, error)
@@ -52,7 +57,7 @@ type Job interface {
 	ClearTaskCache(string, string) (int64, error)
 }
 
-var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.team_id", "t.name", "j.nonce", "array_to_json(j.tags)").
+var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.team_id", "t.name", "j.nonce", "array_to_json(j.tags)", "j.max_in_flight_reached", "j.input_resolution_errors").
 	From("jobs j, pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id").
 	Where(sq.Expr("j.pipeline_id = p.id"))
@@ -79,8 +84,12 @@ type job struct {
 	config             atc.JobConfig
 	tags               []string
 
+	maxInFlightReached    bool
+	inputResolutionErrors map[string]string
+
 	conn        Conn
 	lockFactory lock.LockFactory
+	stores      BuildStores
 }
 
 type Jobs []Job
@@ -106,6 +115,9 @@ func (j *job) TeamName() string        { return j.teamName }
 func (j *job) Config() atc.JobConfig   { return j.config }
 func (j *job) Tags() []string          { return j.tags }
 
+func (j *job) MaxInFlightReached() bool                 { return j.maxInFlightReached }
+func (j *job) InputResolutionErrors() map[string]string { return j.inputResolutionErrors }
+
 func (j *job) Reload() (bool, error) {
 	row := jobsQuery.Where(sq.Eq{"j.id": j.id}).
 		RunWith(j.conn).
@@ -192,7 +204,7 @@ func (j *job) BuildsWithTime(page Page) ([]Build, Pagination, error) {
 			"j.name":        j.name,
 			"j.pipeline_id": j.pipelineID,
 		})
-	return getBuildsWithDates(newBuildsQuery, newMinMaxIdQuery, page, j.conn, j.lockFactory)
+	return getBuildsWithDates(newBuildsQuery, newMinMaxIdQuery, page, j.conn, j.lockFactory, j.stores)
 }
 
 func (j *job) Builds(page Page) ([]Build, Pagination, error) {
@@ -204,7 +216,7 @@ func (j *job) Builds(page Page) ([]Build, Pagination, error) {
 			"j.pipeline_id": j.pipelineID,
 		})
 
-	return getBuildsWithPagination(newBuildsQuery, newMinMaxIdQuery, page, j.conn, j.lockFactory)
+	return getBuildsWithPagination(newBuildsQuery, newMinMaxIdQuery, page, j.conn, j.lockFactory, j.stores)
 }
 
 func (j *job) Build(name string) (Build, bool, error) {
@@ -224,7 +236,7 @@ func (j *job) Build(name string) (Build, bool, error) {
 
 	row := query.RunWith(j.conn).QueryRow()
 
-	build := &build{conn: j.conn, lockFactory: j.lockFactory}
+	build := &build{conn: j.conn, lockFactory: j.lockFactory, stores: j.stores}
 
 	err := scanBuild(build, row, j.conn.EncryptionStrategy())
 	if err != nil {
@@ -256,7 +268,7 @@ func (j *job) GetNextPendingBuildBySerialGroup(serialGroups []string) (Build, bo
 		RunWith(j.conn).
 		QueryRow()
 
-	build := &build{conn: j.conn, lockFactory: j.lockFactory}
+	build := &build{conn: j.conn, lockFactory: j.lockFactory, stores: j.stores}
 	err = scanBuild(build, row, j.conn.EncryptionStrategy())
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -295,7 +307,7 @@ func (j *job) GetRunningBuildsBySerialGroup(serialGroups []string) ([]Build, err
 	bs := []Build{}
 
 	for rows.Next() {
-		build := &build{conn: j.conn, lockFactory: j.lockFactory}
+		build := &build{conn: j.conn, lockFactory: j.lockFactory, stores: j.stores}
 		err = scanBuild(build, rows, j.conn.EncryptionStrategy())
 		if err != nil {
 			return nil, err
@@ -331,6 +343,29 @@ func (j *job) SetMaxInFlightReached(reached bool) error {
 	return nil
 }
 
+// SaveInputResolutionErrors replaces the recorded reasons, keyed by input
+// name, that inputs of the job could not be resolved.
+func (j *job) SaveInputResolutionErrors(resolutionErrors map[string]string) error {
+	if resolutionErrors == nil {
+		resolutionErrors = map[string]string{}
+	}
+
+	payload, err := json.Marshal(resolutionErrors)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("jobs").
+		Set("input_resolution_errors", payload).
+		Where(sq.Eq{
+			"id": j.id,
+		}).
+		RunWith(j.conn).
+		Exec()
+
+	return err
+}
+
 func (j *job) SaveIndependentInputMapping(inputMapping algorithm.InputMapping) error {
 	return j.saveJobInputMapping("independent_build_inputs", inputMapping)
 }
@@ -456,7 +491,7 @@ func (j *job) GetPendingBuilds() ([]Build, error) {
 		"j.pipeline_id": j.pipelineID,
 	}).RunWith(j.conn).QueryRow()
 
-	job := &job{conn: j.conn, lockFactory: j.lockFactory}
+	job := &job{conn: j.conn, lockFactory: j.lockFactory, stores: j.stores}
 	err := scanJob(job, row)
 	if err != nil {
 		return nil, err
@@ -477,7 +512,7 @@ func (j *job) GetPendingBuilds() ([]Build, error) {
 	defer Close(rows)
 
 	for rows.Next() {
-		build := &build{conn: j.conn, lockFactory: j.lockFactory}
+		build := &build{conn: j.conn, lockFactory: j.lockFactory, stores: j.stores}
 		err = scanBuild(build, rows, j.conn.EncryptionStrategy())
 		if err != nil {
 			return nil, err
@@ -502,7 +537,7 @@ func (j *job) CreateBuild() (Build, error) {
 		return nil, err
 	}
 
-	build := &build{conn: j.conn, lockFactory: j.lockFactory}
+	build := &build{conn: j.conn, lockFactory: j.lockFactory, stores: j.stores}
 	err = createBuild(tx, build, map[string]interface{}{
 		"name":               buildName,
 		"job_id":             j.id,
@@ -528,6 +563,48 @@ func (j *job) CreateBuild() (Build, error) {
 	return build, nil
 }
 
+// RerunBuild creates a new pending build of the job which, once scheduled,
+// uses exactly the inputs of the given build rather than resolving new ones.
+func (j *job) RerunBuild(buildToRerun Build) (Build, error) {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Rollback(tx)
+
+	buildName, err := j.getNewBuildName(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	build := &build{conn: j.conn, lockFactory: j.lockFactory, stores: j.stores}
+	err = createBuild(tx, build, map[string]interface{}{
+		"name":               buildName,
+		"job_id":             j.id,
+		"pipeline_id":        j.pipelineID,
+		"team_id":            j.teamID,
+		"status":             BuildStatusPending,
+		"manually_triggered": true,
+		"rerun_of":           buildToRerun.ID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = updateNextBuildForJob(tx, j.id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return build, nil
+}
+
 func (j *job) ClearTaskCache(stepName string, cachePath string) (int64, error) {
 	tx, err := j.conn.Begin()
 	if err != nil {
@@ -765,7 +842,7 @@ func (j *job) nextBuild() (Build, error) {
 		RunWith(j.conn).
 		QueryRow()
 
-	nextBuild := &build{conn: j.conn, lockFactory: j.lockFactory}
+	nextBuild := &build{conn: j.conn, lockFactory: j.lockFactory, stores: j.stores}
 	err := scanBuild(nextBuild, row, j.conn.EncryptionStrategy())
 	if err == nil {
 		next = nextBuild
@@ -785,7 +862,7 @@ func (j *job) finishedBuild() (Build, error) {
 		RunWith(j.conn).
 		QueryRow()
 
-	finishedBuild := &build{conn: j.conn, lockFactory: j.lockFactory}
+	finishedBuild := &build{conn: j.conn, lockFactory: j.lockFactory, stores: j.stores}
 	err := scanBuild(finishedBuild, row, j.conn.EncryptionStrategy())
 	if err == nil {
 		finished = finishedBuild
@@ -802,9 +879,10 @@ func scanJob(j *job, row scannable) error {
 		nonce      sql.NullString
 		tagsBlob   []byte
 		tags       []string
+		errorsBlob []byte
 	)
 
-	err := row.Scan(&j.id, &j.name, &configBlob, &j.paused, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &j.teamID, &j.teamName, &nonce, &tagsBlob)
+	err := row.Scan(&j.id, &j.name, &configBlob, &j.paused, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &j.teamID, &j.teamName, &nonce, &tagsBlob, &j.maxInFlightReached, &errorsBlob)
 	if err != nil {
 		return err
 	}
@@ -836,16 +914,21 @@ func scanJob(j *job, row scannable) error {
 
 	j.tags = tags
 
+	err = json.Unmarshal(errorsBlob, &j.inputResolutionErrors)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func scanJobs(conn Conn, lockFactory lock.LockFactory, rows *sql.Rows) (Jobs, error) {
+func scanJobs(conn Conn, lockFactory lock.LockFactory, stores BuildStores, rows *sql.Rows) (Jobs, error) {
 	defer Close(rows)
 
 	jobs := Jobs{}
 
 	for rows.Next() {
-		job := &job{conn: conn, lockFactory: lockFactory}
+		job := &job{conn: conn, lockFactory: lockFactory, stores: stores}
 
 		err := scanJob(job, rows)
 		if err != nil {
@@ -14,12 +14,14 @@ type JobFactory interface {
 type jobFactory struct {
 	conn        Conn
 	lockFactory lock.LockFactory
+	stores      BuildStores
 }
 
-func NewJobFactory(conn Conn, lockFactory lock.LockFactory) JobFactory {
+func NewJobFactory(conn Conn, lockFactory lock.LockFactory, stores BuildStores) JobFactory {
 	return &jobFactory{
 		conn:        conn,
 		lockFactory: lockFactory,
+		stores:      stores,
 	}
 }
 
@@ -36,7 +38,7 @@ func (j *jobFactory) VisibleJobs(teamNames []string) (Dashboard, error) {
 		return nil, err
 	}
 
-	currentTeamJobs, err := scanJobs(j.conn, j.lockFactory, rows)
+	currentTeamJobs, err := scanJobs(j.conn, j.lockFactory, j.stores, rows)
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +58,7 @@ func (j *jobFactory) VisibleJobs(teamNames []string) (Dashboard, error) {
 		return nil, err
 	}
 
-	otherTeamPublicJobs, err := scanJobs(j.conn, j.lockFactory, rows)
+	otherTeamPublicJobs, err := scanJobs(j.conn, j.lockFactory, j.stores, rows)
 	if err != nil {
 		return nil, err
 	}
@@ -119,7 +121,7 @@ func (j *jobFactory) getBuildsFrom(col string, jobIDs []int) (map[int]Build, err
 	builds := make(map[int]Build)
 
 	for rows.Next() {
-		build := &build{conn: j.conn, lockFactory: j.lockFactory}
+		build := &build{conn: j.conn, lockFactory: j.lockFactory, stores: j.stores}
 		err := scanBuild(build, rows, j.conn.EncryptionStrategy())
 		if err != nil {
 			return nil, err
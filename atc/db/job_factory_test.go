@@ -11,7 +11,7 @@ var _ = Describe("Job Factory", func() {
 	var jobFactory db.JobFactory
 
 	BeforeEach(func() {
-		jobFactory = db.NewJobFactory(dbConn, lockFactory)
+		jobFactory = db.NewJobFactory(dbConn, lockFactory, db.BuildStores{})
 	})
 
 	Describe("VisibleJobs", func() {
@@ -23,7 +23,7 @@ var _ = Describe("Job Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "public-pipeline-job"},
 				},
-			}, db.ConfigVersion(0), db.PipelineUnpaused)
+			}, db.ConfigVersion(0), db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(publicPipeline.Expose()).To(Succeed())
 
@@ -31,7 +31,7 @@ var _ = Describe("Job Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "private-pipeline-job"},
 				},
-			}, db.ConfigVersion(0), db.PipelineUnpaused)
+			}, db.ConfigVersion(0), db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
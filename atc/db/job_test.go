@@ -83,7 +83,7 @@ var _ = Describe("Job", func() {
 					Type: "some-type",
 				},
 			},
-		}, db.ConfigVersion(0), db.PipelineUnpaused)
+		}, db.ConfigVersion(0), db.PipelineUnpaused, 0)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
 
@@ -121,6 +121,48 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("SaveInputResolutionErrors", func() {
+		It("starts out with no errors", func() {
+			Expect(job.InputResolutionErrors()).To(BeEmpty())
+		})
+
+		It("saves the errors, replacing any previous ones", func() {
+			err := job.SaveInputResolutionErrors(map[string]string{
+				"some-input":  "no version passed job 'job-1'",
+				"other-input": "no versions available",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = job.SaveInputResolutionErrors(map[string]string{
+				"some-input": "no version passed job 'job-2'",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			found, err := job.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			Expect(job.InputResolutionErrors()).To(Equal(map[string]string{
+				"some-input": "no version passed job 'job-2'",
+			}))
+		})
+	})
+
+	Describe("SetMaxInFlightReached", func() {
+		It("is reflected on the job", func() {
+			Expect(job.MaxInFlightReached()).To(BeFalse())
+
+			err := job.SetMaxInFlightReached(true)
+			Expect(err).NotTo(HaveOccurred())
+
+			found, err := job.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			Expect(job.MaxInFlightReached()).To(BeTrue())
+		})
+	})
+
 	Describe("FinishedAndNextBuild", func() {
 		var otherPipeline db.Pipeline
 		var otherJob db.Job
@@ -132,7 +174,7 @@ var _ = Describe("Job", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "some-job"},
 				},
-			}, db.ConfigVersion(0), db.PipelineUnpaused)
+			}, db.ConfigVersion(0), db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
 
@@ -358,7 +400,7 @@ var _ = Describe("Job", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -756,7 +798,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 
-			pipeline2, _, err = team.SavePipeline("some-pipeline-2", config, 1, db.PipelineUnpaused)
+			pipeline2, _, err = team.SavePipeline("some-pipeline-2", config, 1, db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource2, found, err = pipeline2.Resource("some-resource")
@@ -935,7 +977,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 
-			pipeline2, _, err = team.SavePipeline("some-pipeline-2", config, 1, db.PipelineUnpaused)
+			pipeline2, _, err = team.SavePipeline("some-pipeline-2", config, 1, db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource2, found, err = pipeline2.Resource("some-resource")
@@ -1087,7 +1129,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 			var err error
-			otherPipeline, _, err = team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
+			otherPipeline, _, err = team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			build1DB, err = job.CreateBuild()
@@ -20,6 +20,8 @@ const (
 	LockTypeVolumeCreating
 	LockTypeContainerCreating
 	LockTypeDatabaseMigration
+	LockTypeCheckQueue
+	LockTypeBuildQueue
 )
 
 var ErrLostLock = errors.New("lock was lost while held, possibly due to connection breakage")
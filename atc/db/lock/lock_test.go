@@ -44,7 +44,7 @@ var _ = Describe("Locks", func() {
 		lockFactory = lock.NewLockFactory(postgresRunner.OpenSingleton(), fakeLogFunc, fakeLogFunc)
 
 		dbConn = postgresRunner.OpenConn()
-		teamFactory = db.NewTeamFactory(dbConn, lockFactory)
+		teamFactory = db.NewTeamFactory(dbConn, lockFactory, db.BuildStores{})
 
 		var err error
 		team, err = teamFactory.CreateTeam(atc.Team{Name: "team-name"})
@@ -74,7 +74,7 @@ var _ = Describe("Locks", func() {
 					},
 				},
 			},
-		}, db.ConfigVersion(0), db.PipelineUnpaused)
+		}, db.ConfigVersion(0), db.PipelineUnpaused, 0)
 		Expect(err).NotTo(HaveOccurred())
 	})
 
@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE pipelines
+    ADD COLUMN parent_build_id integer REFERENCES builds (id) ON DELETE SET NULL;
+COMMIT;
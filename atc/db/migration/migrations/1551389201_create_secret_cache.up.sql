@@ -0,0 +1,13 @@
+BEGIN;
+  CREATE TABLE secret_cache (
+    id serial PRIMARY KEY,
+    key text NOT NULL,
+    value text NOT NULL,
+    nonce text,
+    lease_id text,
+    expires_at timestamp with time zone NOT NULL,
+    UNIQUE (key)
+  );
+
+  CREATE INDEX secret_cache_expires_at_idx ON secret_cache (expires_at);
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  DROP TABLE build_event_archives;
+COMMIT;
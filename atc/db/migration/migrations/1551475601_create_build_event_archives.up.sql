@@ -0,0 +1,9 @@
+BEGIN;
+  -- not referencing builds, so that the archived events of deleted builds
+  -- can still be found and removed from the build event store
+  CREATE TABLE build_event_archives (
+    build_id integer PRIMARY KEY,
+    event_count integer NOT NULL,
+    archived_at timestamp with time zone NOT NULL DEFAULT now()
+  );
+COMMIT;
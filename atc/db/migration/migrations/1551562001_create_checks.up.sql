@@ -0,0 +1,23 @@
+BEGIN;
+  CREATE TABLE checks (
+    id bigserial PRIMARY KEY,
+    resource_id integer REFERENCES resources (id) ON DELETE CASCADE,
+    resource_type_id integer REFERENCES resource_types (id) ON DELETE CASCADE,
+    from_version jsonb,
+    manually_triggered boolean NOT NULL DEFAULT false,
+    status text NOT NULL DEFAULT 'pending',
+    create_time timestamp with time zone NOT NULL DEFAULT now(),
+    start_time timestamp with time zone,
+    end_time timestamp with time zone,
+    check_error text,
+    CHECK ((resource_id IS NULL) != (resource_type_id IS NULL))
+  );
+
+  -- at most one check per resource or resource type may be waiting in the
+  -- queue at any time
+  CREATE UNIQUE INDEX checks_pending_resource_id_key ON checks (resource_id) WHERE status = 'pending';
+  CREATE UNIQUE INDEX checks_pending_resource_type_id_key ON checks (resource_type_id) WHERE status = 'pending';
+
+  CREATE INDEX checks_pending_idx ON checks (id) WHERE status = 'pending';
+  CREATE INDEX checks_start_time_idx ON checks (start_time);
+COMMIT;
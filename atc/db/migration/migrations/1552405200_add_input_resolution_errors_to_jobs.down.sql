@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE jobs
+    DROP COLUMN input_resolution_errors;
+COMMIT;
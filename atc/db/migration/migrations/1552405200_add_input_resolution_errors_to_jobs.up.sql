@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE jobs
+    ADD COLUMN input_resolution_errors jsonb NOT NULL DEFAULT '{}';
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+  CREATE TABLE build_queue (
+    build_id integer PRIMARY KEY REFERENCES builds (id) ON DELETE CASCADE,
+    priority integer NOT NULL DEFAULT 0,
+    queue_time timestamp with time zone NOT NULL DEFAULT now(),
+    heartbeat_time timestamp with time zone NOT NULL DEFAULT now()
+  );
+COMMIT;
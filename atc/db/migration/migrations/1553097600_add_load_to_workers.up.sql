@@ -0,0 +1,6 @@
+BEGIN;
+  ALTER TABLE workers
+    ADD COLUMN max_containers integer NOT NULL DEFAULT 0,
+    ADD COLUMN free_memory bigint NOT NULL DEFAULT 0,
+    ADD COLUMN free_disk bigint NOT NULL DEFAULT 0;
+COMMIT;
@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE builds
+    DROP COLUMN rerun_of;
+COMMIT;
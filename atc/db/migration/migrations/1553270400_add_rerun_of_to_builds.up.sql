@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE builds
+    ADD COLUMN rerun_of integer REFERENCES builds (id) ON DELETE SET NULL;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  DROP TABLE notification_deliveries;
+
+  DROP TABLE pipeline_notifications;
+COMMIT;
@@ -0,0 +1,30 @@
+BEGIN;
+  CREATE TABLE pipeline_notifications (
+    id serial PRIMARY KEY,
+    pipeline_id integer NOT NULL REFERENCES pipelines (id) ON DELETE CASCADE,
+    name text NOT NULL,
+    events text[] NOT NULL DEFAULT '{}',
+    config text NOT NULL,
+    nonce text,
+    UNIQUE (pipeline_id, name)
+  );
+
+  CREATE TABLE notification_deliveries (
+    id serial PRIMARY KEY,
+    build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    pipeline_id integer NOT NULL REFERENCES pipelines (id) ON DELETE CASCADE,
+    notification text NOT NULL,
+    event text NOT NULL,
+    status text NOT NULL DEFAULT 'pending',
+    attempts integer NOT NULL DEFAULT 0,
+    response_status integer,
+    error text,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    next_attempt_at timestamp with time zone NOT NULL DEFAULT now(),
+    last_attempt_at timestamp with time zone
+  );
+
+  CREATE INDEX notification_deliveries_pipeline_id_idx ON notification_deliveries (pipeline_id);
+
+  CREATE INDEX notification_deliveries_pending_idx ON notification_deliveries (next_attempt_at) WHERE status = 'pending';
+COMMIT;
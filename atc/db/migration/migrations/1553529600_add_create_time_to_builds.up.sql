@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE builds ADD COLUMN create_time timestamp with time zone DEFAULT now() NOT NULL;
+COMMIT;
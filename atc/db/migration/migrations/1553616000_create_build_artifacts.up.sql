@@ -0,0 +1,8 @@
+BEGIN;
+  CREATE TABLE build_artifacts (
+    build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    name text NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    PRIMARY KEY (build_id, name)
+  );
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+  DROP INDEX checks_resource_id_idx;
+
+  ALTER TABLE checks
+    DROP COLUMN worker_name,
+    DROP COLUMN exit_status,
+    DROP COLUMN stderr,
+    DROP COLUMN versions;
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+  ALTER TABLE checks
+    ADD COLUMN worker_name text,
+    ADD COLUMN exit_status integer,
+    ADD COLUMN stderr text,
+    ADD COLUMN versions jsonb;
+
+  CREATE INDEX checks_resource_id_idx ON checks (resource_id, id DESC);
+COMMIT;
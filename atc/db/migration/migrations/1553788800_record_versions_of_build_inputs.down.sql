@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE build_resource_config_version_inputs
+    DROP COLUMN version;
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+  ALTER TABLE build_resource_config_version_inputs
+    ADD COLUMN version jsonb;
+
+  UPDATE build_resource_config_version_inputs i
+  SET version = v.version
+  FROM resources r, resource_config_versions v
+  WHERE r.id = i.resource_id
+  AND v.resource_config_id = r.resource_config_id
+  AND v.version_md5 = i.version_md5;
+COMMIT;
@@ -0,0 +1,229 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+// A NotificationDelivery records the sending of a build's status to one of
+// its pipeline's notifications.
+type NotificationDelivery struct {
+	ID           int
+	BuildID      int
+	BuildName    string
+	JobName      string
+	PipelineID   int
+	Notification string
+	Event        atc.NotificationEvent
+
+	Status         atc.NotificationDeliveryStatus
+	Attempts       int
+	ResponseStatus int
+	Error          string
+
+	CreatedAt     time.Time
+	LastAttemptAt time.Time
+}
+
+var notificationDeliveriesQuery = psql.Select("d.id, d.build_id, b.name, j.name, d.pipeline_id, d.notification, d.event, d.status, d.attempts, d.response_status, d.error, d.created_at, d.last_attempt_at").
+	From("notification_deliveries d").
+	Join("builds b ON b.id = d.build_id").
+	LeftJoin("jobs j ON j.id = b.job_id")
+
+func (t *team) saveNotifications(tx Tx, notifications atc.NotificationConfigs, pipelineID int) error {
+	_, err := psql.Delete("pipeline_notifications").
+		Where(sq.Eq{"pipeline_id": pipelineID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	for _, notification := range notifications {
+		payload, err := json.Marshal(notification)
+		if err != nil {
+			return err
+		}
+
+		encryptedPayload, nonce, err := t.conn.EncryptionStrategy().Encrypt(payload)
+		if err != nil {
+			return err
+		}
+
+		events := make([]string, len(notification.Events))
+		for i, event := range notification.Events {
+			events[i] = string(event)
+		}
+
+		_, err = psql.Insert("pipeline_notifications").
+			Columns("pipeline_id", "name", "events", "config", "nonce").
+			Values(pipelineID, notification.Name, pq.Array(events), encryptedPayload, nonce).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *pipeline) Notifications() (atc.NotificationConfigs, error) {
+	rows, err := psql.Select("config, nonce").
+		From("pipeline_notifications").
+		Where(sq.Eq{"pipeline_id": p.id}).
+		OrderBy("id ASC").
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	notifications := atc.NotificationConfigs{}
+	for rows.Next() {
+		var config string
+		var nonce sql.NullString
+
+		err = rows.Scan(&config, &nonce)
+		if err != nil {
+			return nil, err
+		}
+
+		notification, err := decryptNotification(p.conn, config, nonce)
+		if err != nil {
+			return nil, err
+		}
+
+		notifications = append(notifications, notification)
+	}
+
+	return notifications, nil
+}
+
+// NotificationDeliveries returns the most recent deliveries to the pipeline's
+// notifications, newest first.
+func (p *pipeline) NotificationDeliveries(limit int) ([]NotificationDelivery, error) {
+	rows, err := notificationDeliveriesQuery.
+		Where(sq.Eq{"d.pipeline_id": p.id}).
+		OrderBy("d.id DESC").
+		Limit(uint64(limit)).
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	deliveries := []NotificationDelivery{}
+	for rows.Next() {
+		delivery, err := scanNotificationDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+// queueNotificationDeliveries records a pending delivery of the event to each
+// of the pipeline's notifications which subscribe to it, as part of the
+// transaction changing the build's status.
+func queueNotificationDeliveries(tx Tx, buildID int, pipelineID int, event atc.NotificationEvent) error {
+	_, err := tx.Exec(`
+		INSERT INTO notification_deliveries (build_id, pipeline_id, notification, event)
+		SELECT $1, n.pipeline_id, n.name, $3
+		FROM pipeline_notifications n
+		WHERE n.pipeline_id = $2
+		AND (cardinality(n.events) = 0 OR $3 = ANY(n.events))
+		ORDER BY n.id
+	`, buildID, pipelineID, string(event))
+	return err
+}
+
+func notificationEventForStatus(status BuildStatus) (atc.NotificationEvent, bool) {
+	switch status {
+	case BuildStatusStarted:
+		return atc.NotificationEventStarted, true
+	case BuildStatusSucceeded:
+		return atc.NotificationEventSucceeded, true
+	case BuildStatusFailed:
+		return atc.NotificationEventFailed, true
+	case BuildStatusErrored:
+		return atc.NotificationEventErrored, true
+	case BuildStatusAborted:
+		return atc.NotificationEventAborted, true
+	default:
+		return "", false
+	}
+}
+
+func decryptNotification(conn Conn, config string, nonce sql.NullString) (atc.NotificationConfig, error) {
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decrypted, err := conn.EncryptionStrategy().Decrypt(config, noncense)
+	if err != nil {
+		return atc.NotificationConfig{}, err
+	}
+
+	var notification atc.NotificationConfig
+	err = json.Unmarshal(decrypted, &notification)
+	if err != nil {
+		return atc.NotificationConfig{}, err
+	}
+
+	return notification, nil
+}
+
+func scanNotificationDelivery(row scannable, extra ...interface{}) (NotificationDelivery, error) {
+	var (
+		delivery       NotificationDelivery
+		jobName        sql.NullString
+		event, status  string
+		responseStatus sql.NullInt64
+		errorMessage   sql.NullString
+		lastAttemptAt  pq.NullTime
+	)
+
+	dest := []interface{}{
+		&delivery.ID,
+		&delivery.BuildID,
+		&delivery.BuildName,
+		&jobName,
+		&delivery.PipelineID,
+		&delivery.Notification,
+		&event,
+		&status,
+		&delivery.Attempts,
+		&responseStatus,
+		&errorMessage,
+		&delivery.CreatedAt,
+		&lastAttemptAt,
+	}
+
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return NotificationDelivery{}, err
+	}
+
+	delivery.JobName = jobName.String
+	delivery.Event = atc.NotificationEvent(event)
+	delivery.Status = atc.NotificationDeliveryStatus(status)
+	delivery.ResponseStatus = int(responseStatus.Int64)
+	delivery.Error = errorMessage.String
+	delivery.LastAttemptAt = lastAttemptAt.Time
+
+	return delivery, nil
+}
@@ -0,0 +1,141 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// A PendingNotificationDelivery is a delivery which is due to be attempted,
+// along with the notification it is to be sent to.
+type PendingNotificationDelivery struct {
+	NotificationDelivery
+
+	TeamName     string
+	PipelineName string
+
+	// Config is the notification's current configuration. Configured is false
+	// if the notification has since been removed from the pipeline.
+	Config     atc.NotificationConfig
+	Configured bool
+}
+
+//go:generate counterfeiter . NotificationDeliveryFactory
+
+// A NotificationDeliveryFactory manages the deliveries queued as builds
+// change status.
+type NotificationDeliveryFactory interface {
+	// PendingDeliveries returns the oldest deliveries which are due to be
+	// attempted.
+	PendingDeliveries(limit int) ([]PendingNotificationDelivery, error)
+
+	// MarkDelivered records a successful attempt.
+	MarkDelivered(id int, responseStatus int) error
+
+	// MarkFailed records a failed attempt. The delivery is attempted again at
+	// retryAt, or never if retryAt is zero.
+	MarkFailed(id int, responseStatus int, message string, retryAt time.Time) error
+
+	// CleanupDeliveries removes delivered and failed deliveries which were
+	// queued longer ago than the retention period.
+	CleanupDeliveries(retention time.Duration) error
+}
+
+type notificationDeliveryFactory struct {
+	conn Conn
+}
+
+func NewNotificationDeliveryFactory(conn Conn) NotificationDeliveryFactory {
+	return &notificationDeliveryFactory{
+		conn: conn,
+	}
+}
+
+func (f *notificationDeliveryFactory) PendingDeliveries(limit int) ([]PendingNotificationDelivery, error) {
+	rows, err := notificationDeliveriesQuery.
+		Columns("t.name, p.name, n.config, n.nonce").
+		Join("pipelines p ON p.id = d.pipeline_id").
+		Join("teams t ON t.id = p.team_id").
+		LeftJoin("pipeline_notifications n ON n.pipeline_id = d.pipeline_id AND n.name = d.notification").
+		Where(sq.Eq{"d.status": string(atc.NotificationDeliveryPending)}).
+		Where(sq.Expr("d.next_attempt_at <= now()")).
+		OrderBy("d.id ASC").
+		Limit(uint64(limit)).
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	deliveries := []PendingNotificationDelivery{}
+	for rows.Next() {
+		var (
+			pending       PendingNotificationDelivery
+			config, nonce sql.NullString
+		)
+
+		pending.NotificationDelivery, err = scanNotificationDelivery(rows, &pending.TeamName, &pending.PipelineName, &config, &nonce)
+		if err != nil {
+			return nil, err
+		}
+
+		if config.Valid {
+			pending.Config, err = decryptNotification(f.conn, config.String, nonce)
+			if err != nil {
+				return nil, err
+			}
+
+			pending.Configured = true
+		}
+
+		deliveries = append(deliveries, pending)
+	}
+
+	return deliveries, nil
+}
+
+func (f *notificationDeliveryFactory) MarkDelivered(id int, responseStatus int) error {
+	_, err := psql.Update("notification_deliveries").
+		Set("status", string(atc.NotificationDeliveryDelivered)).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("response_status", responseStatus).
+		Set("error", nil).
+		Set("last_attempt_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": id}).
+		RunWith(f.conn).
+		Exec()
+	return err
+}
+
+func (f *notificationDeliveryFactory) MarkFailed(id int, responseStatus int, message string, retryAt time.Time) error {
+	update := psql.Update("notification_deliveries").
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("response_status", sql.NullInt64{Int64: int64(responseStatus), Valid: responseStatus != 0}).
+		Set("error", message).
+		Set("last_attempt_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": id})
+
+	if retryAt.IsZero() {
+		update = update.Set("status", string(atc.NotificationDeliveryFailed))
+	} else {
+		update = update.Set("next_attempt_at", retryAt)
+	}
+
+	_, err := update.RunWith(f.conn).Exec()
+	return err
+}
+
+func (f *notificationDeliveryFactory) CleanupDeliveries(retention time.Duration) error {
+	retentionMs := retention.Nanoseconds() / int64(time.Millisecond)
+
+	_, err := psql.Delete("notification_deliveries").
+		Where(sq.NotEq{"status": string(atc.NotificationDeliveryPending)}).
+		Where(sq.Expr("created_at < now() - (? * interval '1 millisecond')", retentionMs)).
+		RunWith(f.conn).
+		Exec()
+	return err
+}
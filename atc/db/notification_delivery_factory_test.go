@@ -0,0 +1,226 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NotificationDeliveryFactory", func() {
+	var (
+		deliveryFactory db.NotificationDeliveryFactory
+
+		pipeline db.Pipeline
+		job      db.Job
+		build    db.Build
+
+		notifications atc.NotificationConfigs
+	)
+
+	BeforeEach(func() {
+		deliveryFactory = db.NewNotificationDeliveryFactory(dbConn)
+
+		notifications = atc.NotificationConfigs{
+			{
+				Name:   "chat",
+				URL:    "https://chat.example.com/hook",
+				Events: []atc.NotificationEvent{atc.NotificationEventFailed, atc.NotificationEventErrored},
+				Secret: "some-secret",
+			},
+			{
+				Name: "audit",
+				URL:  "https://audit.example.com/builds",
+			},
+		}
+
+		var err error
+		pipeline, _, err = defaultTeam.SavePipeline("notifying-pipeline", atc.Config{
+			Jobs: atc.JobConfigs{
+				{Name: "some-job"},
+			},
+			Notifications: notifications,
+		}, db.ConfigVersion(0), db.PipelineUnpaused, 0)
+		Expect(err).ToNot(HaveOccurred())
+
+		var found bool
+		job, found, err = pipeline.Job("some-job")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		build, err = job.CreateBuild()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("saves the pipeline's notifications", func() {
+		saved, err := pipeline.Notifications()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(saved).To(Equal(notifications))
+	})
+
+	Context("when the build starts", func() {
+		BeforeEach(func() {
+			started, err := build.Start("engine", `{}`, atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(started).To(BeTrue())
+		})
+
+		It("queues a delivery to the notifications subscribing to the event", func() {
+			deliveries, err := deliveryFactory.PendingDeliveries(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveries).To(HaveLen(1))
+
+			delivery := deliveries[0]
+			Expect(delivery.BuildID).To(Equal(build.ID()))
+			Expect(delivery.BuildName).To(Equal(build.Name()))
+			Expect(delivery.JobName).To(Equal("some-job"))
+			Expect(delivery.PipelineName).To(Equal("notifying-pipeline"))
+			Expect(delivery.TeamName).To(Equal(defaultTeam.Name()))
+			Expect(delivery.Notification).To(Equal("audit"))
+			Expect(delivery.Event).To(Equal(atc.NotificationEventStarted))
+			Expect(delivery.Status).To(Equal(atc.NotificationDeliveryPending))
+			Expect(delivery.Configured).To(BeTrue())
+			Expect(delivery.Config).To(Equal(notifications[1]))
+		})
+
+		Context("and then fails", func() {
+			BeforeEach(func() {
+				err := build.Finish(db.BuildStatusFailed)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("queues a delivery to every notification subscribing to the event", func() {
+				deliveries, err := deliveryFactory.PendingDeliveries(10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deliveries).To(HaveLen(3))
+
+				Expect(deliveries[1].Notification).To(Equal("chat"))
+				Expect(deliveries[1].Event).To(Equal(atc.NotificationEventFailed))
+				Expect(deliveries[1].Config.Secret).To(Equal("some-secret"))
+
+				Expect(deliveries[2].Notification).To(Equal("audit"))
+				Expect(deliveries[2].Event).To(Equal(atc.NotificationEventFailed))
+			})
+
+			It("lists the deliveries for the pipeline, newest first", func() {
+				deliveries, err := pipeline.NotificationDeliveries(2)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deliveries).To(HaveLen(2))
+				Expect(deliveries[0].Notification).To(Equal("audit"))
+				Expect(deliveries[0].Event).To(Equal(atc.NotificationEventFailed))
+				Expect(deliveries[1].Notification).To(Equal("chat"))
+			})
+		})
+	})
+
+	Context("when a notification is removed from the pipeline", func() {
+		BeforeEach(func() {
+			_, err := build.Start("engine", `{}`, atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, err = defaultTeam.SavePipeline("notifying-pipeline", atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "some-job"},
+				},
+			}, pipeline.ConfigVersion(), db.PipelineNoChange, 0)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns its pending deliveries as no longer configured", func() {
+			deliveries, err := deliveryFactory.PendingDeliveries(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveries).To(HaveLen(1))
+			Expect(deliveries[0].Configured).To(BeFalse())
+		})
+	})
+
+	Describe("recording attempts", func() {
+		var delivery db.PendingNotificationDelivery
+
+		BeforeEach(func() {
+			_, err := build.Start("engine", `{}`, atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+
+			deliveries, err := deliveryFactory.PendingDeliveries(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveries).To(HaveLen(1))
+
+			delivery = deliveries[0]
+		})
+
+		It("records a delivered attempt", func() {
+			err := deliveryFactory.MarkDelivered(delivery.ID, 204)
+			Expect(err).ToNot(HaveOccurred())
+
+			pending, err := deliveryFactory.PendingDeliveries(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pending).To(BeEmpty())
+
+			deliveries, err := pipeline.NotificationDeliveries(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveries[0].Status).To(Equal(atc.NotificationDeliveryDelivered))
+			Expect(deliveries[0].Attempts).To(Equal(1))
+			Expect(deliveries[0].ResponseStatus).To(Equal(204))
+			Expect(deliveries[0].LastAttemptAt).NotTo(BeZero())
+		})
+
+		It("records a failed attempt to be retried later", func() {
+			err := deliveryFactory.MarkFailed(delivery.ID, 502, "bad gateway", time.Now().Add(time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+
+			pending, err := deliveryFactory.PendingDeliveries(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pending).To(BeEmpty())
+
+			deliveries, err := pipeline.NotificationDeliveries(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveries[0].Status).To(Equal(atc.NotificationDeliveryPending))
+			Expect(deliveries[0].Attempts).To(Equal(1))
+			Expect(deliveries[0].ResponseStatus).To(Equal(502))
+			Expect(deliveries[0].Error).To(Equal("bad gateway"))
+		})
+
+		It("records a failed attempt which is not to be retried", func() {
+			err := deliveryFactory.MarkFailed(delivery.ID, 0, "connection refused", time.Time{})
+			Expect(err).ToNot(HaveOccurred())
+
+			deliveries, err := pipeline.NotificationDeliveries(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveries[0].Status).To(Equal(atc.NotificationDeliveryFailed))
+			Expect(deliveries[0].ResponseStatus).To(BeZero())
+			Expect(deliveries[0].Error).To(Equal("connection refused"))
+		})
+
+		Describe("CleanupDeliveries", func() {
+			It("removes finished deliveries older than the retention period", func() {
+				err := deliveryFactory.MarkDelivered(delivery.ID, 200)
+				Expect(err).ToNot(HaveOccurred())
+
+				err = deliveryFactory.CleanupDeliveries(time.Hour)
+				Expect(err).ToNot(HaveOccurred())
+
+				deliveries, err := pipeline.NotificationDeliveries(10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deliveries).To(HaveLen(1))
+
+				err = deliveryFactory.CleanupDeliveries(0)
+				Expect(err).ToNot(HaveOccurred())
+
+				deliveries, err = pipeline.NotificationDeliveries(10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deliveries).To(BeEmpty())
+			})
+
+			It("keeps pending deliveries", func() {
+				err := deliveryFactory.CleanupDeliveries(0)
+				Expect(err).ToNot(HaveOccurred())
+
+				deliveries, err := pipeline.NotificationDeliveries(10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deliveries).To(HaveLen(1))
+			})
+		})
+	})
+})
@@ -117,11 +117,14 @@ func shouldRetry(err error) bool {
 }
 
 var encryptedColumns = map[string]string{
-	"teams":          "legacy_auth",
-	"resources":      "config",
-	"jobs":           "config",
-	"resource_types": "config",
-	"builds":         "engine_metadata",
+	"teams":                  "legacy_auth",
+	"resources":              "config",
+	"jobs":                   "config",
+	"resource_types":         "config",
+	"builds":                 "engine_metadata",
+	"pipelines":              "var_sources",
+	"secret_cache":           "value",
+	"pipeline_notifications": "config",
 }
 
 func encryptPlaintext(logger lager.Logger, sqlDB *sql.DB, key *encryption.Key) error {
@@ -38,8 +38,12 @@ type Pipeline interface {
 	TeamName() string
 	Groups() atc.GroupConfigs
 	ConfigVersion() ConfigVersion
+	VarSources() (atc.VarSourceConfigs, error)
+	Notifications() (atc.NotificationConfigs, error)
+	NotificationDeliveries(limit int) ([]NotificationDelivery, error)
 	Public() bool
 	Paused() bool
+	ParentBuildID() int
 
 	CheckPaused() (bool, error)
 	Reload() (bool, error)
@@ -55,6 +59,7 @@ type Pipeline interface {
 	BuildsWithTime(page Page) ([]Build, Pagination, error)
 
 	DeleteBuildEventsByBuildIDs(buildIDs []int) error
+	DeleteBuildArtifactsByBuildIDs(buildIDs []int) error
 
 	AcquireSchedulingLock(lager.Logger, time.Duration) (lock.Lock, bool, error)
 
@@ -89,12 +94,17 @@ type pipeline struct {
 	configVersion ConfigVersion
 	paused        bool
 	public        bool
+	parentBuildID int
+
+	encryptedVarSources *string
+	varSourcesNonce     *string
 
 	cacheIndex int
 	versionsDB *algorithm.VersionsDB
 
 	conn        Conn
 	lockFactory lock.LockFactory
+	stores      BuildStores
 }
 
 //ConfigVersion is a sequence identifier used for compare-and-swap
@@ -110,7 +120,10 @@ var pipelinesQuery = psql.Select(`
 		p.team_id,
 		t.name,
 		p.paused,
-		p.public
+		p.public,
+		p.parent_build_id,
+		p.var_sources,
+		p.nonce
 	`).
 	From("pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id")
@@ -137,10 +150,11 @@ func (state PipelinePausedState) Bool() *bool {
 	}
 }
 
-func newPipeline(conn Conn, lockFactory lock.LockFactory) *pipeline {
+func newPipeline(conn Conn, lockFactory lock.LockFactory, stores BuildStores) *pipeline {
 	return &pipeline{
 		conn:        conn,
 		lockFactory: lockFactory,
+		stores:      stores,
 	}
 }
 
@@ -152,6 +166,28 @@ func (p *pipeline) Groups() atc.GroupConfigs     { return p.groups }
 func (p *pipeline) ConfigVersion() ConfigVersion { return p.configVersion }
 func (p *pipeline) Public() bool                 { return p.public }
 func (p *pipeline) Paused() bool                 { return p.paused }
+func (p *pipeline) ParentBuildID() int           { return p.parentBuildID }
+
+// VarSources decrypts and returns the pipeline's var sources. They are only
+// decrypted when asked for, as they hold credentials for credential managers.
+func (p *pipeline) VarSources() (atc.VarSourceConfigs, error) {
+	if p.encryptedVarSources == nil {
+		return nil, nil
+	}
+
+	decryptedVarSources, err := p.conn.EncryptionStrategy().Decrypt(*p.encryptedVarSources, p.varSourcesNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	var varSources atc.VarSourceConfigs
+	err = json.Unmarshal(decryptedVarSources, &varSources)
+	if err != nil {
+		return nil, err
+	}
+
+	return varSources, nil
+}
 
 // IMPORTANT: This method is broken with the new resource config versions changes
 func (p *pipeline) Causality(versionedResourceID int) ([]Cause, error) {
@@ -258,7 +294,7 @@ func (p *pipeline) CreateJobBuild(jobName string) (Build, error) {
 		return nil, err
 	}
 
-	build := &build{conn: p.conn, lockFactory: p.lockFactory}
+	build := &build{conn: p.conn, lockFactory: p.lockFactory, stores: p.stores}
 	err = scanBuild(build, buildsQuery.
 		Where(sq.Eq{"b.id": buildID}).
 		RunWith(tx).
@@ -301,7 +337,7 @@ func (p *pipeline) GetAllPendingBuilds() (map[string][]Build, error) {
 	defer Close(rows)
 
 	for rows.Next() {
-		build := &build{conn: p.conn, lockFactory: p.lockFactory}
+		build := &build{conn: p.conn, lockFactory: p.lockFactory, stores: p.stores}
 		err = scanBuild(build, rows, p.conn.EncryptionStrategy())
 		if err != nil {
 			return nil, err
@@ -379,7 +415,7 @@ func (p *pipeline) GetBuildsWithVersionAsInput(resourceID, resourceConfigVersion
 
 	builds := []Build{}
 	for rows.Next() {
-		build := &build{conn: p.conn, lockFactory: p.lockFactory}
+		build := &build{conn: p.conn, lockFactory: p.lockFactory, stores: p.stores}
 		err = scanBuild(build, rows, p.conn.EncryptionStrategy())
 		if err != nil {
 			return nil, err
@@ -407,7 +443,7 @@ func (p *pipeline) GetBuildsWithVersionAsOutput(resourceID, resourceConfigVersio
 
 	builds := []Build{}
 	for rows.Next() {
-		build := &build{conn: p.conn, lockFactory: p.lockFactory}
+		build := &build{conn: p.conn, lockFactory: p.lockFactory, stores: p.stores}
 		err = scanBuild(build, rows, p.conn.EncryptionStrategy())
 		if err != nil {
 			return nil, err
@@ -440,12 +476,12 @@ func (p *pipeline) Resource(name string) (Resource, bool, error) {
 
 func (p *pipeline) Builds(page Page) ([]Build, Pagination, error) {
 	return getBuildsWithPagination(
-		buildsQuery.Where(sq.Eq{"b.pipeline_id": p.id}), minMaxIdQuery, page, p.conn, p.lockFactory)
+		buildsQuery.Where(sq.Eq{"b.pipeline_id": p.id}), minMaxIdQuery, page, p.conn, p.lockFactory, p.stores)
 }
 
 func (p *pipeline) BuildsWithTime(page Page) ([]Build, Pagination, error) {
 	return getBuildsWithDates(
-		buildsQuery.Where(sq.Eq{"b.pipeline_id": p.id}), minMaxIdQuery, page, p.conn, p.lockFactory)
+		buildsQuery.Where(sq.Eq{"b.pipeline_id": p.id}), minMaxIdQuery, page, p.conn, p.lockFactory, p.stores)
 }
 
 func (p *pipeline) Resources() (Resources, error) {
@@ -500,7 +536,7 @@ func (p *pipeline) Job(name string) (Job, bool, error) {
 		"j.pipeline_id": p.id,
 	}).RunWith(p.conn).QueryRow()
 
-	job := &job{conn: p.conn, lockFactory: p.lockFactory}
+	job := &job{conn: p.conn, lockFactory: p.lockFactory, stores: p.stores}
 	err := scanJob(job, row)
 
 	if err != nil {
@@ -527,7 +563,7 @@ func (p *pipeline) Jobs() (Jobs, error) {
 		return nil, err
 	}
 
-	jobs, err := scanJobs(p.conn, p.lockFactory, rows)
+	jobs, err := scanJobs(p.conn, p.lockFactory, p.stores, rows)
 	return jobs, err
 }
 
@@ -546,7 +582,7 @@ func (p *pipeline) Dashboard() (Dashboard, error) {
 		return nil, err
 	}
 
-	jobs, err := scanJobs(p.conn, p.lockFactory, rows)
+	jobs, err := scanJobs(p.conn, p.lockFactory, p.stores, rows)
 	if err != nil {
 		return nil, err
 	}
@@ -684,6 +720,8 @@ func (p *pipeline) LoadVersionsDB() (*algorithm.VersionsDB, error) {
 		ResourceVersions: []algorithm.ResourceVersion{},
 		JobIDs:           map[string]int{},
 		ResourceIDs:      map[string]int{},
+		Versions:         map[int]atc.Version{},
+		DisabledVersions: []algorithm.ResourceVersion{},
 	}
 
 	rows, err := psql.Select("v.id, v.check_order, r.id, o.build_id, b.job_id").
@@ -768,7 +806,7 @@ func (p *pipeline) LoadVersionsDB() (*algorithm.VersionsDB, error) {
 		}
 	}
 
-	rows, err = psql.Select("v.id, v.check_order, r.id").
+	rows, err = psql.Select("v.id, v.check_order, r.id, v.version").
 		From("resource_config_versions v").
 		Join("resources r ON r.resource_config_id = v.resource_config_id").
 		LeftJoin("resource_disabled_versions d ON d.resource_id = r.id AND d.version_md5 = v.version_md5").
@@ -790,12 +828,48 @@ func (p *pipeline) LoadVersionsDB() (*algorithm.VersionsDB, error) {
 
 	for rows.Next() {
 		var output algorithm.ResourceVersion
-		err = rows.Scan(&output.VersionID, &output.CheckOrder, &output.ResourceID)
+		var versionBlob string
+		err = rows.Scan(&output.VersionID, &output.CheckOrder, &output.ResourceID, &versionBlob)
+		if err != nil {
+			return nil, err
+		}
+
+		var version atc.Version
+		err = json.Unmarshal([]byte(versionBlob), &version)
 		if err != nil {
 			return nil, err
 		}
 
 		db.ResourceVersions = append(db.ResourceVersions, output)
+		db.Versions[output.VersionID] = version
+	}
+
+	rows, err = psql.Select("v.id, v.check_order, r.id").
+		From("resource_config_versions v").
+		Join("resources r ON r.resource_config_id = v.resource_config_id").
+		Join("resource_disabled_versions d ON d.resource_id = r.id AND d.version_md5 = v.version_md5").
+		Where(sq.NotEq{
+			"v.check_order": 0,
+		}).
+		Where(sq.Eq{
+			"r.pipeline_id": p.id,
+		}).
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var disabled algorithm.ResourceVersion
+		err = rows.Scan(&disabled.VersionID, &disabled.CheckOrder, &disabled.ResourceID)
+		if err != nil {
+			return nil, err
+		}
+
+		db.DisabledVersions = append(db.DisabledVersions, disabled)
 	}
 
 	rows, err = psql.Select("j.name, j.id").
@@ -878,6 +952,14 @@ func (p *pipeline) DeleteBuildEventsByBuildIDs(buildIDs []int) error {
 		return err
 	}
 
+	_, err = tx.Exec(`
+		DELETE FROM build_event_archives
+		WHERE build_id IN (`+strings.Join(indexStrings, ",")+`)
+	`, interfaceBuildIDs...)
+	if err != nil {
+		return err
+	}
+
 	_, err = tx.Exec(`
 		UPDATE builds
 		SET reap_time = now()
@@ -891,6 +973,58 @@ func (p *pipeline) DeleteBuildEventsByBuildIDs(buildIDs []int) error {
 	return err
 }
 
+// DeleteBuildArtifactsByBuildIDs removes the artifacts persisted by the
+// builds from the artifact store, and then forgets about them.
+func (p *pipeline) DeleteBuildArtifactsByBuildIDs(buildIDs []int) error {
+	if len(buildIDs) == 0 {
+		return nil
+	}
+
+	rows, err := psql.Select("DISTINCT build_id").
+		From("build_artifacts").
+		Where(sq.Eq{"build_id": buildIDs}).
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	defer Close(rows)
+
+	buildIDsWithArtifacts := []int{}
+	for rows.Next() {
+		var buildID int
+		err = rows.Scan(&buildID)
+		if err != nil {
+			return err
+		}
+
+		buildIDsWithArtifacts = append(buildIDsWithArtifacts, buildID)
+	}
+
+	if len(buildIDsWithArtifacts) == 0 {
+		return nil
+	}
+
+	// if the store is no longer configured, there is nothing more to do than
+	// forget about the artifacts
+	store := p.stores.Artifacts
+	if store != nil {
+		for _, buildID := range buildIDsWithArtifacts {
+			err = store.Delete(buildID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = psql.Delete("build_artifacts").
+		Where(sq.Eq{"build_id": buildIDsWithArtifacts}).
+		RunWith(p.conn).
+		Exec()
+	return err
+}
+
 func (p *pipeline) AcquireSchedulingLock(logger lager.Logger, interval time.Duration) (lock.Lock, bool, error) {
 	lock, acquired, err := p.lockFactory.Acquire(
 		logger.Session("lock", lager.Data{
@@ -948,7 +1082,7 @@ func (p *pipeline) CreateOneOffBuild() (Build, error) {
 
 	defer Rollback(tx)
 
-	build := &build{conn: p.conn, lockFactory: p.lockFactory}
+	build := &build{conn: p.conn, lockFactory: p.lockFactory, stores: p.stores}
 	err = createBuild(tx, build, map[string]interface{}{
 		"name":        sq.Expr("nextval('one_off_name')"),
 		"pipeline_id": p.id,
@@ -1002,7 +1136,7 @@ func (p *pipeline) getBuildsFrom(col string) (map[string]Build, error) {
 	nextBuilds := make(map[string]Build)
 
 	for rows.Next() {
-		build := &build{conn: p.conn, lockFactory: p.lockFactory}
+		build := &build{conn: p.conn, lockFactory: p.lockFactory, stores: p.stores}
 		err := scanBuild(build, rows, p.conn.EncryptionStrategy())
 		if err != nil {
 			return nil, err
@@ -15,12 +15,14 @@ type PipelineFactory interface {
 type pipelineFactory struct {
 	conn        Conn
 	lockFactory lock.LockFactory
+	stores      BuildStores
 }
 
-func NewPipelineFactory(conn Conn, lockFactory lock.LockFactory) PipelineFactory {
+func NewPipelineFactory(conn Conn, lockFactory lock.LockFactory, stores BuildStores) PipelineFactory {
 	return &pipelineFactory{
 		conn:        conn,
 		lockFactory: lockFactory,
+		stores:      stores,
 	}
 }
 
@@ -34,7 +36,7 @@ func (f *pipelineFactory) VisiblePipelines(teamNames []string) ([]Pipeline, erro
 		return nil, err
 	}
 
-	currentTeamPipelines, err := scanPipelines(f.conn, f.lockFactory, rows)
+	currentTeamPipelines, err := scanPipelines(f.conn, f.lockFactory, f.stores, rows)
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +51,7 @@ func (f *pipelineFactory) VisiblePipelines(teamNames []string) ([]Pipeline, erro
 		return nil, err
 	}
 
-	otherTeamPublicPipelines, err := scanPipelines(f.conn, f.lockFactory, rows)
+	otherTeamPublicPipelines, err := scanPipelines(f.conn, f.lockFactory, f.stores, rows)
 	if err != nil {
 		return nil, err
 	}
@@ -66,5 +68,5 @@ func (f *pipelineFactory) AllPipelines() ([]Pipeline, error) {
 		return nil, err
 	}
 
-	return scanPipelines(f.conn, f.lockFactory, rows)
+	return scanPipelines(f.conn, f.lockFactory, f.stores, rows)
 }
@@ -117,7 +117,7 @@ var _ = Describe("Pipeline", func() {
 			},
 		}
 		var created bool
-		pipeline, created, err = team.SavePipeline("fake-pipeline", pipelineConfig, db.ConfigVersion(0), db.PipelineUnpaused)
+		pipeline, created, err = team.SavePipeline("fake-pipeline", pipelineConfig, db.ConfigVersion(0), db.PipelineUnpaused, 0)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
 
@@ -397,10 +397,10 @@ var _ = Describe("Pipeline", func() {
 			}
 
 			var err error
-			dbPipeline, _, err = team.SavePipeline("pipeline-name", pipelineConfig, 0, db.PipelineUnpaused)
+			dbPipeline, _, err = team.SavePipeline("pipeline-name", pipelineConfig, 0, db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
-			otherDBPipeline, _, err = team.SavePipeline("other-pipeline-name", otherPipelineConfig, 0, db.PipelineUnpaused)
+			otherDBPipeline, _, err = team.SavePipeline("other-pipeline-name", otherPipelineConfig, 0, db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 			resource, _, err = dbPipeline.Resource(resourceName)
 			Expect(err).ToNot(HaveOccurred())
@@ -508,6 +508,11 @@ var _ = Describe("Pipeline", func() {
 				{VersionID: savedVR2.ID(), ResourceID: resource.ID(), CheckOrder: savedVR2.CheckOrder()},
 			}))
 
+			Expect(versions.Versions).To(Equal(map[int]atc.Version{
+				savedVR1.ID(): atc.Version{"version": "1"},
+				savedVR2.ID(): atc.Version{"version": "2"},
+			}))
+
 			Expect(versions.BuildOutputs).To(BeEmpty())
 			Expect(versions.ResourceIDs).To(Equal(map[string]int{
 				resource.Name():            resource.ID(),
@@ -966,7 +971,7 @@ var _ = Describe("Pipeline", func() {
 				},
 			}
 			var err error
-			pipelineDB, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipelineDB, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -1207,7 +1212,7 @@ var _ = Describe("Pipeline", func() {
 				},
 			}
 			var err error
-			otherPipeline, _, err = team.SavePipeline("other-pipeline-name", otherPipelineConfig, 0, db.PipelineUnpaused)
+			otherPipeline, _, err = team.SavePipeline("other-pipeline-name", otherPipelineConfig, 0, db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -2097,7 +2102,7 @@ var _ = Describe("Pipeline", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -2117,7 +2122,7 @@ var _ = Describe("Pipeline", func() {
 				Expect(found).To(BeTrue())
 			}
 
-			otherPipeline, _, err := team.SavePipeline("another-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused)
+			otherPipeline, _, err := team.SavePipeline("another-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			otherJob, found, err := otherPipeline.Job("some-job")
@@ -52,6 +52,9 @@ type Resource interface {
 	SetResourceConfig(lager.Logger, atc.Source, creds.VersionedResourceTypes) (ResourceConfig, error)
 	SetCheckError(error) error
 
+	SaveCheckHistoryEntry(CheckHistoryEntry) error
+	CheckHistory(limit int) ([]CheckHistoryEntry, error)
+
 	Reload() (bool, error)
 }
 
@@ -0,0 +1,194 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+	"unicode/utf8"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// CheckStderrLimit is the number of bytes of a check's stderr which are
+// recorded. Earlier output is dropped, as the end is the most likely to
+// explain a failure.
+const CheckStderrLimit = 10 * 1024
+
+// A CheckHistoryEntry records a single attempt to check a resource for new
+// versions.
+type CheckHistoryEntry struct {
+	ID         int
+	StartTime  time.Time
+	EndTime    time.Time
+	WorkerName string
+
+	// ExitStatus is nil if the check script did not run to completion, e.g.
+	// because no worker could be found or the check timed out.
+	ExitStatus *int
+	Stderr     string
+	CheckError string
+
+	Versions []atc.Version
+}
+
+// SaveCheckHistoryEntry records the outcome of checking the resource on the
+// check started from the queue. Checks which were run outside of the queue,
+// e.g. through the API, are recorded as finished checks of their own.
+func (r *resource) SaveCheckHistoryEntry(entry CheckHistoryEntry) error {
+	var versions interface{}
+	if len(entry.Versions) > 0 {
+		payload, err := json.Marshal(entry.Versions)
+		if err != nil {
+			return err
+		}
+
+		versions = string(payload)
+	}
+
+	workerName := sql.NullString{String: entry.WorkerName, Valid: entry.WorkerName != ""}
+	stderr := sql.NullString{String: truncateStderr(entry.Stderr), Valid: entry.Stderr != ""}
+	checkError := sql.NullString{String: entry.CheckError, Valid: entry.CheckError != ""}
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	result, err := psql.Update("checks").
+		Set("worker_name", workerName).
+		Set("exit_status", entry.ExitStatus).
+		Set("stderr", stderr).
+		Set("versions", versions).
+		Where(sq.Expr(`id = (
+			SELECT id
+			FROM checks
+			WHERE resource_id = ?
+			AND status = ?
+			ORDER BY id DESC
+			LIMIT 1
+		)`, r.id, CheckStatusStarted)).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		status := CheckStatusSucceeded
+		if checkError.Valid {
+			status = CheckStatusErrored
+		}
+
+		_, err = psql.Insert("checks").
+			Columns(
+				"resource_id",
+				"status",
+				"create_time",
+				"start_time",
+				"end_time",
+				"worker_name",
+				"exit_status",
+				"stderr",
+				"check_error",
+				"versions",
+			).
+			Values(
+				r.id,
+				status,
+				entry.StartTime,
+				entry.StartTime,
+				entry.EndTime,
+				workerName,
+				entry.ExitStatus,
+				stderr,
+				checkError,
+				versions,
+			).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// CheckHistory returns the most recent finished checks of the resource,
+// newest first. Checks which were skipped, e.g. because the resource had been
+// checked recently through another pipeline, are left out.
+func (r *resource) CheckHistory(limit int) ([]CheckHistoryEntry, error) {
+	rows, err := psql.Select("id, start_time, end_time, worker_name, exit_status, stderr, check_error, versions").
+		From("checks").
+		Where(sq.Eq{"resource_id": r.id}).
+		Where(sq.NotEq{"end_time": nil}).
+		Where(sq.Or{
+			sq.NotEq{"worker_name": nil},
+			sq.NotEq{"check_error": nil},
+		}).
+		OrderBy("id DESC").
+		Limit(uint64(limit)).
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	entries := []CheckHistoryEntry{}
+	for rows.Next() {
+		var (
+			entry                          CheckHistoryEntry
+			workerName, stderr, checkError sql.NullString
+			exitStatus                     sql.NullInt64
+			versions                       []byte
+		)
+
+		err = rows.Scan(&entry.ID, &entry.StartTime, &entry.EndTime, &workerName, &exitStatus, &stderr, &checkError, &versions)
+		if err != nil {
+			return nil, err
+		}
+
+		entry.WorkerName = workerName.String
+		entry.Stderr = stderr.String
+		entry.CheckError = checkError.String
+
+		if exitStatus.Valid {
+			status := int(exitStatus.Int64)
+			entry.ExitStatus = &status
+		}
+
+		if versions != nil {
+			err = json.Unmarshal(versions, &entry.Versions)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func truncateStderr(stderr string) string {
+	if len(stderr) <= CheckStderrLimit {
+		return stderr
+	}
+
+	start := len(stderr) - CheckStderrLimit
+	for start < len(stderr) && !utf8.RuneStart(stderr[start]) {
+		start++
+	}
+
+	return "(truncated)\n" + stderr[start:]
+}
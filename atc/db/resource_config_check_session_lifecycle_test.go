@@ -90,7 +90,7 @@ var _ = Describe("ResourceConfigCheckSessionLifecycle", func() {
 							},
 						},
 					},
-				}, defaultPipeline.ConfigVersion(), db.PipelineUnpaused)
+				}, defaultPipeline.ConfigVersion(), db.PipelineUnpaused, 0)
 				Expect(err).NotTo(HaveOccurred())
 
 				By("cleaning up inactive sessions")
@@ -183,7 +183,7 @@ var _ = Describe("ResourceConfigCheckSessionLifecycle", func() {
 						},
 					},
 					ResourceTypes: atc.ResourceTypes{},
-				}, defaultPipeline.ConfigVersion(), db.PipelineUnpaused)
+				}, defaultPipeline.ConfigVersion(), db.PipelineUnpaused, 0)
 				Expect(err).NotTo(HaveOccurred())
 
 				By("cleaning up inactive sessions")
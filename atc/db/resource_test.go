@@ -3,6 +3,8 @@ package db_test
 import (
 	"errors"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
@@ -52,6 +54,7 @@ var _ = Describe("Resource", func() {
 			},
 			0,
 			db.PipelineUnpaused,
+			0,
 		)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
@@ -240,6 +243,144 @@ var _ = Describe("Resource", func() {
 		})
 	})
 
+	Describe("CheckHistory", func() {
+		var resource db.Resource
+
+		BeforeEach(func() {
+			var err error
+			resource, _, err = pipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when the resource has never been checked", func() {
+			It("returns no entries", func() {
+				entries, err := resource.CheckHistory(10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(entries).To(BeEmpty())
+			})
+		})
+
+		Context("when checks have been recorded", func() {
+			var startTime time.Time
+
+			BeforeEach(func() {
+				startTime = time.Now().Truncate(time.Second)
+				exitStatus := 1
+
+				err := resource.SaveCheckHistoryEntry(db.CheckHistoryEntry{
+					StartTime:  startTime,
+					EndTime:    startTime.Add(time.Second),
+					WorkerName: "some-worker",
+					Versions:   []atc.Version{{"ref": "v1"}, {"ref": "v2"}},
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				err = resource.SaveCheckHistoryEntry(db.CheckHistoryEntry{
+					StartTime:  startTime.Add(time.Minute),
+					EndTime:    startTime.Add(time.Minute + time.Second),
+					WorkerName: "some-worker",
+					ExitStatus: &exitStatus,
+					Stderr:     "some-stderr",
+					CheckError: "some-error",
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("records them as finished checks", func() {
+				var count int
+				err := dbConn.QueryRow(`SELECT COUNT(*) FROM checks WHERE resource_id = $1 AND status IN ('succeeded', 'errored')`, resource.ID()).Scan(&count)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(count).To(Equal(2))
+			})
+
+			It("returns them newest first", func() {
+				entries, err := resource.CheckHistory(10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(entries).To(HaveLen(2))
+
+				Expect(entries[0].StartTime.Unix()).To(Equal(startTime.Add(time.Minute).Unix()))
+				Expect(*entries[0].ExitStatus).To(Equal(1))
+				Expect(entries[0].Stderr).To(Equal("some-stderr"))
+				Expect(entries[0].CheckError).To(Equal("some-error"))
+				Expect(entries[0].Versions).To(BeEmpty())
+
+				Expect(entries[1].StartTime.Unix()).To(Equal(startTime.Unix()))
+				Expect(entries[1].EndTime.Unix()).To(Equal(startTime.Add(time.Second).Unix()))
+				Expect(entries[1].WorkerName).To(Equal("some-worker"))
+				Expect(entries[1].ExitStatus).To(BeNil())
+				Expect(entries[1].Versions).To(Equal([]atc.Version{{"ref": "v1"}, {"ref": "v2"}}))
+			})
+
+			It("returns at most the given number of entries", func() {
+				entries, err := resource.CheckHistory(1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(entries).To(HaveLen(1))
+				Expect(entries[0].CheckError).To(Equal("some-error"))
+			})
+		})
+
+		Context("when a check of the resource has been started from the queue", func() {
+			var check db.Check
+
+			BeforeEach(func() {
+				checkFactory := db.NewCheckFactory(dbConn, lockFactory, db.BuildStores{})
+
+				_, err := checkFactory.CreateResourceCheck(resource.ID(), nil, false)
+				Expect(err).ToNot(HaveOccurred())
+
+				var acquired bool
+				check, acquired, err = checkFactory.AcquireCheck(0, 0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(acquired).To(BeTrue())
+
+				exitStatus := 0
+				err = resource.SaveCheckHistoryEntry(db.CheckHistoryEntry{
+					WorkerName: "some-worker",
+					ExitStatus: &exitStatus,
+					Versions:   []atc.Version{{"ref": "v1"}},
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("records the outcome on the check, once finished", func() {
+				entries, err := resource.CheckHistory(10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(entries).To(BeEmpty())
+
+				err = check.Finish(nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				entries, err = resource.CheckHistory(10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(entries).To(HaveLen(1))
+				Expect(entries[0].ID).To(Equal(check.ID()))
+				Expect(entries[0].WorkerName).To(Equal("some-worker"))
+				Expect(*entries[0].ExitStatus).To(Equal(0))
+				Expect(entries[0].Versions).To(Equal([]atc.Version{{"ref": "v1"}}))
+			})
+		})
+
+		Context("when a check's stderr is too long", func() {
+			It("keeps the end of it", func() {
+				stderr := strings.Repeat("a", db.CheckStderrLimit) + "the end"
+
+				err := resource.SaveCheckHistoryEntry(db.CheckHistoryEntry{
+					WorkerName: "some-worker",
+					Stderr:     stderr,
+					CheckError: "some-error",
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				entries, err := resource.CheckHistory(10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(entries).To(HaveLen(1))
+				Expect(entries[0].Stderr).To(HavePrefix("(truncated)\n"))
+				Expect(entries[0].Stderr).To(HaveSuffix("the end"))
+				Expect(len(entries[0].Stderr)).To(Equal(len("(truncated)\n") + db.CheckStderrLimit))
+			})
+		})
+	})
+
 	Describe("ResourceConfigVersion", func() {
 		var (
 			resource                   db.Resource
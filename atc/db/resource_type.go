@@ -5,12 +5,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/lib/pq"
 )
 
 type ResourceTypeNotFoundError struct {
@@ -32,6 +34,7 @@ type ResourceType interface {
 	Params() atc.Params
 	Tags() atc.Tags
 	CheckEvery() string
+	LastChecked() time.Time
 	CheckError() error
 	ResourceConfigCheckError() error
 
@@ -84,7 +87,7 @@ func (resourceTypes ResourceTypes) Configs() atc.ResourceTypes {
 	return configs
 }
 
-var resourceTypesQuery = psql.Select("r.id, r.name, r.type, r.config, rcv.version, r.nonce, r.check_error, c.check_error").
+var resourceTypesQuery = psql.Select("r.id, r.name, r.type, r.config, rcv.version, r.nonce, r.check_error, c.check_error, c.last_checked").
 	From("resource_types r").
 	LeftJoin("resource_configs c ON r.resource_config_id = c.id").
 	LeftJoin(`LATERAL (
@@ -106,6 +109,7 @@ type resourceType struct {
 	tags                     atc.Tags
 	version                  atc.Version
 	checkEvery               string
+	lastChecked              time.Time
 	checkError               error
 	resourceConfigCheckError error
 
@@ -118,6 +122,7 @@ func (t *resourceType) Name() string                    { return t.name }
 func (t *resourceType) Type() string                    { return t.type_ }
 func (t *resourceType) Privileged() bool                { return t.privileged }
 func (t *resourceType) CheckEvery() string              { return t.checkEvery }
+func (t *resourceType) LastChecked() time.Time          { return t.lastChecked }
 func (t *resourceType) Source() atc.Source              { return t.source }
 func (t *resourceType) Params() atc.Params              { return t.params }
 func (t *resourceType) Tags() atc.Tags                  { return t.tags }
@@ -201,13 +206,16 @@ func scanResourceType(t *resourceType, row scannable) error {
 	var (
 		configJSON                           []byte
 		checkErr, rcCheckErr, version, nonce sql.NullString
+		lastChecked                          pq.NullTime
 	)
 
-	err := row.Scan(&t.id, &t.name, &t.type_, &configJSON, &version, &nonce, &checkErr, &rcCheckErr)
+	err := row.Scan(&t.id, &t.name, &t.type_, &configJSON, &version, &nonce, &checkErr, &rcCheckErr, &lastChecked)
 	if err != nil {
 		return err
 	}
 
+	t.lastChecked = lastChecked.Time
+
 	if version.Valid {
 		err = json.Unmarshal([]byte(version.String), &t.version)
 		if err != nil {
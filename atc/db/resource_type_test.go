@@ -50,6 +50,7 @@ var _ = Describe("ResourceType", func() {
 			},
 			0,
 			db.PipelineUnpaused,
+			0,
 		)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
@@ -120,6 +121,7 @@ var _ = Describe("ResourceType", func() {
 					},
 					pipeline.ConfigVersion(),
 					db.PipelineUnpaused,
+					0,
 				)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(created).To(BeFalse())
@@ -0,0 +1,134 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type secretCache struct {
+	conn Conn
+}
+
+// NewSecretCache stores secrets fetched from a credential manager so that
+// they can be shared by all ATCs. Values are encrypted with the connection's
+// encryption strategy.
+func NewSecretCache(conn Conn) creds.SecretCache {
+	return &secretCache{
+		conn: conn,
+	}
+}
+
+// Get returns the value stored for the key, unless it has expired.
+func (cache *secretCache) Get(key string) ([]byte, bool, error) {
+	var value string
+	var nonce sql.NullString
+	err := psql.Select("value", "nonce").
+		From("secret_cache").
+		Where(sq.Eq{"key": key}).
+		Where(sq.Expr("expires_at > now()")).
+		RunWith(cache.conn).
+		QueryRow().
+		Scan(&value, &nonce)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decrypted, err := cache.conn.EncryptionStrategy().Decrypt(value, noncense)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return decrypted, true, nil
+}
+
+// Set stores the value for the key, replacing any existing value, until the
+// ttl elapses. The expiry is computed by the database so that it is
+// consistent across ATCs.
+func (cache *secretCache) Set(key string, value []byte, leaseID string, ttl time.Duration) error {
+	encrypted, nonce, err := cache.conn.EncryptionStrategy().Encrypt(value)
+	if err != nil {
+		return err
+	}
+
+	var lease sql.NullString
+	if leaseID != "" {
+		lease = sql.NullString{String: leaseID, Valid: true}
+	}
+
+	expiresAt := sq.Expr("now() + ?::interval", fmt.Sprintf("%d milliseconds", ttl/time.Millisecond))
+
+	_, err = psql.Insert("secret_cache").
+		Columns("key", "value", "nonce", "lease_id", "expires_at").
+		Values(key, encrypted, nonce, lease, expiresAt).
+		Suffix(`
+			ON CONFLICT (key) DO UPDATE SET
+				value = EXCLUDED.value,
+				nonce = EXCLUDED.nonce,
+				lease_id = EXCLUDED.lease_id,
+				expires_at = EXCLUDED.expires_at
+		`).
+		RunWith(cache.conn).
+		Exec()
+	return err
+}
+
+// Delete removes the value stored for the key, e.g. when its lease has been
+// revoked.
+func (cache *secretCache) Delete(key string) error {
+	_, err := psql.Delete("secret_cache").
+		Where(sq.Eq{"key": key}).
+		RunWith(cache.conn).
+		Exec()
+	return err
+}
+
+// Leases returns the lease IDs of the unexpired values which have one, by
+// key.
+func (cache *secretCache) Leases() (map[string]string, error) {
+	rows, err := psql.Select("key", "lease_id").
+		From("secret_cache").
+		Where(sq.NotEq{"lease_id": nil}).
+		Where(sq.Expr("expires_at > now()")).
+		RunWith(cache.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	leases := map[string]string{}
+	for rows.Next() {
+		var key, leaseID string
+		err := rows.Scan(&key, &leaseID)
+		if err != nil {
+			return nil, err
+		}
+
+		leases[key] = leaseID
+	}
+
+	return leases, nil
+}
+
+// DeleteExpired removes the values which have expired.
+func (cache *secretCache) DeleteExpired() error {
+	_, err := psql.Delete("secret_cache").
+		Where(sq.Expr("expires_at <= now()")).
+		RunWith(cache.conn).
+		Exec()
+	return err
+}
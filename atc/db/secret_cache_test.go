@@ -0,0 +1,92 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SecretCache", func() {
+	var secretCache creds.SecretCache
+
+	BeforeEach(func() {
+		secretCache = db.NewSecretCache(dbConn)
+	})
+
+	It("stores values until they expire", func() {
+		err := secretCache.Set("some-key", []byte("some-value"), "", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = secretCache.Set("expired-key", []byte("some-value"), "", -time.Second)
+		Expect(err).ToNot(HaveOccurred())
+
+		value, found, err := secretCache.Get("some-key")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal([]byte("some-value")))
+
+		_, found, err = secretCache.Get("expired-key")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	It("replaces existing values", func() {
+		err := secretCache.Set("some-key", []byte("some-value"), "", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = secretCache.Set("some-key", []byte("other-value"), "", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		value, found, err := secretCache.Get("some-key")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal([]byte("other-value")))
+	})
+
+	It("deletes values", func() {
+		err := secretCache.Set("some-key", []byte("some-value"), "", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = secretCache.Delete("some-key")
+		Expect(err).ToNot(HaveOccurred())
+
+		_, found, err := secretCache.Get("some-key")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	It("returns the leases of unexpired values", func() {
+		err := secretCache.Set("leased-key", []byte("some-value"), "some-lease", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = secretCache.Set("unleased-key", []byte("some-value"), "", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = secretCache.Set("expired-key", []byte("some-value"), "expired-lease", -time.Second)
+		Expect(err).ToNot(HaveOccurred())
+
+		leases, err := secretCache.Leases()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(leases).To(Equal(map[string]string{"leased-key": "some-lease"}))
+	})
+
+	It("deletes expired values", func() {
+		err := secretCache.Set("some-key", []byte("some-value"), "", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = secretCache.Set("expired-key", []byte("some-value"), "", -time.Second)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = secretCache.DeleteExpired()
+		Expect(err).ToNot(HaveOccurred())
+
+		var count int
+		err = dbConn.QueryRow("SELECT COUNT(*) FROM secret_cache").Scan(&count)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(count).To(Equal(1))
+	})
+})
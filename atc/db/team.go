@@ -35,6 +35,7 @@ type Team interface {
 		config atc.Config,
 		from ConfigVersion,
 		pausedState PipelinePausedState,
+		parentBuildID int,
 	) (Pipeline, bool, error)
 
 	Pipeline(pipelineName string) (Pipeline, bool, error)
@@ -67,6 +68,7 @@ type team struct {
 	id          int
 	conn        Conn
 	lockFactory lock.LockFactory
+	stores      BuildStores
 
 	name  string
 	admin bool
@@ -293,12 +295,29 @@ func (t *team) SavePipeline(
 	config atc.Config,
 	from ConfigVersion,
 	pausedState PipelinePausedState,
+	parentBuildID int,
 ) (Pipeline, bool, error) {
 	groupsPayload, err := json.Marshal(config.Groups)
 	if err != nil {
 		return nil, false, err
 	}
 
+	var varSourcesPayload, varSourcesNonce *string
+	if len(config.VarSources) > 0 {
+		payload, err := json.Marshal(config.VarSources)
+		if err != nil {
+			return nil, false, err
+		}
+
+		encryptedPayload, nonce, err := t.conn.EncryptionStrategy().Encrypt(payload)
+		if err != nil {
+			return nil, false, err
+		}
+
+		varSourcesPayload = &encryptedPayload
+		varSourcesNonce = nonce
+	}
+
 	jobGroups := make(map[string][]string)
 	for _, group := range config.Groups {
 		for _, job := range group.Jobs {
@@ -309,6 +328,11 @@ func (t *team) SavePipeline(
 	var created bool
 	var existingConfig int
 
+	var parentBuild sql.NullInt64
+	if parentBuildID != 0 {
+		parentBuild = sql.NullInt64{Int64: int64(parentBuildID), Valid: true}
+	}
+
 	tx, err := t.conn.Begin()
 	if err != nil {
 		return nil, false, err
@@ -334,12 +358,15 @@ func (t *team) SavePipeline(
 
 		err = psql.Insert("pipelines").
 			SetMap(map[string]interface{}{
-				"name":     pipelineName,
-				"groups":   groupsPayload,
-				"version":  sq.Expr("nextval('config_version_seq')"),
-				"ordering": sq.Expr("currval('pipelines_id_seq')"),
-				"paused":   pausedState.Bool(),
-				"team_id":  t.id,
+				"name":            pipelineName,
+				"groups":          groupsPayload,
+				"version":         sq.Expr("nextval('config_version_seq')"),
+				"ordering":        sq.Expr("currval('pipelines_id_seq')"),
+				"paused":          pausedState.Bool(),
+				"team_id":         t.id,
+				"parent_build_id": parentBuild,
+				"var_sources":     varSourcesPayload,
+				"nonce":           varSourcesNonce,
 			}).
 			Suffix("RETURNING id").
 			RunWith(tx).
@@ -353,6 +380,9 @@ func (t *team) SavePipeline(
 		update := psql.Update("pipelines").
 			Set("groups", groupsPayload).
 			Set("version", sq.Expr("nextval('config_version_seq')")).
+			Set("var_sources", varSourcesPayload).
+			Set("nonce", varSourcesNonce).
+			Set("parent_build_id", parentBuild).
 			Where(sq.Eq{
 				"name":    pipelineName,
 				"version": from,
@@ -456,7 +486,12 @@ func (t *team) SavePipeline(
 		return nil, false, err
 	}
 
-	pipeline := newPipeline(t.conn, t.lockFactory)
+	err = t.saveNotifications(tx, config.Notifications, pipelineID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	pipeline := newPipeline(t.conn, t.lockFactory, t.stores)
 
 	err = scanPipeline(
 		pipeline,
@@ -478,7 +513,7 @@ func (t *team) SavePipeline(
 }
 
 func (t *team) Pipeline(pipelineName string) (Pipeline, bool, error) {
-	pipeline := newPipeline(t.conn, t.lockFactory)
+	pipeline := newPipeline(t.conn, t.lockFactory, t.stores)
 
 	err := scanPipeline(
 		pipeline,
@@ -512,7 +547,7 @@ func (t *team) Pipelines() ([]Pipeline, error) {
 		return nil, err
 	}
 
-	pipelines, err := scanPipelines(t.conn, t.lockFactory, rows)
+	pipelines, err := scanPipelines(t.conn, t.lockFactory, t.stores, rows)
 	if err != nil {
 		return nil, err
 	}
@@ -533,7 +568,7 @@ func (t *team) PublicPipelines() ([]Pipeline, error) {
 		return nil, err
 	}
 
-	pipelines, err := scanPipelines(t.conn, t.lockFactory, rows)
+	pipelines, err := scanPipelines(t.conn, t.lockFactory, t.stores, rows)
 	if err != nil {
 		return nil, err
 	}
@@ -551,7 +586,7 @@ func (t *team) VisiblePipelines() ([]Pipeline, error) {
 		return nil, err
 	}
 
-	currentTeamPipelines, err := scanPipelines(t.conn, t.lockFactory, rows)
+	currentTeamPipelines, err := scanPipelines(t.conn, t.lockFactory, t.stores, rows)
 	if err != nil {
 		return nil, err
 	}
@@ -566,7 +601,7 @@ func (t *team) VisiblePipelines() ([]Pipeline, error) {
 		return nil, err
 	}
 
-	otherTeamPublicPipelines, err := scanPipelines(t.conn, t.lockFactory, rows)
+	otherTeamPublicPipelines, err := scanPipelines(t.conn, t.lockFactory, t.stores, rows)
 	if err != nil {
 		return nil, err
 	}
@@ -614,7 +649,7 @@ func (t *team) CreateOneOffBuild() (Build, error) {
 
 	defer Rollback(tx)
 
-	build := &build{conn: t.conn, lockFactory: t.lockFactory}
+	build := &build{conn: t.conn, lockFactory: t.lockFactory, stores: t.stores}
 	err = createBuild(tx, build, map[string]interface{}{
 		"name":    sq.Expr("nextval('one_off_name')"),
 		"team_id": t.id,
@@ -636,15 +671,15 @@ func (t *team) PrivateAndPublicBuilds(page Page) ([]Build, Pagination, error) {
 	newBuildsQuery := buildsQuery.
 		Where(sq.Or{sq.Eq{"p.public": true}, sq.Eq{"t.id": t.id}})
 
-	return getBuildsWithPagination(newBuildsQuery, minMaxIdQuery, page, t.conn, t.lockFactory)
+	return getBuildsWithPagination(newBuildsQuery, minMaxIdQuery, page, t.conn, t.lockFactory, t.stores)
 }
 
 func (t *team) BuildsWithTime(page Page) ([]Build, Pagination, error) {
-	return getBuildsWithDates(buildsQuery.Where(sq.Eq{"t.id": t.id}), minMaxIdQuery, page, t.conn, t.lockFactory)
+	return getBuildsWithDates(buildsQuery.Where(sq.Eq{"t.id": t.id}), minMaxIdQuery, page, t.conn, t.lockFactory, t.stores)
 }
 
 func (t *team) Builds(page Page) ([]Build, Pagination, error) {
-	return getBuildsWithPagination(buildsQuery.Where(sq.Eq{"t.id": t.id}), minMaxIdQuery, page, t.conn, t.lockFactory)
+	return getBuildsWithPagination(buildsQuery.Where(sq.Eq{"t.id": t.id}), minMaxIdQuery, page, t.conn, t.lockFactory, t.stores)
 }
 
 func (t *team) SaveWorker(atcWorker atc.Worker, ttl time.Duration) (Worker, error) {
@@ -964,11 +999,25 @@ func (t *team) findContainer(whereClause sq.Sqlizer) (CreatingContainer, Created
 
 func scanPipeline(p *pipeline, scan scannable) error {
 	var groups sql.NullString
-	err := scan.Scan(&p.id, &p.name, &groups, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public)
+	var parentBuildID sql.NullInt64
+	var varSources, nonce sql.NullString
+	err := scan.Scan(&p.id, &p.name, &groups, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public, &parentBuildID, &varSources, &nonce)
 	if err != nil {
 		return err
 	}
 
+	if varSources.Valid {
+		p.encryptedVarSources = &varSources.String
+
+		if nonce.Valid {
+			p.varSourcesNonce = &nonce.String
+		}
+	}
+
+	if parentBuildID.Valid {
+		p.parentBuildID = int(parentBuildID.Int64)
+	}
+
 	if groups.Valid {
 		var pipelineGroups atc.GroupConfigs
 		err = json.Unmarshal([]byte(groups.String), &pipelineGroups)
@@ -982,13 +1031,13 @@ func scanPipeline(p *pipeline, scan scannable) error {
 	return nil
 }
 
-func scanPipelines(conn Conn, lockFactory lock.LockFactory, rows *sql.Rows) ([]Pipeline, error) {
+func scanPipelines(conn Conn, lockFactory lock.LockFactory, stores BuildStores, rows *sql.Rows) ([]Pipeline, error) {
 	defer Close(rows)
 
 	pipelines := []Pipeline{}
 
 	for rows.Next() {
-		pipeline := newPipeline(conn, lockFactory)
+		pipeline := newPipeline(conn, lockFactory, stores)
 
 		err := scanPipeline(pipeline, rows)
 		if err != nil {
@@ -24,12 +24,14 @@ type TeamFactory interface {
 type teamFactory struct {
 	conn        Conn
 	lockFactory lock.LockFactory
+	stores      BuildStores
 }
 
-func NewTeamFactory(conn Conn, lockFactory lock.LockFactory) TeamFactory {
+func NewTeamFactory(conn Conn, lockFactory lock.LockFactory, stores BuildStores) TeamFactory {
 	return &teamFactory{
 		conn:        conn,
 		lockFactory: lockFactory,
+		stores:      stores,
 	}
 }
 
@@ -60,6 +62,7 @@ func (factory *teamFactory) createTeam(t atc.Team, admin bool) (Team, error) {
 	team := &team{
 		conn:        factory.conn,
 		lockFactory: factory.lockFactory,
+		stores:      factory.stores,
 	}
 	err = factory.scanTeam(team, row)
 
@@ -80,6 +83,7 @@ func (factory *teamFactory) GetByID(teamID int) Team {
 		id:          teamID,
 		conn:        factory.conn,
 		lockFactory: factory.lockFactory,
+		stores:      factory.stores,
 	}
 }
 
@@ -87,6 +91,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 	team := &team{
 		conn:        factory.conn,
 		lockFactory: factory.lockFactory,
+		stores:      factory.stores,
 	}
 
 	row := psql.Select("id, name, admin, auth").
@@ -124,6 +129,7 @@ func (factory *teamFactory) GetTeams() ([]Team, error) {
 		team := &team{
 			conn:        factory.conn,
 			lockFactory: factory.lockFactory,
+			stores:      factory.stores,
 		}
 
 		err = factory.scanTeam(team, rows)
@@ -46,7 +46,7 @@ var _ = Describe("Team", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "job-name"},
 				},
-			}, db.ConfigVersion(1), db.PipelineUnpaused)
+			}, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			err = otherTeam.Delete()
@@ -637,14 +637,14 @@ var _ = Describe("Team", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), db.PipelineUnpaused)
+				}, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline2, _, err = team.SavePipeline("fake-pipeline-two", atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), db.PipelineUnpaused)
+				}, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -678,14 +678,14 @@ var _ = Describe("Team", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), db.PipelineUnpaused)
+				}, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline2, _, err = team.SavePipeline("fake-pipeline-two", atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), db.PipelineUnpaused)
+				}, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 				Expect(err).ToNot(HaveOccurred())
 
 				err = pipeline2.Expose()
@@ -727,14 +727,14 @@ var _ = Describe("Team", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), db.PipelineUnpaused)
+				}, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline2, _, err = otherTeam.SavePipeline("fake-pipeline-two", atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), db.PipelineUnpaused)
+				}, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(pipeline2.Expose()).To(Succeed())
@@ -752,7 +752,7 @@ var _ = Describe("Team", func() {
 						Jobs: atc.JobConfigs{
 							{Name: "job-fake-again"},
 						},
-					}, db.ConfigVersion(1), db.PipelineUnpaused)
+					}, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 					Expect(err).ToNot(HaveOccurred())
 				})
 
@@ -777,14 +777,14 @@ var _ = Describe("Team", func() {
 
 		BeforeEach(func() {
 			var err error
-			pipeline1, _, err = team.SavePipeline("pipeline-name-a", atc.Config{}, 0, db.PipelineUnpaused)
+			pipeline1, _, err = team.SavePipeline("pipeline-name-a", atc.Config{}, 0, db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
-			pipeline2, _, err = team.SavePipeline("pipeline-name-b", atc.Config{}, 0, db.PipelineUnpaused)
+			pipeline2, _, err = team.SavePipeline("pipeline-name-b", atc.Config{}, 0, db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline1, _, err = otherTeam.SavePipeline("pipeline-name-a", atc.Config{}, 0, db.PipelineUnpaused)
+			otherPipeline1, _, err = otherTeam.SavePipeline("pipeline-name-a", atc.Config{}, 0, db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
-			otherPipeline2, _, err = otherTeam.SavePipeline("pipeline-name-b", atc.Config{}, 0, db.PipelineUnpaused)
+			otherPipeline2, _, err = otherTeam.SavePipeline("pipeline-name-b", atc.Config{}, 0, db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -870,7 +870,7 @@ var _ = Describe("Team", func() {
 					},
 				}
 				var err error
-				pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused)
+				pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 				Expect(err).ToNot(HaveOccurred())
 
 				job, found, err := pipeline.Job("some-job")
@@ -1021,7 +1021,7 @@ var _ = Describe("Team", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1127,7 +1127,7 @@ var _ = Describe("Team", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1379,13 +1379,13 @@ var _ = Describe("Team", func() {
 		})
 
 		It("returns true for created", func() {
-			_, created, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			_, created, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
 		})
 
 		It("caches the team id", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineName)
@@ -1395,7 +1395,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("can be saved as paused", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelinePaused)
+			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelinePaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineName)
@@ -1406,7 +1406,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("can be saved as unpaused", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused)
+			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineName)
@@ -1416,8 +1416,40 @@ var _ = Describe("Team", func() {
 			Expect(pipeline.Paused()).To(BeFalse())
 		})
 
+		Context("when the pipeline was configured by a build", func() {
+			var parentBuild db.Build
+
+			BeforeEach(func() {
+				var err error
+				parentBuild, err = team.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, _, err = team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, parentBuild.ID())
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("records the build", func() {
+				pipeline, found, err := team.Pipeline(pipelineName)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(pipeline.ParentBuildID()).To(Equal(parentBuild.ID()))
+			})
+
+			It("clears the build when saved through the API", func() {
+				pipeline, _, err := team.Pipeline(pipelineName)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, _, err = team.SavePipeline(pipelineName, otherConfig, pipeline.ConfigVersion(), db.PipelineNoChange, 0)
+				Expect(err).ToNot(HaveOccurred())
+
+				pipeline, _, err = team.Pipeline(pipelineName)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pipeline.ParentBuildID()).To(BeZero())
+			})
+		})
+
 		It("defaults to paused", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineName)
@@ -1427,8 +1459,27 @@ var _ = Describe("Team", func() {
 			Expect(pipeline.Paused()).To(BeTrue())
 		})
 
+		It("saves the var sources", func() {
+			config.VarSources = atc.VarSourceConfigs{
+				{
+					Name:   "some-source",
+					Type:   "vault",
+					Config: map[string]interface{}{"url": "https://vault.example.com"},
+				},
+			}
+
+			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, 0)
+			Expect(err).ToNot(HaveOccurred())
+
+			pipeline, found, err := team.Pipeline(pipelineName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			Expect(pipeline.VarSources()).To(Equal(config.VarSources))
+		})
+
 		It("creates all of the resources from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := savedPipeline.Resource("some-resource")
@@ -1441,14 +1492,14 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates resource config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Resources[0].Source = atc.Source{
 				"source-other-config": "some-other-value",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := savedPipeline.Resource("some-resource")
@@ -1461,7 +1512,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("clears out api pinned version when resaving a pinned version on the pipeline config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := pipeline.Resource("some-resource")
@@ -1503,7 +1554,7 @@ var _ = Describe("Team", func() {
 				"version": "v2",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err = savedPipeline.Resource("some-resource")
@@ -1514,7 +1565,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("does not clear the api pinned version when resaving pipeline config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := pipeline.Resource("some-resource")
@@ -1552,7 +1603,7 @@ var _ = Describe("Team", func() {
 			Expect(reloaded).To(BeTrue())
 			Expect(resource.APIPinnedVersion()).To(Equal(atc.Version{"version": "v1"}))
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err = savedPipeline.Resource("some-resource")
@@ -1562,12 +1613,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks resource as inactive if it is no longer in config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Resources = []atc.ResourceConfig{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.Resource("some-resource")
@@ -1576,7 +1627,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the resource types from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceType, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -1589,14 +1640,14 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates resource type config from the pipeline in the database", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			config.ResourceTypes[0].Source = atc.Source{
 				"source-other-config": "some-other-value",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceType, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -1609,12 +1660,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks resource type as inactive if it is no longer in config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			config.ResourceTypes = []atc.ResourceType{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -1623,7 +1674,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the jobs from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-job")
@@ -1633,12 +1684,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates job config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Jobs[0].Public = false
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1648,12 +1699,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks job inactive when it is no longer in pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Jobs = []atc.JobConfig{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.Job("some-job")
@@ -1662,7 +1713,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("removes worker task caches for jobs that are no longer in pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1678,7 +1729,7 @@ var _ = Describe("Team", func() {
 
 			config.Jobs = []atc.JobConfig{}
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = workerTaskCacheFactory.Find(job.ID(), "some-task", "some-path", defaultWorker.Name())
@@ -1687,7 +1738,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("removes worker task caches for tasks that are no longer exist", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1713,7 +1764,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = workerTaskCacheFactory.Find(job.ID(), "some-task", "some-path", defaultWorker.Name())
@@ -1722,7 +1773,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the serial groups from the jobs in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			serialGroups := []SerialGroup{}
@@ -1753,7 +1804,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("saves tags in the jobs table", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, otherConfig, 0, db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, otherConfig, 0, db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-other-job")
@@ -1764,7 +1815,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates tags in the jobs table", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, otherConfig, 0, db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, otherConfig, 0, db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-other-job")
@@ -1784,7 +1835,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			savedPipeline, _, err = team.SavePipeline(pipelineName, otherConfig, savedPipeline.ConfigVersion(), db.PipelineNoChange)
+			savedPipeline, _, err = team.SavePipeline(pipelineName, otherConfig, savedPipeline.ConfigVersion(), db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = savedPipeline.Job("some-other-job")
@@ -1795,16 +1846,16 @@ var _ = Describe("Team", func() {
 		})
 
 		It("it returns created as false when updated", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 
-			_, created, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			_, created, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, 0)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeFalse())
 		})
 
 		It("updating from paused to unpaused", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelinePaused)
+			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelinePaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineName)
@@ -1812,7 +1863,7 @@ var _ = Describe("Team", func() {
 			Expect(found).To(BeTrue())
 			Expect(pipeline.Paused()).To(BeTrue())
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineUnpaused)
+			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err = team.Pipeline(pipelineName)
@@ -1822,7 +1873,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updating from unpaused to paused", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused)
+			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineName)
@@ -1830,7 +1881,7 @@ var _ = Describe("Team", func() {
 			Expect(found).To(BeTrue())
 			Expect(pipeline.Paused()).To(BeFalse())
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelinePaused)
+			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelinePaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err = team.Pipeline(pipelineName)
@@ -1841,7 +1892,7 @@ var _ = Describe("Team", func() {
 
 		Context("updating with no change", func() {
 			It("maintains paused if the pipeline is paused", func() {
-				_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelinePaused)
+				_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelinePaused, 0)
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline, found, err := team.Pipeline(pipelineName)
@@ -1849,7 +1900,7 @@ var _ = Describe("Team", func() {
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeTrue())
 
-				_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+				_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, 0)
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline, found, err = team.Pipeline(pipelineName)
@@ -1859,7 +1910,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("maintains unpaused if the pipeline is unpaused", func() {
-				_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused)
+				_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused, 0)
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline, found, err := team.Pipeline(pipelineName)
@@ -1867,7 +1918,7 @@ var _ = Describe("Team", func() {
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeFalse())
 
-				_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+				_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, 0)
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline, found, err = team.Pipeline(pipelineName)
@@ -1881,9 +1932,9 @@ var _ = Describe("Team", func() {
 			pipelineName := "a-pipeline-name"
 			otherPipelineName := "an-other-pipeline-name"
 
-			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused)
+			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
-			_, _, err = team.SavePipeline(otherPipelineName, otherConfig, 0, db.PipelineUnpaused)
+			_, _, err = team.SavePipeline(otherPipelineName, otherConfig, 0, db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineName)
@@ -1929,10 +1980,10 @@ var _ = Describe("Team", func() {
 			otherPipelineName := "an-other-pipeline-name"
 
 			By("being able to save the config")
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline, _, err := team.SavePipeline(otherPipelineName, otherConfig, 0, db.PipelineUnpaused)
+			otherPipeline, _, err := team.SavePipeline(otherPipelineName, otherConfig, 0, db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			By("returning the saved config to later gets")
@@ -2002,22 +2053,22 @@ var _ = Describe("Team", func() {
 			})
 
 			By("not allowing non-sequential updates")
-			_, _, err = team.SavePipeline(pipelineName, updatedConfig, pipeline.ConfigVersion()-1, db.PipelineUnpaused)
+			_, _, err = team.SavePipeline(pipelineName, updatedConfig, pipeline.ConfigVersion()-1, db.PipelineUnpaused, 0)
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(pipelineName, updatedConfig, pipeline.ConfigVersion()+10, db.PipelineUnpaused)
+			_, _, err = team.SavePipeline(pipelineName, updatedConfig, pipeline.ConfigVersion()+10, db.PipelineUnpaused, 0)
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(otherPipelineName, updatedConfig, otherPipeline.ConfigVersion()-1, db.PipelineUnpaused)
+			_, _, err = team.SavePipeline(otherPipelineName, updatedConfig, otherPipeline.ConfigVersion()-1, db.PipelineUnpaused, 0)
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(otherPipelineName, updatedConfig, otherPipeline.ConfigVersion()+10, db.PipelineUnpaused)
+			_, _, err = team.SavePipeline(otherPipelineName, updatedConfig, otherPipeline.ConfigVersion()+10, db.PipelineUnpaused, 0)
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
 			By("being able to update the config with a valid con")
-			pipeline, _, err = team.SavePipeline(pipelineName, updatedConfig, pipeline.ConfigVersion(), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline(pipelineName, updatedConfig, pipeline.ConfigVersion(), db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
-			otherPipeline, _, err = team.SavePipeline(otherPipelineName, updatedConfig, otherPipeline.ConfigVersion(), db.PipelineUnpaused)
+			otherPipeline, _, err = team.SavePipeline(otherPipelineName, updatedConfig, otherPipeline.ConfigVersion(), db.PipelineUnpaused, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			By("returning the updated config")
@@ -2057,22 +2108,22 @@ var _ = Describe("Team", func() {
 
 		Context("when there are multiple teams", func() {
 			It("can allow pipelines with the same name across teams", func() {
-				teamPipeline, _, err := team.SavePipeline("steve", config, 0, db.PipelineUnpaused)
+				teamPipeline, _, err := team.SavePipeline("steve", config, 0, db.PipelineUnpaused, 0)
 				Expect(err).ToNot(HaveOccurred())
 
 				By("allowing you to save a pipeline with the same name in another team")
-				otherTeamPipeline, _, err := otherTeam.SavePipeline("steve", otherConfig, 0, db.PipelineUnpaused)
+				otherTeamPipeline, _, err := otherTeam.SavePipeline("steve", otherConfig, 0, db.PipelineUnpaused, 0)
 				Expect(err).ToNot(HaveOccurred())
 
 				By("updating the pipeline config for the correct team's pipeline")
-				teamPipeline, _, err = team.SavePipeline("steve", otherConfig, teamPipeline.ConfigVersion(), db.PipelineNoChange)
+				teamPipeline, _, err = team.SavePipeline("steve", otherConfig, teamPipeline.ConfigVersion(), db.PipelineNoChange, 0)
 				Expect(err).ToNot(HaveOccurred())
 
-				_, _, err = otherTeam.SavePipeline("steve", config, otherTeamPipeline.ConfigVersion(), db.PipelineNoChange)
+				_, _, err = otherTeam.SavePipeline("steve", config, otherTeamPipeline.ConfigVersion(), db.PipelineNoChange, 0)
 				Expect(err).ToNot(HaveOccurred())
 
 				By("pausing the correct team's pipeline")
-				_, _, err = team.SavePipeline("steve", otherConfig, teamPipeline.ConfigVersion(), db.PipelinePaused)
+				_, _, err = team.SavePipeline("steve", otherConfig, teamPipeline.ConfigVersion(), db.PipelinePaused, 0)
 				Expect(err).ToNot(HaveOccurred())
 
 				pausedPipeline, found, err := team.Pipeline("steve")
@@ -2087,10 +2138,10 @@ var _ = Describe("Team", func() {
 				Expect(unpausedPipeline.Paused()).To(BeFalse())
 
 				By("cannot cross update configs")
-				_, _, err = team.SavePipeline("steve", otherConfig, otherTeamPipeline.ConfigVersion(), db.PipelineNoChange)
+				_, _, err = team.SavePipeline("steve", otherConfig, otherTeamPipeline.ConfigVersion(), db.PipelineNoChange, 0)
 				Expect(err).To(HaveOccurred())
 
-				_, _, err = team.SavePipeline("steve", otherConfig, otherTeamPipeline.ConfigVersion(), db.PipelinePaused)
+				_, _, err = team.SavePipeline("steve", otherConfig, otherTeamPipeline.ConfigVersion(), db.PipelinePaused, 0)
 				Expect(err).To(HaveOccurred())
 			})
 		})
@@ -2168,7 +2219,7 @@ var _ = Describe("Team", func() {
 										},
 									},
 								},
-							}, db.ConfigVersion(0), db.PipelineUnpaused)
+							}, db.ConfigVersion(0), db.PipelineUnpaused, 0)
 							Expect(err).NotTo(HaveOccurred())
 
 							otherResource, found, err = otherPipeline.Resource("some-resource")
@@ -41,6 +41,10 @@ type VolumeRepository interface {
 
 	UpdateVolumesMissingSince(workerName string, handles []string) error
 	RemoveMissingVolumes(gracePeriod time.Duration) (removed int, err error)
+
+	// CountVolumesByTeam returns the number of volumes owned by each team,
+	// including teams which own none.
+	CountVolumesByTeam() (map[string]int, error)
 }
 
 const noTeam = 0
@@ -734,3 +738,7 @@ func scanVolume(row sq.RowScanner, conn Conn) (CreatingVolume, CreatedVolume, De
 
 	return nil, nil, nil, nil, nil
 }
+
+func (repository *volumeRepository) CountVolumesByTeam() (map[string]int, error) {
+	return countByTeam(repository.conn, "volumes")
+}
@@ -339,6 +339,25 @@ var _ = Describe("VolumeFactory", func() {
 		})
 	})
 
+	Describe("CountVolumesByTeam", func() {
+		BeforeEach(func() {
+			creatingContainer, err := defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(build.ID(), "some-plan", defaultTeam.ID()), db.ContainerMetadata{
+				Type:     "task",
+				StepName: "some-task",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = volumeRepository.CreateContainerVolume(defaultTeam.ID(), defaultWorker.Name(), creatingContainer, "some-path-1")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the number of volumes owned by every team", func() {
+			counts, err := volumeRepository.CountVolumesByTeam()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counts).To(HaveKeyWithValue(defaultTeam.Name(), 1))
+		})
+	})
+
 	Describe("GetDestroyingVolumes", func() {
 		var expectedDestroyingHandles []string
 		var destroyingVol db.DestroyingVolume
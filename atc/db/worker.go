@@ -51,6 +51,9 @@ type Worker interface {
 	NoProxy() string
 	ActiveContainers() int
 	ActiveVolumes() int
+	MaxContainers() int
+	FreeMemory() int64
+	FreeDisk() int64
 	ResourceTypes() []atc.WorkerResourceType
 	Platform() string
 	Tags() []string
@@ -84,6 +87,9 @@ type worker struct {
 	noProxy          string
 	activeContainers int
 	activeVolumes    int
+	maxContainers    int
+	freeMemory       int64
+	freeDisk         int64
 	resourceTypes    []atc.WorkerResourceType
 	platform         string
 	tags             []string
@@ -107,6 +113,9 @@ func (worker *worker) HTTPSProxyURL() string                   { return worker.h
 func (worker *worker) NoProxy() string                         { return worker.noProxy }
 func (worker *worker) ActiveContainers() int                   { return worker.activeContainers }
 func (worker *worker) ActiveVolumes() int                      { return worker.activeVolumes }
+func (worker *worker) MaxContainers() int                      { return worker.maxContainers }
+func (worker *worker) FreeMemory() int64                       { return worker.freeMemory }
+func (worker *worker) FreeDisk() int64                         { return worker.freeDisk }
 func (worker *worker) ResourceTypes() []atc.WorkerResourceType { return worker.resourceTypes }
 func (worker *worker) Platform() string                        { return worker.platform }
 func (worker *worker) Tags() []string                          { return worker.tags }
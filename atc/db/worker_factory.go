@@ -46,6 +46,9 @@ var workersQuery = psql.Select(`
 		w.no_proxy,
 		w.active_containers,
 		w.active_volumes,
+		w.max_containers,
+		w.free_memory,
+		w.free_disk,
 		w.resource_types,
 		w.platform,
 		w.tags,
@@ -160,6 +163,9 @@ func scanWorker(worker *worker, row scannable) error {
 		&noProxy,
 		&worker.activeContainers,
 		&worker.activeVolumes,
+		&worker.maxContainers,
+		&worker.freeMemory,
+		&worker.freeDisk,
 		&resourceTypes,
 		&platform,
 		&tags,
@@ -268,6 +274,9 @@ func (f *workerFactory) HeartbeatWorker(atcWorker atc.Worker, ttl time.Duration)
 		Set("expires", sq.Expr(expires)).
 		Set("active_containers", atcWorker.ActiveContainers).
 		Set("active_volumes", atcWorker.ActiveVolumes).
+		Set("max_containers", atcWorker.MaxContainers).
+		Set("free_memory", atcWorker.FreeMemory).
+		Set("free_disk", atcWorker.FreeDisk).
 		Set("state", sq.Expr("("+cSQL+")")).
 		Where(sq.Eq{"name": atcWorker.Name}).
 		RunWith(tx).
@@ -412,6 +421,9 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		atcWorker.GardenAddr,
 		atcWorker.ActiveContainers,
 		atcWorker.ActiveVolumes,
+		atcWorker.MaxContainers,
+		atcWorker.FreeMemory,
+		atcWorker.FreeDisk,
 		resourceTypes,
 		tags,
 		atcWorker.Platform,
@@ -443,6 +455,9 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 			"addr",
 			"active_containers",
 			"active_volumes",
+			"max_containers",
+			"free_memory",
+			"free_disk",
 			"resource_types",
 			"tags",
 			"platform",
@@ -465,6 +480,9 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				addr = ?,
 				active_containers = ?,
 				active_volumes = ?,
+				max_containers = ?,
+				free_memory = ?,
+				free_disk = ?,
 				resource_types = ?,
 				tags = ?,
 				platform = ?,
@@ -514,6 +532,9 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		noProxy:          atcWorker.NoProxy,
 		activeContainers: atcWorker.ActiveContainers,
 		activeVolumes:    atcWorker.ActiveVolumes,
+		maxContainers:    atcWorker.MaxContainers,
+		freeMemory:       atcWorker.FreeMemory,
+		freeDisk:         atcWorker.FreeDisk,
 		resourceTypes:    atcWorker.ResourceTypes,
 		platform:         atcWorker.Platform,
 		tags:             atcWorker.Tags,
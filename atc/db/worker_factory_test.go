@@ -28,6 +28,9 @@ var _ = Describe("WorkerFactory", func() {
 			Ephemeral:        true,
 			ActiveContainers: 140,
 			ActiveVolumes:    550,
+			MaxContainers:    250,
+			FreeMemory:       1024,
+			FreeDisk:         4096,
 			ResourceTypes: []atc.WorkerResourceType{
 				{
 					Type:       "some-resource-type",
@@ -259,6 +262,9 @@ var _ = Describe("WorkerFactory", func() {
 				Expect(foundWorker.Ephemeral()).To(Equal(true))
 				Expect(foundWorker.ActiveContainers()).To(Equal(140))
 				Expect(foundWorker.ActiveVolumes()).To(Equal(550))
+				Expect(foundWorker.MaxContainers()).To(Equal(250))
+				Expect(foundWorker.FreeMemory()).To(Equal(int64(1024)))
+				Expect(foundWorker.FreeDisk()).To(Equal(int64(4096)))
 				Expect(foundWorker.ResourceTypes()).To(Equal([]atc.WorkerResourceType{
 					{
 						Type:       "some-resource-type",
@@ -447,6 +453,7 @@ var _ = Describe("WorkerFactory", func() {
 			It("updates the expires field, and the number of active containers and volumes", func() {
 				atcWorker.ActiveContainers = 1
 				atcWorker.ActiveVolumes = 3
+				atcWorker.FreeMemory = 512
 
 				now := time.Now()
 				By("current time")
@@ -463,6 +470,7 @@ var _ = Describe("WorkerFactory", func() {
 				Expect(foundWorker.ExpiresAt()).To(BeTemporally("~", later, epsilon))
 				Expect(foundWorker.ActiveContainers()).To(And(Not(Equal(activeContainers)), Equal(1)))
 				Expect(foundWorker.ActiveVolumes()).To(And(Not(Equal(activeVolumes)), Equal(3)))
+				Expect(foundWorker.FreeMemory()).To(Equal(int64(512)))
 				Expect(*foundWorker.GardenAddr()).To(Equal("some-garden-addr"))
 				Expect(*foundWorker.BaggageclaimURL()).To(Equal("some-bc-url"))
 			})
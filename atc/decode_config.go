@@ -0,0 +1,59 @@
+package atc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// UnknownKeysError is returned by DecodeConfig when the config contains
+// nested keys which don't correspond to any field.
+type UnknownKeysError struct {
+	Keys []string
+}
+
+func (err UnknownKeysError) Error() string {
+	return fmt.Sprintf("unknown/extra keys: %s", strings.Join(err.Keys, ", "))
+}
+
+// DecodeConfig decodes a pipeline config that has been unmarshaled from YAML
+// or JSON into a generic structure. Unknown top-level keys are ignored, but
+// unknown nested keys are rejected with an UnknownKeysError.
+func DecodeConfig(untypedConfig interface{}) (Config, error) {
+	var config Config
+	var metadata mapstructure.Metadata
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Metadata:         &metadata,
+		Result:           &config,
+		WeaklyTypedInput: true,
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			SanitizeDecodeHook,
+			VersionConfigDecodeHook,
+			InputsConfigDecodeHook,
+			ContainerLimitsDecodeHook,
+		),
+	})
+	if err != nil {
+		return Config{}, err
+	}
+
+	err = decoder.Decode(untypedConfig)
+	if err != nil {
+		return Config{}, err
+	}
+
+	nestedUnused := []string{}
+	for _, unused := range metadata.Unused {
+		if strings.Contains(unused, ".") {
+			nestedUnused = append(nestedUnused, unused)
+		}
+	}
+
+	if len(nestedUnused) > 0 {
+		return Config{}, UnknownKeysError{Keys: nestedUnused}
+	}
+
+	return config, nil
+}
@@ -0,0 +1,58 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"gopkg.in/yaml.v2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DecodeConfig", func() {
+	decode := func(payload string) (atc.Config, error) {
+		var untypedConfig interface{}
+		err := yaml.Unmarshal([]byte(payload), &untypedConfig)
+		Expect(err).ToNot(HaveOccurred())
+
+		return atc.DecodeConfig(untypedConfig)
+	}
+
+	It("decodes the config", func() {
+		config, err := decode(`
+resources:
+- name: some-resource
+  type: git
+  source: {uri: some-uri}
+
+jobs:
+- name: some-job
+  plan:
+  - get: some-resource
+    version: every
+`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.Resources).To(HaveLen(1))
+		Expect(config.Resources[0].Source).To(Equal(atc.Source{"uri": "some-uri"}))
+		Expect(config.Jobs).To(HaveLen(1))
+		Expect(config.Jobs[0].Plan[0].Version).To(Equal(&atc.VersionConfig{Every: true}))
+	})
+
+	It("ignores unknown top-level keys", func() {
+		_, err := decode(`
+some-anchors: {a: b}
+jobs:
+- name: some-job
+`)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("rejects unknown nested keys", func() {
+		_, err := decode(`
+jobs:
+- name: some-job
+  bogus: true
+`)
+		Expect(err).To(Equal(atc.UnknownKeysError{Keys: []string{"jobs[0].bogus"}}))
+		Expect(err).To(MatchError("unknown/extra keys: jobs[0].bogus"))
+	})
+})
@@ -0,0 +1,61 @@
+package engine
+
+import (
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/exec"
+)
+
+type approvalDelegate struct {
+	exec.BuildStepDelegate
+
+	build       db.Build
+	eventOrigin event.Origin
+	clock       clock.Clock
+}
+
+func NewApprovalDelegate(build db.Build, planID atc.PlanID, clock clock.Clock) exec.ApprovalDelegate {
+	return &approvalDelegate{
+		BuildStepDelegate: NewBuildStepDelegate(build, planID, clock),
+
+		build: build,
+		eventOrigin: event.Origin{
+			ID: event.OriginID(planID),
+		},
+		clock: clock,
+	}
+}
+
+func (d *approvalDelegate) WaitingForApproval(logger lager.Logger, plan atc.ApprovalPlan) {
+	err := d.build.SaveEvent(event.WaitingForApproval{
+		Origin: d.eventOrigin,
+		Time:   d.clock.Now().Unix(),
+		Name:   plan.Name,
+		Role:   plan.Role,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-waiting-for-approval-event", err)
+		return
+	}
+
+	logger.Info("waiting-for-approval")
+}
+
+func (d *approvalDelegate) Finished(logger lager.Logger, approval db.BuildApproval) {
+	err := d.build.SaveEvent(event.FinishApproval{
+		Origin:    d.eventOrigin,
+		Time:      d.clock.Now().Unix(),
+		Status:    approval.Status,
+		DecidedBy: approval.DecidedBy,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-finish-approval-event", err)
+		return
+	}
+
+	logger.Info("finished", lager.Data{"status": approval.Status, "decided-by": approval.DecidedBy})
+}
@@ -22,6 +22,20 @@ func (build *execBuild) buildAggregateStep(logger lager.Logger, plan atc.Plan) e
 	return agg
 }
 
+func (build *execBuild) buildAcrossStep(logger lager.Logger, plan atc.Plan) exec.Step {
+	logger = logger.Session("across")
+
+	steps := []exec.Step{}
+
+	for _, scoped := range plan.Across.Steps {
+		innerPlan := scoped.Step
+		innerPlan.Attempts = plan.Attempts
+		steps = append(steps, build.buildStep(logger, innerPlan))
+	}
+
+	return exec.Across(steps, plan.Across.MaxInFlight)
+}
+
 func (build *execBuild) buildDoStep(logger lager.Logger, plan atc.Plan) exec.Step {
 	logger = logger.Session("do")
 
@@ -51,6 +65,24 @@ func (build *execBuild) buildTryStep(logger lager.Logger, plan atc.Plan) exec.St
 	return exec.Try(step)
 }
 
+func (build *execBuild) buildConditionalStep(logger lager.Logger, plan atc.Plan) exec.Step {
+	logger = logger.Session("conditional")
+
+	innerPlan := plan.Conditional.Step
+	innerPlan.Attempts = plan.Attempts
+	step := build.buildStep(logger, innerPlan)
+
+	return build.factory.Conditional(
+		logger,
+		plan,
+		build.dbBuild,
+		build.variables,
+		build.stepMetadata,
+		build.delegate.ConditionalDelegate(plan.ID),
+		step,
+	)
+}
+
 func (build *execBuild) buildOnAbortStep(logger lager.Logger, plan atc.Plan) exec.Step {
 	plan.OnAbort.Step.Attempts = plan.Attempts
 	step := build.buildStep(logger, plan.OnAbort.Step)
@@ -96,6 +128,7 @@ func (build *execBuild) buildTaskStep(logger lager.Logger, plan atc.Plan) exec.S
 		logger,
 		plan,
 		build.dbBuild,
+		build.variables,
 		containerMetadata,
 		build.delegate.TaskDelegate(plan.ID),
 	)
@@ -116,6 +149,7 @@ func (build *execBuild) buildGetStep(logger lager.Logger, plan atc.Plan) exec.St
 		logger,
 		plan,
 		build.dbBuild,
+		build.variables,
 		build.stepMetadata,
 		containerMetadata,
 		build.delegate.GetDelegate(plan.ID),
@@ -137,6 +171,7 @@ func (build *execBuild) buildPutStep(logger lager.Logger, plan atc.Plan) exec.St
 		logger,
 		plan,
 		build.dbBuild,
+		build.variables,
 		build.stepMetadata,
 		containerMetadata,
 		build.delegate.PutDelegate(plan.ID),
@@ -165,3 +200,42 @@ func (build *execBuild) buildUserArtifactStep(logger lager.Logger, plan atc.Plan
 func (build *execBuild) buildArtifactOutputStep(logger lager.Logger, plan atc.Plan) exec.Step {
 	return exec.ArtifactOutput(plan.ID, worker.ArtifactName(plan.ArtifactOutput.Name), build.delegate.BuildStepDelegate(plan.ID))
 }
+
+func (build *execBuild) buildLoadVarStep(logger lager.Logger, plan atc.Plan) exec.Step {
+	logger = logger.Session("load-var", lager.Data{
+		"name": plan.LoadVar.Name,
+	})
+
+	return build.factory.LoadVar(
+		logger,
+		plan,
+		build.dbBuild,
+		build.delegate.LoadVarDelegate(plan.ID),
+	)
+}
+
+func (build *execBuild) buildSetPipelineStep(logger lager.Logger, plan atc.Plan) exec.Step {
+	logger = logger.Session("set-pipeline", lager.Data{
+		"name": plan.SetPipeline.Name,
+	})
+
+	return build.factory.SetPipeline(
+		logger,
+		plan,
+		build.dbBuild,
+		build.delegate.SetPipelineDelegate(plan.ID),
+	)
+}
+
+func (build *execBuild) buildApprovalStep(logger lager.Logger, plan atc.Plan) exec.Step {
+	logger = logger.Session("approval", lager.Data{
+		"name": plan.Approval.Name,
+	})
+
+	return build.factory.Approval(
+		logger,
+		plan,
+		build.dbBuild,
+		build.delegate.ApprovalDelegate(plan.ID),
+	)
+}
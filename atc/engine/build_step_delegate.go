@@ -10,6 +10,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/worker"
 )
 
 type BuildStepDelegate struct {
@@ -68,6 +69,19 @@ func (delegate *BuildStepDelegate) Errored(logger lager.Logger, message string)
 	}
 }
 
+func (delegate *BuildStepDelegate) WaitingForWorker(logger lager.Logger, spec worker.WorkerSpec) {
+	err := delegate.build.SaveEvent(event.WaitingForWorker{
+		Time: delegate.clock.Now().Unix(),
+		Origin: event.Origin{
+			ID: event.OriginID(delegate.planID),
+		},
+		Requirements: spec.Description(),
+	})
+	if err != nil {
+		logger.Error("failed-to-save-waiting-for-worker-event", err)
+	}
+}
+
 func newDBEventWriter(build db.Build, origin event.Origin, clock clock.Clock) io.Writer {
 	return &dbEventWriter{
 		build:  build,
@@ -6,10 +6,12 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
 
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/worker"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -46,6 +48,26 @@ var _ = Describe("BuildStepDelegate", func() {
 		})
 	})
 
+	Describe("WaitingForWorker", func() {
+		JustBeforeEach(func() {
+			delegate.WaitingForWorker(lagertest.NewTestLogger("test"), worker.WorkerSpec{
+				Platform: "linux",
+				Tags:     []string{"some-tag"},
+			})
+		})
+
+		It("saves a waiting-for-worker event", func() {
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+			Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.WaitingForWorker{
+				Time:         123456789,
+				Requirements: "platform 'linux', tag 'some-tag'",
+				Origin: event.Origin{
+					ID: "some-plan-id",
+				},
+			}))
+		})
+	})
+
 	Describe("Stdout", func() {
 		var writer io.Writer
 
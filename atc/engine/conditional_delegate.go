@@ -0,0 +1,45 @@
+package engine
+
+import (
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/exec"
+)
+
+type conditionalDelegate struct {
+	exec.BuildStepDelegate
+
+	build       db.Build
+	eventOrigin event.Origin
+	clock       clock.Clock
+}
+
+func NewConditionalDelegate(build db.Build, planID atc.PlanID, clock clock.Clock) exec.ConditionalDelegate {
+	return &conditionalDelegate{
+		BuildStepDelegate: NewBuildStepDelegate(build, planID, clock),
+
+		build: build,
+		eventOrigin: event.Origin{
+			ID: event.OriginID(planID),
+		},
+		clock: clock,
+	}
+}
+
+func (d *conditionalDelegate) Skipped(logger lager.Logger, condition string) {
+	err := d.build.SaveEvent(event.Skipped{
+		Time:      d.clock.Now().Unix(),
+		Origin:    d.eventOrigin,
+		Condition: condition,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-skipped-event", err)
+		return
+	}
+
+	logger.Info("skipped", lager.Data{"condition": condition})
+}
@@ -58,6 +58,15 @@ func (engine *dbEngine) CreateBuild(logger lager.Logger, build db.Build, plan at
 
 	if !started {
 		createdBuild.Abort(logger.Session("aborted-immediately"))
+	} else if !build.CreateTime().IsZero() {
+		metric.BuildQueueDuration{
+			PipelineName: build.PipelineName(),
+			JobName:      build.JobName(),
+			BuildName:    build.Name(),
+			BuildID:      build.ID(),
+			TeamName:     build.TeamName(),
+			Duration:     time.Since(build.CreateTime()),
+		}.Emit(logger)
 	}
 
 	return &dbBuild{
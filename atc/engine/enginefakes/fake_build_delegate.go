@@ -11,6 +11,17 @@ import (
 )
 
 type FakeBuildDelegate struct {
+	ApprovalDelegateStub        func(atc.PlanID) exec.ApprovalDelegate
+	approvalDelegateMutex       sync.RWMutex
+	approvalDelegateArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	approvalDelegateReturns struct {
+		result1 exec.ApprovalDelegate
+	}
+	approvalDelegateReturnsOnCall map[int]struct {
+		result1 exec.ApprovalDelegate
+	}
 	BuildStepDelegateStub        func(atc.PlanID) exec.BuildStepDelegate
 	buildStepDelegateMutex       sync.RWMutex
 	buildStepDelegateArgsForCall []struct {
@@ -22,6 +33,17 @@ type FakeBuildDelegate struct {
 	buildStepDelegateReturnsOnCall map[int]struct {
 		result1 exec.BuildStepDelegate
 	}
+	ConditionalDelegateStub        func(atc.PlanID) exec.ConditionalDelegate
+	conditionalDelegateMutex       sync.RWMutex
+	conditionalDelegateArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	conditionalDelegateReturns struct {
+		result1 exec.ConditionalDelegate
+	}
+	conditionalDelegateReturnsOnCall map[int]struct {
+		result1 exec.ConditionalDelegate
+	}
 	FinishStub        func(lager.Logger, error, bool)
 	finishMutex       sync.RWMutex
 	finishArgsForCall []struct {
@@ -40,6 +62,17 @@ type FakeBuildDelegate struct {
 	getDelegateReturnsOnCall map[int]struct {
 		result1 exec.GetDelegate
 	}
+	LoadVarDelegateStub        func(atc.PlanID) exec.LoadVarDelegate
+	loadVarDelegateMutex       sync.RWMutex
+	loadVarDelegateArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	loadVarDelegateReturns struct {
+		result1 exec.LoadVarDelegate
+	}
+	loadVarDelegateReturnsOnCall map[int]struct {
+		result1 exec.LoadVarDelegate
+	}
 	PutDelegateStub        func(atc.PlanID) exec.PutDelegate
 	putDelegateMutex       sync.RWMutex
 	putDelegateArgsForCall []struct {
@@ -51,6 +84,17 @@ type FakeBuildDelegate struct {
 	putDelegateReturnsOnCall map[int]struct {
 		result1 exec.PutDelegate
 	}
+	SetPipelineDelegateStub        func(atc.PlanID) exec.SetPipelineDelegate
+	setPipelineDelegateMutex       sync.RWMutex
+	setPipelineDelegateArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	setPipelineDelegateReturns struct {
+		result1 exec.SetPipelineDelegate
+	}
+	setPipelineDelegateReturnsOnCall map[int]struct {
+		result1 exec.SetPipelineDelegate
+	}
 	TaskDelegateStub        func(atc.PlanID) exec.TaskDelegate
 	taskDelegateMutex       sync.RWMutex
 	taskDelegateArgsForCall []struct {
@@ -66,6 +110,66 @@ type FakeBuildDelegate struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeBuildDelegate) ApprovalDelegate(arg1 atc.PlanID) exec.ApprovalDelegate {
+	fake.approvalDelegateMutex.Lock()
+	ret, specificReturn := fake.approvalDelegateReturnsOnCall[len(fake.approvalDelegateArgsForCall)]
+	fake.approvalDelegateArgsForCall = append(fake.approvalDelegateArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	fake.recordInvocation("ApprovalDelegate", []interface{}{arg1})
+	fake.approvalDelegateMutex.Unlock()
+	if fake.ApprovalDelegateStub != nil {
+		return fake.ApprovalDelegateStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.approvalDelegateReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildDelegate) ApprovalDelegateCallCount() int {
+	fake.approvalDelegateMutex.RLock()
+	defer fake.approvalDelegateMutex.RUnlock()
+	return len(fake.approvalDelegateArgsForCall)
+}
+
+func (fake *FakeBuildDelegate) ApprovalDelegateCalls(stub func(atc.PlanID) exec.ApprovalDelegate) {
+	fake.approvalDelegateMutex.Lock()
+	defer fake.approvalDelegateMutex.Unlock()
+	fake.ApprovalDelegateStub = stub
+}
+
+func (fake *FakeBuildDelegate) ApprovalDelegateArgsForCall(i int) atc.PlanID {
+	fake.approvalDelegateMutex.RLock()
+	defer fake.approvalDelegateMutex.RUnlock()
+	argsForCall := fake.approvalDelegateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildDelegate) ApprovalDelegateReturns(result1 exec.ApprovalDelegate) {
+	fake.approvalDelegateMutex.Lock()
+	defer fake.approvalDelegateMutex.Unlock()
+	fake.ApprovalDelegateStub = nil
+	fake.approvalDelegateReturns = struct {
+		result1 exec.ApprovalDelegate
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) ApprovalDelegateReturnsOnCall(i int, result1 exec.ApprovalDelegate) {
+	fake.approvalDelegateMutex.Lock()
+	defer fake.approvalDelegateMutex.Unlock()
+	fake.ApprovalDelegateStub = nil
+	if fake.approvalDelegateReturnsOnCall == nil {
+		fake.approvalDelegateReturnsOnCall = make(map[int]struct {
+			result1 exec.ApprovalDelegate
+		})
+	}
+	fake.approvalDelegateReturnsOnCall[i] = struct {
+		result1 exec.ApprovalDelegate
+	}{result1}
+}
+
 func (fake *FakeBuildDelegate) BuildStepDelegate(arg1 atc.PlanID) exec.BuildStepDelegate {
 	fake.buildStepDelegateMutex.Lock()
 	ret, specificReturn := fake.buildStepDelegateReturnsOnCall[len(fake.buildStepDelegateArgsForCall)]
@@ -126,6 +230,66 @@ func (fake *FakeBuildDelegate) BuildStepDelegateReturnsOnCall(i int, result1 exe
 	}{result1}
 }
 
+func (fake *FakeBuildDelegate) ConditionalDelegate(arg1 atc.PlanID) exec.ConditionalDelegate {
+	fake.conditionalDelegateMutex.Lock()
+	ret, specificReturn := fake.conditionalDelegateReturnsOnCall[len(fake.conditionalDelegateArgsForCall)]
+	fake.conditionalDelegateArgsForCall = append(fake.conditionalDelegateArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	fake.recordInvocation("ConditionalDelegate", []interface{}{arg1})
+	fake.conditionalDelegateMutex.Unlock()
+	if fake.ConditionalDelegateStub != nil {
+		return fake.ConditionalDelegateStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.conditionalDelegateReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildDelegate) ConditionalDelegateCallCount() int {
+	fake.conditionalDelegateMutex.RLock()
+	defer fake.conditionalDelegateMutex.RUnlock()
+	return len(fake.conditionalDelegateArgsForCall)
+}
+
+func (fake *FakeBuildDelegate) ConditionalDelegateCalls(stub func(atc.PlanID) exec.ConditionalDelegate) {
+	fake.conditionalDelegateMutex.Lock()
+	defer fake.conditionalDelegateMutex.Unlock()
+	fake.ConditionalDelegateStub = stub
+}
+
+func (fake *FakeBuildDelegate) ConditionalDelegateArgsForCall(i int) atc.PlanID {
+	fake.conditionalDelegateMutex.RLock()
+	defer fake.conditionalDelegateMutex.RUnlock()
+	argsForCall := fake.conditionalDelegateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildDelegate) ConditionalDelegateReturns(result1 exec.ConditionalDelegate) {
+	fake.conditionalDelegateMutex.Lock()
+	defer fake.conditionalDelegateMutex.Unlock()
+	fake.ConditionalDelegateStub = nil
+	fake.conditionalDelegateReturns = struct {
+		result1 exec.ConditionalDelegate
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) ConditionalDelegateReturnsOnCall(i int, result1 exec.ConditionalDelegate) {
+	fake.conditionalDelegateMutex.Lock()
+	defer fake.conditionalDelegateMutex.Unlock()
+	fake.ConditionalDelegateStub = nil
+	if fake.conditionalDelegateReturnsOnCall == nil {
+		fake.conditionalDelegateReturnsOnCall = make(map[int]struct {
+			result1 exec.ConditionalDelegate
+		})
+	}
+	fake.conditionalDelegateReturnsOnCall[i] = struct {
+		result1 exec.ConditionalDelegate
+	}{result1}
+}
+
 func (fake *FakeBuildDelegate) Finish(arg1 lager.Logger, arg2 error, arg3 bool) {
 	fake.finishMutex.Lock()
 	fake.finishArgsForCall = append(fake.finishArgsForCall, struct {
@@ -219,6 +383,66 @@ func (fake *FakeBuildDelegate) GetDelegateReturnsOnCall(i int, result1 exec.GetD
 	}{result1}
 }
 
+func (fake *FakeBuildDelegate) LoadVarDelegate(arg1 atc.PlanID) exec.LoadVarDelegate {
+	fake.loadVarDelegateMutex.Lock()
+	ret, specificReturn := fake.loadVarDelegateReturnsOnCall[len(fake.loadVarDelegateArgsForCall)]
+	fake.loadVarDelegateArgsForCall = append(fake.loadVarDelegateArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	fake.recordInvocation("LoadVarDelegate", []interface{}{arg1})
+	fake.loadVarDelegateMutex.Unlock()
+	if fake.LoadVarDelegateStub != nil {
+		return fake.LoadVarDelegateStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.loadVarDelegateReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildDelegate) LoadVarDelegateCallCount() int {
+	fake.loadVarDelegateMutex.RLock()
+	defer fake.loadVarDelegateMutex.RUnlock()
+	return len(fake.loadVarDelegateArgsForCall)
+}
+
+func (fake *FakeBuildDelegate) LoadVarDelegateCalls(stub func(atc.PlanID) exec.LoadVarDelegate) {
+	fake.loadVarDelegateMutex.Lock()
+	defer fake.loadVarDelegateMutex.Unlock()
+	fake.LoadVarDelegateStub = stub
+}
+
+func (fake *FakeBuildDelegate) LoadVarDelegateArgsForCall(i int) atc.PlanID {
+	fake.loadVarDelegateMutex.RLock()
+	defer fake.loadVarDelegateMutex.RUnlock()
+	argsForCall := fake.loadVarDelegateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildDelegate) LoadVarDelegateReturns(result1 exec.LoadVarDelegate) {
+	fake.loadVarDelegateMutex.Lock()
+	defer fake.loadVarDelegateMutex.Unlock()
+	fake.LoadVarDelegateStub = nil
+	fake.loadVarDelegateReturns = struct {
+		result1 exec.LoadVarDelegate
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) LoadVarDelegateReturnsOnCall(i int, result1 exec.LoadVarDelegate) {
+	fake.loadVarDelegateMutex.Lock()
+	defer fake.loadVarDelegateMutex.Unlock()
+	fake.LoadVarDelegateStub = nil
+	if fake.loadVarDelegateReturnsOnCall == nil {
+		fake.loadVarDelegateReturnsOnCall = make(map[int]struct {
+			result1 exec.LoadVarDelegate
+		})
+	}
+	fake.loadVarDelegateReturnsOnCall[i] = struct {
+		result1 exec.LoadVarDelegate
+	}{result1}
+}
+
 func (fake *FakeBuildDelegate) PutDelegate(arg1 atc.PlanID) exec.PutDelegate {
 	fake.putDelegateMutex.Lock()
 	ret, specificReturn := fake.putDelegateReturnsOnCall[len(fake.putDelegateArgsForCall)]
@@ -279,6 +503,66 @@ func (fake *FakeBuildDelegate) PutDelegateReturnsOnCall(i int, result1 exec.PutD
 	}{result1}
 }
 
+func (fake *FakeBuildDelegate) SetPipelineDelegate(arg1 atc.PlanID) exec.SetPipelineDelegate {
+	fake.setPipelineDelegateMutex.Lock()
+	ret, specificReturn := fake.setPipelineDelegateReturnsOnCall[len(fake.setPipelineDelegateArgsForCall)]
+	fake.setPipelineDelegateArgsForCall = append(fake.setPipelineDelegateArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	fake.recordInvocation("SetPipelineDelegate", []interface{}{arg1})
+	fake.setPipelineDelegateMutex.Unlock()
+	if fake.SetPipelineDelegateStub != nil {
+		return fake.SetPipelineDelegateStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setPipelineDelegateReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildDelegate) SetPipelineDelegateCallCount() int {
+	fake.setPipelineDelegateMutex.RLock()
+	defer fake.setPipelineDelegateMutex.RUnlock()
+	return len(fake.setPipelineDelegateArgsForCall)
+}
+
+func (fake *FakeBuildDelegate) SetPipelineDelegateCalls(stub func(atc.PlanID) exec.SetPipelineDelegate) {
+	fake.setPipelineDelegateMutex.Lock()
+	defer fake.setPipelineDelegateMutex.Unlock()
+	fake.SetPipelineDelegateStub = stub
+}
+
+func (fake *FakeBuildDelegate) SetPipelineDelegateArgsForCall(i int) atc.PlanID {
+	fake.setPipelineDelegateMutex.RLock()
+	defer fake.setPipelineDelegateMutex.RUnlock()
+	argsForCall := fake.setPipelineDelegateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildDelegate) SetPipelineDelegateReturns(result1 exec.SetPipelineDelegate) {
+	fake.setPipelineDelegateMutex.Lock()
+	defer fake.setPipelineDelegateMutex.Unlock()
+	fake.SetPipelineDelegateStub = nil
+	fake.setPipelineDelegateReturns = struct {
+		result1 exec.SetPipelineDelegate
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) SetPipelineDelegateReturnsOnCall(i int, result1 exec.SetPipelineDelegate) {
+	fake.setPipelineDelegateMutex.Lock()
+	defer fake.setPipelineDelegateMutex.Unlock()
+	fake.SetPipelineDelegateStub = nil
+	if fake.setPipelineDelegateReturnsOnCall == nil {
+		fake.setPipelineDelegateReturnsOnCall = make(map[int]struct {
+			result1 exec.SetPipelineDelegate
+		})
+	}
+	fake.setPipelineDelegateReturnsOnCall[i] = struct {
+		result1 exec.SetPipelineDelegate
+	}{result1}
+}
+
 func (fake *FakeBuildDelegate) TaskDelegate(arg1 atc.PlanID) exec.TaskDelegate {
 	fake.taskDelegateMutex.Lock()
 	ret, specificReturn := fake.taskDelegateReturnsOnCall[len(fake.taskDelegateArgsForCall)]
@@ -342,14 +626,22 @@ func (fake *FakeBuildDelegate) TaskDelegateReturnsOnCall(i int, result1 exec.Tas
 func (fake *FakeBuildDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.approvalDelegateMutex.RLock()
+	defer fake.approvalDelegateMutex.RUnlock()
 	fake.buildStepDelegateMutex.RLock()
 	defer fake.buildStepDelegateMutex.RUnlock()
+	fake.conditionalDelegateMutex.RLock()
+	defer fake.conditionalDelegateMutex.RUnlock()
 	fake.finishMutex.RLock()
 	defer fake.finishMutex.RUnlock()
 	fake.getDelegateMutex.RLock()
 	defer fake.getDelegateMutex.RUnlock()
+	fake.loadVarDelegateMutex.RLock()
+	defer fake.loadVarDelegateMutex.RUnlock()
 	fake.putDelegateMutex.RLock()
 	defer fake.putDelegateMutex.RUnlock()
+	fake.setPipelineDelegateMutex.RLock()
+	defer fake.setPipelineDelegateMutex.RUnlock()
 	fake.taskDelegateMutex.RLock()
 	defer fake.taskDelegateMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
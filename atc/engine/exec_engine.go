@@ -11,8 +11,10 @@ import (
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/tracing"
 )
 
 type execMetadata struct {
@@ -25,6 +27,7 @@ type execEngine struct {
 	factory         exec.Factory
 	delegateFactory BuildDelegateFactory
 	externalURL     string
+	redactSecrets   bool
 
 	releaseCh     chan struct{}
 	trackedStates *sync.Map
@@ -34,11 +37,13 @@ func NewExecEngine(
 	factory exec.Factory,
 	delegateFactory BuildDelegateFactory,
 	externalURL string,
+	redactSecrets bool,
 ) Engine {
 	return &execEngine{
 		factory:         factory,
 		delegateFactory: delegateFactory,
 		externalURL:     externalURL,
+		redactSecrets:   redactSecrets,
 
 		releaseCh:     make(chan struct{}),
 		trackedStates: new(sync.Map),
@@ -57,8 +62,9 @@ func (engine *execEngine) CreateBuild(logger lager.Logger, build db.Build, plan
 
 		stepMetadata: buildMetadata(build, engine.externalURL),
 
-		factory:  engine.factory,
-		delegate: engine.delegateFactory.Delegate(build),
+		factory:         engine.factory,
+		delegateFactory: engine.delegateFactory,
+		redactSecrets:   engine.redactSecrets,
 		metadata: execMetadata{
 			Plan: plan,
 		},
@@ -87,9 +93,10 @@ func (engine *execEngine) LookupBuild(logger lager.Logger, build db.Build) (Buil
 
 		stepMetadata: buildMetadata(build, engine.externalURL),
 
-		factory:  engine.factory,
-		delegate: engine.delegateFactory.Delegate(build),
-		metadata: metadata,
+		factory:         engine.factory,
+		delegateFactory: engine.delegateFactory,
+		redactSecrets:   engine.redactSecrets,
+		metadata:        metadata,
 
 		ctx:    ctx,
 		cancel: cancel,
@@ -119,8 +126,11 @@ type execBuild struct {
 	dbBuild      db.Build
 	stepMetadata StepMetadata
 
-	factory  exec.Factory
-	delegate BuildDelegate
+	factory         exec.Factory
+	delegateFactory BuildDelegateFactory
+	delegate        BuildDelegate
+	redactSecrets   bool
+	variables       *creds.BuildVariables
 
 	ctx    context.Context
 	cancel func()
@@ -146,13 +156,27 @@ func (build *execBuild) Abort(lager.Logger) error {
 }
 
 func (build *execBuild) Resume(logger lager.Logger) {
-	step := build.buildStep(logger, build.metadata.Plan)
-
-	runCtx := lagerctx.NewContext(build.ctx, logger)
-
 	state := build.runState()
 	defer build.clearRunState()
 
+	build.variables = state.Variables()
+
+	// redacted vars, e.g. from a 'load_var' step, are always redacted; only
+	// redacting credentials is opt-in
+	build.variables.TrackCredentials(build.redactSecrets)
+
+	build.delegate = build.delegateFactory.Delegate(newRedactingBuild(build.dbBuild, build.variables))
+
+	step := build.buildStep(logger, build.metadata.Plan)
+
+	runCtx, span := tracing.StartSpan(lagerctx.NewContext(build.ctx, logger), "build", tracing.Attrs{
+		"team":     build.stepMetadata.TeamName,
+		"pipeline": build.stepMetadata.PipelineName,
+		"job":      build.stepMetadata.JobName,
+		"build":    build.stepMetadata.BuildName,
+		"build_id": strconv.Itoa(build.stepMetadata.BuildID),
+	})
+
 	done := make(chan error, 1)
 	go func() {
 		done <- step.Run(runCtx, state)
@@ -162,8 +186,10 @@ func (build *execBuild) Resume(logger lager.Logger) {
 		select {
 		case <-build.releaseCh:
 			logger.Info("releasing")
+			span.End()
 			return
 		case err := <-done:
+			tracing.End(span, err)
 			build.delegate.Finish(logger.Session("finish"), err, step.Succeeded())
 			return
 		}
@@ -204,6 +230,14 @@ func (build *execBuild) buildStep(logger lager.Logger, plan atc.Plan) exec.Step
 		return build.buildTryStep(logger, plan)
 	}
 
+	if plan.Conditional != nil {
+		return build.buildConditionalStep(logger, plan)
+	}
+
+	if plan.Across != nil {
+		return build.buildAcrossStep(logger, plan)
+	}
+
 	if plan.OnAbort != nil {
 		return build.buildOnAbortStep(logger, plan)
 	}
@@ -221,15 +255,27 @@ func (build *execBuild) buildStep(logger lager.Logger, plan atc.Plan) exec.Step
 	}
 
 	if plan.Task != nil {
-		return build.buildTaskStep(logger, plan)
+		return build.measure("task", plan.Task.Name, build.buildTaskStep(logger, plan))
 	}
 
 	if plan.Get != nil {
-		return build.buildGetStep(logger, plan)
+		return build.measure("get", plan.Get.Name, build.buildGetStep(logger, plan))
+	}
+
+	if plan.SetPipeline != nil {
+		return build.measure("set_pipeline", plan.SetPipeline.Name, build.buildSetPipelineStep(logger, plan))
+	}
+
+	if plan.LoadVar != nil {
+		return build.measure("load_var", plan.LoadVar.Name, build.buildLoadVarStep(logger, plan))
+	}
+
+	if plan.Approval != nil {
+		return build.measure("approval", plan.Approval.Name, build.buildApprovalStep(logger, plan))
 	}
 
 	if plan.Put != nil {
-		return build.buildPutStep(logger, plan)
+		return build.measure("put", plan.Put.Name, build.buildPutStep(logger, plan))
 	}
 
 	if plan.Retry != nil {
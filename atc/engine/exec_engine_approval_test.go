@@ -0,0 +1,84 @@
+package engine_test
+
+import (
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/engine"
+	"github.com/concourse/concourse/atc/engine/enginefakes"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Exec Engine with Approval", func() {
+	var (
+		fakeFactory         *execfakes.FakeFactory
+		fakeDelegateFactory *enginefakes.FakeBuildDelegateFactory
+
+		execEngine engine.Engine
+
+		build  *dbfakes.FakeBuild
+		logger *lagertest.TestLogger
+
+		fakeDelegate         *enginefakes.FakeBuildDelegate
+		fakeApprovalDelegate *execfakes.FakeApprovalDelegate
+
+		approvalStep *execfakes.FakeStep
+
+		plan atc.Plan
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+
+		fakeFactory = new(execfakes.FakeFactory)
+		fakeDelegateFactory = new(enginefakes.FakeBuildDelegateFactory)
+
+		execEngine = engine.NewExecEngine(
+			fakeFactory,
+			fakeDelegateFactory,
+			"http://example.com",
+			true,
+		)
+
+		fakeDelegate = new(enginefakes.FakeBuildDelegate)
+		fakeDelegateFactory.DelegateReturns(fakeDelegate)
+
+		fakeApprovalDelegate = new(execfakes.FakeApprovalDelegate)
+		fakeDelegate.ApprovalDelegateReturns(fakeApprovalDelegate)
+
+		build = new(dbfakes.FakeBuild)
+		build.IDReturns(4444)
+
+		approvalStep = new(execfakes.FakeStep)
+		approvalStep.SucceededReturns(true)
+		fakeFactory.ApprovalReturns(approvalStep)
+
+		plan = atc.NewPlanFactory(123).NewPlan(atc.ApprovalPlan{
+			Name: "ship-it",
+			Role: "owner",
+		})
+	})
+
+	JustBeforeEach(func() {
+		build, err := execEngine.CreateBuild(logger, build, plan)
+		Expect(err).NotTo(HaveOccurred())
+		build.Resume(logger)
+	})
+
+	It("constructs the approval step with its delegate", func() {
+		Expect(fakeFactory.ApprovalCallCount()).To(Equal(1))
+		_, approvalPlan, dbBuild, delegate := fakeFactory.ApprovalArgsForCall(0)
+		Expect(approvalPlan).To(Equal(plan))
+		Expect(dbBuild).To(Equal(build))
+		Expect(delegate).To(Equal(fakeApprovalDelegate))
+
+		Expect(fakeDelegate.ApprovalDelegateArgsForCall(0)).To(Equal(plan.ID))
+	})
+
+	It("runs the approval step", func() {
+		Expect(approvalStep.RunCallCount()).To(Equal(1))
+	})
+})
@@ -16,6 +16,10 @@ type BuildDelegate interface {
 	GetDelegate(atc.PlanID) exec.GetDelegate
 	PutDelegate(atc.PlanID) exec.PutDelegate
 	TaskDelegate(atc.PlanID) exec.TaskDelegate
+	ConditionalDelegate(atc.PlanID) exec.ConditionalDelegate
+	SetPipelineDelegate(atc.PlanID) exec.SetPipelineDelegate
+	LoadVarDelegate(atc.PlanID) exec.LoadVarDelegate
+	ApprovalDelegate(atc.PlanID) exec.ApprovalDelegate
 
 	BuildStepDelegate(atc.PlanID) exec.BuildStepDelegate
 
@@ -60,6 +64,22 @@ func (delegate *delegate) TaskDelegate(planID atc.PlanID) exec.TaskDelegate {
 	return NewTaskDelegate(delegate.build, planID, clock.NewClock())
 }
 
+func (delegate *delegate) ConditionalDelegate(planID atc.PlanID) exec.ConditionalDelegate {
+	return NewConditionalDelegate(delegate.build, planID, clock.NewClock())
+}
+
+func (delegate *delegate) SetPipelineDelegate(planID atc.PlanID) exec.SetPipelineDelegate {
+	return NewSetPipelineDelegate(delegate.build, planID, clock.NewClock())
+}
+
+func (delegate *delegate) LoadVarDelegate(planID atc.PlanID) exec.LoadVarDelegate {
+	return NewLoadVarDelegate(delegate.build, planID, clock.NewClock())
+}
+
+func (delegate *delegate) ApprovalDelegate(planID atc.PlanID) exec.ApprovalDelegate {
+	return NewApprovalDelegate(delegate.build, planID, clock.NewClock())
+}
+
 func (delegate *delegate) BuildStepDelegate(planID atc.PlanID) exec.BuildStepDelegate {
 	return NewBuildStepDelegate(delegate.build, planID, clock.NewClock())
 }
@@ -0,0 +1,126 @@
+package engine_test
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/engine"
+	"github.com/concourse/concourse/atc/engine/enginefakes"
+
+	"github.com/concourse/concourse/atc/exec/execfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Exec Engine with Conditional", func() {
+	var (
+		fakeFactory         *execfakes.FakeFactory
+		fakeDelegateFactory *enginefakes.FakeBuildDelegateFactory
+
+		execEngine engine.Engine
+
+		build            *dbfakes.FakeBuild
+		expectedMetadata engine.StepMetadata
+		logger           *lagertest.TestLogger
+
+		fakeDelegate            *enginefakes.FakeBuildDelegate
+		fakeConditionalDelegate *execfakes.FakeConditionalDelegate
+
+		inputStep       *execfakes.FakeStep
+		conditionalStep *execfakes.FakeStep
+
+		inputPlan atc.Plan
+		plan      atc.Plan
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+
+		fakeFactory = new(execfakes.FakeFactory)
+		fakeDelegateFactory = new(enginefakes.FakeBuildDelegateFactory)
+
+		execEngine = engine.NewExecEngine(
+			fakeFactory,
+			fakeDelegateFactory,
+			"http://example.com",
+			false,
+		)
+
+		fakeDelegate = new(enginefakes.FakeBuildDelegate)
+		fakeDelegateFactory.DelegateReturns(fakeDelegate)
+
+		fakeConditionalDelegate = new(execfakes.FakeConditionalDelegate)
+		fakeDelegate.ConditionalDelegateReturns(fakeConditionalDelegate)
+
+		build = new(dbfakes.FakeBuild)
+		build.IDReturns(4444)
+		build.NameReturns("42")
+		build.JobNameReturns("some-job")
+		build.PipelineNameReturns("some-pipeline")
+		build.TeamNameReturns("some-team")
+
+		expectedMetadata = engine.StepMetadata{
+			BuildID:      4444,
+			BuildName:    "42",
+			JobName:      "some-job",
+			PipelineName: "some-pipeline",
+			TeamName:     "some-team",
+			ExternalURL:  "http://example.com",
+		}
+
+		inputStep = new(execfakes.FakeStep)
+		inputStep.SucceededReturns(true)
+		fakeFactory.GetReturns(inputStep)
+
+		conditionalStep = new(execfakes.FakeStep)
+		conditionalStep.SucceededReturns(true)
+		fakeFactory.ConditionalReturns(conditionalStep)
+
+		planFactory := atc.NewPlanFactory(123)
+
+		inputPlan = planFactory.NewPlan(atc.GetPlan{
+			Name: "some-input",
+		})
+
+		plan = planFactory.NewPlan(atc.ConditionalPlan{
+			Condition: `var("enabled")`,
+			Step:      inputPlan,
+		})
+	})
+
+	JustBeforeEach(func() {
+		build, err := execEngine.CreateBuild(logger, build, plan)
+		Expect(err).NotTo(HaveOccurred())
+		build.Resume(logger)
+	})
+
+	It("constructs the conditional step around the nested step", func() {
+		Expect(fakeFactory.GetCallCount()).To(Equal(1))
+		_, getPlan, _, _, _, _, _ := fakeFactory.GetArgsForCall(0)
+		Expect(getPlan).To(Equal(inputPlan))
+
+		Expect(fakeFactory.ConditionalCallCount()).To(Equal(1))
+		_, conditionalPlan, dbBuild, _, stepMetadata, delegate, step := fakeFactory.ConditionalArgsForCall(0)
+		Expect(conditionalPlan).To(Equal(plan))
+		Expect(dbBuild).To(Equal(build))
+		Expect(stepMetadata).To(Equal(expectedMetadata))
+		Expect(delegate).To(Equal(fakeConditionalDelegate))
+
+		By("wrapping the nested step to measure it")
+		Expect(step.Run(context.TODO(), new(execfakes.FakeRunState))).To(Succeed())
+		Expect(inputStep.RunCallCount()).To(Equal(1))
+		Expect(step.Succeeded()).To(BeTrue())
+	})
+
+	It("uses the conditional delegate for the plan", func() {
+		Expect(fakeDelegate.ConditionalDelegateCallCount()).To(Equal(1))
+		Expect(fakeDelegate.ConditionalDelegateArgsForCall(0)).To(Equal(plan.ID))
+	})
+
+	It("runs the conditional step", func() {
+		Expect(conditionalStep.RunCallCount()).To(Equal(1))
+	})
+})
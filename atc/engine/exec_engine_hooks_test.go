@@ -41,6 +41,7 @@ var _ = Describe("Exec Engine With Hooks", func() {
 			fakeFactory,
 			fakeDelegateFactory,
 			"http://example.com",
+			false,
 		)
 
 		fakeDelegate = new(enginefakes.FakeBuildDelegate)
@@ -155,7 +156,7 @@ var _ = Describe("Exec Engine With Hooks", func() {
 
 				It("constructs the step correctly", func() {
 					Expect(fakeFactory.GetCallCount()).To(Equal(1))
-					logger, plan, dbBuild, stepMetadata, containerMetadata, _ := fakeFactory.GetArgsForCall(0)
+					logger, plan, dbBuild, _, stepMetadata, containerMetadata, _ := fakeFactory.GetArgsForCall(0)
 					Expect(logger).NotTo(BeNil())
 					Expect(dbBuild).To(Equal(build))
 					Expect(plan).To(Equal(inputPlan))
@@ -174,7 +175,7 @@ var _ = Describe("Exec Engine With Hooks", func() {
 
 				It("constructs the completion hook correctly", func() {
 					Expect(fakeFactory.TaskCallCount()).To(Equal(4))
-					logger, plan, dbBuild, containerMetadata, _ := fakeFactory.TaskArgsForCall(2)
+					logger, plan, dbBuild, _, containerMetadata, _ := fakeFactory.TaskArgsForCall(2)
 					Expect(logger).NotTo(BeNil())
 					Expect(dbBuild).To(Equal(build))
 					Expect(plan).To(Equal(completionTaskPlan))
@@ -192,7 +193,7 @@ var _ = Describe("Exec Engine With Hooks", func() {
 
 				It("constructs the failure hook correctly", func() {
 					Expect(fakeFactory.TaskCallCount()).To(Equal(4))
-					logger, plan, dbBuild, containerMetadata, _ := fakeFactory.TaskArgsForCall(0)
+					logger, plan, dbBuild, _, containerMetadata, _ := fakeFactory.TaskArgsForCall(0)
 					Expect(logger).NotTo(BeNil())
 					Expect(dbBuild).To(Equal(build))
 					Expect(plan).To(Equal(failureTaskPlan))
@@ -210,7 +211,7 @@ var _ = Describe("Exec Engine With Hooks", func() {
 
 				It("constructs the success hook correctly", func() {
 					Expect(fakeFactory.TaskCallCount()).To(Equal(4))
-					logger, plan, dbBuild, containerMetadata, _ := fakeFactory.TaskArgsForCall(1)
+					logger, plan, dbBuild, _, containerMetadata, _ := fakeFactory.TaskArgsForCall(1)
 					Expect(logger).NotTo(BeNil())
 					Expect(dbBuild).To(Equal(build))
 					Expect(plan).To(Equal(successTaskPlan))
@@ -228,7 +229,7 @@ var _ = Describe("Exec Engine With Hooks", func() {
 
 				It("constructs the next step correctly", func() {
 					Expect(fakeFactory.TaskCallCount()).To(Equal(4))
-					logger, plan, dbBuild, containerMetadata, _ := fakeFactory.TaskArgsForCall(3)
+					logger, plan, dbBuild, _, containerMetadata, _ := fakeFactory.TaskArgsForCall(3)
 					Expect(logger).NotTo(BeNil())
 					Expect(dbBuild).To(Equal(build))
 					Expect(plan).To(Equal(nextTaskPlan))
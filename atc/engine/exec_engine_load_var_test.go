@@ -0,0 +1,121 @@
+package engine_test
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/engine"
+	"github.com/concourse/concourse/atc/engine/enginefakes"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Exec Engine with LoadVar", func() {
+	var (
+		fakeFactory         *execfakes.FakeFactory
+		fakeDelegateFactory *enginefakes.FakeBuildDelegateFactory
+
+		execEngine engine.Engine
+
+		build  *dbfakes.FakeBuild
+		logger *lagertest.TestLogger
+
+		fakeDelegate        *enginefakes.FakeBuildDelegate
+		fakeLoadVarDelegate *execfakes.FakeLoadVarDelegate
+
+		loadVarStep *execfakes.FakeStep
+
+		plan atc.Plan
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+
+		fakeFactory = new(execfakes.FakeFactory)
+		fakeDelegateFactory = new(enginefakes.FakeBuildDelegateFactory)
+
+		execEngine = engine.NewExecEngine(
+			fakeFactory,
+			fakeDelegateFactory,
+			"http://example.com",
+			true,
+		)
+
+		fakeDelegate = new(enginefakes.FakeBuildDelegate)
+		fakeDelegateFactory.DelegateReturns(fakeDelegate)
+
+		fakeLoadVarDelegate = new(execfakes.FakeLoadVarDelegate)
+		fakeDelegate.LoadVarDelegateReturns(fakeLoadVarDelegate)
+
+		build = new(dbfakes.FakeBuild)
+		build.IDReturns(4444)
+
+		loadVarStep = new(execfakes.FakeStep)
+		loadVarStep.SucceededReturns(true)
+		loadVarStep.RunStub = func(ctx context.Context, state exec.RunState) error {
+			state.Variables().SetVar("some-var", "some-secret", true)
+			state.Variables().SetVar("some-revealed-var", "some-value", false)
+			return nil
+		}
+		fakeFactory.LoadVarReturns(loadVarStep)
+
+		plan = atc.NewPlanFactory(123).NewPlan(atc.LoadVarPlan{
+			Name: "some-var",
+			File: "some-artifact/some-file",
+		})
+	})
+
+	JustBeforeEach(func() {
+		build, err := execEngine.CreateBuild(logger, build, plan)
+		Expect(err).NotTo(HaveOccurred())
+		build.Resume(logger)
+	})
+
+	It("constructs the load_var step with its delegate", func() {
+		Expect(fakeFactory.LoadVarCallCount()).To(Equal(1))
+		_, loadVarPlan, _, delegate := fakeFactory.LoadVarArgsForCall(0)
+		Expect(loadVarPlan).To(Equal(plan))
+		Expect(delegate).To(Equal(fakeLoadVarDelegate))
+
+		Expect(fakeDelegate.LoadVarDelegateArgsForCall(0)).To(Equal(plan.ID))
+	})
+
+	It("runs the load_var step", func() {
+		Expect(loadVarStep.RunCallCount()).To(Equal(1))
+	})
+
+	Describe("the build given to the delegate", func() {
+		It("redacts the loaded vars from log events", func() {
+			delegateBuild := fakeDelegateFactory.DelegateArgsForCall(0)
+
+			err := delegateBuild.SaveEvent(event.Log{
+				Payload: "some-secret and some-value",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(build.SaveEventCallCount()).To(Equal(1))
+			Expect(build.SaveEventArgsForCall(0)).To(Equal(event.Log{
+				Payload: "((redacted)) and some-value",
+			}))
+		})
+
+		It("leaves other events alone", func() {
+			delegateBuild := fakeDelegateFactory.DelegateArgsForCall(0)
+
+			err := delegateBuild.SaveEvent(event.Error{
+				Message: "some-secret",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(build.SaveEventArgsForCall(0)).To(Equal(event.Error{
+				Message: "some-secret",
+			}))
+		})
+	})
+})
@@ -0,0 +1,187 @@
+package engine_test
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/engine"
+	"github.com/concourse/concourse/atc/engine/enginefakes"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Exec Engine redacting secrets", func() {
+	var (
+		fakeFactory         *execfakes.FakeFactory
+		fakeDelegateFactory *enginefakes.FakeBuildDelegateFactory
+
+		redactSecrets bool
+
+		build  *dbfakes.FakeBuild
+		logger *lagertest.TestLogger
+
+		fakeDelegate     *enginefakes.FakeBuildDelegate
+		fakeTaskDelegate *execfakes.FakeTaskDelegate
+
+		taskStep *execfakes.FakeStep
+
+		delegateBuild interface {
+			SaveEvent(atc.Event) error
+		}
+
+		stdout = event.Origin{ID: "some-plan", Source: event.OriginSourceStdout}
+		stderr = event.Origin{ID: "some-plan", Source: event.OriginSourceStderr}
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+
+		fakeFactory = new(execfakes.FakeFactory)
+		fakeDelegateFactory = new(enginefakes.FakeBuildDelegateFactory)
+
+		fakeDelegate = new(enginefakes.FakeBuildDelegate)
+		fakeDelegateFactory.DelegateReturns(fakeDelegate)
+
+		fakeTaskDelegate = new(execfakes.FakeTaskDelegate)
+		fakeDelegate.TaskDelegateReturns(fakeTaskDelegate)
+
+		build = new(dbfakes.FakeBuild)
+		build.IDReturns(4444)
+
+		taskStep = new(execfakes.FakeStep)
+		taskStep.SucceededReturns(true)
+		fakeFactory.TaskReturns(taskStep)
+
+		redactSecrets = true
+	})
+
+	JustBeforeEach(func() {
+		fakeFactory.TaskStub = func(_ lager.Logger, _ atc.Plan, _ db.Build, buildVariables *creds.BuildVariables, _ db.ContainerMetadata, _ exec.TaskDelegate) exec.Step {
+			taskStep.RunStub = func(context.Context, exec.RunState) error {
+				buildVariables.Track("some-secret")
+				buildVariables.SetVar("some-var", "some-loaded-value", true)
+				return nil
+			}
+
+			return taskStep
+		}
+
+		execEngine := engine.NewExecEngine(
+			fakeFactory,
+			fakeDelegateFactory,
+			"http://example.com",
+			redactSecrets,
+		)
+
+		plan := atc.NewPlanFactory(123).NewPlan(atc.TaskPlan{
+			Name:   "some-task",
+			Config: &atc.TaskConfig{},
+		})
+
+		engineBuild, err := execEngine.CreateBuild(logger, build, plan)
+		Expect(err).NotTo(HaveOccurred())
+		engineBuild.Resume(logger)
+
+		delegateBuild = fakeDelegateFactory.DelegateArgsForCall(0)
+	})
+
+	It("gives the build's vars to the factory", func() {
+		Expect(fakeFactory.TaskCallCount()).To(Equal(1))
+		_, _, _, buildVariables, _, _ := fakeFactory.TaskArgsForCall(0)
+		Expect(buildVariables.RedactedValues()).To(Equal([]string{"some-loaded-value", "some-secret"}))
+	})
+
+	It("redacts the values tracked during the build from log events", func() {
+		err := delegateBuild.SaveEvent(event.Log{
+			Origin:  stdout,
+			Payload: "echo some-secret\n",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(build.SaveEventCallCount()).To(Equal(1))
+		Expect(build.SaveEventArgsForCall(0)).To(Equal(event.Log{
+			Origin:  stdout,
+			Payload: "echo ((redacted))\n",
+		}))
+	})
+
+	It("redacts values split across log events", func() {
+		Expect(delegateBuild.SaveEvent(event.Log{Origin: stdout, Payload: "echo some-"})).To(Succeed())
+		Expect(delegateBuild.SaveEvent(event.Log{Origin: stderr, Payload: "some-other-output\n"})).To(Succeed())
+		Expect(delegateBuild.SaveEvent(event.Log{Origin: stdout, Payload: "sec"})).To(Succeed())
+		Expect(delegateBuild.SaveEvent(event.Log{Origin: stdout, Payload: "ret and more\n"})).To(Succeed())
+
+		Expect(build.SaveEventCallCount()).To(Equal(3))
+		Expect(build.SaveEventArgsForCall(0)).To(Equal(event.Log{
+			Origin:  stdout,
+			Payload: "echo ",
+		}))
+		Expect(build.SaveEventArgsForCall(1)).To(Equal(event.Log{
+			Origin:  stderr,
+			Payload: "some-other-output\n",
+		}))
+		Expect(build.SaveEventArgsForCall(2)).To(Equal(event.Log{
+			Origin:  stdout,
+			Payload: "((redacted)) and more\n",
+		}))
+	})
+
+	It("flushes held back output before saving other events", func() {
+		Expect(delegateBuild.SaveEvent(event.Log{Origin: stdout, Payload: "done: some-"})).To(Succeed())
+		Expect(delegateBuild.SaveEvent(event.FinishTask{ExitStatus: 0})).To(Succeed())
+
+		Expect(build.SaveEventCallCount()).To(Equal(3))
+		Expect(build.SaveEventArgsForCall(0)).To(Equal(event.Log{
+			Origin:  stdout,
+			Payload: "done: ",
+		}))
+		Expect(build.SaveEventArgsForCall(1)).To(Equal(event.Log{
+			Origin:  stdout,
+			Payload: "some-",
+		}))
+		Expect(build.SaveEventArgsForCall(2)).To(Equal(event.FinishTask{ExitStatus: 0}))
+	})
+
+	Context("when redacting secrets is disabled", func() {
+		BeforeEach(func() {
+			redactSecrets = false
+		})
+
+		It("does not redact credentials tracked during the build", func() {
+			err := delegateBuild.SaveEvent(event.Log{
+				Origin:  stdout,
+				Payload: "echo some-secret\n",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(build.SaveEventCallCount()).To(Equal(1))
+			Expect(build.SaveEventArgsForCall(0)).To(Equal(event.Log{
+				Origin:  stdout,
+				Payload: "echo some-secret\n",
+			}))
+		})
+
+		It("still redacts the values of redacted vars", func() {
+			err := delegateBuild.SaveEvent(event.Log{
+				Origin:  stdout,
+				Payload: "echo some-loaded-value\n",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(build.SaveEventCallCount()).To(Equal(1))
+			Expect(build.SaveEventArgsForCall(0)).To(Equal(event.Log{
+				Origin:  stdout,
+				Payload: "echo ((redacted))\n",
+			}))
+		})
+	})
+})
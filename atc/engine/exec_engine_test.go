@@ -36,6 +36,7 @@ var _ = Describe("ExecEngine", func() {
 			fakeFactory,
 			fakeDelegateFactory,
 			"http://example.com",
+			false,
 		)
 	})
 
@@ -141,7 +142,7 @@ var _ = Describe("ExecEngine", func() {
 					build.Resume(logger)
 					Expect(fakeFactory.PutCallCount()).To(Equal(2))
 
-					logger, plan, build, stepMetadata, containerMetadata, _ := fakeFactory.PutArgsForCall(0)
+					logger, plan, build, _, stepMetadata, containerMetadata, _ := fakeFactory.PutArgsForCall(0)
 					Expect(logger).NotTo(BeNil())
 					Expect(build).To(Equal(dbBuild))
 					Expect(plan).To(Equal(putPlan))
@@ -157,7 +158,7 @@ var _ = Describe("ExecEngine", func() {
 						BuildName:    "42",
 					}))
 
-					logger, plan, build, stepMetadata, containerMetadata, _ = fakeFactory.PutArgsForCall(1)
+					logger, plan, build, _, stepMetadata, containerMetadata, _ = fakeFactory.PutArgsForCall(1)
 					Expect(logger).NotTo(BeNil())
 					Expect(build).To(Equal(dbBuild))
 					Expect(plan).To(Equal(otherPutPlan))
@@ -235,7 +236,7 @@ var _ = Describe("ExecEngine", func() {
 			})
 
 			It("constructs the first get correctly", func() {
-				logger, plan, build, stepMetadata, containerMetadata, _ := fakeFactory.GetArgsForCall(0)
+				logger, plan, build, _, stepMetadata, containerMetadata, _ := fakeFactory.GetArgsForCall(0)
 				Expect(logger).NotTo(BeNil())
 				Expect(build).To(Equal(dbBuild))
 				expectedPlan := getPlan
@@ -256,7 +257,7 @@ var _ = Describe("ExecEngine", func() {
 			})
 
 			It("constructs the second get correctly", func() {
-				logger, plan, build, stepMetadata, containerMetadata, _ := fakeFactory.GetArgsForCall(1)
+				logger, plan, build, _, stepMetadata, containerMetadata, _ := fakeFactory.GetArgsForCall(1)
 				Expect(logger).NotTo(BeNil())
 				Expect(build).To(Equal(dbBuild))
 				expectedPlan := getPlan
@@ -281,7 +282,7 @@ var _ = Describe("ExecEngine", func() {
 			})
 
 			It("constructs nested steps correctly", func() {
-				logger, plan, build, containerMetadata, _ := fakeFactory.TaskArgsForCall(0)
+				logger, plan, build, _, containerMetadata, _ := fakeFactory.TaskArgsForCall(0)
 				Expect(logger).NotTo(BeNil())
 				Expect(build).To(Equal(dbBuild))
 				expectedPlan := taskPlan
@@ -299,7 +300,7 @@ var _ = Describe("ExecEngine", func() {
 					Attempt:      "2.1",
 				}))
 
-				logger, plan, build, containerMetadata, _ = fakeFactory.TaskArgsForCall(1)
+				logger, plan, build, _, containerMetadata, _ = fakeFactory.TaskArgsForCall(1)
 				Expect(logger).NotTo(BeNil())
 				Expect(build).To(Equal(dbBuild))
 				expectedPlan = taskPlan
@@ -368,15 +369,15 @@ var _ = Describe("ExecEngine", func() {
 			})
 
 			It("constructs nested steps correctly", func() {
-				_, _, _, containerMetadata, _ := fakeFactory.TaskArgsForCall(0)
+				_, _, _, _, containerMetadata, _ := fakeFactory.TaskArgsForCall(0)
 				Expect(containerMetadata.Attempt).To(Equal("1"))
-				_, _, _, containerMetadata, _ = fakeFactory.TaskArgsForCall(1)
+				_, _, _, _, containerMetadata, _ = fakeFactory.TaskArgsForCall(1)
 				Expect(containerMetadata.Attempt).To(Equal("1"))
-				_, _, _, containerMetadata, _ = fakeFactory.TaskArgsForCall(2)
+				_, _, _, _, containerMetadata, _ = fakeFactory.TaskArgsForCall(2)
 				Expect(containerMetadata.Attempt).To(Equal("1"))
-				_, _, _, containerMetadata, _ = fakeFactory.TaskArgsForCall(3)
+				_, _, _, _, containerMetadata, _ = fakeFactory.TaskArgsForCall(3)
 				Expect(containerMetadata.Attempt).To(Equal("1"))
-				_, _, _, containerMetadata, _ = fakeFactory.TaskArgsForCall(4)
+				_, _, _, _, containerMetadata, _ = fakeFactory.TaskArgsForCall(4)
 				Expect(containerMetadata.Attempt).To(Equal("1"))
 			})
 		})
@@ -405,7 +406,7 @@ var _ = Describe("ExecEngine", func() {
 					build.Resume(logger)
 					Expect(fakeFactory.GetCallCount()).To(Equal(1))
 
-					logger, plan, dBuild, stepMetadata, containerMetadata, _ := fakeFactory.GetArgsForCall(0)
+					logger, plan, dBuild, _, stepMetadata, containerMetadata, _ := fakeFactory.GetArgsForCall(0)
 					Expect(logger).NotTo(BeNil())
 					Expect(dBuild).To(Equal(dbBuild))
 					Expect(plan).To(Equal(expectedPlan))
@@ -454,7 +455,7 @@ var _ = Describe("ExecEngine", func() {
 					build.Resume(logger)
 					Expect(fakeFactory.TaskCallCount()).To(Equal(1))
 
-					logger, plan, build, containerMetadata, _ := fakeFactory.TaskArgsForCall(0)
+					logger, plan, build, _, containerMetadata, _ := fakeFactory.TaskArgsForCall(0)
 					Expect(logger).NotTo(BeNil())
 					Expect(build).To(Equal(dbBuild))
 					Expect(plan).To(Equal(expectedPlan))
@@ -511,7 +512,7 @@ var _ = Describe("ExecEngine", func() {
 					build.Resume(logger)
 					Expect(fakeFactory.PutCallCount()).To(Equal(1))
 
-					logger, plan, build, stepMetadata, containerMetadata, _ := fakeFactory.PutArgsForCall(0)
+					logger, plan, build, _, stepMetadata, containerMetadata, _ := fakeFactory.PutArgsForCall(0)
 					Expect(logger).NotTo(BeNil())
 					Expect(build).To(Equal(dbBuild))
 					Expect(plan).To(Equal(putPlan))
@@ -536,7 +537,7 @@ var _ = Describe("ExecEngine", func() {
 					build.Resume(logger)
 					Expect(fakeFactory.GetCallCount()).To(Equal(1))
 
-					logger, plan, build, stepMetadata, containerMetadata, _ := fakeFactory.GetArgsForCall(0)
+					logger, plan, build, _, stepMetadata, containerMetadata, _ := fakeFactory.GetArgsForCall(0)
 					Expect(logger).NotTo(BeNil())
 					Expect(build).To(Equal(dbBuild))
 					Expect(plan).To(Equal(dependentGetPlan))
@@ -603,7 +604,7 @@ var _ = Describe("ExecEngine", func() {
 
 				foundBuild.Resume(logger)
 				Expect(fakeFactory.GetCallCount()).To(Equal(1))
-				logger, plan, build, stepMetadata, containerMetadata, _ := fakeFactory.GetArgsForCall(0)
+				logger, plan, build, _, stepMetadata, containerMetadata, _ := fakeFactory.GetArgsForCall(0)
 				Expect(logger).NotTo(BeNil())
 				Expect(build).To(Equal(dbBuild))
 				Expect(plan.ID).To(Equal(atc.PlanID("47")))
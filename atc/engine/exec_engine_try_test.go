@@ -42,6 +42,7 @@ var _ = Describe("Exec Engine with Try", func() {
 			fakeFactory,
 			fakeDelegateFactory,
 			"http://example.com",
+			false,
 		)
 
 		fakeDelegate = new(enginefakes.FakeBuildDelegate)
@@ -110,7 +111,7 @@ var _ = Describe("Exec Engine with Try", func() {
 
 			It("constructs the step correctly", func() {
 				Expect(fakeFactory.GetCallCount()).To(Equal(1))
-				logger, plan, dbBuild, stepMetadata, containerMetadata, _ := fakeFactory.GetArgsForCall(0)
+				logger, plan, dbBuild, _, stepMetadata, containerMetadata, _ := fakeFactory.GetArgsForCall(0)
 				Expect(logger).NotTo(BeNil())
 				Expect(dbBuild).To(Equal(build))
 				Expect(plan).To(Equal(inputPlan))
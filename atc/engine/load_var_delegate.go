@@ -0,0 +1,58 @@
+package engine
+
+import (
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/exec"
+)
+
+type loadVarDelegate struct {
+	exec.BuildStepDelegate
+
+	build       db.Build
+	eventOrigin event.Origin
+	clock       clock.Clock
+}
+
+func NewLoadVarDelegate(build db.Build, planID atc.PlanID, clock clock.Clock) exec.LoadVarDelegate {
+	return &loadVarDelegate{
+		BuildStepDelegate: NewBuildStepDelegate(build, planID, clock),
+
+		build: build,
+		eventOrigin: event.Origin{
+			ID: event.OriginID(planID),
+		},
+		clock: clock,
+	}
+}
+
+func (d *loadVarDelegate) Starting(logger lager.Logger) {
+	err := d.build.SaveEvent(event.StartLoadVar{
+		Origin: d.eventOrigin,
+		Time:   d.clock.Now().Unix(),
+	})
+	if err != nil {
+		logger.Error("failed-to-save-start-load-var-event", err)
+		return
+	}
+
+	logger.Debug("starting")
+}
+
+func (d *loadVarDelegate) Finished(logger lager.Logger, succeeded bool) {
+	err := d.build.SaveEvent(event.FinishLoadVar{
+		Origin:    d.eventOrigin,
+		Time:      d.clock.Now().Unix(),
+		Succeeded: succeeded,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-finish-load-var-event", err)
+		return
+	}
+
+	logger.Info("finished", lager.Data{"succeeded": succeeded})
+}
@@ -0,0 +1,51 @@
+package engine
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+// measuredStep emits a metric with the duration and outcome of the step it
+// wraps once the step has run.
+type measuredStep struct {
+	exec.Step
+
+	metadata StepMetadata
+	stepType string
+	stepName string
+}
+
+func (build *execBuild) measure(stepType string, stepName string, step exec.Step) exec.Step {
+	return measuredStep{
+		Step: step,
+
+		metadata: build.stepMetadata,
+		stepType: stepType,
+		stepName: stepName,
+	}
+}
+
+func (step measuredStep) Run(ctx context.Context, state exec.RunState) error {
+	start := time.Now()
+
+	err := step.Step.Run(ctx, state)
+
+	metric.StepFinished{
+		PipelineName: step.metadata.PipelineName,
+		JobName:      step.metadata.JobName,
+		BuildName:    step.metadata.BuildName,
+		BuildID:      step.metadata.BuildID,
+		TeamName:     step.metadata.TeamName,
+		StepName:     step.stepName,
+		StepType:     step.stepType,
+		Succeeded:    err == nil && step.Step.Succeeded(),
+		Errored:      err != nil,
+		Duration:     time.Since(start),
+	}.Emit(lagerctx.FromContext(ctx))
+
+	return err
+}
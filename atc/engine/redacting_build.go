@@ -0,0 +1,131 @@
+package engine
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+)
+
+// redactingBuild redacts the values tracked by the build's vars, i.e.
+// redacted vars and resolved credentials, from the log events saved for the
+// build.
+//
+// Output is saved in chunks, so a value may be split across events. To catch
+// these, any trailing text which could be the start of a redacted value is
+// held back and prepended to the next log event from the same origin. Held
+// back text is flushed before any other event is saved, e.g. when the step
+// finishes.
+type redactingBuild struct {
+	db.Build
+
+	variables *creds.BuildVariables
+
+	lock    sync.Mutex
+	origins []event.Origin
+	pending map[event.Origin]event.Log
+}
+
+func newRedactingBuild(build db.Build, variables *creds.BuildVariables) db.Build {
+	return &redactingBuild{
+		Build:     build,
+		variables: variables,
+
+		pending: map[event.Origin]event.Log{},
+	}
+}
+
+func (build *redactingBuild) SaveEvent(ev atc.Event) error {
+	build.lock.Lock()
+	defer build.lock.Unlock()
+
+	log, ok := ev.(event.Log)
+	if !ok {
+		err := build.flush()
+		if err != nil {
+			return err
+		}
+
+		return build.Build.SaveEvent(ev)
+	}
+
+	payload := log.Payload
+
+	held, found := build.pending[log.Origin]
+	if found {
+		payload = held.Payload + payload
+	}
+
+	payload = build.variables.Redact(payload)
+
+	holdBack := partialValueLength(payload, build.variables.RedactedValues())
+	if holdBack > 0 {
+		if !found {
+			build.origins = append(build.origins, log.Origin)
+		}
+
+		build.pending[log.Origin] = event.Log{
+			Time:    log.Time,
+			Origin:  log.Origin,
+			Payload: payload[len(payload)-holdBack:],
+		}
+
+		payload = payload[:len(payload)-holdBack]
+	} else if found {
+		build.forget(log.Origin)
+	}
+
+	if payload == "" {
+		return nil
+	}
+
+	log.Payload = payload
+
+	return build.Build.SaveEvent(log)
+}
+
+func (build *redactingBuild) flush() error {
+	for len(build.origins) > 0 {
+		origin := build.origins[0]
+
+		err := build.Build.SaveEvent(build.pending[origin])
+		if err != nil {
+			return err
+		}
+
+		build.forget(origin)
+	}
+
+	return nil
+}
+
+func (build *redactingBuild) forget(origin event.Origin) {
+	delete(build.pending, origin)
+
+	for i, o := range build.origins {
+		if o == origin {
+			build.origins = append(build.origins[:i], build.origins[i+1:]...)
+			break
+		}
+	}
+}
+
+// partialValueLength returns the length of the longest suffix of the text
+// which is the start of one of the values.
+func partialValueLength(text string, values []string) int {
+	longest := 0
+
+	for _, value := range values {
+		for length := len(value) - 1; length > longest; length-- {
+			if strings.HasSuffix(text, value[:length]) {
+				longest = length
+				break
+			}
+		}
+	}
+
+	return longest
+}
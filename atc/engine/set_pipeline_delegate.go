@@ -0,0 +1,58 @@
+package engine
+
+import (
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/exec"
+)
+
+type setPipelineDelegate struct {
+	exec.BuildStepDelegate
+
+	build       db.Build
+	eventOrigin event.Origin
+	clock       clock.Clock
+}
+
+func NewSetPipelineDelegate(build db.Build, planID atc.PlanID, clock clock.Clock) exec.SetPipelineDelegate {
+	return &setPipelineDelegate{
+		BuildStepDelegate: NewBuildStepDelegate(build, planID, clock),
+
+		build: build,
+		eventOrigin: event.Origin{
+			ID: event.OriginID(planID),
+		},
+		clock: clock,
+	}
+}
+
+func (d *setPipelineDelegate) Starting(logger lager.Logger) {
+	err := d.build.SaveEvent(event.StartSetPipeline{
+		Origin: d.eventOrigin,
+		Time:   d.clock.Now().Unix(),
+	})
+	if err != nil {
+		logger.Error("failed-to-save-start-set-pipeline-event", err)
+		return
+	}
+
+	logger.Debug("starting")
+}
+
+func (d *setPipelineDelegate) Finished(logger lager.Logger, succeeded bool) {
+	err := d.build.SaveEvent(event.FinishSetPipeline{
+		Origin:    d.eventOrigin,
+		Time:      d.clock.Now().Unix(),
+		Succeeded: succeeded,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-finish-set-pipeline-event", err)
+		return
+	}
+
+	logger.Info("finished", lager.Data{"succeeded": succeeded})
+}
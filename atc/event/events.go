@@ -87,6 +87,78 @@ type Status struct {
 func (Status) EventType() atc.EventType  { return EventTypeStatus }
 func (Status) Version() atc.EventVersion { return "1.0" }
 
+type Skipped struct {
+	Time      int64  `json:"time"`
+	Origin    Origin `json:"origin"`
+	Condition string `json:"condition"`
+}
+
+func (Skipped) EventType() atc.EventType  { return EventTypeSkipped }
+func (Skipped) Version() atc.EventVersion { return "1.0" }
+
+type WaitingForWorker struct {
+	Time         int64  `json:"time"`
+	Origin       Origin `json:"origin"`
+	Requirements string `json:"requirements"`
+}
+
+func (WaitingForWorker) EventType() atc.EventType  { return EventTypeWaitingForWorker }
+func (WaitingForWorker) Version() atc.EventVersion { return "1.0" }
+
+type StartSetPipeline struct {
+	Time   int64  `json:"time"`
+	Origin Origin `json:"origin"`
+}
+
+func (StartSetPipeline) EventType() atc.EventType  { return EventTypeStartSetPipeline }
+func (StartSetPipeline) Version() atc.EventVersion { return "1.0" }
+
+type FinishSetPipeline struct {
+	Time      int64  `json:"time"`
+	Origin    Origin `json:"origin"`
+	Succeeded bool   `json:"succeeded"`
+}
+
+func (FinishSetPipeline) EventType() atc.EventType  { return EventTypeFinishSetPipeline }
+func (FinishSetPipeline) Version() atc.EventVersion { return "1.0" }
+
+type StartLoadVar struct {
+	Time   int64  `json:"time"`
+	Origin Origin `json:"origin"`
+}
+
+func (StartLoadVar) EventType() atc.EventType  { return EventTypeStartLoadVar }
+func (StartLoadVar) Version() atc.EventVersion { return "1.0" }
+
+type FinishLoadVar struct {
+	Time      int64  `json:"time"`
+	Origin    Origin `json:"origin"`
+	Succeeded bool   `json:"succeeded"`
+}
+
+func (FinishLoadVar) EventType() atc.EventType  { return EventTypeFinishLoadVar }
+func (FinishLoadVar) Version() atc.EventVersion { return "1.0" }
+
+type WaitingForApproval struct {
+	Time   int64  `json:"time"`
+	Origin Origin `json:"origin"`
+	Name   string `json:"name"`
+	Role   string `json:"role"`
+}
+
+func (WaitingForApproval) EventType() atc.EventType  { return EventTypeWaitingForApproval }
+func (WaitingForApproval) Version() atc.EventVersion { return "1.0" }
+
+type FinishApproval struct {
+	Time      int64                   `json:"time"`
+	Origin    Origin                  `json:"origin"`
+	Status    atc.BuildApprovalStatus `json:"status"`
+	DecidedBy string                  `json:"decided_by,omitempty"`
+}
+
+func (FinishApproval) EventType() atc.EventType  { return EventTypeFinishApproval }
+func (FinishApproval) Version() atc.EventVersion { return "1.0" }
+
 type Log struct {
 	Time    int64  `json:"time"`
 	Origin  Origin `json:"origin"`
@@ -41,6 +41,14 @@ func init() {
 	registerEvent(Status{})
 	registerEvent(Log{})
 	registerEvent(Error{})
+	registerEvent(Skipped{})
+	registerEvent(WaitingForWorker{})
+	registerEvent(StartSetPipeline{})
+	registerEvent(FinishSetPipeline{})
+	registerEvent(StartLoadVar{})
+	registerEvent(FinishLoadVar{})
+	registerEvent(WaitingForApproval{})
+	registerEvent(FinishApproval{})
 
 	// deprecated:
 	registerEvent(InitializeV10{})
@@ -27,6 +27,30 @@ const (
 	// finished putting something
 	EventTypeFinishPut atc.EventType = "finish-put"
 
+	// started configuring a pipeline
+	EventTypeStartSetPipeline atc.EventType = "start-set-pipeline"
+
+	// finished configuring a pipeline
+	EventTypeFinishSetPipeline atc.EventType = "finish-set-pipeline"
+
+	// started loading a build var
+	EventTypeStartLoadVar atc.EventType = "start-load-var"
+
+	// finished loading a build var
+	EventTypeFinishLoadVar atc.EventType = "finish-load-var"
+
+	// build waiting for an approval step to be decided
+	EventTypeWaitingForApproval atc.EventType = "waiting-for-approval"
+
+	// approval step approved, rejected or expired
+	EventTypeFinishApproval atc.EventType = "finish-approval"
+
+	// step skipped as its condition was not met
+	EventTypeSkipped atc.EventType = "skipped"
+
+	// step waiting for a worker satisfying its tags, team and platform
+	EventTypeWaitingForWorker atc.EventType = "waiting-for-worker"
+
 	// error occurred
 	EventTypeError atc.EventType = "error"
 )
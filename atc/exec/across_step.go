@@ -0,0 +1,90 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// AcrossStep runs a step once for each combination of an 'across' step's var
+// values, in parallel.
+type AcrossStep struct {
+	steps       []Step
+	maxInFlight int
+}
+
+// Across constructs an AcrossStep which runs at most maxInFlight of the given
+// steps at once. A maxInFlight of 0 runs all of them at once.
+func Across(steps []Step, maxInFlight int) AcrossStep {
+	return AcrossStep{
+		steps:       steps,
+		maxInFlight: maxInFlight,
+	}
+}
+
+// Run executes the steps in parallel, starting a new step as soon as a running
+// one exits once maxInFlight steps are running.
+//
+// Like AggregateStep, it will wait for all started steps to exit, even if one
+// of them fails or errors, and returns their errors (if any) as a single
+// error. No more steps are started once the context is canceled.
+func (step AcrossStep) Run(ctx context.Context, state RunState) error {
+	maxInFlight := step.maxInFlight
+	if maxInFlight <= 0 || maxInFlight > len(step.steps) {
+		maxInFlight = len(step.steps)
+	}
+
+	slots := make(chan struct{}, maxInFlight)
+	errs := make(chan error, len(step.steps))
+
+	started := 0
+	for _, s := range step.steps {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		started++
+
+		s := s
+		go func() {
+			defer func() { <-slots }()
+			errs <- s.Run(ctx, state)
+		}()
+	}
+
+	var errorMessages []string
+	for i := 0; i < started; i++ {
+		err := <-errs
+		if err != nil {
+			errorMessages = append(errorMessages, err.Error())
+		}
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if len(errorMessages) > 0 {
+		return fmt.Errorf("one or more steps across values errored:\n%s", strings.Join(errorMessages, "\n"))
+	}
+
+	return nil
+}
+
+// Succeeded is true if all of the steps' Succeeded is true.
+func (step AcrossStep) Succeeded() bool {
+	succeeded := true
+
+	for _, s := range step.steps {
+		if !s.Succeeded() {
+			succeeded = false
+		}
+	}
+
+	return succeeded
+}
@@ -0,0 +1,187 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	. "github.com/concourse/concourse/atc/exec"
+
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Across", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeStepA *execfakes.FakeStep
+		fakeStepB *execfakes.FakeStep
+		fakeStepC *execfakes.FakeStep
+
+		maxInFlight int
+
+		state *execfakes.FakeRunState
+
+		step    Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeStepA = new(execfakes.FakeStep)
+		fakeStepB = new(execfakes.FakeStep)
+		fakeStepC = new(execfakes.FakeStep)
+
+		maxInFlight = 0
+
+		state = new(execfakes.FakeRunState)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = Across([]Step{fakeStepA, fakeStepB, fakeStepC}, maxInFlight)
+		stepErr = step.Run(ctx, state)
+	})
+
+	It("succeeds", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+	})
+
+	It("runs every step with the run state", func() {
+		for _, fakeStep := range []*execfakes.FakeStep{fakeStepA, fakeStepB, fakeStepC} {
+			Expect(fakeStep.RunCallCount()).To(Equal(1))
+			_, runState := fakeStep.RunArgsForCall(0)
+			Expect(runState).To(Equal(state))
+		}
+	})
+
+	Context("without a max in flight", func() {
+		BeforeEach(func() {
+			wg := new(sync.WaitGroup)
+			wg.Add(3)
+
+			for _, fakeStep := range []*execfakes.FakeStep{fakeStepA, fakeStepB, fakeStepC} {
+				fakeStep.RunStub = func(context.Context, RunState) error {
+					wg.Done()
+					wg.Wait()
+					return nil
+				}
+			}
+		})
+
+		It("runs every step concurrently", func() {
+			Expect(fakeStepA.RunCallCount()).To(Equal(1))
+			Expect(fakeStepB.RunCallCount()).To(Equal(1))
+			Expect(fakeStepC.RunCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("with a max in flight", func() {
+		var (
+			lock    sync.Mutex
+			running int
+			maxSeen int
+		)
+
+		BeforeEach(func() {
+			maxInFlight = 2
+
+			running = 0
+			maxSeen = 0
+
+			for _, fakeStep := range []*execfakes.FakeStep{fakeStepA, fakeStepB, fakeStepC} {
+				fakeStep.RunStub = func(context.Context, RunState) error {
+					lock.Lock()
+					running++
+					if running > maxSeen {
+						maxSeen = running
+					}
+					lock.Unlock()
+
+					time.Sleep(10 * time.Millisecond)
+
+					lock.Lock()
+					running--
+					lock.Unlock()
+
+					return nil
+				}
+			}
+		})
+
+		It("runs no more than that many steps at once", func() {
+			Expect(maxSeen).To(BeNumerically("<=", 2))
+		})
+
+		It("still runs every step", func() {
+			Expect(fakeStepA.RunCallCount()).To(Equal(1))
+			Expect(fakeStepB.RunCallCount()).To(Equal(1))
+			Expect(fakeStepC.RunCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when a step errors", func() {
+		BeforeEach(func() {
+			fakeStepB.RunReturns(errors.New("nope"))
+		})
+
+		It("still runs the other steps", func() {
+			Expect(fakeStepA.RunCallCount()).To(Equal(1))
+			Expect(fakeStepC.RunCallCount()).To(Equal(1))
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(HaveOccurred())
+			Expect(stepErr.Error()).To(ContainSubstring("nope"))
+		})
+	})
+
+	Context("when the context is canceled before starting", func() {
+		BeforeEach(func() {
+			maxInFlight = 1
+			cancel()
+		})
+
+		It("does not start any more steps", func() {
+			Expect(fakeStepA.RunCallCount() + fakeStepB.RunCallCount() + fakeStepC.RunCallCount()).To(BeNumerically("<=", 1))
+		})
+
+		It("returns the context's error", func() {
+			Expect(stepErr).To(Equal(context.Canceled))
+		})
+	})
+
+	Describe("Succeeded", func() {
+		Context("when all steps succeeded", func() {
+			BeforeEach(func() {
+				fakeStepA.SucceededReturns(true)
+				fakeStepB.SucceededReturns(true)
+				fakeStepC.SucceededReturns(true)
+			})
+
+			It("returns true", func() {
+				Expect(step.Succeeded()).To(BeTrue())
+			})
+		})
+
+		Context("when any step did not succeed", func() {
+			BeforeEach(func() {
+				fakeStepA.SucceededReturns(true)
+				fakeStepB.SucceededReturns(false)
+				fakeStepC.SucceededReturns(true)
+			})
+
+			It("returns false", func() {
+				Expect(step.Succeeded()).To(BeFalse())
+			})
+		})
+	})
+})
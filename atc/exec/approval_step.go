@@ -0,0 +1,129 @@
+package exec
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+//go:generate counterfeiter . ApprovalDelegate
+
+type ApprovalDelegate interface {
+	BuildStepDelegate
+
+	WaitingForApproval(lager.Logger, atc.ApprovalPlan)
+	Finished(lager.Logger, db.BuildApproval)
+}
+
+// ApprovalStep blocks the build until a user with the required role on the
+// build's team approves or rejects it.
+type ApprovalStep struct {
+	planID   atc.PlanID
+	plan     atc.ApprovalPlan
+	build    db.Build
+	delegate ApprovalDelegate
+
+	succeeded bool
+}
+
+// NewApprovalStep constructs an ApprovalStep.
+func NewApprovalStep(
+	planID atc.PlanID,
+	plan atc.ApprovalPlan,
+	build db.Build,
+	delegate ApprovalDelegate,
+) *ApprovalStep {
+	return &ApprovalStep{
+		planID:   planID,
+		plan:     plan,
+		build:    build,
+		delegate: delegate,
+	}
+}
+
+// Run requests the approval and waits for it to be decided.
+//
+// If the build is resumed while the approval is waiting, it carries on
+// waiting for the same approval. If the context is done before a decision is
+// made, e.g. because of a timeout or the build being aborted, the approval is
+// marked as expired and the context's error is returned.
+func (step *ApprovalStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx).Session("approval-step", lager.Data{
+		"name": step.plan.Name,
+	})
+
+	approval, err := step.build.RequestApproval(step.planID, step.plan.Name, step.plan.Role)
+	if err != nil {
+		return err
+	}
+
+	if !approval.IsDecided() {
+		step.delegate.WaitingForApproval(logger, step.plan)
+
+		approval, err = step.waitForDecision(ctx, logger)
+		if err != nil {
+			return err
+		}
+	}
+
+	step.succeeded = approval.Status == atc.ApprovalStatusApproved
+	step.delegate.Finished(logger, approval)
+
+	if approval.Status == atc.ApprovalStatusExpired {
+		return ctx.Err()
+	}
+
+	return nil
+}
+
+func (step *ApprovalStep) waitForDecision(ctx context.Context, logger lager.Logger) (db.BuildApproval, error) {
+	notifier, err := step.build.ApprovalNotifier(step.planID)
+	if err != nil {
+		return db.BuildApproval{}, err
+	}
+
+	defer notifier.Close()
+
+	for {
+		approval, found, err := step.build.Approval(step.planID)
+		if err != nil {
+			return db.BuildApproval{}, err
+		}
+
+		if !found {
+			return db.BuildApproval{}, db.ErrBuildDisappeared
+		}
+
+		if approval.IsDecided() {
+			return approval, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("expired")
+
+			_, err := step.build.DecideApproval(step.planID, atc.ApprovalStatusExpired, "")
+			if err != nil {
+				return db.BuildApproval{}, err
+			}
+
+			// the approval may have been decided just before it expired
+			approval, _, err := step.build.Approval(step.planID)
+			if err != nil {
+				return db.BuildApproval{}, err
+			}
+
+			return approval, nil
+
+		case <-notifier.Notify():
+		}
+	}
+}
+
+// Succeeded is true if the approval was approved.
+func (step *ApprovalStep) Succeeded() bool {
+	return step.succeeded
+}
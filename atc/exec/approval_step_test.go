@@ -0,0 +1,210 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ApprovalStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeBuild    *dbfakes.FakeBuild
+		fakeDelegate *execfakes.FakeApprovalDelegate
+		fakeNotifier *dbfakes.FakeNotifier
+		notify       chan struct{}
+
+		waiting db.BuildApproval
+		decided db.BuildApproval
+
+		planID atc.PlanID
+		plan   atc.ApprovalPlan
+
+		step    Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		planID = atc.PlanID("some-plan-id")
+		plan = atc.ApprovalPlan{
+			Name: "ship-it",
+			Role: "owner",
+		}
+
+		waiting = db.BuildApproval{
+			BuildID: 42,
+			PlanID:  planID,
+			Name:    "ship-it",
+			Role:    "owner",
+			Status:  atc.ApprovalStatusWaiting,
+		}
+
+		decided = waiting
+		decided.Status = atc.ApprovalStatusApproved
+		decided.DecidedBy = "some-user"
+
+		notify = make(chan struct{}, 1)
+		fakeNotifier = new(dbfakes.FakeNotifier)
+		fakeNotifier.NotifyReturns(notify)
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.RequestApprovalReturns(waiting, nil)
+		fakeBuild.ApprovalNotifierReturns(fakeNotifier, nil)
+		fakeBuild.ApprovalReturnsOnCall(0, waiting, true, nil)
+		fakeBuild.ApprovalReturnsOnCall(1, decided, true, nil)
+
+		fakeDelegate = new(execfakes.FakeApprovalDelegate)
+
+		notify <- struct{}{}
+	})
+
+	JustBeforeEach(func() {
+		step = NewApprovalStep(planID, plan, fakeBuild, fakeDelegate)
+		stepErr = step.Run(ctx, NewRunState())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("requests the approval", func() {
+		Expect(fakeBuild.RequestApprovalCallCount()).To(Equal(1))
+		requestedPlanID, name, role := fakeBuild.RequestApprovalArgsForCall(0)
+		Expect(requestedPlanID).To(Equal(planID))
+		Expect(name).To(Equal("ship-it"))
+		Expect(role).To(Equal("owner"))
+	})
+
+	It("tells the delegate it is waiting", func() {
+		Expect(fakeDelegate.WaitingForApprovalCallCount()).To(Equal(1))
+		_, waitingPlan := fakeDelegate.WaitingForApprovalArgsForCall(0)
+		Expect(waitingPlan).To(Equal(plan))
+	})
+
+	It("waits for the approval to be decided", func() {
+		Expect(fakeBuild.ApprovalCallCount()).To(Equal(2))
+		Expect(fakeBuild.ApprovalArgsForCall(1)).To(Equal(planID))
+	})
+
+	It("closes the notifier", func() {
+		Expect(fakeNotifier.CloseCallCount()).To(Equal(1))
+	})
+
+	Context("when the approval is approved", func() {
+		It("succeeds", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeTrue())
+		})
+
+		It("finishes with the decision", func() {
+			Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+			_, approval := fakeDelegate.FinishedArgsForCall(0)
+			Expect(approval).To(Equal(decided))
+		})
+	})
+
+	Context("when the approval is rejected", func() {
+		BeforeEach(func() {
+			decided.Status = atc.ApprovalStatusRejected
+			fakeBuild.ApprovalReturnsOnCall(1, decided, true, nil)
+		})
+
+		It("fails without erroring", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+
+		It("finishes with the decision", func() {
+			_, approval := fakeDelegate.FinishedArgsForCall(0)
+			Expect(approval.Status).To(Equal(atc.ApprovalStatusRejected))
+			Expect(approval.DecidedBy).To(Equal("some-user"))
+		})
+	})
+
+	Context("when the approval was already decided", func() {
+		BeforeEach(func() {
+			fakeBuild.RequestApprovalReturns(decided, nil)
+		})
+
+		It("does not wait", func() {
+			Expect(fakeDelegate.WaitingForApprovalCallCount()).To(Equal(0))
+			Expect(fakeBuild.ApprovalNotifierCallCount()).To(Equal(0))
+		})
+
+		It("succeeds", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeTrue())
+		})
+	})
+
+	Context("when the context is done before the approval is decided", func() {
+		var expired db.BuildApproval
+
+		BeforeEach(func() {
+			<-notify
+			cancel()
+
+			expired = waiting
+			expired.Status = atc.ApprovalStatusExpired
+
+			fakeBuild.ApprovalReturnsOnCall(1, expired, true, nil)
+		})
+
+		It("marks the approval as expired", func() {
+			Expect(fakeBuild.DecideApprovalCallCount()).To(Equal(1))
+			decidedPlanID, status, decidedBy := fakeBuild.DecideApprovalArgsForCall(0)
+			Expect(decidedPlanID).To(Equal(planID))
+			Expect(status).To(Equal(atc.ApprovalStatusExpired))
+			Expect(decidedBy).To(BeEmpty())
+		})
+
+		It("finishes with the expired approval", func() {
+			_, approval := fakeDelegate.FinishedArgsForCall(0)
+			Expect(approval).To(Equal(expired))
+		})
+
+		It("returns the context's error", func() {
+			Expect(stepErr).To(Equal(context.Canceled))
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+
+		Context("when it was decided just before expiring", func() {
+			BeforeEach(func() {
+				fakeBuild.ApprovalReturnsOnCall(1, decided, true, nil)
+			})
+
+			It("succeeds with the decision", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+				Expect(step.Succeeded()).To(BeTrue())
+			})
+		})
+	})
+
+	Context("when requesting the approval fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeBuild.RequestApprovalReturns(db.BuildApproval{}, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(Equal(disaster))
+		})
+
+		It("does not finish", func() {
+			Expect(fakeDelegate.FinishedCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,39 @@
+package exec
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc/worker"
+)
+
+// readArtifactFile reads a file in the form ARTIFACT/FILE/PATH out of the
+// worker.ArtifactRepository.
+func readArtifactFile(logger lager.Logger, repo *worker.ArtifactRepository, path string) ([]byte, error) {
+	segs := strings.SplitN(path, "/", 2)
+	if len(segs) != 2 {
+		return nil, UnspecifiedArtifactSourceError{path}
+	}
+
+	sourceName := worker.ArtifactName(segs[0])
+
+	source, found := repo.SourceFor(sourceName)
+	if !found {
+		return nil, UnknownArtifactSourceError{sourceName, path}
+	}
+
+	stream, err := source.StreamFile(logger, segs[1])
+	if err != nil {
+		if err == baggageclaim.ErrFileNotFound {
+			return nil, FileNotFoundError{Path: path}
+		}
+
+		return nil, err
+	}
+
+	defer stream.Close()
+
+	return ioutil.ReadAll(stream)
+}
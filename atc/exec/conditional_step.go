@@ -0,0 +1,117 @@
+package exec
+
+import (
+	"context"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/condition"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+//go:generate counterfeiter . ConditionalDelegate
+
+type ConditionalDelegate interface {
+	BuildStepDelegate
+
+	Skipped(lager.Logger, string)
+}
+
+// ConditionalStep runs the nested step only when its condition evaluates to
+// true.
+type ConditionalStep struct {
+	condition string
+	step      Step
+	metadata  StepMetadata
+	variables creds.Variables
+	delegate  ConditionalDelegate
+
+	skipped bool
+}
+
+// Conditional constructs a ConditionalStep.
+func Conditional(
+	condition string,
+	step Step,
+	metadata StepMetadata,
+	variables creds.Variables,
+	delegate ConditionalDelegate,
+) *ConditionalStep {
+	return &ConditionalStep{
+		condition: condition,
+		step:      step,
+		metadata:  metadata,
+		variables: variables,
+		delegate:  delegate,
+	}
+}
+
+// Run parses and evaluates the condition, running the nested step if it is
+// true.
+//
+// If the condition is false, the delegate is notified that the step was
+// skipped, and nil is returned.
+//
+// If the condition cannot be parsed or evaluated, the error is reported to the
+// delegate and returned.
+func (cs *ConditionalStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx).Session("conditional")
+
+	cond, err := condition.Parse(cs.condition)
+	if err != nil {
+		cs.delegate.Errored(logger, err.Error())
+		return err
+	}
+
+	proceed, err := cond.Eval(conditionEnv{
+		logger:    logger,
+		metadata:  cs.metadata,
+		variables: cs.variables,
+		state:     state,
+	})
+	if err != nil {
+		cs.delegate.Errored(logger, err.Error())
+		return err
+	}
+
+	if !proceed {
+		cs.skipped = true
+		cs.delegate.Skipped(logger, cs.condition)
+		return nil
+	}
+
+	return cs.step.Run(ctx, state)
+}
+
+// Succeeded is true if the step was skipped or the nested step succeeded.
+func (cs *ConditionalStep) Succeeded() bool {
+	return cs.skipped || cs.step.Succeeded()
+}
+
+type conditionEnv struct {
+	logger    lager.Logger
+	metadata  StepMetadata
+	variables creds.Variables
+	state     RunState
+}
+
+func (env conditionEnv) Metadata(name string) (string, bool) {
+	for _, kv := range env.metadata.Env() {
+		segs := strings.SplitN(kv, "=", 2)
+		if len(segs) == 2 && segs[0] == name {
+			return segs[1], true
+		}
+	}
+
+	return "", false
+}
+
+func (env conditionEnv) Var(name string) (interface{}, bool, error) {
+	return env.variables.Get(template.VariableDefinition{Name: name})
+}
+
+func (env conditionEnv) File(path string) ([]byte, error) {
+	return readArtifactFile(env.logger, env.state.Artifacts(), path)
+}
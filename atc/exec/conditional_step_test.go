@@ -0,0 +1,201 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/baggageclaim"
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	"github.com/onsi/gomega/gbytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Conditional Step", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeStep     *execfakes.FakeStep
+		fakeDelegate *execfakes.FakeConditionalDelegate
+		fakeSource   *workerfakes.FakeArtifactSource
+
+		repo  *worker.ArtifactRepository
+		state *execfakes.FakeRunState
+
+		stepMetadata testMetadata = []string{"BUILD_JOB_NAME=some-job"}
+		variables    template.StaticVariables
+
+		condition string
+
+		step    Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeStep = new(execfakes.FakeStep)
+		fakeDelegate = new(execfakes.FakeConditionalDelegate)
+
+		fakeSource = new(workerfakes.FakeArtifactSource)
+
+		repo = worker.NewArtifactRepository()
+		repo.RegisterSource("some-artifact", fakeSource)
+
+		state = new(execfakes.FakeRunState)
+		state.ArtifactsReturns(repo)
+
+		variables = template.StaticVariables{
+			"enabled": true,
+		}
+	})
+
+	JustBeforeEach(func() {
+		step = Conditional(condition, fakeStep, stepMetadata, variables, fakeDelegate)
+		stepErr = step.Run(ctx, state)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	Context("when the condition is true", func() {
+		BeforeEach(func() {
+			condition = `var("enabled") && metadata("BUILD_JOB_NAME") == "some-job"`
+		})
+
+		It("runs the nested step", func() {
+			Expect(fakeStep.RunCallCount()).To(Equal(1))
+
+			runCtx, runState := fakeStep.RunArgsForCall(0)
+			Expect(runCtx).To(Equal(ctx))
+			Expect(runState).To(Equal(state))
+		})
+
+		It("does not report the step as skipped", func() {
+			Expect(fakeDelegate.SkippedCallCount()).To(Equal(0))
+		})
+
+		Context("when the nested step succeeds", func() {
+			BeforeEach(func() {
+				fakeStep.SucceededReturns(true)
+			})
+
+			It("succeeds", func() {
+				Expect(step.Succeeded()).To(BeTrue())
+			})
+		})
+
+		Context("when the nested step fails", func() {
+			BeforeEach(func() {
+				fakeStep.SucceededReturns(false)
+			})
+
+			It("fails", func() {
+				Expect(step.Succeeded()).To(BeFalse())
+			})
+		})
+
+		Context("when the nested step errors", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeStep.RunReturns(disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(stepErr).To(Equal(disaster))
+			})
+		})
+	})
+
+	Context("when the condition is false", func() {
+		BeforeEach(func() {
+			condition = `!var("enabled")`
+		})
+
+		It("does not run the nested step", func() {
+			Expect(fakeStep.RunCallCount()).To(Equal(0))
+		})
+
+		It("reports the step as skipped", func() {
+			Expect(fakeDelegate.SkippedCallCount()).To(Equal(1))
+
+			_, skippedCondition := fakeDelegate.SkippedArgsForCall(0)
+			Expect(skippedCondition).To(Equal(`!var("enabled")`))
+		})
+
+		It("succeeds", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeTrue())
+		})
+	})
+
+	Context("when the condition refers to a file", func() {
+		BeforeEach(func() {
+			condition = `file("some-artifact/flag") == "deploy"`
+		})
+
+		Context("when the file exists", func() {
+			BeforeEach(func() {
+				fakeSource.StreamFileReturns(gbytes.BufferWithBytes([]byte("deploy\n")), nil)
+			})
+
+			It("streams the file from the artifact", func() {
+				Expect(fakeSource.StreamFileCallCount()).To(Equal(1))
+
+				_, path := fakeSource.StreamFileArgsForCall(0)
+				Expect(path).To(Equal("flag"))
+			})
+
+			It("runs the nested step", func() {
+				Expect(fakeStep.RunCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the file does not exist", func() {
+			BeforeEach(func() {
+				fakeSource.StreamFileReturns(nil, baggageclaim.ErrFileNotFound)
+			})
+
+			It("returns an error", func() {
+				Expect(stepErr).To(Equal(FileNotFoundError{Path: "some-artifact/flag"}))
+			})
+
+			It("reports the error", func() {
+				Expect(fakeDelegate.ErroredCallCount()).To(Equal(1))
+
+				_, message := fakeDelegate.ErroredArgsForCall(0)
+				Expect(message).To(Equal("file not found: some-artifact/flag"))
+			})
+
+			It("does not run the nested step", func() {
+				Expect(fakeStep.RunCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the condition is invalid", func() {
+		BeforeEach(func() {
+			condition = `var("enabled") &&`
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(HaveOccurred())
+		})
+
+		It("reports the error", func() {
+			Expect(fakeDelegate.ErroredCallCount()).To(Equal(1))
+		})
+
+		It("does not run the nested step", func() {
+			Expect(fakeStep.RunCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,403 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package execfakes
+
+import (
+	io "io"
+	sync "sync"
+
+	lager "code.cloudfoundry.org/lager"
+	atc "github.com/concourse/concourse/atc"
+	db "github.com/concourse/concourse/atc/db"
+	exec "github.com/concourse/concourse/atc/exec"
+	worker "github.com/concourse/concourse/atc/worker"
+)
+
+type FakeApprovalDelegate struct {
+	ErroredStub        func(lager.Logger, string)
+	erroredMutex       sync.RWMutex
+	erroredArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	FinishedStub        func(lager.Logger, db.BuildApproval)
+	finishedMutex       sync.RWMutex
+	finishedArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 db.BuildApproval
+	}
+	ImageVersionDeterminedStub        func(db.UsedResourceCache) error
+	imageVersionDeterminedMutex       sync.RWMutex
+	imageVersionDeterminedArgsForCall []struct {
+		arg1 db.UsedResourceCache
+	}
+	imageVersionDeterminedReturns struct {
+		result1 error
+	}
+	imageVersionDeterminedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StderrStub        func() io.Writer
+	stderrMutex       sync.RWMutex
+	stderrArgsForCall []struct {
+	}
+	stderrReturns struct {
+		result1 io.Writer
+	}
+	stderrReturnsOnCall map[int]struct {
+		result1 io.Writer
+	}
+	StdoutStub        func() io.Writer
+	stdoutMutex       sync.RWMutex
+	stdoutArgsForCall []struct {
+	}
+	stdoutReturns struct {
+		result1 io.Writer
+	}
+	stdoutReturnsOnCall map[int]struct {
+		result1 io.Writer
+	}
+	WaitingForApprovalStub        func(lager.Logger, atc.ApprovalPlan)
+	waitingForApprovalMutex       sync.RWMutex
+	waitingForApprovalArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 atc.ApprovalPlan
+	}
+	WaitingForWorkerStub        func(lager.Logger, worker.WorkerSpec)
+	waitingForWorkerMutex       sync.RWMutex
+	waitingForWorkerArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 worker.WorkerSpec
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeApprovalDelegate) Errored(arg1 lager.Logger, arg2 string) {
+	fake.erroredMutex.Lock()
+	fake.erroredArgsForCall = append(fake.erroredArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Errored", []interface{}{arg1, arg2})
+	fake.erroredMutex.Unlock()
+	if fake.ErroredStub != nil {
+		fake.ErroredStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeApprovalDelegate) ErroredCallCount() int {
+	fake.erroredMutex.RLock()
+	defer fake.erroredMutex.RUnlock()
+	return len(fake.erroredArgsForCall)
+}
+
+func (fake *FakeApprovalDelegate) ErroredCalls(stub func(lager.Logger, string)) {
+	fake.erroredMutex.Lock()
+	defer fake.erroredMutex.Unlock()
+	fake.ErroredStub = stub
+}
+
+func (fake *FakeApprovalDelegate) ErroredArgsForCall(i int) (lager.Logger, string) {
+	fake.erroredMutex.RLock()
+	defer fake.erroredMutex.RUnlock()
+	argsForCall := fake.erroredArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeApprovalDelegate) Finished(arg1 lager.Logger, arg2 db.BuildApproval) {
+	fake.finishedMutex.Lock()
+	fake.finishedArgsForCall = append(fake.finishedArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 db.BuildApproval
+	}{arg1, arg2})
+	fake.recordInvocation("Finished", []interface{}{arg1, arg2})
+	fake.finishedMutex.Unlock()
+	if fake.FinishedStub != nil {
+		fake.FinishedStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeApprovalDelegate) FinishedCallCount() int {
+	fake.finishedMutex.RLock()
+	defer fake.finishedMutex.RUnlock()
+	return len(fake.finishedArgsForCall)
+}
+
+func (fake *FakeApprovalDelegate) FinishedCalls(stub func(lager.Logger, db.BuildApproval)) {
+	fake.finishedMutex.Lock()
+	defer fake.finishedMutex.Unlock()
+	fake.FinishedStub = stub
+}
+
+func (fake *FakeApprovalDelegate) FinishedArgsForCall(i int) (lager.Logger, db.BuildApproval) {
+	fake.finishedMutex.RLock()
+	defer fake.finishedMutex.RUnlock()
+	argsForCall := fake.finishedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeApprovalDelegate) ImageVersionDetermined(arg1 db.UsedResourceCache) error {
+	fake.imageVersionDeterminedMutex.Lock()
+	ret, specificReturn := fake.imageVersionDeterminedReturnsOnCall[len(fake.imageVersionDeterminedArgsForCall)]
+	fake.imageVersionDeterminedArgsForCall = append(fake.imageVersionDeterminedArgsForCall, struct {
+		arg1 db.UsedResourceCache
+	}{arg1})
+	fake.recordInvocation("ImageVersionDetermined", []interface{}{arg1})
+	fake.imageVersionDeterminedMutex.Unlock()
+	if fake.ImageVersionDeterminedStub != nil {
+		return fake.ImageVersionDeterminedStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.imageVersionDeterminedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeApprovalDelegate) ImageVersionDeterminedCallCount() int {
+	fake.imageVersionDeterminedMutex.RLock()
+	defer fake.imageVersionDeterminedMutex.RUnlock()
+	return len(fake.imageVersionDeterminedArgsForCall)
+}
+
+func (fake *FakeApprovalDelegate) ImageVersionDeterminedCalls(stub func(db.UsedResourceCache) error) {
+	fake.imageVersionDeterminedMutex.Lock()
+	defer fake.imageVersionDeterminedMutex.Unlock()
+	fake.ImageVersionDeterminedStub = stub
+}
+
+func (fake *FakeApprovalDelegate) ImageVersionDeterminedArgsForCall(i int) db.UsedResourceCache {
+	fake.imageVersionDeterminedMutex.RLock()
+	defer fake.imageVersionDeterminedMutex.RUnlock()
+	argsForCall := fake.imageVersionDeterminedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeApprovalDelegate) ImageVersionDeterminedReturns(result1 error) {
+	fake.imageVersionDeterminedMutex.Lock()
+	defer fake.imageVersionDeterminedMutex.Unlock()
+	fake.ImageVersionDeterminedStub = nil
+	fake.imageVersionDeterminedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApprovalDelegate) ImageVersionDeterminedReturnsOnCall(i int, result1 error) {
+	fake.imageVersionDeterminedMutex.Lock()
+	defer fake.imageVersionDeterminedMutex.Unlock()
+	fake.ImageVersionDeterminedStub = nil
+	if fake.imageVersionDeterminedReturnsOnCall == nil {
+		fake.imageVersionDeterminedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.imageVersionDeterminedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApprovalDelegate) Stderr() io.Writer {
+	fake.stderrMutex.Lock()
+	ret, specificReturn := fake.stderrReturnsOnCall[len(fake.stderrArgsForCall)]
+	fake.stderrArgsForCall = append(fake.stderrArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Stderr", []interface{}{})
+	fake.stderrMutex.Unlock()
+	if fake.StderrStub != nil {
+		return fake.StderrStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.stderrReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeApprovalDelegate) StderrCallCount() int {
+	fake.stderrMutex.RLock()
+	defer fake.stderrMutex.RUnlock()
+	return len(fake.stderrArgsForCall)
+}
+
+func (fake *FakeApprovalDelegate) StderrCalls(stub func() io.Writer) {
+	fake.stderrMutex.Lock()
+	defer fake.stderrMutex.Unlock()
+	fake.StderrStub = stub
+}
+
+func (fake *FakeApprovalDelegate) StderrReturns(result1 io.Writer) {
+	fake.stderrMutex.Lock()
+	defer fake.stderrMutex.Unlock()
+	fake.StderrStub = nil
+	fake.stderrReturns = struct {
+		result1 io.Writer
+	}{result1}
+}
+
+func (fake *FakeApprovalDelegate) StderrReturnsOnCall(i int, result1 io.Writer) {
+	fake.stderrMutex.Lock()
+	defer fake.stderrMutex.Unlock()
+	fake.StderrStub = nil
+	if fake.stderrReturnsOnCall == nil {
+		fake.stderrReturnsOnCall = make(map[int]struct {
+			result1 io.Writer
+		})
+	}
+	fake.stderrReturnsOnCall[i] = struct {
+		result1 io.Writer
+	}{result1}
+}
+
+func (fake *FakeApprovalDelegate) Stdout() io.Writer {
+	fake.stdoutMutex.Lock()
+	ret, specificReturn := fake.stdoutReturnsOnCall[len(fake.stdoutArgsForCall)]
+	fake.stdoutArgsForCall = append(fake.stdoutArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Stdout", []interface{}{})
+	fake.stdoutMutex.Unlock()
+	if fake.StdoutStub != nil {
+		return fake.StdoutStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.stdoutReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeApprovalDelegate) StdoutCallCount() int {
+	fake.stdoutMutex.RLock()
+	defer fake.stdoutMutex.RUnlock()
+	return len(fake.stdoutArgsForCall)
+}
+
+func (fake *FakeApprovalDelegate) StdoutCalls(stub func() io.Writer) {
+	fake.stdoutMutex.Lock()
+	defer fake.stdoutMutex.Unlock()
+	fake.StdoutStub = stub
+}
+
+func (fake *FakeApprovalDelegate) StdoutReturns(result1 io.Writer) {
+	fake.stdoutMutex.Lock()
+	defer fake.stdoutMutex.Unlock()
+	fake.StdoutStub = nil
+	fake.stdoutReturns = struct {
+		result1 io.Writer
+	}{result1}
+}
+
+func (fake *FakeApprovalDelegate) StdoutReturnsOnCall(i int, result1 io.Writer) {
+	fake.stdoutMutex.Lock()
+	defer fake.stdoutMutex.Unlock()
+	fake.StdoutStub = nil
+	if fake.stdoutReturnsOnCall == nil {
+		fake.stdoutReturnsOnCall = make(map[int]struct {
+			result1 io.Writer
+		})
+	}
+	fake.stdoutReturnsOnCall[i] = struct {
+		result1 io.Writer
+	}{result1}
+}
+
+func (fake *FakeApprovalDelegate) WaitingForApproval(arg1 lager.Logger, arg2 atc.ApprovalPlan) {
+	fake.waitingForApprovalMutex.Lock()
+	fake.waitingForApprovalArgsForCall = append(fake.waitingForApprovalArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 atc.ApprovalPlan
+	}{arg1, arg2})
+	fake.recordInvocation("WaitingForApproval", []interface{}{arg1, arg2})
+	fake.waitingForApprovalMutex.Unlock()
+	if fake.WaitingForApprovalStub != nil {
+		fake.WaitingForApprovalStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeApprovalDelegate) WaitingForApprovalCallCount() int {
+	fake.waitingForApprovalMutex.RLock()
+	defer fake.waitingForApprovalMutex.RUnlock()
+	return len(fake.waitingForApprovalArgsForCall)
+}
+
+func (fake *FakeApprovalDelegate) WaitingForApprovalCalls(stub func(lager.Logger, atc.ApprovalPlan)) {
+	fake.waitingForApprovalMutex.Lock()
+	defer fake.waitingForApprovalMutex.Unlock()
+	fake.WaitingForApprovalStub = stub
+}
+
+func (fake *FakeApprovalDelegate) WaitingForApprovalArgsForCall(i int) (lager.Logger, atc.ApprovalPlan) {
+	fake.waitingForApprovalMutex.RLock()
+	defer fake.waitingForApprovalMutex.RUnlock()
+	argsForCall := fake.waitingForApprovalArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeApprovalDelegate) WaitingForWorker(arg1 lager.Logger, arg2 worker.WorkerSpec) {
+	fake.waitingForWorkerMutex.Lock()
+	fake.waitingForWorkerArgsForCall = append(fake.waitingForWorkerArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 worker.WorkerSpec
+	}{arg1, arg2})
+	fake.recordInvocation("WaitingForWorker", []interface{}{arg1, arg2})
+	fake.waitingForWorkerMutex.Unlock()
+	if fake.WaitingForWorkerStub != nil {
+		fake.WaitingForWorkerStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeApprovalDelegate) WaitingForWorkerCallCount() int {
+	fake.waitingForWorkerMutex.RLock()
+	defer fake.waitingForWorkerMutex.RUnlock()
+	return len(fake.waitingForWorkerArgsForCall)
+}
+
+func (fake *FakeApprovalDelegate) WaitingForWorkerCalls(stub func(lager.Logger, worker.WorkerSpec)) {
+	fake.waitingForWorkerMutex.Lock()
+	defer fake.waitingForWorkerMutex.Unlock()
+	fake.WaitingForWorkerStub = stub
+}
+
+func (fake *FakeApprovalDelegate) WaitingForWorkerArgsForCall(i int) (lager.Logger, worker.WorkerSpec) {
+	fake.waitingForWorkerMutex.RLock()
+	defer fake.waitingForWorkerMutex.RUnlock()
+	argsForCall := fake.waitingForWorkerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeApprovalDelegate) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.erroredMutex.RLock()
+	defer fake.erroredMutex.RUnlock()
+	fake.finishedMutex.RLock()
+	defer fake.finishedMutex.RUnlock()
+	fake.imageVersionDeterminedMutex.RLock()
+	defer fake.imageVersionDeterminedMutex.RUnlock()
+	fake.stderrMutex.RLock()
+	defer fake.stderrMutex.RUnlock()
+	fake.stdoutMutex.RLock()
+	defer fake.stdoutMutex.RUnlock()
+	fake.waitingForApprovalMutex.RLock()
+	defer fake.waitingForApprovalMutex.RUnlock()
+	fake.waitingForWorkerMutex.RLock()
+	defer fake.waitingForWorkerMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeApprovalDelegate) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ exec.ApprovalDelegate = new(FakeApprovalDelegate)
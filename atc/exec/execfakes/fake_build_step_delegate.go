@@ -8,6 +8,7 @@ import (
 	lager "code.cloudfoundry.org/lager"
 	db "github.com/concourse/concourse/atc/db"
 	exec "github.com/concourse/concourse/atc/exec"
+	worker "github.com/concourse/concourse/atc/worker"
 )
 
 type FakeBuildStepDelegate struct {
@@ -48,6 +49,12 @@ type FakeBuildStepDelegate struct {
 	stdoutReturnsOnCall map[int]struct {
 		result1 io.Writer
 	}
+	WaitingForWorkerStub        func(lager.Logger, worker.WorkerSpec)
+	waitingForWorkerMutex       sync.RWMutex
+	waitingForWorkerArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 worker.WorkerSpec
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -248,6 +255,38 @@ func (fake *FakeBuildStepDelegate) StdoutReturnsOnCall(i int, result1 io.Writer)
 	}{result1}
 }
 
+func (fake *FakeBuildStepDelegate) WaitingForWorker(arg1 lager.Logger, arg2 worker.WorkerSpec) {
+	fake.waitingForWorkerMutex.Lock()
+	fake.waitingForWorkerArgsForCall = append(fake.waitingForWorkerArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 worker.WorkerSpec
+	}{arg1, arg2})
+	fake.recordInvocation("WaitingForWorker", []interface{}{arg1, arg2})
+	fake.waitingForWorkerMutex.Unlock()
+	if fake.WaitingForWorkerStub != nil {
+		fake.WaitingForWorkerStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeBuildStepDelegate) WaitingForWorkerCallCount() int {
+	fake.waitingForWorkerMutex.RLock()
+	defer fake.waitingForWorkerMutex.RUnlock()
+	return len(fake.waitingForWorkerArgsForCall)
+}
+
+func (fake *FakeBuildStepDelegate) WaitingForWorkerCalls(stub func(lager.Logger, worker.WorkerSpec)) {
+	fake.waitingForWorkerMutex.Lock()
+	defer fake.waitingForWorkerMutex.Unlock()
+	fake.WaitingForWorkerStub = stub
+}
+
+func (fake *FakeBuildStepDelegate) WaitingForWorkerArgsForCall(i int) (lager.Logger, worker.WorkerSpec) {
+	fake.waitingForWorkerMutex.RLock()
+	defer fake.waitingForWorkerMutex.RUnlock()
+	argsForCall := fake.waitingForWorkerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeBuildStepDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -259,6 +298,8 @@ func (fake *FakeBuildStepDelegate) Invocations() map[string][][]interface{} {
 	defer fake.stderrMutex.RUnlock()
 	fake.stdoutMutex.RLock()
 	defer fake.stdoutMutex.RUnlock()
+	fake.waitingForWorkerMutex.RLock()
+	defer fake.waitingForWorkerMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
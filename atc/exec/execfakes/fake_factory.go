@@ -6,20 +6,53 @@ import (
 
 	lager "code.cloudfoundry.org/lager"
 	atc "github.com/concourse/concourse/atc"
+	creds "github.com/concourse/concourse/atc/creds"
 	db "github.com/concourse/concourse/atc/db"
 	exec "github.com/concourse/concourse/atc/exec"
 )
 
 type FakeFactory struct {
-	GetStub        func(lager.Logger, atc.Plan, db.Build, exec.StepMetadata, db.ContainerMetadata, exec.GetDelegate) exec.Step
+	ApprovalStub        func(lager.Logger, atc.Plan, db.Build, exec.ApprovalDelegate) exec.Step
+	approvalMutex       sync.RWMutex
+	approvalArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 atc.Plan
+		arg3 db.Build
+		arg4 exec.ApprovalDelegate
+	}
+	approvalReturns struct {
+		result1 exec.Step
+	}
+	approvalReturnsOnCall map[int]struct {
+		result1 exec.Step
+	}
+	ConditionalStub        func(lager.Logger, atc.Plan, db.Build, *creds.BuildVariables, exec.StepMetadata, exec.ConditionalDelegate, exec.Step) exec.Step
+	conditionalMutex       sync.RWMutex
+	conditionalArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 atc.Plan
+		arg3 db.Build
+		arg4 *creds.BuildVariables
+		arg5 exec.StepMetadata
+		arg6 exec.ConditionalDelegate
+		arg7 exec.Step
+	}
+	conditionalReturns struct {
+		result1 exec.Step
+	}
+	conditionalReturnsOnCall map[int]struct {
+		result1 exec.Step
+	}
+	GetStub        func(lager.Logger, atc.Plan, db.Build, *creds.BuildVariables, exec.StepMetadata, db.ContainerMetadata, exec.GetDelegate) exec.Step
 	getMutex       sync.RWMutex
 	getArgsForCall []struct {
 		arg1 lager.Logger
 		arg2 atc.Plan
 		arg3 db.Build
-		arg4 exec.StepMetadata
-		arg5 db.ContainerMetadata
-		arg6 exec.GetDelegate
+		arg4 *creds.BuildVariables
+		arg5 exec.StepMetadata
+		arg6 db.ContainerMetadata
+		arg7 exec.GetDelegate
 	}
 	getReturns struct {
 		result1 exec.Step
@@ -27,15 +60,30 @@ type FakeFactory struct {
 	getReturnsOnCall map[int]struct {
 		result1 exec.Step
 	}
-	PutStub        func(lager.Logger, atc.Plan, db.Build, exec.StepMetadata, db.ContainerMetadata, exec.PutDelegate) exec.Step
+	LoadVarStub        func(lager.Logger, atc.Plan, db.Build, exec.LoadVarDelegate) exec.Step
+	loadVarMutex       sync.RWMutex
+	loadVarArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 atc.Plan
+		arg3 db.Build
+		arg4 exec.LoadVarDelegate
+	}
+	loadVarReturns struct {
+		result1 exec.Step
+	}
+	loadVarReturnsOnCall map[int]struct {
+		result1 exec.Step
+	}
+	PutStub        func(lager.Logger, atc.Plan, db.Build, *creds.BuildVariables, exec.StepMetadata, db.ContainerMetadata, exec.PutDelegate) exec.Step
 	putMutex       sync.RWMutex
 	putArgsForCall []struct {
 		arg1 lager.Logger
 		arg2 atc.Plan
 		arg3 db.Build
-		arg4 exec.StepMetadata
-		arg5 db.ContainerMetadata
-		arg6 exec.PutDelegate
+		arg4 *creds.BuildVariables
+		arg5 exec.StepMetadata
+		arg6 db.ContainerMetadata
+		arg7 exec.PutDelegate
 	}
 	putReturns struct {
 		result1 exec.Step
@@ -43,14 +91,29 @@ type FakeFactory struct {
 	putReturnsOnCall map[int]struct {
 		result1 exec.Step
 	}
-	TaskStub        func(lager.Logger, atc.Plan, db.Build, db.ContainerMetadata, exec.TaskDelegate) exec.Step
+	SetPipelineStub        func(lager.Logger, atc.Plan, db.Build, exec.SetPipelineDelegate) exec.Step
+	setPipelineMutex       sync.RWMutex
+	setPipelineArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 atc.Plan
+		arg3 db.Build
+		arg4 exec.SetPipelineDelegate
+	}
+	setPipelineReturns struct {
+		result1 exec.Step
+	}
+	setPipelineReturnsOnCall map[int]struct {
+		result1 exec.Step
+	}
+	TaskStub        func(lager.Logger, atc.Plan, db.Build, *creds.BuildVariables, db.ContainerMetadata, exec.TaskDelegate) exec.Step
 	taskMutex       sync.RWMutex
 	taskArgsForCall []struct {
 		arg1 lager.Logger
 		arg2 atc.Plan
 		arg3 db.Build
-		arg4 db.ContainerMetadata
-		arg5 exec.TaskDelegate
+		arg4 *creds.BuildVariables
+		arg5 db.ContainerMetadata
+		arg6 exec.TaskDelegate
 	}
 	taskReturns struct {
 		result1 exec.Step
@@ -62,21 +125,151 @@ type FakeFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeFactory) Get(arg1 lager.Logger, arg2 atc.Plan, arg3 db.Build, arg4 exec.StepMetadata, arg5 db.ContainerMetadata, arg6 exec.GetDelegate) exec.Step {
+func (fake *FakeFactory) Approval(arg1 lager.Logger, arg2 atc.Plan, arg3 db.Build, arg4 exec.ApprovalDelegate) exec.Step {
+	fake.approvalMutex.Lock()
+	ret, specificReturn := fake.approvalReturnsOnCall[len(fake.approvalArgsForCall)]
+	fake.approvalArgsForCall = append(fake.approvalArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 atc.Plan
+		arg3 db.Build
+		arg4 exec.ApprovalDelegate
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("Approval", []interface{}{arg1, arg2, arg3, arg4})
+	fake.approvalMutex.Unlock()
+	if fake.ApprovalStub != nil {
+		return fake.ApprovalStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.approvalReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeFactory) ApprovalCallCount() int {
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
+	return len(fake.approvalArgsForCall)
+}
+
+func (fake *FakeFactory) ApprovalCalls(stub func(lager.Logger, atc.Plan, db.Build, exec.ApprovalDelegate) exec.Step) {
+	fake.approvalMutex.Lock()
+	defer fake.approvalMutex.Unlock()
+	fake.ApprovalStub = stub
+}
+
+func (fake *FakeFactory) ApprovalArgsForCall(i int) (lager.Logger, atc.Plan, db.Build, exec.ApprovalDelegate) {
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
+	argsForCall := fake.approvalArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeFactory) ApprovalReturns(result1 exec.Step) {
+	fake.approvalMutex.Lock()
+	defer fake.approvalMutex.Unlock()
+	fake.ApprovalStub = nil
+	fake.approvalReturns = struct {
+		result1 exec.Step
+	}{result1}
+}
+
+func (fake *FakeFactory) ApprovalReturnsOnCall(i int, result1 exec.Step) {
+	fake.approvalMutex.Lock()
+	defer fake.approvalMutex.Unlock()
+	fake.ApprovalStub = nil
+	if fake.approvalReturnsOnCall == nil {
+		fake.approvalReturnsOnCall = make(map[int]struct {
+			result1 exec.Step
+		})
+	}
+	fake.approvalReturnsOnCall[i] = struct {
+		result1 exec.Step
+	}{result1}
+}
+
+func (fake *FakeFactory) Conditional(arg1 lager.Logger, arg2 atc.Plan, arg3 db.Build, arg4 *creds.BuildVariables, arg5 exec.StepMetadata, arg6 exec.ConditionalDelegate, arg7 exec.Step) exec.Step {
+	fake.conditionalMutex.Lock()
+	ret, specificReturn := fake.conditionalReturnsOnCall[len(fake.conditionalArgsForCall)]
+	fake.conditionalArgsForCall = append(fake.conditionalArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 atc.Plan
+		arg3 db.Build
+		arg4 *creds.BuildVariables
+		arg5 exec.StepMetadata
+		arg6 exec.ConditionalDelegate
+		arg7 exec.Step
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.recordInvocation("Conditional", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.conditionalMutex.Unlock()
+	if fake.ConditionalStub != nil {
+		return fake.ConditionalStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.conditionalReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeFactory) ConditionalCallCount() int {
+	fake.conditionalMutex.RLock()
+	defer fake.conditionalMutex.RUnlock()
+	return len(fake.conditionalArgsForCall)
+}
+
+func (fake *FakeFactory) ConditionalCalls(stub func(lager.Logger, atc.Plan, db.Build, *creds.BuildVariables, exec.StepMetadata, exec.ConditionalDelegate, exec.Step) exec.Step) {
+	fake.conditionalMutex.Lock()
+	defer fake.conditionalMutex.Unlock()
+	fake.ConditionalStub = stub
+}
+
+func (fake *FakeFactory) ConditionalArgsForCall(i int) (lager.Logger, atc.Plan, db.Build, *creds.BuildVariables, exec.StepMetadata, exec.ConditionalDelegate, exec.Step) {
+	fake.conditionalMutex.RLock()
+	defer fake.conditionalMutex.RUnlock()
+	argsForCall := fake.conditionalArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+}
+
+func (fake *FakeFactory) ConditionalReturns(result1 exec.Step) {
+	fake.conditionalMutex.Lock()
+	defer fake.conditionalMutex.Unlock()
+	fake.ConditionalStub = nil
+	fake.conditionalReturns = struct {
+		result1 exec.Step
+	}{result1}
+}
+
+func (fake *FakeFactory) ConditionalReturnsOnCall(i int, result1 exec.Step) {
+	fake.conditionalMutex.Lock()
+	defer fake.conditionalMutex.Unlock()
+	fake.ConditionalStub = nil
+	if fake.conditionalReturnsOnCall == nil {
+		fake.conditionalReturnsOnCall = make(map[int]struct {
+			result1 exec.Step
+		})
+	}
+	fake.conditionalReturnsOnCall[i] = struct {
+		result1 exec.Step
+	}{result1}
+}
+
+func (fake *FakeFactory) Get(arg1 lager.Logger, arg2 atc.Plan, arg3 db.Build, arg4 *creds.BuildVariables, arg5 exec.StepMetadata, arg6 db.ContainerMetadata, arg7 exec.GetDelegate) exec.Step {
 	fake.getMutex.Lock()
 	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
 	fake.getArgsForCall = append(fake.getArgsForCall, struct {
 		arg1 lager.Logger
 		arg2 atc.Plan
 		arg3 db.Build
-		arg4 exec.StepMetadata
-		arg5 db.ContainerMetadata
-		arg6 exec.GetDelegate
-	}{arg1, arg2, arg3, arg4, arg5, arg6})
-	fake.recordInvocation("Get", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+		arg4 *creds.BuildVariables
+		arg5 exec.StepMetadata
+		arg6 db.ContainerMetadata
+		arg7 exec.GetDelegate
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.recordInvocation("Get", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
 	fake.getMutex.Unlock()
 	if fake.GetStub != nil {
-		return fake.GetStub(arg1, arg2, arg3, arg4, arg5, arg6)
+		return fake.GetStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 	}
 	if specificReturn {
 		return ret.result1
@@ -91,17 +284,17 @@ func (fake *FakeFactory) GetCallCount() int {
 	return len(fake.getArgsForCall)
 }
 
-func (fake *FakeFactory) GetCalls(stub func(lager.Logger, atc.Plan, db.Build, exec.StepMetadata, db.ContainerMetadata, exec.GetDelegate) exec.Step) {
+func (fake *FakeFactory) GetCalls(stub func(lager.Logger, atc.Plan, db.Build, *creds.BuildVariables, exec.StepMetadata, db.ContainerMetadata, exec.GetDelegate) exec.Step) {
 	fake.getMutex.Lock()
 	defer fake.getMutex.Unlock()
 	fake.GetStub = stub
 }
 
-func (fake *FakeFactory) GetArgsForCall(i int) (lager.Logger, atc.Plan, db.Build, exec.StepMetadata, db.ContainerMetadata, exec.GetDelegate) {
+func (fake *FakeFactory) GetArgsForCall(i int) (lager.Logger, atc.Plan, db.Build, *creds.BuildVariables, exec.StepMetadata, db.ContainerMetadata, exec.GetDelegate) {
 	fake.getMutex.RLock()
 	defer fake.getMutex.RUnlock()
 	argsForCall := fake.getArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
 }
 
 func (fake *FakeFactory) GetReturns(result1 exec.Step) {
@@ -127,21 +320,85 @@ func (fake *FakeFactory) GetReturnsOnCall(i int, result1 exec.Step) {
 	}{result1}
 }
 
-func (fake *FakeFactory) Put(arg1 lager.Logger, arg2 atc.Plan, arg3 db.Build, arg4 exec.StepMetadata, arg5 db.ContainerMetadata, arg6 exec.PutDelegate) exec.Step {
+func (fake *FakeFactory) LoadVar(arg1 lager.Logger, arg2 atc.Plan, arg3 db.Build, arg4 exec.LoadVarDelegate) exec.Step {
+	fake.loadVarMutex.Lock()
+	ret, specificReturn := fake.loadVarReturnsOnCall[len(fake.loadVarArgsForCall)]
+	fake.loadVarArgsForCall = append(fake.loadVarArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 atc.Plan
+		arg3 db.Build
+		arg4 exec.LoadVarDelegate
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("LoadVar", []interface{}{arg1, arg2, arg3, arg4})
+	fake.loadVarMutex.Unlock()
+	if fake.LoadVarStub != nil {
+		return fake.LoadVarStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.loadVarReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeFactory) LoadVarCallCount() int {
+	fake.loadVarMutex.RLock()
+	defer fake.loadVarMutex.RUnlock()
+	return len(fake.loadVarArgsForCall)
+}
+
+func (fake *FakeFactory) LoadVarCalls(stub func(lager.Logger, atc.Plan, db.Build, exec.LoadVarDelegate) exec.Step) {
+	fake.loadVarMutex.Lock()
+	defer fake.loadVarMutex.Unlock()
+	fake.LoadVarStub = stub
+}
+
+func (fake *FakeFactory) LoadVarArgsForCall(i int) (lager.Logger, atc.Plan, db.Build, exec.LoadVarDelegate) {
+	fake.loadVarMutex.RLock()
+	defer fake.loadVarMutex.RUnlock()
+	argsForCall := fake.loadVarArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeFactory) LoadVarReturns(result1 exec.Step) {
+	fake.loadVarMutex.Lock()
+	defer fake.loadVarMutex.Unlock()
+	fake.LoadVarStub = nil
+	fake.loadVarReturns = struct {
+		result1 exec.Step
+	}{result1}
+}
+
+func (fake *FakeFactory) LoadVarReturnsOnCall(i int, result1 exec.Step) {
+	fake.loadVarMutex.Lock()
+	defer fake.loadVarMutex.Unlock()
+	fake.LoadVarStub = nil
+	if fake.loadVarReturnsOnCall == nil {
+		fake.loadVarReturnsOnCall = make(map[int]struct {
+			result1 exec.Step
+		})
+	}
+	fake.loadVarReturnsOnCall[i] = struct {
+		result1 exec.Step
+	}{result1}
+}
+
+func (fake *FakeFactory) Put(arg1 lager.Logger, arg2 atc.Plan, arg3 db.Build, arg4 *creds.BuildVariables, arg5 exec.StepMetadata, arg6 db.ContainerMetadata, arg7 exec.PutDelegate) exec.Step {
 	fake.putMutex.Lock()
 	ret, specificReturn := fake.putReturnsOnCall[len(fake.putArgsForCall)]
 	fake.putArgsForCall = append(fake.putArgsForCall, struct {
 		arg1 lager.Logger
 		arg2 atc.Plan
 		arg3 db.Build
-		arg4 exec.StepMetadata
-		arg5 db.ContainerMetadata
-		arg6 exec.PutDelegate
-	}{arg1, arg2, arg3, arg4, arg5, arg6})
-	fake.recordInvocation("Put", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+		arg4 *creds.BuildVariables
+		arg5 exec.StepMetadata
+		arg6 db.ContainerMetadata
+		arg7 exec.PutDelegate
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.recordInvocation("Put", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
 	fake.putMutex.Unlock()
 	if fake.PutStub != nil {
-		return fake.PutStub(arg1, arg2, arg3, arg4, arg5, arg6)
+		return fake.PutStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 	}
 	if specificReturn {
 		return ret.result1
@@ -156,17 +413,17 @@ func (fake *FakeFactory) PutCallCount() int {
 	return len(fake.putArgsForCall)
 }
 
-func (fake *FakeFactory) PutCalls(stub func(lager.Logger, atc.Plan, db.Build, exec.StepMetadata, db.ContainerMetadata, exec.PutDelegate) exec.Step) {
+func (fake *FakeFactory) PutCalls(stub func(lager.Logger, atc.Plan, db.Build, *creds.BuildVariables, exec.StepMetadata, db.ContainerMetadata, exec.PutDelegate) exec.Step) {
 	fake.putMutex.Lock()
 	defer fake.putMutex.Unlock()
 	fake.PutStub = stub
 }
 
-func (fake *FakeFactory) PutArgsForCall(i int) (lager.Logger, atc.Plan, db.Build, exec.StepMetadata, db.ContainerMetadata, exec.PutDelegate) {
+func (fake *FakeFactory) PutArgsForCall(i int) (lager.Logger, atc.Plan, db.Build, *creds.BuildVariables, exec.StepMetadata, db.ContainerMetadata, exec.PutDelegate) {
 	fake.putMutex.RLock()
 	defer fake.putMutex.RUnlock()
 	argsForCall := fake.putArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
 }
 
 func (fake *FakeFactory) PutReturns(result1 exec.Step) {
@@ -192,20 +449,84 @@ func (fake *FakeFactory) PutReturnsOnCall(i int, result1 exec.Step) {
 	}{result1}
 }
 
-func (fake *FakeFactory) Task(arg1 lager.Logger, arg2 atc.Plan, arg3 db.Build, arg4 db.ContainerMetadata, arg5 exec.TaskDelegate) exec.Step {
+func (fake *FakeFactory) SetPipeline(arg1 lager.Logger, arg2 atc.Plan, arg3 db.Build, arg4 exec.SetPipelineDelegate) exec.Step {
+	fake.setPipelineMutex.Lock()
+	ret, specificReturn := fake.setPipelineReturnsOnCall[len(fake.setPipelineArgsForCall)]
+	fake.setPipelineArgsForCall = append(fake.setPipelineArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 atc.Plan
+		arg3 db.Build
+		arg4 exec.SetPipelineDelegate
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("SetPipeline", []interface{}{arg1, arg2, arg3, arg4})
+	fake.setPipelineMutex.Unlock()
+	if fake.SetPipelineStub != nil {
+		return fake.SetPipelineStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setPipelineReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeFactory) SetPipelineCallCount() int {
+	fake.setPipelineMutex.RLock()
+	defer fake.setPipelineMutex.RUnlock()
+	return len(fake.setPipelineArgsForCall)
+}
+
+func (fake *FakeFactory) SetPipelineCalls(stub func(lager.Logger, atc.Plan, db.Build, exec.SetPipelineDelegate) exec.Step) {
+	fake.setPipelineMutex.Lock()
+	defer fake.setPipelineMutex.Unlock()
+	fake.SetPipelineStub = stub
+}
+
+func (fake *FakeFactory) SetPipelineArgsForCall(i int) (lager.Logger, atc.Plan, db.Build, exec.SetPipelineDelegate) {
+	fake.setPipelineMutex.RLock()
+	defer fake.setPipelineMutex.RUnlock()
+	argsForCall := fake.setPipelineArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeFactory) SetPipelineReturns(result1 exec.Step) {
+	fake.setPipelineMutex.Lock()
+	defer fake.setPipelineMutex.Unlock()
+	fake.SetPipelineStub = nil
+	fake.setPipelineReturns = struct {
+		result1 exec.Step
+	}{result1}
+}
+
+func (fake *FakeFactory) SetPipelineReturnsOnCall(i int, result1 exec.Step) {
+	fake.setPipelineMutex.Lock()
+	defer fake.setPipelineMutex.Unlock()
+	fake.SetPipelineStub = nil
+	if fake.setPipelineReturnsOnCall == nil {
+		fake.setPipelineReturnsOnCall = make(map[int]struct {
+			result1 exec.Step
+		})
+	}
+	fake.setPipelineReturnsOnCall[i] = struct {
+		result1 exec.Step
+	}{result1}
+}
+
+func (fake *FakeFactory) Task(arg1 lager.Logger, arg2 atc.Plan, arg3 db.Build, arg4 *creds.BuildVariables, arg5 db.ContainerMetadata, arg6 exec.TaskDelegate) exec.Step {
 	fake.taskMutex.Lock()
 	ret, specificReturn := fake.taskReturnsOnCall[len(fake.taskArgsForCall)]
 	fake.taskArgsForCall = append(fake.taskArgsForCall, struct {
 		arg1 lager.Logger
 		arg2 atc.Plan
 		arg3 db.Build
-		arg4 db.ContainerMetadata
-		arg5 exec.TaskDelegate
-	}{arg1, arg2, arg3, arg4, arg5})
-	fake.recordInvocation("Task", []interface{}{arg1, arg2, arg3, arg4, arg5})
+		arg4 *creds.BuildVariables
+		arg5 db.ContainerMetadata
+		arg6 exec.TaskDelegate
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.recordInvocation("Task", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
 	fake.taskMutex.Unlock()
 	if fake.TaskStub != nil {
-		return fake.TaskStub(arg1, arg2, arg3, arg4, arg5)
+		return fake.TaskStub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
 		return ret.result1
@@ -220,17 +541,17 @@ func (fake *FakeFactory) TaskCallCount() int {
 	return len(fake.taskArgsForCall)
 }
 
-func (fake *FakeFactory) TaskCalls(stub func(lager.Logger, atc.Plan, db.Build, db.ContainerMetadata, exec.TaskDelegate) exec.Step) {
+func (fake *FakeFactory) TaskCalls(stub func(lager.Logger, atc.Plan, db.Build, *creds.BuildVariables, db.ContainerMetadata, exec.TaskDelegate) exec.Step) {
 	fake.taskMutex.Lock()
 	defer fake.taskMutex.Unlock()
 	fake.TaskStub = stub
 }
 
-func (fake *FakeFactory) TaskArgsForCall(i int) (lager.Logger, atc.Plan, db.Build, db.ContainerMetadata, exec.TaskDelegate) {
+func (fake *FakeFactory) TaskArgsForCall(i int) (lager.Logger, atc.Plan, db.Build, *creds.BuildVariables, db.ContainerMetadata, exec.TaskDelegate) {
 	fake.taskMutex.RLock()
 	defer fake.taskMutex.RUnlock()
 	argsForCall := fake.taskArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
 func (fake *FakeFactory) TaskReturns(result1 exec.Step) {
@@ -259,10 +580,18 @@ func (fake *FakeFactory) TaskReturnsOnCall(i int, result1 exec.Step) {
 func (fake *FakeFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
+	fake.conditionalMutex.RLock()
+	defer fake.conditionalMutex.RUnlock()
 	fake.getMutex.RLock()
 	defer fake.getMutex.RUnlock()
+	fake.loadVarMutex.RLock()
+	defer fake.loadVarMutex.RUnlock()
 	fake.putMutex.RLock()
 	defer fake.putMutex.RUnlock()
+	fake.setPipelineMutex.RLock()
+	defer fake.setPipelineMutex.RUnlock()
 	fake.taskMutex.RLock()
 	defer fake.taskMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -6,6 +6,7 @@ import (
 	sync "sync"
 
 	atc "github.com/concourse/concourse/atc"
+	creds "github.com/concourse/concourse/atc/creds"
 	exec "github.com/concourse/concourse/atc/exec"
 	worker "github.com/concourse/concourse/atc/worker"
 )
@@ -75,6 +76,16 @@ type FakeRunState struct {
 		arg1 atc.PlanID
 		arg2 interface{}
 	}
+	VariablesStub        func() *creds.BuildVariables
+	variablesMutex       sync.RWMutex
+	variablesArgsForCall []struct {
+	}
+	variablesReturns struct {
+		result1 *creds.BuildVariables
+	}
+	variablesReturnsOnCall map[int]struct {
+		result1 *creds.BuildVariables
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -410,6 +421,58 @@ func (fake *FakeRunState) StoreResultArgsForCall(i int) (atc.PlanID, interface{}
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeRunState) Variables() *creds.BuildVariables {
+	fake.variablesMutex.Lock()
+	ret, specificReturn := fake.variablesReturnsOnCall[len(fake.variablesArgsForCall)]
+	fake.variablesArgsForCall = append(fake.variablesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Variables", []interface{}{})
+	fake.variablesMutex.Unlock()
+	if fake.VariablesStub != nil {
+		return fake.VariablesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.variablesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeRunState) VariablesCallCount() int {
+	fake.variablesMutex.RLock()
+	defer fake.variablesMutex.RUnlock()
+	return len(fake.variablesArgsForCall)
+}
+
+func (fake *FakeRunState) VariablesCalls(stub func() *creds.BuildVariables) {
+	fake.variablesMutex.Lock()
+	defer fake.variablesMutex.Unlock()
+	fake.VariablesStub = stub
+}
+
+func (fake *FakeRunState) VariablesReturns(result1 *creds.BuildVariables) {
+	fake.variablesMutex.Lock()
+	defer fake.variablesMutex.Unlock()
+	fake.VariablesStub = nil
+	fake.variablesReturns = struct {
+		result1 *creds.BuildVariables
+	}{result1}
+}
+
+func (fake *FakeRunState) VariablesReturnsOnCall(i int, result1 *creds.BuildVariables) {
+	fake.variablesMutex.Lock()
+	defer fake.variablesMutex.Unlock()
+	fake.VariablesStub = nil
+	if fake.variablesReturnsOnCall == nil {
+		fake.variablesReturnsOnCall = make(map[int]struct {
+			result1 *creds.BuildVariables
+		})
+	}
+	fake.variablesReturnsOnCall[i] = struct {
+		result1 *creds.BuildVariables
+	}{result1}
+}
+
 func (fake *FakeRunState) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -427,6 +490,8 @@ func (fake *FakeRunState) Invocations() map[string][][]interface{} {
 	defer fake.sendUserInputMutex.RUnlock()
 	fake.storeResultMutex.RLock()
 	defer fake.storeResultMutex.RUnlock()
+	fake.variablesMutex.RLock()
+	defer fake.variablesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
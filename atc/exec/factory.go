@@ -5,18 +5,24 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/worker"
 )
 
 //go:generate counterfeiter . Factory
 
 // Factory is used when building up the steps for a build.
+//
+// The build's vars are given to steps which resolve credentials, so that the
+// values they resolve are tracked and can be redacted from the build's output.
 type Factory interface {
 	// Get constructs a Get step.
 	Get(
 		lager.Logger,
 		atc.Plan,
 		db.Build,
+		*creds.BuildVariables,
 		StepMetadata,
 		db.ContainerMetadata,
 		GetDelegate,
@@ -27,6 +33,7 @@ type Factory interface {
 		lager.Logger,
 		atc.Plan,
 		db.Build,
+		*creds.BuildVariables,
 		StepMetadata,
 		db.ContainerMetadata,
 		PutDelegate,
@@ -37,9 +44,45 @@ type Factory interface {
 		lager.Logger,
 		atc.Plan,
 		db.Build,
+		*creds.BuildVariables,
 		db.ContainerMetadata,
 		TaskDelegate,
 	) Step
+
+	// SetPipeline constructs a SetPipeline step.
+	SetPipeline(
+		lager.Logger,
+		atc.Plan,
+		db.Build,
+		SetPipelineDelegate,
+	) Step
+
+	// LoadVar constructs a LoadVar step.
+	LoadVar(
+		lager.Logger,
+		atc.Plan,
+		db.Build,
+		LoadVarDelegate,
+	) Step
+
+	// Approval constructs an Approval step.
+	Approval(
+		lager.Logger,
+		atc.Plan,
+		db.Build,
+		ApprovalDelegate,
+	) Step
+
+	// Conditional constructs a Conditional step wrapping the given step.
+	Conditional(
+		lager.Logger,
+		atc.Plan,
+		db.Build,
+		*creds.BuildVariables,
+		StepMetadata,
+		ConditionalDelegate,
+		Step,
+	) Step
 }
 
 // StepMetadata is used to inject metadata to make available to the step when
@@ -57,6 +100,8 @@ type BuildStepDelegate interface {
 	Stderr() io.Writer
 
 	Errored(lager.Logger, string)
+
+	WaitingForWorker(lager.Logger, worker.WorkerSpec)
 }
 
 // Privileged is used to indicate whether the given step should run with
@@ -12,6 +12,7 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -22,6 +23,7 @@ type gardenFactory struct {
 	resourceCacheFactory  db.ResourceCacheFactory
 	resourceConfigFactory db.ResourceConfigFactory
 	variablesFactory      creds.VariablesFactory
+	teamFactory           db.TeamFactory
 	defaultLimits         atc.ContainerLimits
 }
 
@@ -32,6 +34,7 @@ func NewGardenFactory(
 	resourceCacheFactory db.ResourceCacheFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	variablesFactory creds.VariablesFactory,
+	teamFactory db.TeamFactory,
 	defaultLimits atc.ContainerLimits,
 ) Factory {
 	return &gardenFactory{
@@ -41,6 +44,7 @@ func NewGardenFactory(
 		resourceCacheFactory:  resourceCacheFactory,
 		resourceConfigFactory: resourceConfigFactory,
 		variablesFactory:      variablesFactory,
+		teamFactory:           teamFactory,
 		defaultLimits:         defaultLimits,
 	}
 }
@@ -49,13 +53,14 @@ func (factory *gardenFactory) Get(
 	logger lager.Logger,
 	plan atc.Plan,
 	build db.Build,
+	buildVariables *creds.BuildVariables,
 	stepMetadata StepMetadata,
 	workerMetadata db.ContainerMetadata,
 	delegate GetDelegate,
 ) Step {
 	workerMetadata.WorkingDirectory = resource.ResourcesDir("get")
 
-	variables := factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName())
+	variables := factory.variables(build, buildVariables)
 
 	getStep := NewGetStep(
 		build,
@@ -80,20 +85,24 @@ func (factory *gardenFactory) Get(
 		creds.NewVersionedResourceTypes(variables, plan.Get.VersionedResourceTypes),
 	)
 
-	return LogError(getStep, delegate)
+	return LogError(Traced(getStep, "get", tracing.Attrs{
+		"name":     plan.Get.Name,
+		"resource": plan.Get.Resource,
+	}), delegate)
 }
 
 func (factory *gardenFactory) Put(
 	logger lager.Logger,
 	plan atc.Plan,
 	build db.Build,
+	buildVariables *creds.BuildVariables,
 	stepMetadata StepMetadata,
 	workerMetadata db.ContainerMetadata,
 	delegate PutDelegate,
 ) Step {
 	workerMetadata.WorkingDirectory = resource.ResourcesDir("put")
 
-	variables := factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName())
+	variables := factory.variables(build, buildVariables)
 
 	var putInputs PutInputs
 	if plan.Put.Inputs != nil {
@@ -123,20 +132,24 @@ func (factory *gardenFactory) Put(
 		creds.NewVersionedResourceTypes(variables, plan.Put.VersionedResourceTypes),
 	)
 
-	return LogError(putStep, delegate)
+	return LogError(Traced(putStep, "put", tracing.Attrs{
+		"name":     plan.Put.Name,
+		"resource": plan.Put.Resource,
+	}), delegate)
 }
 
 func (factory *gardenFactory) Task(
 	logger lager.Logger,
 	plan atc.Plan,
 	build db.Build,
+	buildVariables *creds.BuildVariables,
 	containerMetadata db.ContainerMetadata,
 	delegate TaskDelegate,
 ) Step {
 	workingDirectory := factory.taskWorkingDirectory(worker.ArtifactName(plan.Task.Name))
 	containerMetadata.WorkingDirectory = workingDirectory
 
-	credMgrVariables := factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName())
+	credMgrVariables := factory.variables(build, buildVariables)
 
 	var taskConfigSource TaskConfigSource
 	var taskVars []boshtemplate.Variables
@@ -160,15 +173,23 @@ func (factory *gardenFactory) Task(
 	// interpolate template vars
 	taskConfigSource = InterpolateTemplateConfigSource{ConfigSource: taskConfigSource, Vars: taskVars}
 
+	// interpolate references to var sources and build vars
+	taskConfigSource = InterpolateVarRefsConfigSource{
+		ConfigSource:   taskConfigSource,
+		Variables:      credMgrVariables,
+		BuildVariables: buildVariables,
+	}
+
 	// validate
 	taskConfigSource = ValidatingConfigSource{ConfigSource: taskConfigSource}
 
-	taskStep := NewTaskStep(
+	var taskStep Step = NewTaskStep(
 		Privileged(plan.Task.Privileged),
 		taskConfigSource,
 		plan.Task.Tags,
 		plan.Task.InputMapping,
 		plan.Task.OutputMapping,
+		plan.Task.ContainerPlacementStrategy,
 
 		workingDirectory,
 		plan.Task.ImageArtifactName,
@@ -187,7 +208,84 @@ func (factory *gardenFactory) Task(
 		factory.defaultLimits,
 	)
 
-	return LogError(taskStep, delegate)
+	if len(plan.Task.Artifacts) != 0 {
+		taskStep = PersistArtifacts(taskStep, build, plan.Task.Artifacts, plan.Task.OutputMapping, delegate)
+	}
+
+	return LogError(Traced(taskStep, "task", tracing.Attrs{
+		"name": plan.Task.Name,
+	}), delegate)
+}
+
+func (factory *gardenFactory) SetPipeline(
+	logger lager.Logger,
+	plan atc.Plan,
+	build db.Build,
+	delegate SetPipelineDelegate,
+) Step {
+	setPipelineStep := NewSetPipelineStep(
+		*plan.SetPipeline,
+		build,
+		factory.teamFactory,
+		delegate,
+	)
+
+	return LogError(Traced(setPipelineStep, "set_pipeline", tracing.Attrs{
+		"name": plan.SetPipeline.Name,
+	}), delegate)
+}
+
+func (factory *gardenFactory) LoadVar(
+	logger lager.Logger,
+	plan atc.Plan,
+	build db.Build,
+	delegate LoadVarDelegate,
+) Step {
+	loadVarStep := NewLoadVarStep(*plan.LoadVar, delegate)
+
+	return LogError(Traced(loadVarStep, "load_var", tracing.Attrs{
+		"name": plan.LoadVar.Name,
+	}), delegate)
+}
+
+func (factory *gardenFactory) Approval(
+	logger lager.Logger,
+	plan atc.Plan,
+	build db.Build,
+	delegate ApprovalDelegate,
+) Step {
+	approvalStep := NewApprovalStep(plan.ID, *plan.Approval, build, delegate)
+
+	return LogError(Traced(approvalStep, "approval", tracing.Attrs{
+		"name": plan.Approval.Name,
+	}), delegate)
+}
+
+func (factory *gardenFactory) Conditional(
+	logger lager.Logger,
+	plan atc.Plan,
+	build db.Build,
+	buildVariables *creds.BuildVariables,
+	stepMetadata StepMetadata,
+	delegate ConditionalDelegate,
+	step Step,
+) Step {
+	variables := factory.variables(build, buildVariables)
+
+	return Conditional(
+		plan.Conditional.Condition,
+		step,
+		stepMetadata,
+		variables,
+		delegate,
+	)
+}
+
+func (factory *gardenFactory) variables(build db.Build, buildVariables *creds.BuildVariables) creds.Variables {
+	return creds.NewTrackedVariables(
+		factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName()),
+		buildVariables,
+	)
 }
 
 func (factory *gardenFactory) taskWorkingDirectory(sourceName worker.ArtifactName) string {
@@ -140,12 +140,12 @@ func (step *GetStep) Run(ctx context.Context, state RunState) error {
 		return err
 	}
 
-	source, err := step.source.Evaluate()
+	source, err := step.source.WithBuildVariables(state.Variables()).Evaluate()
 	if err != nil {
 		return err
 	}
 
-	params, err := step.params.Evaluate()
+	params, err := step.params.WithBuildVariables(state.Variables()).Evaluate()
 	if err != nil {
 		return err
 	}
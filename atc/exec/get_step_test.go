@@ -114,7 +114,7 @@ var _ = Describe("GetStep", func() {
 			VersionedResourceTypes: resourceTypes,
 		}
 
-		factory = exec.NewGardenFactory(fakeWorkerClient, fakeResourceFetcher, fakeResourceFactory, fakeResourceCacheFactory, fakeResourceConfigFactory, fakeVariablesFactory, atc.ContainerLimits{})
+		factory = exec.NewGardenFactory(fakeWorkerClient, fakeResourceFetcher, fakeResourceFactory, fakeResourceCacheFactory, fakeResourceConfigFactory, fakeVariablesFactory, new(dbfakes.FakeTeamFactory), atc.ContainerLimits{})
 
 		fakeDelegate = new(execfakes.FakeGetDelegate)
 	})
@@ -132,6 +132,7 @@ var _ = Describe("GetStep", func() {
 				Get: getPlan,
 			},
 			fakeBuild,
+			state.Variables(),
 			stepMetadata,
 			containerMetadata,
 			fakeDelegate,
@@ -0,0 +1,170 @@
+package exec
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	LoadVarFormatRaw  = "raw"
+	LoadVarFormatJSON = "json"
+	LoadVarFormatYAML = "yaml"
+)
+
+//go:generate counterfeiter . LoadVarDelegate
+
+type LoadVarDelegate interface {
+	BuildStepDelegate
+
+	Starting(lager.Logger)
+	Finished(lager.Logger, bool)
+}
+
+// LoadVarStep reads a file from one of the build's artifacts and sets it as
+// a var for the rest of the build, referenced as ((.:name)).
+type LoadVarStep struct {
+	plan     atc.LoadVarPlan
+	delegate LoadVarDelegate
+
+	succeeded bool
+}
+
+// NewLoadVarStep constructs a LoadVarStep.
+func NewLoadVarStep(
+	plan atc.LoadVarPlan,
+	delegate LoadVarDelegate,
+) *LoadVarStep {
+	return &LoadVarStep{
+		plan:     plan,
+		delegate: delegate,
+	}
+}
+
+// Run reads and parses the file, and sets the var on the RunState.
+//
+// Unless the plan says to reveal it, the value is redacted from the build's
+// output from then on.
+//
+// If the file cannot be parsed in the given format, the problem is written to
+// stderr and the step fails.
+func (step *LoadVarStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx).Session("load-var-step", lager.Data{
+		"var": step.plan.Name,
+	})
+
+	step.delegate.Starting(logger)
+
+	contents, err := readArtifactFile(logger, state.Artifacts(), step.plan.File)
+	if err != nil {
+		return err
+	}
+
+	format := step.plan.Format
+	if format == "" {
+		format = loadVarFormatFromExtension(step.plan.File)
+	}
+
+	value, err := parseLoadVarFile(contents, format)
+	if err != nil {
+		fmt.Fprintf(step.delegate.Stderr(), "failed to parse %s as %s: %s\n", step.plan.File, format, err)
+		step.delegate.Finished(logger, false)
+		return nil
+	}
+
+	state.Variables().SetVar(step.plan.Name, value, !step.plan.Reveal)
+
+	step.succeeded = true
+	step.delegate.Finished(logger, true)
+
+	return nil
+}
+
+// Succeeded is true if the var was set.
+func (step *LoadVarStep) Succeeded() bool {
+	return step.succeeded
+}
+
+func loadVarFormatFromExtension(path string) string {
+	switch filepath.Ext(path) {
+	case ".json":
+		return LoadVarFormatJSON
+	case ".yml", ".yaml":
+		return LoadVarFormatYAML
+	default:
+		return LoadVarFormatRaw
+	}
+}
+
+func parseLoadVarFile(contents []byte, format string) (interface{}, error) {
+	switch format {
+	case LoadVarFormatRaw:
+		return string(contents), nil
+
+	case LoadVarFormatJSON:
+		var value interface{}
+		err := json.Unmarshal(contents, &value)
+		if err != nil {
+			return nil, err
+		}
+
+		return value, nil
+
+	case LoadVarFormatYAML:
+		var value interface{}
+		err := yaml.Unmarshal(contents, &value)
+		if err != nil {
+			return nil, err
+		}
+
+		return stringifyKeys(value)
+
+	default:
+		return nil, fmt.Errorf("unknown format '%s'", format)
+	}
+}
+
+// stringifyKeys converts the maps decoded from YAML so that the value can be
+// formatted as JSON when interpolated.
+func stringifyKeys(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			str, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("non-string key: %v", key)
+			}
+
+			sub, err := stringifyKeys(val)
+			if err != nil {
+				return nil, err
+			}
+
+			converted[str] = sub
+		}
+
+		return converted, nil
+
+	case []interface{}:
+		converted := make([]interface{}, len(v))
+		for i, val := range v {
+			sub, err := stringifyKeys(val)
+			if err != nil {
+				return nil, err
+			}
+
+			converted[i] = sub
+		}
+
+		return converted, nil
+	}
+
+	return value, nil
+}
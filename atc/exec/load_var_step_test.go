@@ -0,0 +1,182 @@
+package exec_test
+
+import (
+	"context"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	"github.com/onsi/gomega/gbytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LoadVarStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeDelegate *execfakes.FakeLoadVarDelegate
+		fakeSource   *workerfakes.FakeArtifactSource
+
+		stderr *gbytes.Buffer
+
+		state RunState
+
+		plan     atc.LoadVarPlan
+		contents string
+
+		step    Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		stderr = gbytes.NewBuffer()
+
+		fakeDelegate = new(execfakes.FakeLoadVarDelegate)
+		fakeDelegate.StderrReturns(stderr)
+
+		fakeSource = new(workerfakes.FakeArtifactSource)
+
+		state = NewRunState()
+		state.Artifacts().RegisterSource("some-artifact", fakeSource)
+
+		contents = "some-value\n"
+
+		plan = atc.LoadVarPlan{
+			Name: "some-var",
+			File: "some-artifact/some-file",
+		}
+	})
+
+	JustBeforeEach(func() {
+		fakeSource.StreamFileReturns(gbytes.BufferWithBytes([]byte(contents)), nil)
+
+		step = NewLoadVarStep(plan, fakeDelegate)
+		stepErr = step.Run(ctx, state)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("reads the file from the artifact", func() {
+		Expect(fakeSource.StreamFileCallCount()).To(Equal(1))
+		_, path := fakeSource.StreamFileArgsForCall(0)
+		Expect(path).To(Equal("some-file"))
+	})
+
+	It("sets the raw contents as the var", func() {
+		value, found := state.Variables().Var("some-var")
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("some-value\n"))
+	})
+
+	It("redacts the value", func() {
+		Expect(state.Variables().RedactedValues()).To(Equal([]string{"some-value"}))
+	})
+
+	It("succeeds", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+		Expect(step.Succeeded()).To(BeTrue())
+
+		Expect(fakeDelegate.StartingCallCount()).To(Equal(1))
+		Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+		_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+		Expect(succeeded).To(BeTrue())
+	})
+
+	Context("when the var is to be revealed", func() {
+		BeforeEach(func() {
+			plan.Reveal = true
+		})
+
+		It("does not redact the value", func() {
+			Expect(state.Variables().RedactedValues()).To(BeEmpty())
+		})
+	})
+
+	Context("when the file has a .json extension", func() {
+		BeforeEach(func() {
+			plan.File = "some-artifact/some-file.json"
+			contents = `{"some":{"nested":"value"},"list":[1,2]}`
+		})
+
+		It("parses it as JSON", func() {
+			value, _ := state.Variables().Var("some-var")
+			Expect(value).To(Equal(map[string]interface{}{
+				"some": map[string]interface{}{"nested": "value"},
+				"list": []interface{}{float64(1), float64(2)},
+			}))
+		})
+
+		It("redacts the strings within it", func() {
+			Expect(state.Variables().RedactedValues()).To(Equal([]string{"value"}))
+		})
+	})
+
+	Context("when the file has a .yml extension", func() {
+		BeforeEach(func() {
+			plan.File = "some-artifact/some-file.yml"
+			contents = "some:\n  nested: value\n"
+		})
+
+		It("parses it as YAML", func() {
+			value, _ := state.Variables().Var("some-var")
+			Expect(value).To(Equal(map[string]interface{}{
+				"some": map[string]interface{}{"nested": "value"},
+			}))
+		})
+	})
+
+	Context("when the format is given", func() {
+		BeforeEach(func() {
+			plan.File = "some-artifact/some-file.json"
+			plan.Format = "raw"
+			contents = `{"some":"json"}`
+		})
+
+		It("uses it regardless of the extension", func() {
+			value, _ := state.Variables().Var("some-var")
+			Expect(value).To(Equal(`{"some":"json"}`))
+		})
+	})
+
+	Context("when the file cannot be parsed", func() {
+		BeforeEach(func() {
+			plan.Format = "json"
+			contents = "not json"
+		})
+
+		It("fails without erroring", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeFalse())
+
+			_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+			Expect(succeeded).To(BeFalse())
+		})
+
+		It("says why on stderr", func() {
+			Expect(stderr).To(gbytes.Say("failed to parse some-artifact/some-file as json"))
+		})
+
+		It("does not set the var", func() {
+			_, found := state.Variables().Var("some-var")
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("when the artifact does not exist", func() {
+		BeforeEach(func() {
+			plan.File = "bogus-artifact/some-file"
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(BeAssignableToTypeOf(UnknownArtifactSourceError{}))
+		})
+	})
+})
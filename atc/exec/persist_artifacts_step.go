@@ -0,0 +1,85 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/worker"
+)
+
+// PersistArtifactsStep saves some of a task's outputs as build artifacts once
+// the task has run, whether or not it succeeded, so that they can be
+// downloaded after the build's volumes have been garbage collected.
+type PersistArtifactsStep struct {
+	Step
+
+	build         db.Build
+	artifacts     []string
+	outputMapping map[string]string
+	delegate      BuildStepDelegate
+}
+
+func PersistArtifacts(step Step, build db.Build, artifacts []string, outputMapping map[string]string, delegate BuildStepDelegate) Step {
+	return PersistArtifactsStep{
+		Step: step,
+
+		build:         build,
+		artifacts:     artifacts,
+		outputMapping: outputMapping,
+		delegate:      delegate,
+	}
+}
+
+func (step PersistArtifactsStep) Run(ctx context.Context, state RunState) error {
+	err := step.Step.Run(ctx, state)
+	if err != nil {
+		return err
+	}
+
+	logger := lagerctx.FromContext(ctx)
+
+	for _, name := range step.artifacts {
+		sourceName := name
+		if destinationName, ok := step.outputMapping[name]; ok {
+			sourceName = destinationName
+		}
+
+		source, found := state.Artifacts().SourceFor(worker.ArtifactName(sourceName))
+		if !found {
+			return UnknownArtifactSourceError{
+				SourceName: worker.ArtifactName(sourceName),
+			}
+		}
+
+		err := step.persist(logger.Session("persist-artifact", lager.Data{"artifact": name}), name, source)
+		if err == db.ErrNoBuildArtifactStore {
+			fmt.Fprintln(step.delegate.Stderr(), "WARNING: not persisting artifacts as no artifact store is configured")
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (step PersistArtifactsStep) persist(logger lager.Logger, name string, source worker.ArtifactSource) error {
+	reader, writer := io.Pipe()
+
+	go func() {
+		writer.CloseWithError(source.StreamTo(logger, streamDestination{writer}))
+	}()
+
+	err := step.build.SaveArtifact(name, reader)
+
+	// unblock the stream if saving gave up before reading all of it
+	_ = reader.CloseWithError(err)
+
+	return err
+}
@@ -0,0 +1,144 @@
+package exec_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PersistArtifactsStep", func() {
+	var (
+		ctx context.Context
+
+		fakeStep     *execfakes.FakeStep
+		fakeBuild    *dbfakes.FakeBuild
+		fakeDelegate *execfakes.FakeBuildStepDelegate
+		fakeSource   *workerfakes.FakeArtifactSource
+		stderr       *bytes.Buffer
+
+		state         RunState
+		outputMapping map[string]string
+		saved         map[string]string
+
+		runErr error
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		fakeStep = new(execfakes.FakeStep)
+		fakeBuild = new(dbfakes.FakeBuild)
+
+		stderr = new(bytes.Buffer)
+		fakeDelegate = new(execfakes.FakeBuildStepDelegate)
+		fakeDelegate.StderrReturns(stderr)
+
+		fakeSource = new(workerfakes.FakeArtifactSource)
+		fakeSource.StreamToStub = func(logger lager.Logger, dest worker.ArtifactDestination) error {
+			return dest.StreamIn(".", strings.NewReader("some-tarball"))
+		}
+
+		state = NewRunState()
+		state.Artifacts().RegisterSource("some-output", fakeSource)
+
+		outputMapping = nil
+
+		saved = map[string]string{}
+		fakeBuild.SaveArtifactStub = func(name string, artifact io.Reader) error {
+			contents, err := ioutil.ReadAll(artifact)
+			if err != nil {
+				return err
+			}
+
+			saved[name] = string(contents)
+			return nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		step := PersistArtifacts(fakeStep, fakeBuild, []string{"some-output"}, outputMapping, fakeDelegate)
+		runErr = step.Run(ctx, state)
+	})
+
+	It("runs the inner step", func() {
+		Expect(fakeStep.RunCallCount()).To(Equal(1))
+	})
+
+	It("saves the outputs as the build's artifacts", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+		Expect(saved).To(Equal(map[string]string{"some-output": "some-tarball"}))
+	})
+
+	Context("when the output is mapped to another name", func() {
+		BeforeEach(func() {
+			outputMapping = map[string]string{"some-output": "mapped-output"}
+
+			state = NewRunState()
+			state.Artifacts().RegisterSource("mapped-output", fakeSource)
+		})
+
+		It("saves the mapped output under the output's name", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(saved).To(Equal(map[string]string{"some-output": "some-tarball"}))
+		})
+	})
+
+	Context("when the inner step errors", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeStep.RunReturns(disaster)
+		})
+
+		It("returns the error without saving anything", func() {
+			Expect(runErr).To(Equal(disaster))
+			Expect(fakeBuild.SaveArtifactCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the output is missing", func() {
+		BeforeEach(func() {
+			state = NewRunState()
+		})
+
+		It("returns an error", func() {
+			Expect(runErr).To(Equal(UnknownArtifactSourceError{SourceName: "some-output"}))
+		})
+	})
+
+	Context("when streaming the output fails", func() {
+		BeforeEach(func() {
+			fakeSource.StreamToReturns(errors.New("stream failed"))
+			fakeSource.StreamToStub = nil
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("stream failed"))
+		})
+	})
+
+	Context("when no artifact store is configured", func() {
+		BeforeEach(func() {
+			fakeBuild.SaveArtifactStub = nil
+			fakeBuild.SaveArtifactReturns(db.ErrNoBuildArtifactStore)
+		})
+
+		It("warns and succeeds", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(stderr.String()).To(ContainSubstring("WARNING: not persisting artifacts as no artifact store is configured"))
+		})
+	})
+})
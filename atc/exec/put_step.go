@@ -134,12 +134,12 @@ func (step *PutStep) Run(ctx context.Context, state RunState) error {
 		return err
 	}
 
-	source, err := step.source.Evaluate()
+	source, err := step.source.WithBuildVariables(state.Variables()).Evaluate()
 	if err != nil {
 		return err
 	}
 
-	params, err := step.params.Evaluate()
+	params, err := step.params.WithBuildVariables(state.Variables()).Evaluate()
 	if err != nil {
 		return err
 	}
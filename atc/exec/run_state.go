@@ -6,11 +6,13 @@ import (
 	"sync"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/worker"
 )
 
 type runState struct {
 	artifacts *worker.ArtifactRepository
+	variables *creds.BuildVariables
 	results   *sync.Map
 	inputs    *sync.Map
 	outputs   *sync.Map
@@ -19,6 +21,7 @@ type runState struct {
 func NewRunState() RunState {
 	return &runState{
 		artifacts: worker.NewArtifactRepository(),
+		variables: creds.NewBuildVariables(),
 		results:   &sync.Map{},
 		inputs:    &sync.Map{},
 		outputs:   &sync.Map{},
@@ -29,6 +32,10 @@ func (state *runState) Artifacts() *worker.ArtifactRepository {
 	return state.artifacts
 }
 
+func (state *runState) Variables() *creds.BuildVariables {
+	return state.variables
+}
+
 func (state *runState) Result(id atc.PlanID, to interface{}) bool {
 	val, ok := state.results.Load(id)
 	if !ok {
@@ -0,0 +1,151 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	boshtemplate "github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/template"
+	"gopkg.in/yaml.v2"
+)
+
+//go:generate counterfeiter . SetPipelineDelegate
+
+type SetPipelineDelegate interface {
+	BuildStepDelegate
+
+	Starting(lager.Logger)
+	Finished(lager.Logger, bool)
+}
+
+// SetPipelineStep configures a pipeline in the build's team from a config
+// file in one of the build's artifacts.
+type SetPipelineStep struct {
+	plan        atc.SetPipelinePlan
+	build       db.Build
+	teamFactory db.TeamFactory
+	delegate    SetPipelineDelegate
+
+	succeeded bool
+}
+
+// NewSetPipelineStep constructs a SetPipelineStep.
+func NewSetPipelineStep(
+	plan atc.SetPipelinePlan,
+	build db.Build,
+	teamFactory db.TeamFactory,
+	delegate SetPipelineDelegate,
+) *SetPipelineStep {
+	return &SetPipelineStep{
+		plan:        plan,
+		build:       build,
+		teamFactory: teamFactory,
+		delegate:    delegate,
+	}
+}
+
+// Run reads the pipeline config from the artifact, interpolates the plan's
+// vars into it, and validates it the same way as 'fly set-pipeline' does.
+//
+// If the config is invalid, the problems are written to stderr and the step
+// fails. Otherwise the pipeline is saved, recording the build as the one
+// which configured it. New pipelines are created unpaused.
+func (step *SetPipelineStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx).Session("set-pipeline-step", lager.Data{
+		"pipeline": step.plan.Name,
+	})
+
+	step.delegate.Starting(logger)
+
+	stdout := step.delegate.Stdout()
+	stderr := step.delegate.Stderr()
+
+	configBytes, err := readArtifactFile(logger, state.Artifacts(), step.plan.File)
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to read pipeline config from %s: %s\n", step.plan.File, err)
+		step.delegate.Finished(logger, false)
+		return nil
+	}
+
+	config, err := loadPipelineConfig(configBytes, step.plan.Vars)
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to load pipeline config from %s: %s\n", step.plan.File, err)
+		step.delegate.Finished(logger, false)
+		return nil
+	}
+
+	warnings, errorMessages := config.Validate()
+	for _, warning := range warnings {
+		fmt.Fprintf(stderr, "WARNING: %s\n", warning.Message)
+	}
+
+	if len(errorMessages) > 0 {
+		fmt.Fprintln(stderr, "invalid pipeline config:")
+		for _, message := range errorMessages {
+			fmt.Fprintf(stderr, "  - %s\n", message)
+		}
+
+		step.delegate.Finished(logger, false)
+		return nil
+	}
+
+	team := step.teamFactory.GetByID(step.build.TeamID())
+
+	pipeline, found, err := team.Pipeline(step.plan.Name)
+	if err != nil {
+		return err
+	}
+
+	from := db.ConfigVersion(0)
+	pausedState := db.PipelineUnpaused
+	if found {
+		from = pipeline.ConfigVersion()
+		pausedState = db.PipelineNoChange
+	}
+
+	_, created, err := team.SavePipeline(step.plan.Name, config, from, pausedState, step.build.ID())
+	if err != nil {
+		return err
+	}
+
+	if created {
+		fmt.Fprintf(stdout, "created pipeline '%s'\n", step.plan.Name)
+	} else {
+		fmt.Fprintf(stdout, "configured pipeline '%s'\n", step.plan.Name)
+	}
+
+	step.succeeded = true
+	step.delegate.Finished(logger, true)
+
+	return nil
+}
+
+// Succeeded is true if the pipeline was saved.
+func (step *SetPipelineStep) Succeeded() bool {
+	return step.succeeded
+}
+
+func loadPipelineConfig(configBytes []byte, vars atc.Params) (atc.Config, error) {
+	if len(vars) > 0 {
+		var err error
+		configBytes, err = template.NewTemplateResolver(
+			configBytes,
+			[]boshtemplate.Variables{boshtemplate.StaticVariables(vars)},
+		).Resolve(false, true)
+		if err != nil {
+			return atc.Config{}, err
+		}
+	}
+
+	var untypedConfig interface{}
+	err := yaml.Unmarshal(configBytes, &untypedConfig)
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	return atc.DecodeConfig(untypedConfig)
+}
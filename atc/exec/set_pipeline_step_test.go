@@ -0,0 +1,253 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	"github.com/onsi/gomega/gbytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SetPipelineStep", func() {
+	const validConfig = `
+resources:
+- name: some-resource
+  type: git
+  source: {uri: ((uri))}
+
+jobs:
+- name: some-job
+  plan:
+  - get: some-resource
+`
+
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeBuild       *dbfakes.FakeBuild
+		fakeTeamFactory *dbfakes.FakeTeamFactory
+		fakeTeam        *dbfakes.FakeTeam
+		fakePipeline    *dbfakes.FakePipeline
+		fakeDelegate    *execfakes.FakeSetPipelineDelegate
+		fakeSource      *workerfakes.FakeArtifactSource
+
+		stdout *gbytes.Buffer
+		stderr *gbytes.Buffer
+
+		repo  *worker.ArtifactRepository
+		state *execfakes.FakeRunState
+
+		plan atc.SetPipelinePlan
+
+		step    Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.IDReturns(42)
+		fakeBuild.TeamIDReturns(7)
+
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeamFactory = new(dbfakes.FakeTeamFactory)
+		fakeTeamFactory.GetByIDReturns(fakeTeam)
+
+		fakePipeline = new(dbfakes.FakePipeline)
+		fakePipeline.ConfigVersionReturns(db.ConfigVersion(3))
+
+		stdout = gbytes.NewBuffer()
+		stderr = gbytes.NewBuffer()
+
+		fakeDelegate = new(execfakes.FakeSetPipelineDelegate)
+		fakeDelegate.StdoutReturns(stdout)
+		fakeDelegate.StderrReturns(stderr)
+
+		fakeSource = new(workerfakes.FakeArtifactSource)
+		fakeSource.StreamFileReturns(gbytes.BufferWithBytes([]byte(validConfig)), nil)
+
+		repo = worker.NewArtifactRepository()
+		repo.RegisterSource("some-artifact", fakeSource)
+
+		state = new(execfakes.FakeRunState)
+		state.ArtifactsReturns(repo)
+
+		plan = atc.SetPipelinePlan{
+			Name: "some-pipeline",
+			File: "some-artifact/pipeline.yml",
+		}
+	})
+
+	JustBeforeEach(func() {
+		step = NewSetPipelineStep(plan, fakeBuild, fakeTeamFactory, fakeDelegate)
+		stepErr = step.Run(ctx, state)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("reads the config from the artifact", func() {
+		Expect(fakeSource.StreamFileCallCount()).To(Equal(1))
+		_, path := fakeSource.StreamFileArgsForCall(0)
+		Expect(path).To(Equal("pipeline.yml"))
+	})
+
+	It("looks up the build's team", func() {
+		Expect(fakeTeamFactory.GetByIDCallCount()).To(Equal(1))
+		Expect(fakeTeamFactory.GetByIDArgsForCall(0)).To(Equal(7))
+	})
+
+	It("reports that it started", func() {
+		Expect(fakeDelegate.StartingCallCount()).To(Equal(1))
+	})
+
+	Context("when the pipeline does not exist yet", func() {
+		BeforeEach(func() {
+			fakeTeam.PipelineReturns(nil, false, nil)
+			fakeTeam.SavePipelineReturns(fakePipeline, true, nil)
+		})
+
+		It("creates it unpaused, recording the build", func() {
+			Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
+			name, config, from, pausedState, buildID := fakeTeam.SavePipelineArgsForCall(0)
+			Expect(name).To(Equal("some-pipeline"))
+			Expect(config.Jobs).To(HaveLen(1))
+			Expect(config.Resources[0].Source).To(Equal(atc.Source{"uri": "((uri))"}))
+			Expect(from).To(Equal(db.ConfigVersion(0)))
+			Expect(pausedState).To(Equal(db.PipelineUnpaused))
+			Expect(buildID).To(Equal(42))
+		})
+
+		It("succeeds", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeTrue())
+
+			Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+			_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+			Expect(succeeded).To(BeTrue())
+		})
+
+		It("says so on stdout", func() {
+			Expect(stdout).To(gbytes.Say("created pipeline 'some-pipeline'"))
+		})
+	})
+
+	Context("when the pipeline already exists", func() {
+		BeforeEach(func() {
+			fakeTeam.PipelineReturns(fakePipeline, true, nil)
+			fakeTeam.SavePipelineReturns(fakePipeline, false, nil)
+		})
+
+		It("updates it from its current version without changing whether it's paused", func() {
+			Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
+			_, _, from, pausedState, _ := fakeTeam.SavePipelineArgsForCall(0)
+			Expect(from).To(Equal(db.ConfigVersion(3)))
+			Expect(pausedState).To(Equal(db.PipelineNoChange))
+		})
+
+		It("says so on stdout", func() {
+			Expect(stdout).To(gbytes.Say("configured pipeline 'some-pipeline'"))
+		})
+	})
+
+	Context("when vars are given", func() {
+		BeforeEach(func() {
+			plan.Vars = atc.Params{"uri": "https://example.com/repo.git"}
+			fakeTeam.SavePipelineReturns(fakePipeline, true, nil)
+		})
+
+		It("interpolates them into the config", func() {
+			_, config, _, _, _ := fakeTeam.SavePipelineArgsForCall(0)
+			Expect(config.Resources[0].Source).To(Equal(atc.Source{"uri": "https://example.com/repo.git"}))
+		})
+	})
+
+	Context("when the config is invalid", func() {
+		BeforeEach(func() {
+			fakeSource.StreamFileReturns(gbytes.BufferWithBytes([]byte(`
+jobs:
+- name: some-job
+  plan:
+  - get: some-missing-resource
+`)), nil)
+		})
+
+		It("does not save the pipeline", func() {
+			Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+		})
+
+		It("prints the errors to stderr", func() {
+			Expect(stderr).To(gbytes.Say("invalid pipeline config"))
+			Expect(stderr).To(gbytes.Say("some-missing-resource"))
+		})
+
+		It("fails without erroring", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeFalse())
+
+			_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+			Expect(succeeded).To(BeFalse())
+		})
+	})
+
+	Context("when the config has unknown keys", func() {
+		BeforeEach(func() {
+			fakeSource.StreamFileReturns(gbytes.BufferWithBytes([]byte(`
+jobs:
+- name: some-job
+  bogus: true
+`)), nil)
+		})
+
+		It("fails without saving the pipeline", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeFalse())
+			Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+			Expect(stderr).To(gbytes.Say("unknown/extra keys"))
+		})
+	})
+
+	Context("when the file cannot be found", func() {
+		BeforeEach(func() {
+			plan.File = "bogus-artifact/pipeline.yml"
+		})
+
+		It("fails without saving the pipeline", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeFalse())
+			Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+			Expect(stderr).To(gbytes.Say("failed to read pipeline config from bogus-artifact/pipeline.yml"))
+		})
+
+		It("reports that it finished unsuccessfully", func() {
+			Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+			_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+			Expect(succeeded).To(BeFalse())
+		})
+	})
+
+	Context("when saving the pipeline fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeTeam.SavePipelineReturns(nil, false, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(Equal(disaster))
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+	})
+})
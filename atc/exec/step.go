@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -36,6 +37,7 @@ type OutputHandler func(io.Writer) error
 
 type RunState interface {
 	Artifacts() *worker.ArtifactRepository
+	Variables() *creds.BuildVariables
 
 	Result(atc.PlanID, interface{}) bool
 	StoreResult(atc.PlanID, interface{})
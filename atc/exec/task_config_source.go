@@ -13,6 +13,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/baggageclaim"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -191,6 +192,55 @@ func (configSource InterpolateTemplateConfigSource) Warnings() []string {
 	return []string{}
 }
 
+// InterpolateVarRefsConfigSource resolves the references to var sources, e.g.
+// ((source:path)), and to the build's vars, e.g. ((.:name)), which template
+// interpolation leaves alone.
+type InterpolateVarRefsConfigSource struct {
+	ConfigSource   TaskConfigSource
+	Variables      creds.Variables
+	BuildVariables *creds.BuildVariables
+}
+
+// FetchConfig returns the interpolated configuration
+func (configSource InterpolateVarRefsConfigSource) FetchConfig(logger lager.Logger, source *worker.ArtifactRepository) (atc.TaskConfig, error) {
+	taskConfig, err := configSource.ConfigSource.FetchConfig(logger, source)
+	if err != nil {
+		return atc.TaskConfig{}, err
+	}
+
+	byteConfig, err := json.Marshal(taskConfig)
+	if err != nil {
+		return atc.TaskConfig{}, fmt.Errorf("failed to marshal task config: %s", err)
+	}
+
+	var untypedConfig interface{}
+	err = json.Unmarshal(byteConfig, &untypedConfig)
+	if err != nil {
+		return atc.TaskConfig{}, fmt.Errorf("failed to unmarshal task config: %s", err)
+	}
+
+	untypedConfig, err = creds.InterpolateRefs(configSource.Variables, configSource.BuildVariables, untypedConfig)
+	if err != nil {
+		return atc.TaskConfig{}, fmt.Errorf("failed to interpolate task config: %s", err)
+	}
+
+	byteConfig, err = json.Marshal(untypedConfig)
+	if err != nil {
+		return atc.TaskConfig{}, fmt.Errorf("failed to marshal task config: %s", err)
+	}
+
+	taskConfig, err = atc.NewTaskConfig(byteConfig)
+	if err != nil {
+		return atc.TaskConfig{}, fmt.Errorf("failed to create task config from bytes: %s", err)
+	}
+
+	return taskConfig, nil
+}
+
+func (configSource InterpolateVarRefsConfigSource) Warnings() []string {
+	return []string{}
+}
+
 // ValidatingConfigSource delegates to another ConfigSource, and validates its
 // task config.
 type ValidatingConfigSource struct {
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/baggageclaim"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	. "github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/execfakes"
 	"github.com/concourse/concourse/atc/worker"
@@ -434,4 +435,95 @@ run: {path: a/file}
 			}))
 		})
 	})
+
+	Describe("InterpolateVarRefsConfigSource", func() {
+		var (
+			variables      creds.Variables
+			buildVariables *creds.BuildVariables
+
+			fetchedConfig atc.TaskConfig
+			fetchErr      error
+		)
+
+		BeforeEach(func() {
+			taskConfig.Params = map[string]string{
+				"loaded":  "((.:some-var))",
+				"sourced": "((some-source:some-secret.password))",
+				"mixed":   "prefix-((.:some-var))",
+			}
+
+			variables = sourcedVariables{
+				StaticVariables: boshtemplate.StaticVariables{},
+				sources: map[string]boshtemplate.StaticVariables{
+					"some-source": {
+						"some-secret": map[interface{}]interface{}{"password": "some-password"},
+					},
+				},
+			}
+
+			buildVariables = creds.NewBuildVariables()
+			buildVariables.SetVar("some-var", "some-value", false)
+		})
+
+		JustBeforeEach(func() {
+			configSource := InterpolateVarRefsConfigSource{
+				ConfigSource:   StaticConfigSource{Config: &taskConfig},
+				Variables:      variables,
+				BuildVariables: buildVariables,
+			}
+
+			fetchedConfig, fetchErr = configSource.FetchConfig(logger, repo)
+		})
+
+		It("resolves references to build vars and var sources", func() {
+			Expect(fetchErr).ToNot(HaveOccurred())
+			Expect(fetchedConfig.Params).To(Equal(map[string]string{
+				"loaded":  "some-value",
+				"sourced": "some-password",
+				"mixed":   "prefix-some-value",
+			}))
+		})
+
+		It("leaves the rest of the config alone", func() {
+			Expect(fetchedConfig.Run.Args).To(Equal([]string{"-al", "((task-variable-name))"}))
+		})
+
+		Context("when a build var is not defined", func() {
+			BeforeEach(func() {
+				buildVariables = creds.NewBuildVariables()
+			})
+
+			It("fails", func() {
+				Expect(fetchErr).To(MatchError(ContainSubstring("undefined build vars: some-var")))
+			})
+		})
+
+		Context("when the var source is not configured", func() {
+			BeforeEach(func() {
+				variables = sourcedVariables{
+					StaticVariables: boshtemplate.StaticVariables{},
+					sources:         map[string]boshtemplate.StaticVariables{},
+				}
+			})
+
+			It("fails", func() {
+				Expect(fetchErr).To(MatchError(ContainSubstring("unknown var source: some-source")))
+			})
+		})
+	})
 })
+
+type sourcedVariables struct {
+	boshtemplate.StaticVariables
+
+	sources map[string]boshtemplate.StaticVariables
+}
+
+func (variables sourcedVariables) SourceVariables(name string) (creds.Variables, error) {
+	source, found := variables.sources[name]
+	if !found {
+		return nil, creds.UnknownVarSourceError{Name: name}
+	}
+
+	return source, nil
+}
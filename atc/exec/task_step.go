@@ -20,6 +20,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -74,6 +75,8 @@ type TaskStep struct {
 	inputMapping  map[string]string
 	outputMapping map[string]string
 
+	placementStrategy []string
+
 	artifactsRoot     string
 	imageArtifactName string
 
@@ -100,6 +103,7 @@ func NewTaskStep(
 	tags atc.Tags,
 	inputMapping map[string]string,
 	outputMapping map[string]string,
+	placementStrategy []string,
 	artifactsRoot string,
 	imageArtifactName string,
 	delegate TaskDelegate,
@@ -119,6 +123,7 @@ func NewTaskStep(
 		tags:              tags,
 		inputMapping:      inputMapping,
 		outputMapping:     outputMapping,
+		placementStrategy: placementStrategy,
 		artifactsRoot:     artifactsRoot,
 		imageArtifactName: imageArtifactName,
 		delegate:          delegate,
@@ -177,6 +182,10 @@ func (action *TaskStep) Run(ctx context.Context, state RunState) error {
 		return err
 	}
 
+	if traceParent := tracing.TraceParent(ctx); traceParent != "" {
+		containerSpec.Env = append(containerSpec.Env, tracing.TraceParentEnv+"="+traceParent)
+	}
+
 	workerSpec, err := action.workerSpec(logger, action.resourceTypes, repository, config)
 	if err != nil {
 		return err
@@ -388,6 +397,8 @@ func (action *TaskStep) containerSpec(logger lager.Logger, repository *worker.Ar
 		Dir:       action.artifactsRoot,
 		Env:       action.envForParams(config.Params),
 
+		PlacementStrategy: action.placementStrategy,
+
 		Inputs:  []worker.InputSource{},
 		Outputs: worker.OutputPaths{},
 	}
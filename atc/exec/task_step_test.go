@@ -54,6 +54,8 @@ var _ = Describe("TaskStep", func() {
 		inputMapping  map[string]string
 		outputMapping map[string]string
 
+		placementStrategy []string
+
 		repo  *worker.ArtifactRepository
 		state *execfakes.FakeRunState
 
@@ -101,6 +103,7 @@ var _ = Describe("TaskStep", func() {
 
 		inputMapping = nil
 		outputMapping = nil
+		placementStrategy = nil
 		imageArtifactName = ""
 
 		containerMetadata = db.ContainerMetadata{
@@ -118,6 +121,7 @@ var _ = Describe("TaskStep", func() {
 			tags,
 			inputMapping,
 			outputMapping,
+			placementStrategy,
 			"some-artifact-root",
 			imageArtifactName,
 			fakeDelegate,
@@ -236,6 +240,17 @@ var _ = Describe("TaskStep", func() {
 				Expect(actualResourceTypes).To(Equal(resourceTypes))
 			})
 
+			Context("when the step chains its own placement strategies", func() {
+				BeforeEach(func() {
+					placementStrategy = []string{"volume-locality", "random"}
+				})
+
+				It("places the container with them", func() {
+					_, _, _, _, _, containerSpec, _, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+					Expect(containerSpec.PlacementStrategy).To(Equal([]string{"volume-locality", "random"}))
+				})
+			})
+
 			Context("when rootfs uri is set instead of image resource", func() {
 				BeforeEach(func() {
 					fetchedConfig = atc.TaskConfig{
@@ -1618,5 +1633,40 @@ var _ = Describe("TaskStep", func() {
 				Expect(taskStep.Succeeded()).To(BeFalse())
 			})
 		})
+
+		Context("when the task's params reference build vars", func() {
+			BeforeEach(func() {
+				buildVariables := creds.NewBuildVariables()
+				buildVariables.SetVar("foo", "some-loaded-value", false)
+
+				var taskConfigSource exec.TaskConfigSource = exec.StaticConfigSource{Config: &fetchedConfig}
+				taskConfigSource = &exec.OverrideParamsConfigSource{
+					ConfigSource: taskConfigSource,
+					Params:       atc.Params{"FOO": "((.:foo))"},
+				}
+				taskConfigSource = exec.InterpolateTemplateConfigSource{
+					ConfigSource: taskConfigSource,
+					Vars:         []template.Variables{template.StaticVariables{}},
+				}
+				taskConfigSource = exec.InterpolateVarRefsConfigSource{
+					ConfigSource:   taskConfigSource,
+					Variables:      template.StaticVariables{},
+					BuildVariables: buildVariables,
+				}
+
+				configSource.FetchConfigStub = taskConfigSource.FetchConfig
+
+				fakeWorkerClient.FindOrCreateContainerReturns(nil, errors.New("nope"))
+			})
+
+			It("runs the task with the values of the vars", func() {
+				Expect(fakeWorkerClient.FindOrCreateContainerCallCount()).To(Equal(1))
+				_, _, _, _, _, containerSpec, _, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+				Expect(containerSpec.Env).To(ConsistOf(
+					"SECURE=secret-task-param",
+					"FOO=some-loaded-value",
+				))
+			})
+		})
 	})
 })
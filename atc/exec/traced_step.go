@@ -0,0 +1,39 @@
+package exec
+
+import (
+	"context"
+
+	"github.com/concourse/concourse/atc/tracing"
+)
+
+// TracedStep runs its step within a tracing span, so that the operations the
+// step performs, e.g. fetching images and creating containers, are traced as
+// its children.
+type TracedStep struct {
+	Step
+
+	name  string
+	attrs tracing.Attrs
+}
+
+func Traced(step Step, name string, attrs tracing.Attrs) Step {
+	return TracedStep{
+		Step: step,
+
+		name:  name,
+		attrs: attrs,
+	}
+}
+
+func (step TracedStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, step.name, step.attrs)
+
+	err := step.Step.Run(ctx, state)
+	if err == nil && !step.Step.Succeeded() {
+		span.SetAttribute("failed", "true")
+	}
+
+	tracing.End(span, err)
+
+	return err
+}
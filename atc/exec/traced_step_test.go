@@ -0,0 +1,127 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/tracing"
+	"github.com/concourse/concourse/atc/tracing/tracingfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TracedStep", func() {
+	var (
+		ctx context.Context
+
+		fakeStep     *execfakes.FakeStep
+		fakeExporter *tracingfakes.FakeExporter
+		state        *execfakes.FakeRunState
+
+		step Step
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		fakeStep = new(execfakes.FakeStep)
+		fakeExporter = new(tracingfakes.FakeExporter)
+		state = new(execfakes.FakeRunState)
+
+		step = Traced(fakeStep, "task", tracing.Attrs{"name": "some-task"})
+	})
+
+	exportedSpans := func() []tracing.SpanData {
+		tracing.Deconfigure()
+
+		spans := []tracing.SpanData{}
+		for i := 0; i < fakeExporter.ExportSpansCallCount(); i++ {
+			_, batch := fakeExporter.ExportSpansArgsForCall(i)
+			spans = append(spans, batch...)
+		}
+
+		return spans
+	}
+
+	Context("when tracing is configured", func() {
+		var runErr error
+
+		BeforeEach(func() {
+			tracing.Configure(lagertest.NewTestLogger("test"), fakeExporter)
+		})
+
+		AfterEach(func() {
+			tracing.Deconfigure()
+		})
+
+		JustBeforeEach(func() {
+			runErr = step.Run(ctx, state)
+		})
+
+		It("runs the inner step within a span", func() {
+			Expect(fakeStep.RunCallCount()).To(Equal(1))
+			runCtx, runState := fakeStep.RunArgsForCall(0)
+			Expect(runState).To(Equal(state))
+			Expect(tracing.FromContext(runCtx)).ToNot(BeNil())
+		})
+
+		Context("when the inner step succeeds", func() {
+			BeforeEach(func() {
+				fakeStep.SucceededReturns(true)
+			})
+
+			It("exports the span", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+
+				spans := exportedSpans()
+				Expect(spans).To(HaveLen(1))
+				Expect(spans[0].Name).To(Equal("task"))
+				Expect(spans[0].Attributes).To(Equal(tracing.Attrs{"name": "some-task"}))
+				Expect(spans[0].Error).To(BeEmpty())
+			})
+		})
+
+		Context("when the inner step fails", func() {
+			BeforeEach(func() {
+				fakeStep.SucceededReturns(false)
+			})
+
+			It("marks the span as failed", func() {
+				spans := exportedSpans()
+				Expect(spans).To(HaveLen(1))
+				Expect(spans[0].Attributes).To(HaveKeyWithValue("failed", "true"))
+			})
+		})
+
+		Context("when the inner step errors", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeStep.RunReturns(disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(Equal(disaster))
+			})
+
+			It("records the error on the span", func() {
+				spans := exportedSpans()
+				Expect(spans).To(HaveLen(1))
+				Expect(spans[0].Error).To(Equal("nope"))
+			})
+		})
+	})
+
+	Context("when tracing is not configured", func() {
+		It("runs the inner step without a span", func() {
+			Expect(step.Run(ctx, state)).To(Succeed())
+
+			Expect(fakeStep.RunCallCount()).To(Equal(1))
+			runCtx, _ := fakeStep.RunArgsForCall(0)
+			Expect(tracing.FromContext(runCtx)).To(BeNil())
+		})
+	})
+})
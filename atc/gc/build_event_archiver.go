@@ -0,0 +1,76 @@
+package gc
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type buildEventArchiver struct {
+	buildFactory    db.BuildFactory
+	buildEventStore db.BuildEventStore
+	batchSize       int
+}
+
+// NewBuildEventArchiver returns a Collector which moves the events of
+// completed builds to the build event store, and removes the archived events
+// of builds which have since been deleted.
+func NewBuildEventArchiver(
+	buildFactory db.BuildFactory,
+	buildEventStore db.BuildEventStore,
+	batchSize int,
+) Collector {
+	return &buildEventArchiver{
+		buildFactory:    buildFactory,
+		buildEventStore: buildEventStore,
+		batchSize:       batchSize,
+	}
+}
+
+func (a *buildEventArchiver) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("build-event-archiver")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	builds, err := a.buildFactory.GetArchivableBuilds(a.batchSize)
+	if err != nil {
+		logger.Error("failed-to-get-archivable-builds", err)
+		return err
+	}
+
+	for _, build := range builds {
+		err := build.ArchiveEvents()
+		if err != nil {
+			logger.Error("failed-to-archive-build-events", err, lager.Data{"build": build.ID()})
+			continue
+		}
+	}
+
+	orphanedBuildIDs, err := a.buildFactory.OrphanedEventArchives()
+	if err != nil {
+		logger.Error("failed-to-get-orphaned-event-archives", err)
+		return err
+	}
+
+	deletedBuildIDs := []int{}
+	for _, buildID := range orphanedBuildIDs {
+		err := a.buildEventStore.Delete(buildID)
+		if err != nil {
+			logger.Error("failed-to-delete-orphaned-build-events", err, lager.Data{"build": buildID})
+			continue
+		}
+
+		deletedBuildIDs = append(deletedBuildIDs, buildID)
+	}
+
+	err = a.buildFactory.DeleteEventArchives(deletedBuildIDs)
+	if err != nil {
+		logger.Error("failed-to-delete-event-archives", err)
+		return err
+	}
+
+	return nil
+}
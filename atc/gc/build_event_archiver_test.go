@@ -0,0 +1,106 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildEventArchiver", func() {
+	var (
+		archiver            gc.Collector
+		fakeBuildFactory    *dbfakes.FakeBuildFactory
+		fakeBuildEventStore *dbfakes.FakeBuildEventStore
+
+		build1 *dbfakes.FakeBuild
+		build2 *dbfakes.FakeBuild
+
+		err error
+	)
+
+	BeforeEach(func() {
+		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
+		fakeBuildEventStore = new(dbfakes.FakeBuildEventStore)
+
+		build1 = new(dbfakes.FakeBuild)
+		build1.IDReturns(1)
+		build2 = new(dbfakes.FakeBuild)
+		build2.IDReturns(2)
+
+		fakeBuildFactory.GetArchivableBuildsReturns([]db.Build{build1, build2}, nil)
+		fakeBuildFactory.OrphanedEventArchivesReturns([]int{3, 4}, nil)
+
+		archiver = gc.NewBuildEventArchiver(fakeBuildFactory, fakeBuildEventStore, 10)
+	})
+
+	JustBeforeEach(func() {
+		err = archiver.Run(context.TODO())
+	})
+
+	It("archives the events of a batch of completed builds", func() {
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeBuildFactory.GetArchivableBuildsCallCount()).To(Equal(1))
+		Expect(fakeBuildFactory.GetArchivableBuildsArgsForCall(0)).To(Equal(10))
+
+		Expect(build1.ArchiveEventsCallCount()).To(Equal(1))
+		Expect(build2.ArchiveEventsCallCount()).To(Equal(1))
+	})
+
+	It("deletes the archived events of deleted builds", func() {
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeBuildEventStore.DeleteCallCount()).To(Equal(2))
+		Expect(fakeBuildEventStore.DeleteArgsForCall(0)).To(Equal(3))
+		Expect(fakeBuildEventStore.DeleteArgsForCall(1)).To(Equal(4))
+
+		Expect(fakeBuildFactory.DeleteEventArchivesCallCount()).To(Equal(1))
+		Expect(fakeBuildFactory.DeleteEventArchivesArgsForCall(0)).To(Equal([]int{3, 4}))
+	})
+
+	Context("when archiving a build fails", func() {
+		BeforeEach(func() {
+			build1.ArchiveEventsReturns(errors.New("nope"))
+		})
+
+		It("carries on with the other builds", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(build2.ArchiveEventsCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when deleting archived events from the store fails", func() {
+		BeforeEach(func() {
+			fakeBuildEventStore.DeleteStub = func(buildID int) error {
+				if buildID == 3 {
+					return errors.New("nope")
+				}
+
+				return nil
+			}
+		})
+
+		It("keeps the index entry so that it is retried", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeBuildFactory.DeleteEventArchivesArgsForCall(0)).To(Equal([]int{4}))
+		})
+	})
+
+	Context("when getting the archivable builds fails", func() {
+		disaster := errors.New("disaster")
+
+		BeforeEach(func() {
+			fakeBuildFactory.GetArchivableBuildsReturns(nil, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(Equal(disaster))
+		})
+	})
+})
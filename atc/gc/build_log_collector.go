@@ -12,6 +12,7 @@ type buildLogCollector struct {
 	batchSize                   int
 	drainerConfigured           bool
 	buildLogRetentionCalculator BuildLogRetentionCalculator
+	buildEventStore             db.BuildEventStore
 }
 
 func NewBuildLogCollector(
@@ -19,12 +20,14 @@ func NewBuildLogCollector(
 	batchSize int,
 	buildLogRetentionCalculator BuildLogRetentionCalculator,
 	drainerConfigured bool,
+	buildEventStore db.BuildEventStore,
 ) Collector {
 	return &buildLogCollector{
 		pipelineFactory:             pipelineFactory,
 		batchSize:                   batchSize,
 		drainerConfigured:           drainerConfigured,
 		buildLogRetentionCalculator: buildLogRetentionCalculator,
+		buildEventStore:             buildEventStore,
 	}
 }
 
@@ -130,12 +133,30 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 				continue
 			}
 
+			// delete the archived events before the rows indexing them, so that
+			// they are retried rather than leaked if deleting them fails
+			if br.buildEventStore != nil {
+				for _, buildID := range buildIDsToDelete {
+					err = br.buildEventStore.Delete(buildID)
+					if err != nil {
+						logger.Error("failed-to-delete-archived-build-events", err)
+						return err
+					}
+				}
+			}
+
 			err = pipeline.DeleteBuildEventsByBuildIDs(buildIDsToDelete)
 			if err != nil {
 				logger.Error("failed-to-delete-build-events", err)
 				return err
 			}
 
+			err = pipeline.DeleteBuildArtifactsByBuildIDs(buildIDsToDelete)
+			if err != nil {
+				logger.Error("failed-to-delete-build-artifacts", err)
+				return err
+			}
+
 			err = job.UpdateFirstLoggedBuildID(buildIDsToDelete[len(buildIDsToDelete)-1] + 1)
 			if err != nil {
 				logger.Error("failed-to-update-first-logged-build-id", err)
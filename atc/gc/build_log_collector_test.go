@@ -20,12 +20,14 @@ var _ = Describe("BuildLogCollector", func() {
 		fakePipelineFactory *dbfakes.FakePipelineFactory
 		batchSize           int
 		buildLogRetainCalc  BuildLogRetentionCalculator
+		buildEventStore     db.BuildEventStore
 	)
 
 	BeforeEach(func() {
 		fakePipelineFactory = new(dbfakes.FakePipelineFactory)
 		batchSize = 5
 		buildLogRetainCalc = NewBuildLogRetentionCalculator(0, 0)
+		buildEventStore = nil
 	})
 
 	JustBeforeEach(func() {
@@ -34,6 +36,7 @@ var _ = Describe("BuildLogCollector", func() {
 			batchSize,
 			buildLogRetainCalc,
 			false,
+			buildEventStore,
 		)
 	})
 
@@ -82,6 +85,7 @@ var _ = Describe("BuildLogCollector", func() {
 						batchSize,
 						buildLogRetainCalc,
 						true,
+						nil,
 					)
 				})
 				BeforeEach(func() {
@@ -178,6 +182,71 @@ var _ = Describe("BuildLogCollector", func() {
 						actualNewFirstLoggedBuildID := fakeJob.UpdateFirstLoggedBuildIDArgsForCall(0)
 						Expect(actualNewFirstLoggedBuildID).To(Equal(11))
 					})
+
+					It("deletes the reaped builds' artifacts", func() {
+						err := buildLogCollector.Run(context.TODO())
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakePipeline.DeleteBuildArtifactsByBuildIDsCallCount()).To(Equal(1))
+						actualBuildIDs := fakePipeline.DeleteBuildArtifactsByBuildIDsArgsForCall(0)
+						Expect(actualBuildIDs).To(ConsistOf(6, 7, 8, 9, 10))
+					})
+
+					Context("when deleting the builds' artifacts fails", func() {
+						var disaster error
+
+						BeforeEach(func() {
+							disaster = errors.New("artifacts stuck")
+							fakePipeline.DeleteBuildArtifactsByBuildIDsReturns(disaster)
+						})
+
+						It("returns the error without updating the first logged build id", func() {
+							err := buildLogCollector.Run(context.TODO())
+							Expect(err).To(Equal(disaster))
+
+							Expect(fakeJob.UpdateFirstLoggedBuildIDCallCount()).To(BeZero())
+						})
+					})
+
+					Context("when a build event store is configured", func() {
+						var fakeBuildEventStore *dbfakes.FakeBuildEventStore
+
+						BeforeEach(func() {
+							fakeBuildEventStore = new(dbfakes.FakeBuildEventStore)
+							buildEventStore = fakeBuildEventStore
+						})
+
+						It("deletes the reaped builds' events from the store", func() {
+							err := buildLogCollector.Run(context.TODO())
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(fakeBuildEventStore.DeleteCallCount()).To(Equal(5))
+
+							deletedBuildIDs := []int{}
+							for i := 0; i < fakeBuildEventStore.DeleteCallCount(); i++ {
+								deletedBuildIDs = append(deletedBuildIDs, fakeBuildEventStore.DeleteArgsForCall(i))
+							}
+
+							Expect(deletedBuildIDs).To(ConsistOf(6, 7, 8, 9, 10))
+						})
+
+						Context("when deleting from the store fails", func() {
+							var disaster error
+
+							BeforeEach(func() {
+								disaster = errors.New("bucket kicked")
+								fakeBuildEventStore.DeleteReturns(disaster)
+							})
+
+							It("returns the error without deleting the build events", func() {
+								err := buildLogCollector.Run(context.TODO())
+								Expect(err).To(Equal(disaster))
+
+								Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(BeZero())
+								Expect(fakeJob.UpdateFirstLoggedBuildIDCallCount()).To(BeZero())
+							})
+						})
+					})
 				})
 
 				Context("when deleting build events fails", func() {
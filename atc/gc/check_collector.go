@@ -0,0 +1,48 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type checkCollector struct {
+	checkFactory db.CheckFactory
+	staleTimeout time.Duration
+	retention    time.Duration
+}
+
+// NewCheckCollector returns a Collector which errors checks which have been
+// started for longer than the stale timeout, and removes finished checks and
+// the history of resource checks once they are older than the retention
+// period.
+func NewCheckCollector(checkFactory db.CheckFactory, staleTimeout time.Duration, retention time.Duration) Collector {
+	return &checkCollector{
+		checkFactory: checkFactory,
+		staleTimeout: staleTimeout,
+		retention:    retention,
+	}
+}
+
+func (cc *checkCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("check-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	err := cc.checkFactory.ErrorStaleChecks(cc.staleTimeout)
+	if err != nil {
+		logger.Error("failed-to-error-stale-checks", err)
+		return err
+	}
+
+	err = cc.checkFactory.CleanupChecks(cc.retention)
+	if err != nil {
+		logger.Error("failed-to-clean-up-checks", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckCollector", func() {
+	var (
+		collector        gc.Collector
+		fakeCheckFactory *dbfakes.FakeCheckFactory
+
+		err error
+	)
+
+	BeforeEach(func() {
+		fakeCheckFactory = new(dbfakes.FakeCheckFactory)
+		collector = gc.NewCheckCollector(fakeCheckFactory, 2*time.Hour, 24*time.Hour)
+	})
+
+	JustBeforeEach(func() {
+		err = collector.Run(context.TODO())
+	})
+
+	It("errors checks which have been started for longer than the stale timeout", func() {
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeCheckFactory.ErrorStaleChecksCallCount()).To(Equal(1))
+		Expect(fakeCheckFactory.ErrorStaleChecksArgsForCall(0)).To(Equal(2 * time.Hour))
+	})
+
+	Context("when erroring stale checks fails", func() {
+		disaster := errors.New("disaster")
+
+		BeforeEach(func() {
+			fakeCheckFactory.ErrorStaleChecksReturns(disaster)
+		})
+
+		It("returns the error without cleaning up", func() {
+			Expect(err).To(Equal(disaster))
+			Expect(fakeCheckFactory.CleanupChecksCallCount()).To(BeZero())
+		})
+	})
+
+	It("cleans up checks older than the retention period", func() {
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeCheckFactory.CleanupChecksCallCount()).To(Equal(1))
+		Expect(fakeCheckFactory.CleanupChecksArgsForCall(0)).To(Equal(24 * time.Hour))
+	})
+
+	Context("when cleaning up fails", func() {
+		disaster := errors.New("disaster")
+
+		BeforeEach(func() {
+			fakeCheckFactory.CleanupChecksReturns(disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(Equal(disaster))
+		})
+	})
+})
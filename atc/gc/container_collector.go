@@ -73,9 +73,31 @@ func (c *containerCollector) Run(ctx context.Context) error {
 		logger.Error("failed-to-clean-up-missing-containers", err)
 	}
 
+	err = c.emitTeamContainers(logger.Session("team-containers"))
+	if err != nil {
+		errs = multierror.Append(errs, err)
+		logger.Error("failed-to-count-team-containers", err)
+	}
+
 	return errs
 }
 
+func (c *containerCollector) emitTeamContainers(logger lager.Logger) error {
+	counts, err := c.containerRepository.CountContainersByTeam()
+	if err != nil {
+		return err
+	}
+
+	for teamName, containers := range counts {
+		metric.TeamContainers{
+			TeamName:   teamName,
+			Containers: containers,
+		}.Emit(logger)
+	}
+
+	return nil
+}
+
 func (c *containerCollector) cleanupFailedContainers(logger lager.Logger) error {
 	failedContainersLen, err := c.containerRepository.DestroyFailedContainers()
 	if err != nil {
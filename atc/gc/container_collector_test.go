@@ -90,6 +90,20 @@ var _ = Describe("ContainerCollector", func() {
 			Expect(fakeContainerRepository.RemoveMissingContainersArgsForCall(0)).To(Equal(missingContainerGracePeriod))
 		})
 
+		It("counts the containers owned by each team", func() {
+			Expect(fakeContainerRepository.CountContainersByTeamCallCount()).To(Equal(1))
+		})
+
+		Context("when counting the containers owned by each team fails", func() {
+			BeforeEach(func() {
+				fakeContainerRepository.CountContainersByTeamReturns(nil, errors.New("disaster"))
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
 		Describe("Failed Containers", func() {
 			Context("when there are failed containers", func() {
 				It("tries to delete them from the database", func() {
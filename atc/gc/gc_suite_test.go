@@ -69,8 +69,8 @@ var _ = BeforeEach(func() {
 
 	lockFactory = lock.NewLockFactory(postgresRunner.OpenSingleton(), fakeLogFunc, fakeLogFunc)
 
-	teamFactory = db.NewTeamFactory(dbConn, lockFactory)
-	buildFactory = db.NewBuildFactory(dbConn, lockFactory, 0)
+	teamFactory = db.NewTeamFactory(dbConn, lockFactory, db.BuildStores{})
+	buildFactory = db.NewBuildFactory(dbConn, lockFactory, db.BuildStores{}, 0)
 
 	defaultTeam, err = teamFactory.CreateTeam(atc.Team{Name: "default-team"})
 	Expect(err).NotTo(HaveOccurred())
@@ -103,7 +103,7 @@ var _ = BeforeEach(func() {
 		},
 	}
 
-	defaultPipeline, _, err = defaultTeam.SavePipeline("default-pipeline", atcConfig, db.ConfigVersion(0), db.PipelineUnpaused)
+	defaultPipeline, _, err = defaultTeam.SavePipeline("default-pipeline", atcConfig, db.ConfigVersion(0), db.PipelineUnpaused, 0)
 	Expect(err).NotTo(HaveOccurred())
 
 	var found bool
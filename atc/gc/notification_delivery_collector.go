@@ -0,0 +1,39 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type notificationDeliveryCollector struct {
+	deliveryFactory db.NotificationDeliveryFactory
+	retention       time.Duration
+}
+
+// NewNotificationDeliveryCollector returns a Collector which removes
+// delivered and failed notification deliveries once they are older than the
+// retention period.
+func NewNotificationDeliveryCollector(deliveryFactory db.NotificationDeliveryFactory, retention time.Duration) Collector {
+	return &notificationDeliveryCollector{
+		deliveryFactory: deliveryFactory,
+		retention:       retention,
+	}
+}
+
+func (nc *notificationDeliveryCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("notification-delivery-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	err := nc.deliveryFactory.CleanupDeliveries(nc.retention)
+	if err != nil {
+		logger.Error("failed-to-clean-up-notification-deliveries", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NotificationDeliveryCollector", func() {
+	var (
+		collector           gc.Collector
+		fakeDeliveryFactory *dbfakes.FakeNotificationDeliveryFactory
+
+		err error
+	)
+
+	BeforeEach(func() {
+		fakeDeliveryFactory = new(dbfakes.FakeNotificationDeliveryFactory)
+		collector = gc.NewNotificationDeliveryCollector(fakeDeliveryFactory, 168*time.Hour)
+	})
+
+	JustBeforeEach(func() {
+		err = collector.Run(context.TODO())
+	})
+
+	It("cleans up deliveries older than the retention period", func() {
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeDeliveryFactory.CleanupDeliveriesCallCount()).To(Equal(1))
+		Expect(fakeDeliveryFactory.CleanupDeliveriesArgsForCall(0)).To(Equal(168 * time.Hour))
+	})
+
+	Context("when cleaning up fails", func() {
+		disaster := errors.New("disaster")
+
+		BeforeEach(func() {
+			fakeDeliveryFactory.CleanupDeliveriesReturns(disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(Equal(disaster))
+		})
+	})
+})
@@ -52,9 +52,31 @@ func (vc *volumeCollector) Run(ctx context.Context) error {
 		logger.Error("failed-to-clean-up-missing-volumes", err)
 	}
 
+	err = vc.emitTeamVolumes(logger.Session("team-volumes"))
+	if err != nil {
+		errs = multierror.Append(errs, err)
+		logger.Error("failed-to-count-team-volumes", err)
+	}
+
 	return errs
 }
 
+func (vc *volumeCollector) emitTeamVolumes(logger lager.Logger) error {
+	counts, err := vc.volumeRepository.CountVolumesByTeam()
+	if err != nil {
+		return err
+	}
+
+	for teamName, volumes := range counts {
+		metric.TeamVolumes{
+			TeamName: teamName,
+			Volumes:  volumes,
+		}.Emit(logger)
+	}
+
+	return nil
+}
+
 func (vc *volumeCollector) cleanupFailedVolumes(logger lager.Logger) error {
 	failedVolumesLen, err := vc.volumeRepository.DestroyFailedVolumes()
 	if err != nil {
@@ -83,6 +83,10 @@ var _ = Describe("VolumeCollector", func() {
 				Expect(fakeVolumeRepository.RemoveMissingVolumesCallCount()).To(Equal(1))
 				Expect(fakeVolumeRepository.RemoveMissingVolumesArgsForCall(0)).To(Equal(missingVolumeGracePeriod))
 			})
+
+			It("counts the volumes owned by each team", func() {
+				Expect(fakeVolumeRepository.CountVolumesByTeamCallCount()).To(Equal(1))
+			})
 		})
 
 		Context("when there are failed volumes", func() {
@@ -12,6 +12,7 @@ type Job struct {
 	NextBuild            *Build `json:"next_build"`
 	FinishedBuild        *Build `json:"finished_build"`
 	TransitionBuild      *Build `json:"transition_build,omitempty"`
+	MaxInFlightReached   bool   `json:"max_in_flight_reached,omitempty"`
 
 	Inputs  []JobInput  `json:"inputs"`
 	Outputs []JobOutput `json:"outputs"`
@@ -20,13 +21,17 @@ type Job struct {
 }
 
 type JobInput struct {
-	Name     string         `json:"name"`
-	Resource string         `json:"resource"`
-	Passed   []string       `json:"passed,omitempty"`
-	Trigger  bool           `json:"trigger"`
-	Version  *VersionConfig `json:"version,omitempty"`
-	Params   Params         `json:"params,omitempty"`
-	Tags     Tags           `json:"tags,omitempty"`
+	Name          string               `json:"name"`
+	Resource      string               `json:"resource"`
+	Passed        []string             `json:"passed,omitempty"`
+	Trigger       bool                 `json:"trigger"`
+	Version       *VersionConfig       `json:"version,omitempty"`
+	VersionFilter *VersionFilterConfig `json:"version_filter,omitempty"`
+	Params        Params               `json:"params,omitempty"`
+	Tags          Tags                 `json:"tags,omitempty"`
+
+	// why the input could not be resolved to a version, if it couldn't
+	ResolveError string `json:"resolve_error,omitempty"`
 }
 
 type JobOutput struct {
@@ -42,4 +47,13 @@ type BuildInput struct {
 	Params   Params   `json:"params,omitempty"`
 	Version  Version  `json:"version"`
 	Tags     []string `json:"tags,omitempty"`
+
+	ExcludedVersions []ExcludedVersion `json:"excluded_versions,omitempty"`
+}
+
+// An ExcludedVersion is a version of an input's resource that was newer than
+// the one chosen but was rejected by the input's version filter.
+type ExcludedVersion struct {
+	Version Version `json:"version"`
+	Reason  string  `json:"reason"`
 }
@@ -10,6 +10,7 @@ type JobConfig struct {
 	SerialGroups         []string `yaml:"serial_groups,omitempty" json:"serial_groups,omitempty" mapstructure:"serial_groups"`
 	RawMaxInFlight       int      `yaml:"max_in_flight,omitempty" json:"max_in_flight,omitempty" mapstructure:"max_in_flight"`
 	BuildLogsToRetain    int      `yaml:"build_logs_to_retain,omitempty" json:"build_logs_to_retain,omitempty" mapstructure:"build_logs_to_retain"`
+	Priority             int      `yaml:"priority,omitempty" json:"priority,omitempty" mapstructure:"priority"`
 
 	Plan PlanSequence `yaml:"plan,omitempty" json:"plan,omitempty" mapstructure:"plan"`
 
@@ -134,13 +135,14 @@ func (config JobConfig) Inputs() []JobInput {
 			}
 
 			inputs = append(inputs, JobInput{
-				Name:     get,
-				Resource: resource,
-				Passed:   plan.Passed,
-				Version:  plan.Version,
-				Trigger:  plan.Trigger,
-				Params:   plan.Params,
-				Tags:     plan.Tags,
+				Name:          get,
+				Resource:      resource,
+				Passed:        plan.Passed,
+				Version:       plan.Version,
+				VersionFilter: plan.VersionFilter,
+				Trigger:       plan.Trigger,
+				Params:        plan.Params,
+				Tags:          plan.Tags,
 			})
 		}
 	}
@@ -17,6 +17,7 @@ import (
 
 type PrometheusEmitter struct {
 	buildDurationsVec *prometheus.HistogramVec
+	buildQueueVec     *prometheus.HistogramVec
 	buildsAborted     prometheus.Counter
 	buildsErrored     prometheus.Counter
 	buildsFailed      prometheus.Counter
@@ -38,9 +39,19 @@ type PrometheusEmitter struct {
 
 	resourceChecksVec *prometheus.CounterVec
 
+	checkQueueDepth   prometheus.Gauge
+	checkQueueLatency prometheus.Histogram
+	checkDuration     prometheus.Histogram
+
+	stepsWaiting     prometheus.Gauge
+	stepDurationsVec *prometheus.HistogramVec
+
 	schedulingFullDuration    *prometheus.CounterVec
 	schedulingLoadingDuration *prometheus.CounterVec
 
+	teamContainers *prometheus.GaugeVec
+	teamVolumes    *prometheus.GaugeVec
+
 	workerContainers *prometheus.GaugeVec
 	workerInfo       *prometheus.GaugeVec
 	workerVolumes    *prometheus.GaugeVec
@@ -155,10 +166,45 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 			Help:      "Build time in seconds",
 			Buckets:   []float64{1, 60, 180, 300, 600, 900, 1200, 1800, 2700, 3600, 7200, 18000, 36000},
 		},
-		[]string{"team", "pipeline"},
+		[]string{"team", "pipeline", "job"},
 	)
 	prometheus.MustRegister(buildDurationsVec)
 
+	buildQueueVec := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "builds",
+			Name:      "queue_duration_seconds",
+			Help:      "Time in seconds builds spent pending before they were started",
+			Buckets:   []float64{1, 5, 10, 30, 60, 120, 300, 600, 1800, 3600},
+		},
+		[]string{"team", "pipeline", "job"},
+	)
+	prometheus.MustRegister(buildQueueVec)
+
+	// team metrics
+	teamContainers := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "teams",
+			Name:      "containers",
+			Help:      "Number of containers per team",
+		},
+		[]string{"team"},
+	)
+	prometheus.MustRegister(teamContainers)
+
+	teamVolumes := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "teams",
+			Name:      "volumes",
+			Help:      "Number of volumes per team",
+		},
+		[]string{"team"},
+	)
+	prometheus.MustRegister(teamVolumes)
+
 	// worker metrics
 	workerContainers := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -269,6 +315,52 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(resourceChecksVec)
 
+	checkQueueDepth := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "concourse",
+		Subsystem: "checks",
+		Name:      "queue_depth",
+		Help:      "Number of checks waiting in the check queue",
+	})
+	prometheus.MustRegister(checkQueueDepth)
+
+	checkQueueLatency := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "concourse",
+		Subsystem: "checks",
+		Name:      "queue_latency_seconds",
+		Help:      "Time checks spent waiting in the check queue",
+		Buckets:   []float64{0.1, 0.5, 1, 5, 10, 30, 60, 300, 600},
+	})
+	prometheus.MustRegister(checkQueueLatency)
+
+	checkDuration := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "concourse",
+		Subsystem: "checks",
+		Name:      "duration_seconds",
+		Help:      "Time taken to run checks",
+		Buckets:   []float64{1, 5, 10, 30, 60, 120, 300, 600, 1800},
+	})
+	prometheus.MustRegister(checkDuration)
+
+	stepsWaiting := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "concourse",
+		Subsystem: "steps",
+		Name:      "waiting",
+		Help:      "Number of steps waiting for a worker satisfying their tags, team and platform",
+	})
+	prometheus.MustRegister(stepsWaiting)
+
+	stepDurationsVec := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "duration_seconds",
+			Help:      "Step time in seconds",
+			Buckets:   []float64{1, 5, 10, 30, 60, 180, 300, 600, 1200, 1800, 3600, 7200},
+		},
+		[]string{"team", "pipeline", "job", "step", "type"},
+	)
+	prometheus.MustRegister(stepDurationsVec)
+
 	listener, err := net.Listen("tcp", config.bind())
 	if err != nil {
 		return nil, err
@@ -278,6 +370,7 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 
 	emitter := &PrometheusEmitter{
 		buildDurationsVec: buildDurationsVec,
+		buildQueueVec:     buildQueueVec,
 		buildsAborted:     buildsAborted,
 		buildsErrored:     buildsErrored,
 		buildsFailed:      buildsFailed,
@@ -299,9 +392,19 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 
 		resourceChecksVec: resourceChecksVec,
 
+		checkQueueDepth:   checkQueueDepth,
+		checkQueueLatency: checkQueueLatency,
+		checkDuration:     checkDuration,
+
+		stepsWaiting:     stepsWaiting,
+		stepDurationsVec: stepDurationsVec,
+
 		schedulingFullDuration:    schedulingFullDuration,
 		schedulingLoadingDuration: schedulingLoadingDuration,
 
+		teamContainers: teamContainers,
+		teamVolumes:    teamVolumes,
+
 		workerContainers: workerContainers,
 		workerInfo:       workerInfo,
 		workerLastSeen:   map[string]time.Time{},
@@ -330,6 +433,14 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.buildsStarted.Inc()
 	case "build finished":
 		emitter.buildFinishedMetrics(logger, event)
+	case "build queue duration":
+		emitter.buildQueueMetric(logger, event)
+	case "step finished":
+		emitter.stepFinishedMetric(logger, event)
+	case "team containers":
+		emitter.teamContainersMetric(logger, event)
+	case "team volumes":
+		emitter.teamVolumesMetric(logger, event)
 	case "worker containers":
 		emitter.workerContainersMetric(logger, event)
 	case "worker volumes":
@@ -350,6 +461,10 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.databaseMetrics(logger, event)
 	case "resource checked":
 		emitter.resourceMetric(logger, event)
+	case "check queue depth", "check queue latency", "check duration":
+		emitter.checkMetrics(logger, event)
+	case "steps waiting":
+		emitter.stepsWaitingMetric(logger, event)
 	default:
 		// unless we have a specific metric, we do nothing
 	}
@@ -434,7 +549,107 @@ func (emitter *PrometheusEmitter) buildFinishedMetrics(logger lager.Logger, even
 	}
 	// seconds are the standard prometheus base unit for time
 	duration = duration / 1000
-	emitter.buildDurationsVec.WithLabelValues(team, pipeline).Observe(duration)
+	emitter.buildDurationsVec.WithLabelValues(team, pipeline, job).Observe(duration)
+}
+
+func (emitter *PrometheusEmitter) buildQueueMetric(logger lager.Logger, event metric.Event) {
+	team, exists := event.Attributes["team_name"]
+	if !exists {
+		logger.Error("failed-to-find-team-name-in-event", fmt.Errorf("expected team_name to exist in event.Attributes"))
+		return
+	}
+
+	pipeline, exists := event.Attributes["pipeline"]
+	if !exists {
+		logger.Error("failed-to-find-pipeline-in-event", fmt.Errorf("expected pipeline to exist in event.Attributes"))
+		return
+	}
+
+	job, exists := event.Attributes["job"]
+	if !exists {
+		logger.Error("failed-to-find-job-in-event", fmt.Errorf("expected job to exist in event.Attributes"))
+		return
+	}
+
+	duration, ok := event.Value.(float64)
+	if !ok {
+		logger.Error("build-queue-duration-event-value-type-mismatch", fmt.Errorf("expected event.Value to be a float64"))
+		return
+	}
+
+	emitter.buildQueueVec.WithLabelValues(team, pipeline, job).Observe(duration / 1000)
+}
+
+func (emitter *PrometheusEmitter) stepFinishedMetric(logger lager.Logger, event metric.Event) {
+	team, exists := event.Attributes["team_name"]
+	if !exists {
+		logger.Error("failed-to-find-team-name-in-event", fmt.Errorf("expected team_name to exist in event.Attributes"))
+		return
+	}
+
+	pipeline, exists := event.Attributes["pipeline"]
+	if !exists {
+		logger.Error("failed-to-find-pipeline-in-event", fmt.Errorf("expected pipeline to exist in event.Attributes"))
+		return
+	}
+
+	job, exists := event.Attributes["job"]
+	if !exists {
+		logger.Error("failed-to-find-job-in-event", fmt.Errorf("expected job to exist in event.Attributes"))
+		return
+	}
+
+	step, exists := event.Attributes["step_name"]
+	if !exists {
+		logger.Error("failed-to-find-step-name-in-event", fmt.Errorf("expected step_name to exist in event.Attributes"))
+		return
+	}
+
+	stepType, exists := event.Attributes["step_type"]
+	if !exists {
+		logger.Error("failed-to-find-step-type-in-event", fmt.Errorf("expected step_type to exist in event.Attributes"))
+		return
+	}
+
+	duration, ok := event.Value.(float64)
+	if !ok {
+		logger.Error("step-finished-event-value-type-mismatch", fmt.Errorf("expected event.Value to be a float64"))
+		return
+	}
+
+	emitter.stepDurationsVec.WithLabelValues(team, pipeline, job, step, stepType).Observe(duration / 1000)
+}
+
+func (emitter *PrometheusEmitter) teamContainersMetric(logger lager.Logger, event metric.Event) {
+	team, exists := event.Attributes["team_name"]
+	if !exists {
+		logger.Error("failed-to-find-team-name-in-event", fmt.Errorf("expected team_name to exist in event.Attributes"))
+		return
+	}
+
+	containers, ok := event.Value.(int)
+	if !ok {
+		logger.Error("team-containers-event-value-type-mismatch", fmt.Errorf("expected event.Value to be an int"))
+		return
+	}
+
+	emitter.teamContainers.WithLabelValues(team).Set(float64(containers))
+}
+
+func (emitter *PrometheusEmitter) teamVolumesMetric(logger lager.Logger, event metric.Event) {
+	team, exists := event.Attributes["team_name"]
+	if !exists {
+		logger.Error("failed-to-find-team-name-in-event", fmt.Errorf("expected team_name to exist in event.Attributes"))
+		return
+	}
+
+	volumes, ok := event.Value.(int)
+	if !ok {
+		logger.Error("team-volumes-event-value-type-mismatch", fmt.Errorf("expected event.Value to be an int"))
+		return
+	}
+
+	emitter.teamVolumes.WithLabelValues(team).Set(float64(volumes))
 }
 
 func (emitter *PrometheusEmitter) workerContainersMetric(logger lager.Logger, event metric.Event) {
@@ -585,6 +800,41 @@ func (emitter *PrometheusEmitter) resourceMetric(logger lager.Logger, event metr
 	emitter.resourceChecksVec.WithLabelValues(team, pipeline).Inc()
 }
 
+func (emitter *PrometheusEmitter) checkMetrics(logger lager.Logger, event metric.Event) {
+	switch event.Name {
+	case "check queue depth":
+		depth, ok := event.Value.(int)
+		if !ok {
+			logger.Error("check-queue-depth-event-value-type-mismatch", fmt.Errorf("expected event.Value to be an int"))
+			return
+		}
+
+		emitter.checkQueueDepth.Set(float64(depth))
+	case "check queue latency", "check duration":
+		duration, ok := event.Value.(float64)
+		if !ok {
+			logger.Error("check-event-value-type-mismatch", fmt.Errorf("expected event.Value to be a float64"))
+			return
+		}
+
+		if event.Name == "check queue latency" {
+			emitter.checkQueueLatency.Observe(duration / 1000)
+		} else {
+			emitter.checkDuration.Observe(duration / 1000)
+		}
+	}
+}
+
+func (emitter *PrometheusEmitter) stepsWaitingMetric(logger lager.Logger, event metric.Event) {
+	waiting, ok := event.Value.(int)
+	if !ok {
+		logger.Error("steps-waiting-event-value-type-mismatch", fmt.Errorf("expected event.Value to be an int"))
+		return
+	}
+
+	emitter.stepsWaiting.Set(float64(waiting))
+}
+
 // updateLastSeen tracks for each worker when it last received a metric event.
 func (emitter *PrometheusEmitter) updateLastSeen(event metric.Event) {
 	emitter.mu.Lock()
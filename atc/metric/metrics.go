@@ -21,6 +21,11 @@ var FailedVolumes = Meter(0)
 var ContainersDeleted = Meter(0)
 var VolumesDeleted = Meter(0)
 
+var SecretCacheHits = Meter(0)
+var SecretCacheMisses = Meter(0)
+
+var StepsWaiting = &Gauge{}
+
 type SchedulingFullDuration struct {
 	PipelineName string
 	Duration     time.Duration
@@ -152,6 +157,44 @@ func (event WorkerVolumes) Emit(logger lager.Logger) {
 	)
 }
 
+type TeamContainers struct {
+	TeamName   string
+	Containers int
+}
+
+func (event TeamContainers) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("team-containers"),
+		Event{
+			Name:  "team containers",
+			Value: event.Containers,
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"team_name": event.TeamName,
+			},
+		},
+	)
+}
+
+type TeamVolumes struct {
+	TeamName string
+	Volumes  int
+}
+
+func (event TeamVolumes) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("team-volumes"),
+		Event{
+			Name:  "team volumes",
+			Value: event.Volumes,
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"team_name": event.TeamName,
+			},
+		},
+	)
+}
+
 type VolumesToBeGarbageCollected struct {
 	Volumes int
 }
@@ -353,6 +396,82 @@ func (event BuildFinished) Emit(logger lager.Logger) {
 	)
 }
 
+// BuildQueueDuration is the time a build spent pending before it was
+// started.
+type BuildQueueDuration struct {
+	PipelineName string
+	JobName      string
+	BuildName    string
+	BuildID      int
+	TeamName     string
+	Duration     time.Duration
+}
+
+func (event BuildQueueDuration) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("build-queue-duration"),
+		Event{
+			Name:  "build queue duration",
+			Value: ms(event.Duration),
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"pipeline":   event.PipelineName,
+				"job":        event.JobName,
+				"build_name": event.BuildName,
+				"build_id":   strconv.Itoa(event.BuildID),
+				"team_name":  event.TeamName,
+			},
+		},
+	)
+}
+
+// StepFinished is emitted when a get, put, task, set_pipeline, load_var or
+// approval step of a build finishes running.
+type StepFinished struct {
+	PipelineName string
+	JobName      string
+	BuildName    string
+	BuildID      int
+	TeamName     string
+	StepName     string
+	StepType     string
+	Succeeded    bool
+	Errored      bool
+	Duration     time.Duration
+}
+
+func (event StepFinished) Emit(logger lager.Logger) {
+	state := EventStateOK
+	status := "succeeded"
+
+	switch {
+	case event.Errored:
+		state = EventStateWarning
+		status = "errored"
+	case !event.Succeeded:
+		status = "failed"
+	}
+
+	emit(
+		logger.Session("step-finished"),
+		Event{
+			Name:  "step finished",
+			Value: ms(event.Duration),
+			State: state,
+			Attributes: map[string]string{
+				"pipeline":    event.PipelineName,
+				"job":         event.JobName,
+				"build_name":  event.BuildName,
+				"build_id":    strconv.Itoa(event.BuildID),
+				"team_name":   event.TeamName,
+				"step_name":   event.StepName,
+				"step_type":   event.StepType,
+				"step_status": status,
+			},
+		},
+	)
+}
+
 func ms(duration time.Duration) float64 {
 	return float64(duration) / 1000000
 }
@@ -438,6 +557,73 @@ func (event ResourceCheck) Emit(logger lager.Logger) {
 	)
 }
 
+type CheckQueueDepth struct {
+	Depth int
+}
+
+func (event CheckQueueDepth) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("check-queue-depth"),
+		Event{
+			Name:  "check queue depth",
+			Value: event.Depth,
+			State: EventStateOK,
+		},
+	)
+}
+
+type CheckStarted struct {
+	PipelineName string
+	Name         string
+	TeamName     string
+	QueueTime    time.Duration
+}
+
+func (event CheckStarted) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("check-started"),
+		Event{
+			Name:  "check queue latency",
+			Value: ms(event.QueueTime),
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"pipeline": event.PipelineName,
+				"resource": event.Name,
+				"team":     event.TeamName,
+			},
+		},
+	)
+}
+
+type CheckFinished struct {
+	PipelineName string
+	Name         string
+	TeamName     string
+	Duration     time.Duration
+	Success      bool
+}
+
+func (event CheckFinished) Emit(logger lager.Logger) {
+	state := EventStateOK
+	if !event.Success {
+		state = EventStateWarning
+	}
+
+	emit(
+		logger.Session("check-finished"),
+		Event{
+			Name:  "check duration",
+			Value: ms(event.Duration),
+			State: state,
+			Attributes: map[string]string{
+				"pipeline": event.PipelineName,
+				"resource": event.Name,
+				"team":     event.TeamName,
+			},
+		},
+	)
+}
+
 var lockTypeNames = map[int]string{
 	lock.LockTypeResourceConfigChecking: "ResourceConfigChecking",
 	lock.LockTypeBuildTracking:          "BuildTracking",
@@ -446,6 +632,7 @@ var lockTypeNames = map[int]string{
 	lock.LockTypeVolumeCreating:         "VolumeCreating",
 	lock.LockTypeContainerCreating:      "ContainerCreating",
 	lock.LockTypeDatabaseMigration:      "DatabaseMigration",
+	lock.LockTypeCheckQueue:             "CheckQueue",
 }
 
 type LockAcquired struct {
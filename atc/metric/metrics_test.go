@@ -0,0 +1,150 @@
+package metric_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/metric/metricfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Metrics", func() {
+	var (
+		emitter *metricfakes.FakeEmitter
+		logger  *lagertest.TestLogger
+	)
+
+	BeforeEach(func() {
+		emitterFactory := &metricfakes.FakeEmitterFactory{}
+		emitter = &metricfakes.FakeEmitter{}
+
+		metric.RegisterEmitter(emitterFactory)
+		emitterFactory.IsConfiguredReturns(true)
+		emitterFactory.NewEmitterReturns(emitter, nil)
+		metric.Initialize(nil, "test", map[string]string{})
+
+		logger = lagertest.NewTestLogger("test")
+	})
+
+	AfterEach(func() {
+		metric.Deinitialize(nil)
+	})
+
+	emitted := func() metric.Event {
+		Eventually(emitter.EmitCallCount).Should(Equal(1))
+		_, event := emitter.EmitArgsForCall(0)
+		return event
+	}
+
+	Describe("BuildQueueDuration", func() {
+		It("emits the time the build spent pending in milliseconds", func() {
+			metric.BuildQueueDuration{
+				PipelineName: "some-pipeline",
+				JobName:      "some-job",
+				BuildName:    "42",
+				BuildID:      7,
+				TeamName:     "some-team",
+				Duration:     2 * time.Second,
+			}.Emit(logger)
+
+			event := emitted()
+			Expect(event.Name).To(Equal("build queue duration"))
+			Expect(event.Value).To(Equal(2000.0))
+			Expect(event.Attributes).To(Equal(map[string]string{
+				"pipeline":   "some-pipeline",
+				"job":        "some-job",
+				"build_name": "42",
+				"build_id":   "7",
+				"team_name":  "some-team",
+			}))
+		})
+	})
+
+	Describe("StepFinished", func() {
+		var event metric.StepFinished
+
+		BeforeEach(func() {
+			event = metric.StepFinished{
+				PipelineName: "some-pipeline",
+				JobName:      "some-job",
+				BuildName:    "42",
+				BuildID:      7,
+				TeamName:     "some-team",
+				StepName:     "some-task",
+				StepType:     "task",
+				Succeeded:    true,
+				Duration:     time.Minute,
+			}
+		})
+
+		It("emits the step's duration in milliseconds", func() {
+			event.Emit(logger)
+
+			emitted := emitted()
+			Expect(emitted.Name).To(Equal("step finished"))
+			Expect(emitted.Value).To(Equal(60000.0))
+			Expect(emitted.State).To(Equal(metric.EventStateOK))
+			Expect(emitted.Attributes).To(Equal(map[string]string{
+				"pipeline":    "some-pipeline",
+				"job":         "some-job",
+				"build_name":  "42",
+				"build_id":    "7",
+				"team_name":   "some-team",
+				"step_name":   "some-task",
+				"step_type":   "task",
+				"step_status": "succeeded",
+			}))
+		})
+
+		Context("when the step failed", func() {
+			BeforeEach(func() {
+				event.Succeeded = false
+			})
+
+			It("emits the failed status", func() {
+				event.Emit(logger)
+				Expect(emitted().Attributes).To(HaveKeyWithValue("step_status", "failed"))
+			})
+		})
+
+		Context("when the step errored", func() {
+			BeforeEach(func() {
+				event.Succeeded = false
+				event.Errored = true
+			})
+
+			It("emits the errored status as a warning", func() {
+				event.Emit(logger)
+
+				emitted := emitted()
+				Expect(emitted.State).To(Equal(metric.EventStateWarning))
+				Expect(emitted.Attributes).To(HaveKeyWithValue("step_status", "errored"))
+			})
+		})
+	})
+
+	Describe("TeamContainers", func() {
+		It("emits the number of containers owned by the team", func() {
+			metric.TeamContainers{TeamName: "some-team", Containers: 3}.Emit(logger)
+
+			event := emitted()
+			Expect(event.Name).To(Equal("team containers"))
+			Expect(event.Value).To(Equal(3))
+			Expect(event.Attributes).To(Equal(map[string]string{"team_name": "some-team"}))
+		})
+	})
+
+	Describe("TeamVolumes", func() {
+		It("emits the number of volumes owned by the team", func() {
+			metric.TeamVolumes{TeamName: "some-team", Volumes: 5}.Emit(logger)
+
+			event := emitted()
+			Expect(event.Name).To(Equal("team volumes"))
+			Expect(event.Value).To(Equal(5))
+			Expect(event.Attributes).To(Equal(map[string]string{"team_name": "some-team"}))
+		})
+	})
+})
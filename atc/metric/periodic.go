@@ -107,6 +107,33 @@ func tick(logger lager.Logger) {
 		},
 	)
 
+	emit(
+		logger.Session("secret-cache-hits"),
+		Event{
+			Name:  "secret cache hits",
+			Value: SecretCacheHits.Delta(),
+			State: EventStateOK,
+		},
+	)
+
+	emit(
+		logger.Session("secret-cache-misses"),
+		Event{
+			Name:  "secret cache misses",
+			Value: SecretCacheMisses.Delta(),
+			State: EventStateOK,
+		},
+	)
+
+	emit(
+		logger.Session("steps-waiting"),
+		Event{
+			Name:  "steps waiting",
+			Value: StepsWaiting.Max(),
+			State: EventStateOK,
+		},
+	)
+
 	var memStats runtime.MemStats
 	runtime.ReadMemStats(&memStats)
 
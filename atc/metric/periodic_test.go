@@ -80,4 +80,16 @@ var _ = Describe("Periodic emission of metrics", func() {
 			),
 		)
 	})
+
+	It("emits the number of steps waiting for a worker", func() {
+		Eventually(func() [][]interface{} { return emitter.Invocations()["Emit"] }).Should(
+			ContainElement(
+				ContainElement(
+					MatchFields(IgnoreExtras, Fields{
+						"Name": Equal("steps waiting"),
+					}),
+				),
+			),
+		)
+	})
 })
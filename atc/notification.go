@@ -0,0 +1,58 @@
+package atc
+
+// A NotificationEvent is a build status transition which is sent to a
+// pipeline's notifications.
+type NotificationEvent string
+
+const (
+	NotificationEventStarted   NotificationEvent = "started"
+	NotificationEventSucceeded NotificationEvent = "succeeded"
+	NotificationEventFailed    NotificationEvent = "failed"
+	NotificationEventErrored   NotificationEvent = "errored"
+	NotificationEventAborted   NotificationEvent = "aborted"
+)
+
+var NotificationEvents = []NotificationEvent{
+	NotificationEventStarted,
+	NotificationEventSucceeded,
+	NotificationEventFailed,
+	NotificationEventErrored,
+	NotificationEventAborted,
+}
+
+// DefaultNotificationAttempts is the number of times a delivery is tried when
+// the notification does not configure it.
+const DefaultNotificationAttempts = 5
+
+type NotificationDeliveryStatus string
+
+const (
+	NotificationDeliveryPending   NotificationDeliveryStatus = "pending"
+	NotificationDeliveryDelivered NotificationDeliveryStatus = "delivered"
+	NotificationDeliveryFailed    NotificationDeliveryStatus = "failed"
+)
+
+type NotificationDelivery struct {
+	ID             int                        `json:"id"`
+	Notification   string                     `json:"notification"`
+	Event          NotificationEvent          `json:"event"`
+	BuildID        int                        `json:"build_id"`
+	BuildName      string                     `json:"build_name"`
+	JobName        string                     `json:"job_name,omitempty"`
+	Status         NotificationDeliveryStatus `json:"status"`
+	Attempts       int                        `json:"attempts"`
+	ResponseStatus int                        `json:"response_status,omitempty"`
+	Error          string                     `json:"error,omitempty"`
+	CreatedAt      int64                      `json:"created_at"`
+	LastAttemptAt  int64                      `json:"last_attempt_at,omitempty"`
+}
+
+func isNotificationEvent(event NotificationEvent) bool {
+	for _, e := range NotificationEvents {
+		if e == event {
+			return true
+		}
+	}
+
+	return false
+}
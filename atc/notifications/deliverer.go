@@ -0,0 +1,335 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"text/template"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+)
+
+const (
+	// EventHeader is the request header carrying the event being delivered.
+	EventHeader = "X-Concourse-Event"
+
+	// DeliveryHeader is the request header carrying the ID of the delivery,
+	// which stays the same when a delivery is retried.
+	DeliveryHeader = "X-Concourse-Delivery"
+
+	// SignatureHeader is the request header carrying the hex-encoded
+	// HMAC-SHA256 of the request body, keyed with the notification's secret
+	// and prefixed with "sha256=".
+	SignatureHeader = "X-Concourse-Signature"
+)
+
+const (
+	initialRetryInterval = 30 * time.Second
+	maxRetryInterval     = time.Hour
+)
+
+//go:generate counterfeiter . Deliverer
+
+type Deliverer interface {
+	Run(context.Context) error
+}
+
+type deliverer struct {
+	deliveryFactory  db.NotificationDeliveryFactory
+	buildFactory     db.BuildFactory
+	variablesFactory creds.VariablesFactory
+	externalURL      string
+	httpClient       *http.Client
+	clock            clock.Clock
+	batchSize        int
+
+	maxInFlightPerEndpoint int
+}
+
+// NewDeliverer returns a Deliverer which sends pending notification
+// deliveries to their webhooks, batchSize at a time. Deliveries to different
+// endpoints are sent concurrently, with at most maxInFlightPerEndpoint
+// requests to each host at once.
+//
+// A failed delivery is retried with an exponential backoff until it has been
+// attempted as many times as its notification allows.
+func NewDeliverer(
+	deliveryFactory db.NotificationDeliveryFactory,
+	buildFactory db.BuildFactory,
+	variablesFactory creds.VariablesFactory,
+	externalURL string,
+	httpClient *http.Client,
+	clock clock.Clock,
+	batchSize int,
+	maxInFlightPerEndpoint int,
+) Deliverer {
+	return &deliverer{
+		deliveryFactory:  deliveryFactory,
+		buildFactory:     buildFactory,
+		variablesFactory: variablesFactory,
+		externalURL:      externalURL,
+		httpClient:       httpClient,
+		clock:            clock,
+		batchSize:        batchSize,
+
+		maxInFlightPerEndpoint: maxInFlightPerEndpoint,
+	}
+}
+
+func (d *deliverer) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("notification-deliverer")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	deliveries, err := d.deliveryFactory.PendingDeliveries(d.batchSize)
+	if err != nil {
+		logger.Error("failed-to-get-pending-deliveries", err)
+		return err
+	}
+
+	endpoints := newEndpoints(d.maxInFlightPerEndpoint)
+	errs := make(chan error, len(deliveries))
+
+	wg := new(sync.WaitGroup)
+	for _, delivery := range deliveries {
+		wg.Add(1)
+
+		go func(delivery db.PendingNotificationDelivery) {
+			defer wg.Done()
+
+			err := d.deliver(ctx, logger.Session("deliver", lager.Data{
+				"delivery":     delivery.ID,
+				"notification": delivery.Notification,
+				"event":        delivery.Event,
+				"build":        delivery.BuildID,
+			}), endpoints, delivery)
+			if err != nil {
+				logger.Error("failed-to-record-delivery", err)
+				errs <- err
+			}
+		}(delivery)
+	}
+
+	wg.Wait()
+	close(errs)
+
+	return <-errs
+}
+
+func (d *deliverer) deliver(ctx context.Context, logger lager.Logger, endpoints *endpoints, delivery db.PendingNotificationDelivery) error {
+	if !delivery.Configured {
+		logger.Info("notification-no-longer-configured")
+		return d.deliveryFactory.MarkFailed(delivery.ID, 0, "notification is no longer configured", time.Time{})
+	}
+
+	build, found, err := d.buildFactory.Build(delivery.BuildID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		logger.Info("build-not-found")
+		return d.deliveryFactory.MarkFailed(delivery.ID, 0, "build not found", time.Time{})
+	}
+
+	request, err := d.request(ctx, delivery, build)
+	if err != nil {
+		return d.failed(logger, delivery, 0, err.Error())
+	}
+
+	statusCode, err := d.send(endpoints, request)
+	if err != nil {
+		return d.failed(logger, delivery, 0, err.Error())
+	}
+
+	// the status text is chosen by the endpoint, so only the code is recorded
+	if statusCode < 200 || statusCode >= 300 {
+		return d.failed(logger, delivery, statusCode, fmt.Sprintf("unexpected response status %d", statusCode))
+	}
+
+	logger.Debug("delivered", lager.Data{"status": statusCode})
+
+	return d.deliveryFactory.MarkDelivered(delivery.ID, statusCode)
+}
+
+func (d *deliverer) send(endpoints *endpoints, request *http.Request) (int, error) {
+	release, err := endpoints.acquire(request.Context(), request.URL.Host)
+	if err != nil {
+		return 0, err
+	}
+
+	defer release()
+
+	response, err := d.httpClient.Do(request)
+	if err != nil {
+		// leave out the url, which may have been interpolated with credentials
+		if urlErr, ok := err.(*url.Error); ok {
+			return 0, urlErr.Err
+		}
+
+		return 0, err
+	}
+
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(response.Body, 4096))
+	_ = response.Body.Close()
+
+	return response.StatusCode, nil
+}
+
+func (d *deliverer) failed(logger lager.Logger, delivery db.PendingNotificationDelivery, responseStatus int, message string) error {
+	maxAttempts := delivery.Config.Attempts
+	if maxAttempts == 0 {
+		maxAttempts = atc.DefaultNotificationAttempts
+	}
+
+	var retryAt time.Time
+	if delivery.Attempts+1 < maxAttempts {
+		retryAt = d.clock.Now().Add(retryInterval(delivery.Attempts))
+	}
+
+	logger.Info("failed", lager.Data{
+		"attempts": delivery.Attempts + 1,
+		"status":   responseStatus,
+		"error":    message,
+		"retry-at": retryAt,
+	})
+
+	return d.deliveryFactory.MarkFailed(delivery.ID, responseStatus, message, retryAt)
+}
+
+// retryInterval doubles the interval between attempts with each failure, up
+// to maxRetryInterval.
+func retryInterval(failedAttempts int) time.Duration {
+	interval := initialRetryInterval
+	for i := 0; i < failedAttempts && interval < maxRetryInterval; i++ {
+		interval *= 2
+	}
+
+	if interval > maxRetryInterval {
+		interval = maxRetryInterval
+	}
+
+	return interval
+}
+
+func (d *deliverer) request(ctx context.Context, delivery db.PendingNotificationDelivery, build db.Build) (*http.Request, error) {
+	config := delivery.Config
+	variables := d.variablesFactory.NewVariables(delivery.TeamName, delivery.PipelineName)
+
+	webhookURL, err := creds.NewString(variables, config.URL).Evaluate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to interpolate url: %s", err)
+	}
+
+	secret, err := creds.NewString(variables, config.Secret).Evaluate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to interpolate secret: %s", err)
+	}
+
+	body, err := d.body(config, d.payload(delivery, build))
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	for name, value := range config.Headers {
+		value, err := creds.NewString(variables, value).Evaluate()
+		if err != nil {
+			return nil, fmt.Errorf("failed to interpolate header '%s': %s", name, err)
+		}
+
+		request.Header.Set(name, value)
+	}
+
+	request.Header.Set(EventHeader, string(delivery.Event))
+	request.Header.Set(DeliveryHeader, strconv.Itoa(delivery.ID))
+
+	if secret != "" {
+		mac := hmac.New(sha256.New, []byte(secret))
+		_, _ = mac.Write(body)
+		request.Header.Set(SignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	return request.WithContext(ctx), nil
+}
+
+func (d *deliverer) body(config atc.NotificationConfig, payload Payload) ([]byte, error) {
+	if config.Payload == "" {
+		return json.Marshal(payload)
+	}
+
+	tmpl, err := template.New(config.Name).Parse(config.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payload template: %s", err)
+	}
+
+	buf := new(bytes.Buffer)
+	err = tmpl.Execute(buf, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render payload: %s", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (d *deliverer) payload(delivery db.PendingNotificationDelivery, build db.Build) Payload {
+	var buildURL string
+	if delivery.JobName != "" {
+		buildURL = fmt.Sprintf(
+			"%s/teams/%s/pipelines/%s/jobs/%s/builds/%s",
+			d.externalURL,
+			url.PathEscape(delivery.TeamName),
+			url.PathEscape(delivery.PipelineName),
+			url.PathEscape(delivery.JobName),
+			url.PathEscape(delivery.BuildName),
+		)
+	} else {
+		buildURL = fmt.Sprintf("%s/builds/%d", d.externalURL, delivery.BuildID)
+	}
+
+	payload := Payload{
+		Event:    delivery.Event,
+		Team:     delivery.TeamName,
+		Pipeline: delivery.PipelineName,
+		Job:      delivery.JobName,
+		Build: BuildPayload{
+			ID:     delivery.BuildID,
+			Name:   delivery.BuildName,
+			Status: string(delivery.Event),
+			URL:    buildURL,
+		},
+	}
+
+	if !build.StartTime().IsZero() {
+		payload.Build.StartTime = build.StartTime().Unix()
+	}
+
+	if !build.EndTime().IsZero() {
+		payload.Build.EndTime = build.EndTime().Unix()
+	}
+
+	return payload
+}
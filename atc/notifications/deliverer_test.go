@@ -0,0 +1,367 @@
+package notifications_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/notifications"
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Deliverer", func() {
+	var (
+		fakeDeliveryFactory  *dbfakes.FakeNotificationDeliveryFactory
+		fakeBuildFactory     *dbfakes.FakeBuildFactory
+		fakeVariablesFactory *credsfakes.FakeVariablesFactory
+		fakeBuild            *dbfakes.FakeBuild
+		fakeClock            *fakeclock.FakeClock
+		server               *ghttp.Server
+
+		delivery   db.PendingNotificationDelivery
+		deliveries []db.PendingNotificationDelivery
+		pendingErr error
+
+		deliverer notifications.Deliverer
+		err       error
+	)
+
+	BeforeEach(func() {
+		fakeDeliveryFactory = new(dbfakes.FakeNotificationDeliveryFactory)
+		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
+		fakeVariablesFactory = new(credsfakes.FakeVariablesFactory)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(1000, 0))
+		server = ghttp.NewServer()
+		deliveries = nil
+		pendingErr = nil
+
+		fakeVariablesFactory.NewVariablesReturns(template.StaticVariables{
+			"webhook-secret": "s3cr3t",
+			"webhook-token":  "some-token",
+		})
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.StartTimeReturns(time.Unix(100, 0))
+		fakeBuild.EndTimeReturns(time.Unix(200, 0))
+		fakeBuildFactory.BuildReturns(fakeBuild, true, nil)
+
+		delivery = db.PendingNotificationDelivery{
+			NotificationDelivery: db.NotificationDelivery{
+				ID:           42,
+				BuildID:      7,
+				BuildName:    "3",
+				JobName:      "some-job",
+				PipelineID:   1,
+				Notification: "chat",
+				Event:        atc.NotificationEventFailed,
+				Status:       atc.NotificationDeliveryPending,
+			},
+			TeamName:     "some-team",
+			PipelineName: "some-pipeline",
+			Config: atc.NotificationConfig{
+				Name: "chat",
+				URL:  server.URL() + "/hook",
+			},
+			Configured: true,
+		}
+
+		deliverer = notifications.NewDeliverer(
+			fakeDeliveryFactory,
+			fakeBuildFactory,
+			fakeVariablesFactory,
+			"https://ci.example.com",
+			http.DefaultClient,
+			fakeClock,
+			10,
+			2,
+		)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	JustBeforeEach(func() {
+		if deliveries == nil {
+			deliveries = []db.PendingNotificationDelivery{delivery}
+		}
+
+		fakeDeliveryFactory.PendingDeliveriesReturns(deliveries, pendingErr)
+		err = deliverer.Run(context.TODO())
+	})
+
+	Context("when the webhook accepts the delivery", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/hook"),
+					ghttp.VerifyContentType("application/json"),
+					ghttp.VerifyHeaderKV(notifications.EventHeader, "failed"),
+					ghttp.VerifyHeaderKV(notifications.DeliveryHeader, "42"),
+					ghttp.VerifyJSONRepresenting(notifications.Payload{
+						Event:    atc.NotificationEventFailed,
+						Team:     "some-team",
+						Pipeline: "some-pipeline",
+						Job:      "some-job",
+						Build: notifications.BuildPayload{
+							ID:        7,
+							Name:      "3",
+							Status:    "failed",
+							URL:       "https://ci.example.com/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/3",
+							StartTime: 100,
+							EndTime:   200,
+						},
+					}),
+					func(w http.ResponseWriter, r *http.Request) {
+						Expect(r.Header.Get(notifications.SignatureHeader)).To(BeEmpty())
+					},
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+		})
+
+		It("gets a batch of pending deliveries", func() {
+			Expect(fakeDeliveryFactory.PendingDeliveriesCallCount()).To(Equal(1))
+			Expect(fakeDeliveryFactory.PendingDeliveriesArgsForCall(0)).To(Equal(10))
+		})
+
+		It("sends the build's status to the webhook", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("looks up the build", func() {
+			Expect(fakeBuildFactory.BuildArgsForCall(0)).To(Equal(7))
+		})
+
+		It("marks the delivery as delivered", func() {
+			Expect(fakeDeliveryFactory.MarkDeliveredCallCount()).To(Equal(1))
+			id, status := fakeDeliveryFactory.MarkDeliveredArgsForCall(0)
+			Expect(id).To(Equal(42))
+			Expect(status).To(Equal(http.StatusNoContent))
+		})
+	})
+
+	Context("when the notification has a secret and headers", func() {
+		BeforeEach(func() {
+			delivery.Config.Secret = "((webhook-secret))"
+			delivery.Config.Headers = map[string]string{
+				"Authorization": "Bearer ((webhook-token))",
+			}
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyHeaderKV("Authorization", "Bearer some-token"),
+					func(w http.ResponseWriter, r *http.Request) {
+						body, err := ioutil.ReadAll(r.Body)
+						Expect(err).ToNot(HaveOccurred())
+
+						mac := hmac.New(sha256.New, []byte("s3cr3t"))
+						mac.Write(body)
+
+						Expect(r.Header.Get(notifications.SignatureHeader)).To(Equal("sha256=" + hex.EncodeToString(mac.Sum(nil))))
+					},
+					ghttp.RespondWith(http.StatusOK, nil),
+				),
+			)
+		})
+
+		It("interpolates them with the pipeline's credentials and signs the payload", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+
+			teamName, pipelineName := fakeVariablesFactory.NewVariablesArgsForCall(0)
+			Expect(teamName).To(Equal("some-team"))
+			Expect(pipelineName).To(Equal("some-pipeline"))
+
+			Expect(fakeDeliveryFactory.MarkDeliveredCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the notification has a payload template", func() {
+		BeforeEach(func() {
+			delivery.Config.Payload = `{"text":"{{.Pipeline}}/{{.Job}} #{{.Build.Name}} {{.Build.Status}}: {{.Build.URL}}"}`
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyJSON(`{"text":"some-pipeline/some-job #3 failed: https://ci.example.com/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/3"}`),
+					ghttp.RespondWith(http.StatusOK, nil),
+				),
+			)
+		})
+
+		It("sends the rendered template", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+			Expect(fakeDeliveryFactory.MarkDeliveredCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the webhook responds with an error", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusBadGateway, nil))
+		})
+
+		It("schedules the delivery to be retried", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeDeliveryFactory.MarkDeliveredCallCount()).To(BeZero())
+			Expect(fakeDeliveryFactory.MarkFailedCallCount()).To(Equal(1))
+
+			id, status, message, retryAt := fakeDeliveryFactory.MarkFailedArgsForCall(0)
+			Expect(id).To(Equal(42))
+			Expect(status).To(Equal(http.StatusBadGateway))
+			Expect(message).To(Equal("unexpected response status 502"))
+			Expect(retryAt).To(Equal(fakeClock.Now().Add(30 * time.Second)))
+		})
+
+		Context("after previous failed attempts", func() {
+			BeforeEach(func() {
+				delivery.Attempts = 2
+			})
+
+			It("backs off exponentially", func() {
+				_, _, _, retryAt := fakeDeliveryFactory.MarkFailedArgsForCall(0)
+				Expect(retryAt).To(Equal(fakeClock.Now().Add(2 * time.Minute)))
+			})
+		})
+
+		Context("on the last attempt", func() {
+			BeforeEach(func() {
+				delivery.Config.Attempts = 3
+				delivery.Attempts = 2
+			})
+
+			It("gives up on the delivery", func() {
+				_, _, _, retryAt := fakeDeliveryFactory.MarkFailedArgsForCall(0)
+				Expect(retryAt).To(BeZero())
+			})
+		})
+	})
+
+	Context("when there are many deliveries", func() {
+		var (
+			otherServer *ghttp.Server
+
+			inFlight    int32
+			maxInFlight int32
+		)
+
+		BeforeEach(func() {
+			otherServer = ghttp.NewServer()
+
+			inFlight = 0
+			maxInFlight = 0
+
+			handler := func(w http.ResponseWriter, r *http.Request) {
+				current := atomic.AddInt32(&inFlight, 1)
+				defer atomic.AddInt32(&inFlight, -1)
+
+				for {
+					max := atomic.LoadInt32(&maxInFlight)
+					if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+						break
+					}
+				}
+
+				time.Sleep(50 * time.Millisecond)
+			}
+
+			server.RouteToHandler("POST", "/hook", handler)
+			otherServer.RouteToHandler("POST", "/hook", handler)
+
+			deliveries = []db.PendingNotificationDelivery{}
+			for i := 0; i < 3; i++ {
+				deliveries = append(deliveries, delivery)
+
+				otherDelivery := delivery
+				otherDelivery.Config.URL = otherServer.URL() + "/hook"
+				deliveries = append(deliveries, otherDelivery)
+			}
+		})
+
+		AfterEach(func() {
+			otherServer.Close()
+		})
+
+		It("sends them concurrently, limiting the requests to each endpoint", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(3))
+			Expect(otherServer.ReceivedRequests()).To(HaveLen(3))
+			Expect(fakeDeliveryFactory.MarkDeliveredCallCount()).To(Equal(6))
+			Expect(atomic.LoadInt32(&maxInFlight)).To(Equal(int32(4)))
+		})
+	})
+
+	Context("when the webhook cannot be reached", func() {
+		BeforeEach(func() {
+			server.Close()
+		})
+
+		It("schedules the delivery to be retried", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeDeliveryFactory.MarkFailedCallCount()).To(Equal(1))
+
+			_, status, message, retryAt := fakeDeliveryFactory.MarkFailedArgsForCall(0)
+			Expect(status).To(BeZero())
+			Expect(message).ToNot(BeEmpty())
+			Expect(retryAt).ToNot(BeZero())
+		})
+	})
+
+	Context("when the notification is no longer configured", func() {
+		BeforeEach(func() {
+			delivery.Configured = false
+			delivery.Config = atc.NotificationConfig{}
+		})
+
+		It("gives up on the delivery without sending it", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+
+			_, _, message, retryAt := fakeDeliveryFactory.MarkFailedArgsForCall(0)
+			Expect(message).To(Equal("notification is no longer configured"))
+			Expect(retryAt).To(BeZero())
+		})
+	})
+
+	Context("when getting pending deliveries fails", func() {
+		disaster := errors.New("disaster")
+
+		BeforeEach(func() {
+			pendingErr = disaster
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(Equal(disaster))
+			Expect(fakeBuildFactory.BuildCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when recording the delivery fails", func() {
+		disaster := errors.New("disaster")
+
+		BeforeEach(func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusOK, nil))
+			fakeDeliveryFactory.MarkDeliveredReturns(disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(Equal(disaster))
+		})
+	})
+})
@@ -0,0 +1,98 @@
+package notifications
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// A DestinationPolicy restricts the addresses webhooks may be sent to, so
+// that pipelines cannot use notifications to reach services on the ATC's own
+// network, e.g. a cloud metadata endpoint.
+type DestinationPolicy struct {
+	// Allowed networks. Any address which is not denied is allowed if empty.
+	Allowed []CIDR
+
+	// Denied networks, which take precedence over Allowed.
+	Denied []CIDR
+}
+
+// Permits returns true if webhooks may be sent to the address.
+func (p DestinationPolicy) Permits(ip net.IP) bool {
+	for _, network := range p.Denied {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+
+	if len(p.Allowed) == 0 {
+		return true
+	}
+
+	for _, network := range p.Allowed {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type DestinationNotPermittedError struct {
+	Address string
+}
+
+func (e DestinationNotPermittedError) Error() string {
+	return fmt.Sprintf("destination %s is not permitted", e.Address)
+}
+
+// NewHTTPClient returns a client which refuses to connect to addresses the
+// policy does not permit. The address is checked once the webhook's host
+// has been resolved, so the policy also applies to redirects and to hosts
+// which resolve to a different address by the time they are connected to.
+func NewHTTPClient(timeout time.Duration, policy DestinationPolicy) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second,
+		Control: func(network string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			ip := net.ParseIP(host)
+			if ip == nil || !policy.Permits(ip) {
+				return DestinationNotPermittedError{Address: host}
+			}
+
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 10 * time.Second,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+}
+
+// CIDR is a network given on the command line, e.g. 10.0.0.0/8.
+type CIDR struct {
+	*net.IPNet
+}
+
+func (c *CIDR) UnmarshalFlag(value string) error {
+	_, network, err := net.ParseCIDR(value)
+	if err != nil {
+		return err
+	}
+
+	c.IPNet = network
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package notifications_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/concourse/concourse/atc/notifications"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DestinationPolicy", func() {
+	cidr := func(value string) notifications.CIDR {
+		var network notifications.CIDR
+		Expect(network.UnmarshalFlag(value)).To(Succeed())
+		return network
+	}
+
+	Describe("Permits", func() {
+		var policy notifications.DestinationPolicy
+
+		BeforeEach(func() {
+			policy = notifications.DestinationPolicy{
+				Denied: []notifications.CIDR{cidr("169.254.0.0/16")},
+			}
+		})
+
+		It("permits addresses which are not denied", func() {
+			Expect(policy.Permits(net.ParseIP("203.0.113.1"))).To(BeTrue())
+			Expect(policy.Permits(net.ParseIP("169.254.169.254"))).To(BeFalse())
+		})
+
+		Context("when networks are allowed", func() {
+			BeforeEach(func() {
+				policy.Allowed = []notifications.CIDR{cidr("203.0.113.0/24"), cidr("169.254.0.0/16")}
+			})
+
+			It("only permits addresses within them which are not denied", func() {
+				Expect(policy.Permits(net.ParseIP("203.0.113.1"))).To(BeTrue())
+				Expect(policy.Permits(net.ParseIP("198.51.100.1"))).To(BeFalse())
+				Expect(policy.Permits(net.ParseIP("169.254.169.254"))).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("NewHTTPClient", func() {
+		var server *httptest.Server
+
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("connects to permitted addresses", func() {
+			client := notifications.NewHTTPClient(time.Second, notifications.DestinationPolicy{})
+
+			response, err := client.Get(server.URL)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.Body.Close()).To(Succeed())
+		})
+
+		It("refuses to connect to addresses which are not permitted", func() {
+			client := notifications.NewHTTPClient(time.Second, notifications.DestinationPolicy{
+				Denied: []notifications.CIDR{cidr("127.0.0.0/8")},
+			})
+
+			_, err := client.Get(server.URL)
+			Expect(err).To(MatchError(ContainSubstring("destination 127.0.0.1 is not permitted")))
+		})
+	})
+})
@@ -0,0 +1,44 @@
+package notifications
+
+import (
+	"context"
+	"sync"
+)
+
+// endpoints limits the number of requests in flight to each host.
+type endpoints struct {
+	maxInFlight int
+
+	slotsMutex sync.Mutex
+	slots      map[string]chan struct{}
+}
+
+func newEndpoints(maxInFlight int) *endpoints {
+	if maxInFlight < 1 {
+		maxInFlight = 1
+	}
+
+	return &endpoints{
+		maxInFlight: maxInFlight,
+		slots:       map[string]chan struct{}{},
+	}
+}
+
+// acquire waits for a slot to send a request to the host, returning a func
+// which gives it back.
+func (e *endpoints) acquire(ctx context.Context, host string) (func(), error) {
+	e.slotsMutex.Lock()
+	slots, found := e.slots[host]
+	if !found {
+		slots = make(chan struct{}, e.maxInFlight)
+		e.slots[host] = slots
+	}
+	e.slotsMutex.Unlock()
+
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,24 @@
+package notifications
+
+import "github.com/concourse/concourse/atc"
+
+// Payload describes a build's status change. It is sent as JSON unless the
+// notification configures a payload template, which is executed against it.
+type Payload struct {
+	Event    atc.NotificationEvent `json:"event"`
+	Team     string                `json:"team"`
+	Pipeline string                `json:"pipeline"`
+	Job      string                `json:"job,omitempty"`
+	Build    BuildPayload          `json:"build"`
+}
+
+// BuildPayload describes the build whose status changed. Its status is the
+// status the build changed to, even if it has changed again since.
+type BuildPayload struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	URL       string `json:"url"`
+	StartTime int64  `json:"start_time,omitempty"`
+	EndTime   int64  `json:"end_time,omitempty"`
+}
@@ -1,12 +1,13 @@
 package atc
 
 type Pipeline struct {
-	ID       int          `json:"id"`
-	Name     string       `json:"name"`
-	Paused   bool         `json:"paused"`
-	Public   bool         `json:"public"`
-	Groups   GroupConfigs `json:"groups,omitempty"`
-	TeamName string       `json:"team_name"`
+	ID            int          `json:"id"`
+	Name          string       `json:"name"`
+	Paused        bool         `json:"paused"`
+	Public        bool         `json:"public"`
+	Groups        GroupConfigs `json:"groups,omitempty"`
+	TeamName      string       `json:"team_name"`
+	ParentBuildID int          `json:"parent_build_id,omitempty"`
 }
 
 type RenameRequest struct {
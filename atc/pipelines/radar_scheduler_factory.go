@@ -11,6 +11,7 @@ import (
 	"github.com/concourse/concourse/atc/radar"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/scheduler"
+	"github.com/concourse/concourse/atc/scheduler/buildqueue"
 	"github.com/concourse/concourse/atc/scheduler/factory"
 	"github.com/concourse/concourse/atc/scheduler/inputmapper"
 	"github.com/concourse/concourse/atc/scheduler/inputmapper/inputconfig"
@@ -20,7 +21,6 @@ import (
 //go:generate counterfeiter . RadarSchedulerFactory
 
 type RadarSchedulerFactory interface {
-	BuildScanRunnerFactory(dbPipeline db.Pipeline, externalURL string, variables creds.Variables) radar.ScanRunnerFactory
 	BuildScheduler(pipeline db.Pipeline, externalURL string, variables creds.Variables) scheduler.BuildScheduler
 }
 
@@ -30,6 +30,7 @@ type radarSchedulerFactory struct {
 	resourceTypeCheckingInterval time.Duration
 	resourceCheckingInterval     time.Duration
 	engine                       engine.Engine
+	buildQueue                   buildqueue.Queue
 }
 
 func NewRadarSchedulerFactory(
@@ -38,6 +39,7 @@ func NewRadarSchedulerFactory(
 	resourceTypeCheckingInterval time.Duration,
 	resourceCheckingInterval time.Duration,
 	engine engine.Engine,
+	buildQueue buildqueue.Queue,
 ) RadarSchedulerFactory {
 	return &radarSchedulerFactory{
 		resourceFactory:              resourceFactory,
@@ -45,13 +47,10 @@ func NewRadarSchedulerFactory(
 		resourceTypeCheckingInterval: resourceTypeCheckingInterval,
 		resourceCheckingInterval:     resourceCheckingInterval,
 		engine:                       engine,
+		buildQueue:                   buildQueue,
 	}
 }
 
-func (rsf *radarSchedulerFactory) BuildScanRunnerFactory(dbPipeline db.Pipeline, externalURL string, variables creds.Variables) radar.ScanRunnerFactory {
-	return radar.NewScanRunnerFactory(rsf.resourceFactory, rsf.resourceConfigFactory, rsf.resourceTypeCheckingInterval, rsf.resourceCheckingInterval, dbPipeline, clock.NewClock(), externalURL, variables)
-}
-
 func (rsf *radarSchedulerFactory) BuildScheduler(pipeline db.Pipeline, externalURL string, variables creds.Variables) scheduler.BuildScheduler {
 
 	resourceTypeScanner := radar.NewResourceTypeScanner(
@@ -92,6 +91,7 @@ func (rsf *radarSchedulerFactory) BuildScheduler(pipeline db.Pipeline, externalU
 			scanner,
 			inputMapper,
 			rsf.engine,
+			rsf.buildQueue,
 		),
 		Scanner: scanner,
 	}
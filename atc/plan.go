@@ -17,6 +17,12 @@ type Plan struct {
 	Timeout   *TimeoutPlan   `json:"timeout,omitempty"`
 	Retry     *RetryPlan     `json:"retry,omitempty"`
 
+	Conditional *ConditionalPlan `json:"conditional,omitempty"`
+	Across      *AcrossPlan      `json:"across,omitempty"`
+	SetPipeline *SetPipelinePlan `json:"set_pipeline,omitempty"`
+	LoadVar     *LoadVarPlan     `json:"load_var,omitempty"`
+	Approval    *ApprovalPlan    `json:"approval,omitempty"`
+
 	// used for 'fly execute'
 	UserArtifact   *UserArtifactPlan   `json:"user_artifact,omitempty"`
 	ArtifactOutput *ArtifactOutputPlan `json:"artifact_output,omitempty"`
@@ -60,6 +66,27 @@ type TimeoutPlan struct {
 	Duration string `json:"duration"`
 }
 
+type ConditionalPlan struct {
+	Condition string `json:"condition"`
+	Step      Plan   `json:"step"`
+}
+
+type AcrossPlan struct {
+	Vars        []AcrossVar     `json:"vars"`
+	Steps       []VarScopedPlan `json:"steps"`
+	MaxInFlight int             `json:"max_in_flight,omitempty"`
+}
+
+type AcrossVar struct {
+	Var    string        `json:"name"`
+	Values []interface{} `json:"values"`
+}
+
+type VarScopedPlan struct {
+	Step   Plan          `json:"step"`
+	Values []interface{} `json:"values"`
+}
+
 type TryPlan struct {
 	Step Plan `json:"step"`
 }
@@ -99,6 +126,8 @@ type TaskPlan struct {
 	Privileged bool `json:"privileged"`
 	Tags       Tags `json:"tags,omitempty"`
 
+	ContainerPlacementStrategy []string `json:"container_placement_strategy,omitempty"`
+
 	ConfigPath string      `json:"config_path,omitempty"`
 	Config     *TaskConfig `json:"config,omitempty"`
 	Vars       Params      `json:"vars,omitempty"`
@@ -108,9 +137,29 @@ type TaskPlan struct {
 	OutputMapping     map[string]string `json:"output_mapping,omitempty"`
 	ImageArtifactName string            `json:"image,omitempty"`
 
+	Artifacts []string `json:"artifacts,omitempty"`
+
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
+type SetPipelinePlan struct {
+	Name string `json:"name"`
+	File string `json:"file"`
+	Vars Params `json:"vars,omitempty"`
+}
+
+type LoadVarPlan struct {
+	Name   string `json:"name"`
+	File   string `json:"file"`
+	Format string `json:"format,omitempty"`
+	Reveal bool   `json:"reveal,omitempty"`
+}
+
+type ApprovalPlan struct {
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
+
 type RetryPlan []Plan
 
 type DependentGetPlan struct {
@@ -35,6 +35,12 @@ func (factory PlanFactory) NewPlan(step Step) Plan {
 		plan.Put = &t
 	case TaskPlan:
 		plan.Task = &t
+	case SetPipelinePlan:
+		plan.SetPipeline = &t
+	case LoadVarPlan:
+		plan.LoadVar = &t
+	case ApprovalPlan:
+		plan.Approval = &t
 	case OnAbortPlan:
 		plan.OnAbort = &t
 	case EnsurePlan:
@@ -49,6 +55,10 @@ func (factory PlanFactory) NewPlan(step Step) Plan {
 		plan.Timeout = &t
 	case RetryPlan:
 		plan.Retry = &t
+	case ConditionalPlan:
+		plan.Conditional = &t
+	case AcrossPlan:
+		plan.Across = &t
 	case UserArtifactPlan:
 		plan.UserArtifact = &t
 	case ArtifactOutputPlan:
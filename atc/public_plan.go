@@ -11,6 +11,9 @@ func (plan Plan) Public() *json.RawMessage {
 		Get            *json.RawMessage `json:"get,omitempty"`
 		Put            *json.RawMessage `json:"put,omitempty"`
 		Task           *json.RawMessage `json:"task,omitempty"`
+		SetPipeline    *json.RawMessage `json:"set_pipeline,omitempty"`
+		LoadVar        *json.RawMessage `json:"load_var,omitempty"`
+		Approval       *json.RawMessage `json:"approval,omitempty"`
 		OnAbort        *json.RawMessage `json:"on_abort,omitempty"`
 		Ensure         *json.RawMessage `json:"ensure,omitempty"`
 		OnSuccess      *json.RawMessage `json:"on_success,omitempty"`
@@ -19,6 +22,8 @@ func (plan Plan) Public() *json.RawMessage {
 		DependentGet   *json.RawMessage `json:"dependent_get,omitempty"`
 		Timeout        *json.RawMessage `json:"timeout,omitempty"`
 		Retry          *json.RawMessage `json:"retry,omitempty"`
+		Conditional    *json.RawMessage `json:"conditional,omitempty"`
+		Across         *json.RawMessage `json:"across,omitempty"`
 		UserArtifact   *json.RawMessage `json:"user_artifact,omitempty"`
 		ArtifactOutput *json.RawMessage `json:"artifact_output,omitempty"`
 	}
@@ -45,6 +50,18 @@ func (plan Plan) Public() *json.RawMessage {
 		public.Task = plan.Task.Public()
 	}
 
+	if plan.SetPipeline != nil {
+		public.SetPipeline = plan.SetPipeline.Public()
+	}
+
+	if plan.LoadVar != nil {
+		public.LoadVar = plan.LoadVar.Public()
+	}
+
+	if plan.Approval != nil {
+		public.Approval = plan.Approval.Public()
+	}
+
 	if plan.OnAbort != nil {
 		public.OnAbort = plan.OnAbort.Public()
 	}
@@ -73,6 +90,14 @@ func (plan Plan) Public() *json.RawMessage {
 		public.Retry = plan.Retry.Public()
 	}
 
+	if plan.Conditional != nil {
+		public.Conditional = plan.Conditional.Public()
+	}
+
+	if plan.Across != nil {
+		public.Across = plan.Across.Public()
+	}
+
 	if plan.UserArtifact != nil {
 		public.UserArtifact = plan.UserArtifact.Public()
 	}
@@ -214,6 +239,71 @@ func (plan TryPlan) Public() *json.RawMessage {
 	})
 }
 
+func (plan ConditionalPlan) Public() *json.RawMessage {
+	return enc(struct {
+		Condition string           `json:"condition"`
+		Step      *json.RawMessage `json:"step"`
+	}{
+		Condition: plan.Condition,
+		Step:      plan.Step.Public(),
+	})
+}
+
+func (plan SetPipelinePlan) Public() *json.RawMessage {
+	return enc(struct {
+		Name string `json:"name"`
+		File string `json:"file"`
+	}{
+		Name: plan.Name,
+		File: plan.File,
+	})
+}
+
+func (plan LoadVarPlan) Public() *json.RawMessage {
+	return enc(struct {
+		Name string `json:"name"`
+		File string `json:"file"`
+	}{
+		Name: plan.Name,
+		File: plan.File,
+	})
+}
+
+func (plan ApprovalPlan) Public() *json.RawMessage {
+	return enc(struct {
+		Name string `json:"name"`
+		Role string `json:"role"`
+	}{
+		Name: plan.Name,
+		Role: plan.Role,
+	})
+}
+
+func (plan AcrossPlan) Public() *json.RawMessage {
+	type publicVarScopedPlan struct {
+		Step   *json.RawMessage `json:"step"`
+		Values []interface{}    `json:"values"`
+	}
+
+	steps := make([]publicVarScopedPlan, len(plan.Steps))
+	for i, step := range plan.Steps {
+		steps[i] = publicVarScopedPlan{
+			Step:   step.Step.Public(),
+			Values: step.Values,
+		}
+	}
+
+	return enc(struct {
+		Vars        []AcrossVar           `json:"vars"`
+		Steps       []publicVarScopedPlan `json:"steps"`
+		MaxInFlight int                   `json:"max_in_flight,omitempty"`
+	}{
+		Vars:        plan.Vars,
+		Steps:       steps,
+		MaxInFlight: plan.MaxInFlight,
+	})
+}
+
 func (plan RetryPlan) Public() *json.RawMessage {
 	public := make([]*json.RawMessage, len(plan))
 
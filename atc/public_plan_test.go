@@ -295,6 +295,81 @@ var _ = Describe("Plan", func() {
 							Name: "some-name",
 						},
 					},
+
+					atc.Plan{
+						ID: "33",
+						Conditional: &atc.ConditionalPlan{
+							Condition: `var("enabled")`,
+							Step: atc.Plan{
+								ID: "34",
+								Task: &atc.TaskPlan{
+									Name:       "name",
+									ConfigPath: "some/config/path.yml",
+									Config: &atc.TaskConfig{
+										Params: map[string]string{"some": "secret"},
+									},
+								},
+							},
+						},
+					},
+
+					atc.Plan{
+						ID: "35",
+						Across: &atc.AcrossPlan{
+							Vars: []atc.AcrossVar{
+								{Var: "go", Values: []interface{}{"1.11", "1.12"}},
+							},
+							Steps: []atc.VarScopedPlan{
+								{
+									Step: atc.Plan{
+										ID: "36",
+										Task: &atc.TaskPlan{
+											Name:   "test-1.11",
+											Params: atc.Params{"some": "secret"},
+										},
+									},
+									Values: []interface{}{"1.11"},
+								},
+								{
+									Step: atc.Plan{
+										ID: "37",
+										Task: &atc.TaskPlan{
+											Name:   "test-1.12",
+											Params: atc.Params{"some": "secret"},
+										},
+									},
+									Values: []interface{}{"1.12"},
+								},
+							},
+							MaxInFlight: 1,
+						},
+					},
+
+					atc.Plan{
+						ID: "38",
+						SetPipeline: &atc.SetPipelinePlan{
+							Name: "some-pipeline",
+							File: "some-repo/pipeline.yml",
+							Vars: atc.Params{"some": "secret"},
+						},
+					},
+
+					atc.Plan{
+						ID: "39",
+						LoadVar: &atc.LoadVarPlan{
+							Name:   "some-var",
+							File:   "some-repo/version.json",
+							Format: "json",
+						},
+					},
+
+					atc.Plan{
+						ID: "40",
+						Approval: &atc.ApprovalPlan{
+							Name: "deploy-to-prod",
+							Role: "owner",
+						},
+					},
 				},
 			}
 
@@ -510,6 +585,74 @@ var _ = Describe("Plan", func() {
 			"artifact_output": {
 				"name": "some-name"
 			}
+		},
+		{
+			"id": "33",
+			"conditional": {
+				"condition": "var(\"enabled\")",
+				"step": {
+					"id": "34",
+					"task": {
+						"name": "name",
+						"privileged": false
+					}
+				}
+			}
+		},
+		{
+			"id": "35",
+			"across": {
+				"vars": [
+					{
+						"name": "go",
+						"values": ["1.11", "1.12"]
+					}
+				],
+				"steps": [
+					{
+						"step": {
+							"id": "36",
+							"task": {
+								"name": "test-1.11",
+								"privileged": false
+							}
+						},
+						"values": ["1.11"]
+					},
+					{
+						"step": {
+							"id": "37",
+							"task": {
+								"name": "test-1.12",
+								"privileged": false
+							}
+						},
+						"values": ["1.12"]
+					}
+				],
+				"max_in_flight": 1
+			}
+		},
+		{
+			"id": "38",
+			"set_pipeline": {
+				"name": "some-pipeline",
+				"file": "some-repo/pipeline.yml"
+			}
+		},
+		{
+			"id": "39",
+			"load_var": {
+				"name": "some-var",
+				"file": "some-repo/version.json"
+			}
+		},
+		{
+			"id": "40",
+			"approval": {
+				"name": "deploy-to-prod",
+				"role": "owner"
+			}
 		}
   ]
 }
@@ -0,0 +1,136 @@
+package radar
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+//go:generate counterfeiter . CheckEnqueuer
+
+type CheckEnqueuer interface {
+	Run(context.Context) error
+}
+
+type checkEnqueuer struct {
+	clock                        clock.Clock
+	pipelineFactory              db.PipelineFactory
+	checkFactory                 db.CheckFactory
+	resourceTypeCheckingInterval time.Duration
+	resourceCheckingInterval     time.Duration
+}
+
+// NewCheckEnqueuer returns a CheckEnqueuer which queues a check of each
+// resource and resource type in every unpaused pipeline once its checking
+// interval has elapsed.
+func NewCheckEnqueuer(
+	clock clock.Clock,
+	pipelineFactory db.PipelineFactory,
+	checkFactory db.CheckFactory,
+	resourceTypeCheckingInterval time.Duration,
+	resourceCheckingInterval time.Duration,
+) CheckEnqueuer {
+	return &checkEnqueuer{
+		clock:                        clock,
+		pipelineFactory:              pipelineFactory,
+		checkFactory:                 checkFactory,
+		resourceTypeCheckingInterval: resourceTypeCheckingInterval,
+		resourceCheckingInterval:     resourceCheckingInterval,
+	}
+}
+
+func (e *checkEnqueuer) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("check-enqueuer")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	pipelines, err := e.pipelineFactory.AllPipelines()
+	if err != nil {
+		logger.Error("failed-to-get-pipelines", err)
+		return err
+	}
+
+	for _, pipeline := range pipelines {
+		if pipeline.Paused() {
+			continue
+		}
+
+		// errors are logged; carry on so that one pipeline cannot hold up
+		// the checks of the others
+		e.enqueuePipeline(logger.WithData(lager.Data{
+			"team":     pipeline.TeamName(),
+			"pipeline": pipeline.Name(),
+		}), pipeline)
+	}
+
+	depth, err := e.checkFactory.PendingChecksCount()
+	if err != nil {
+		logger.Error("failed-to-get-pending-checks-count", err)
+		return err
+	}
+
+	metric.CheckQueueDepth{
+		Depth: depth,
+	}.Emit(logger)
+
+	return nil
+}
+
+func (e *checkEnqueuer) enqueuePipeline(logger lager.Logger, pipeline db.Pipeline) {
+	resourceTypes, err := pipeline.ResourceTypes()
+	if err != nil {
+		logger.Error("failed-to-get-resource-types", err)
+		return
+	}
+
+	for _, resourceType := range resourceTypes {
+		if !e.due(resourceType.LastChecked(), resourceType.CheckEvery(), e.resourceTypeCheckingInterval) {
+			continue
+		}
+
+		_, err := e.checkFactory.CreateResourceTypeCheck(resourceType.ID(), nil, false)
+		if err != nil {
+			logger.Error("failed-to-create-resource-type-check", err, lager.Data{"resource-type": resourceType.Name()})
+			return
+		}
+	}
+
+	resources, err := pipeline.Resources()
+	if err != nil {
+		logger.Error("failed-to-get-resources", err)
+		return
+	}
+
+	for _, resource := range resources {
+		if !e.due(resource.LastChecked(), resource.CheckEvery(), e.resourceCheckingInterval) {
+			continue
+		}
+
+		_, err := e.checkFactory.CreateResourceCheck(resource.ID(), nil, false)
+		if err != nil {
+			logger.Error("failed-to-create-resource-check", err, lager.Data{"resource": resource.Name()})
+			return
+		}
+	}
+}
+
+// due returns whether the checking interval has elapsed since the last check.
+// An invalid check_every is left for the scanner to report, so a check is
+// queued at the default interval.
+func (e *checkEnqueuer) due(lastChecked time.Time, checkEvery string, defaultInterval time.Duration) bool {
+	interval := defaultInterval
+	if checkEvery != "" {
+		configuredInterval, err := time.ParseDuration(checkEvery)
+		if err == nil {
+			interval = configuredInterval
+		}
+	}
+
+	return e.clock.Now().Sub(lastChecked) >= interval
+}
@@ -0,0 +1,148 @@
+package radar_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/radar"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckEnqueuer", func() {
+	var (
+		fakeClock           *fakeclock.FakeClock
+		fakePipelineFactory *dbfakes.FakePipelineFactory
+		fakeCheckFactory    *dbfakes.FakeCheckFactory
+		fakePipeline        *dbfakes.FakePipeline
+		fakeResource        *dbfakes.FakeResource
+		fakeResourceType    *dbfakes.FakeResourceType
+
+		enqueuer CheckEnqueuer
+
+		err error
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
+		fakePipelineFactory = new(dbfakes.FakePipelineFactory)
+		fakeCheckFactory = new(dbfakes.FakeCheckFactory)
+
+		fakeResourceType = new(dbfakes.FakeResourceType)
+		fakeResourceType.IDReturns(1)
+		fakeResourceType.NameReturns("some-resource-type")
+
+		fakeResource = new(dbfakes.FakeResource)
+		fakeResource.IDReturns(2)
+		fakeResource.NameReturns("some-resource")
+
+		fakePipeline = new(dbfakes.FakePipeline)
+		fakePipeline.ResourceTypesReturns(db.ResourceTypes{fakeResourceType}, nil)
+		fakePipeline.ResourcesReturns(db.Resources{fakeResource}, nil)
+
+		fakePipelineFactory.AllPipelinesReturns([]db.Pipeline{fakePipeline}, nil)
+
+		enqueuer = NewCheckEnqueuer(
+			fakeClock,
+			fakePipelineFactory,
+			fakeCheckFactory,
+			time.Minute,
+			2*time.Minute,
+		)
+	})
+
+	JustBeforeEach(func() {
+		ctx := lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		err = enqueuer.Run(ctx)
+	})
+
+	Context("when the resources and resource types have never been checked", func() {
+		It("queues a check of each of them", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeCheckFactory.CreateResourceTypeCheckCallCount()).To(Equal(1))
+			resourceTypeID, fromVersion, manuallyTriggered := fakeCheckFactory.CreateResourceTypeCheckArgsForCall(0)
+			Expect(resourceTypeID).To(Equal(1))
+			Expect(fromVersion).To(BeNil())
+			Expect(manuallyTriggered).To(BeFalse())
+
+			Expect(fakeCheckFactory.CreateResourceCheckCallCount()).To(Equal(1))
+			resourceID, fromVersion, manuallyTriggered := fakeCheckFactory.CreateResourceCheckArgsForCall(0)
+			Expect(resourceID).To(Equal(2))
+			Expect(fromVersion).To(BeNil())
+			Expect(manuallyTriggered).To(BeFalse())
+		})
+	})
+
+	Context("when they have been checked within the default interval", func() {
+		BeforeEach(func() {
+			fakeResourceType.LastCheckedReturns(fakeClock.Now().Add(-59 * time.Second))
+			fakeResource.LastCheckedReturns(fakeClock.Now().Add(-119 * time.Second))
+		})
+
+		It("does not queue any checks", func() {
+			Expect(fakeCheckFactory.CreateResourceTypeCheckCallCount()).To(Equal(0))
+			Expect(fakeCheckFactory.CreateResourceCheckCallCount()).To(Equal(0))
+		})
+
+		Context("when they configure a shorter interval", func() {
+			BeforeEach(func() {
+				fakeResourceType.CheckEveryReturns("30s")
+				fakeResource.CheckEveryReturns("30s")
+			})
+
+			It("queues a check of each of them", func() {
+				Expect(fakeCheckFactory.CreateResourceTypeCheckCallCount()).To(Equal(1))
+				Expect(fakeCheckFactory.CreateResourceCheckCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when the pipeline is paused", func() {
+		BeforeEach(func() {
+			fakePipeline.PausedReturns(true)
+		})
+
+		It("does not queue any checks", func() {
+			Expect(fakeCheckFactory.CreateResourceTypeCheckCallCount()).To(Equal(0))
+			Expect(fakeCheckFactory.CreateResourceCheckCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when getting the resources of a pipeline fails", func() {
+		var otherPipeline *dbfakes.FakePipeline
+
+		BeforeEach(func() {
+			fakePipeline.ResourcesReturns(nil, errors.New("nope"))
+
+			otherPipeline = new(dbfakes.FakePipeline)
+			otherPipeline.ResourcesReturns(db.Resources{fakeResource}, nil)
+
+			fakePipelineFactory.AllPipelinesReturns([]db.Pipeline{fakePipeline, otherPipeline}, nil)
+		})
+
+		It("carries on with the other pipelines", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeCheckFactory.CreateResourceCheckCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when getting the pipelines fails", func() {
+		disaster := errors.New("disaster")
+
+		BeforeEach(func() {
+			fakePipelineFactory.AllPipelinesReturns(nil, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(Equal(disaster))
+		})
+	})
+})
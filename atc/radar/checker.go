@@ -0,0 +1,199 @@
+package radar
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+// CheckPollingInterval is how long an idle checker waits before looking for
+// checks in the queue again.
+var CheckPollingInterval = time.Second
+
+type Checker struct {
+	logger lager.Logger
+
+	noop bool
+
+	clock           clock.Clock
+	checkFactory    db.CheckFactory
+	scannerFactory  ScannerFactory
+	workers         int
+	checksPerSecond float64
+}
+
+// NewChecker returns a Checker which runs checks from the queue shared by
+// every ATC, running at most the given number of checks at once. If
+// checksPerSecond is non-zero, checks are started no faster than that across
+// the whole cluster.
+func NewChecker(
+	logger lager.Logger,
+	noop bool,
+	clock clock.Clock,
+	checkFactory db.CheckFactory,
+	scannerFactory ScannerFactory,
+	workers int,
+	checksPerSecond float64,
+) *Checker {
+	return &Checker{
+		logger:          logger,
+		noop:            noop,
+		clock:           clock,
+		checkFactory:    checkFactory,
+		scannerFactory:  scannerFactory,
+		workers:         workers,
+		checksPerSecond: checksPerSecond,
+	}
+}
+
+func (c *Checker) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	c.logger.Info("start", lager.Data{"workers": c.workers})
+	defer c.logger.Info("done")
+
+	close(ready)
+
+	if c.noop {
+		<-signals
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	wg := new(sync.WaitGroup)
+	for i := 0; i < c.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.work(ctx)
+		}()
+	}
+
+	<-signals
+
+	cancel()
+	wg.Wait()
+
+	return nil
+}
+
+func (c *Checker) work(ctx context.Context) {
+	for {
+		if !c.runNext() {
+			select {
+			case <-ctx.Done():
+				return
+			case <-c.clock.After(CheckPollingInterval):
+			}
+
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+	}
+}
+
+// runNext runs the next check in the queue, returning false if there was
+// none to run.
+func (c *Checker) runNext() bool {
+	window, limit := c.rateLimit()
+
+	check, found, err := c.checkFactory.AcquireCheck(window, limit)
+	if err != nil {
+		c.logger.Error("failed-to-acquire-check", err)
+		return false
+	}
+
+	if !found {
+		return false
+	}
+
+	logger := c.logger.Session("check", lager.Data{
+		"check":    check.ID(),
+		"team":     check.TeamName(),
+		"pipeline": check.PipelineName(),
+		"name":     check.Name(),
+	})
+
+	metric.CheckStarted{
+		PipelineName: check.PipelineName(),
+		Name:         check.Name(),
+		TeamName:     check.TeamName(),
+		QueueTime:    check.StartTime().Sub(check.CreateTime()),
+	}.Emit(logger)
+
+	start := c.clock.Now()
+
+	checkErr := c.check(logger, check)
+
+	metric.CheckFinished{
+		PipelineName: check.PipelineName(),
+		Name:         check.Name(),
+		TeamName:     check.TeamName(),
+		Duration:     c.clock.Now().Sub(start),
+		Success:      checkErr == nil,
+	}.Emit(logger)
+
+	err = check.Finish(checkErr)
+	if err != nil {
+		logger.Error("failed-to-finish-check", err)
+	}
+
+	return true
+}
+
+func (c *Checker) check(logger lager.Logger, check db.Check) error {
+	pipeline, found, err := check.Pipeline()
+	if err != nil {
+		logger.Error("failed-to-get-pipeline", err)
+		return err
+	}
+
+	if !found {
+		logger.Info("pipeline-removed")
+		return errPipelineRemoved
+	}
+
+	var scanner Scanner
+	if check.ResourceTypeID() != 0 {
+		scanner = c.scannerFactory.NewResourceTypeScanner(pipeline)
+	} else {
+		scanner = c.scannerFactory.NewResourceScanner(pipeline)
+	}
+
+	if check.ManuallyTriggered() {
+		return scanner.ScanFromVersion(logger, check.Name(), check.FromVersion())
+	}
+
+	_, err = scanner.Run(logger, check.Name())
+	if err == ErrFailedToAcquireLock {
+		// the resource config is being checked elsewhere or has been checked
+		// within its interval, e.g. through another pipeline
+		return nil
+	}
+
+	return err
+}
+
+// rateLimit converts the checks per second into the number of checks which
+// may be started within a window of time. Rates above one per second are
+// rounded down.
+func (c *Checker) rateLimit() (time.Duration, int) {
+	switch {
+	case c.checksPerSecond <= 0:
+		return 0, 0
+	case c.checksPerSecond < 1:
+		return time.Duration(float64(time.Second) / c.checksPerSecond), 1
+	default:
+		return time.Second, int(c.checksPerSecond)
+	}
+}
@@ -0,0 +1,196 @@
+package radar_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/radar"
+	"github.com/concourse/concourse/atc/radar/radarfakes"
+	"github.com/tedsuo/ifrit"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Checker", func() {
+	var (
+		fakeClock          *fakeclock.FakeClock
+		fakeCheckFactory   *dbfakes.FakeCheckFactory
+		fakeScannerFactory *radarfakes.FakeScannerFactory
+		fakeScanner        *radarfakes.FakeScanner
+		fakeCheck          *dbfakes.FakeCheck
+		fakePipeline       *dbfakes.FakePipeline
+
+		noop            bool
+		checksPerSecond float64
+
+		process ifrit.Process
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
+		fakeCheckFactory = new(dbfakes.FakeCheckFactory)
+		fakeScannerFactory = new(radarfakes.FakeScannerFactory)
+		fakeScanner = new(radarfakes.FakeScanner)
+		fakeScannerFactory.NewResourceScannerReturns(fakeScanner)
+		fakeScannerFactory.NewResourceTypeScannerReturns(fakeScanner)
+
+		fakePipeline = new(dbfakes.FakePipeline)
+
+		fakeCheck = new(dbfakes.FakeCheck)
+		fakeCheck.NameReturns("some-resource")
+		fakeCheck.ResourceIDReturns(1)
+		fakeCheck.PipelineReturns(fakePipeline, true, nil)
+
+		fakeCheckFactory.AcquireCheckReturnsOnCall(0, fakeCheck, true, nil)
+
+		noop = false
+		checksPerSecond = 0
+	})
+
+	JustBeforeEach(func() {
+		process = ifrit.Invoke(NewChecker(
+			lagertest.NewTestLogger("test"),
+			noop,
+			fakeClock,
+			fakeCheckFactory,
+			fakeScannerFactory,
+			1,
+			checksPerSecond,
+		))
+	})
+
+	AfterEach(func() {
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait()).Should(Receive())
+	})
+
+	It("runs the queued check with the resource scanner for its pipeline", func() {
+		Eventually(fakeScanner.RunCallCount).Should(Equal(1))
+		_, name := fakeScanner.RunArgsForCall(0)
+		Expect(name).To(Equal("some-resource"))
+
+		Expect(fakeScannerFactory.NewResourceScannerCallCount()).To(Equal(1))
+		Expect(fakeScannerFactory.NewResourceScannerArgsForCall(0)).To(Equal(fakePipeline))
+	})
+
+	It("finishes the check", func() {
+		Eventually(fakeCheck.FinishCallCount).Should(Equal(1))
+		Expect(fakeCheck.FinishArgsForCall(0)).To(BeNil())
+	})
+
+	It("polls the queue again once it is empty", func() {
+		Eventually(fakeCheckFactory.AcquireCheckCallCount).Should(Equal(2))
+		Consistently(fakeCheckFactory.AcquireCheckCallCount).Should(Equal(2))
+
+		fakeClock.WaitForWatcherAndIncrement(CheckPollingInterval)
+
+		Eventually(fakeCheckFactory.AcquireCheckCallCount).Should(Equal(3))
+	})
+
+	Context("when the check fails", func() {
+		disaster := errors.New("disaster")
+
+		BeforeEach(func() {
+			fakeScanner.RunReturns(0, disaster)
+		})
+
+		It("finishes the check with the error", func() {
+			Eventually(fakeCheck.FinishCallCount).Should(Equal(1))
+			Expect(fakeCheck.FinishArgsForCall(0)).To(Equal(disaster))
+		})
+	})
+
+	Context("when the resource config could not be locked", func() {
+		BeforeEach(func() {
+			fakeScanner.RunReturns(0, ErrFailedToAcquireLock)
+		})
+
+		It("finishes the check without an error", func() {
+			Eventually(fakeCheck.FinishCallCount).Should(Equal(1))
+			Expect(fakeCheck.FinishArgsForCall(0)).To(BeNil())
+		})
+	})
+
+	Context("when the check is of a resource type", func() {
+		BeforeEach(func() {
+			fakeCheck.ResourceIDReturns(0)
+			fakeCheck.ResourceTypeIDReturns(2)
+		})
+
+		It("runs it with the resource type scanner", func() {
+			Eventually(fakeScanner.RunCallCount).Should(Equal(1))
+			Expect(fakeScannerFactory.NewResourceTypeScannerCallCount()).To(Equal(1))
+			Expect(fakeScannerFactory.NewResourceScannerCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the check was manually triggered", func() {
+		BeforeEach(func() {
+			fakeCheck.ManuallyTriggeredReturns(true)
+			fakeCheck.FromVersionReturns(atc.Version{"some": "version"})
+		})
+
+		It("scans from the given version", func() {
+			Eventually(fakeScanner.ScanFromVersionCallCount).Should(Equal(1))
+			_, name, fromVersion := fakeScanner.ScanFromVersionArgsForCall(0)
+			Expect(name).To(Equal("some-resource"))
+			Expect(fromVersion).To(Equal(atc.Version{"some": "version"}))
+
+			Expect(fakeScanner.RunCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the pipeline has been removed", func() {
+		BeforeEach(func() {
+			fakeCheck.PipelineReturns(nil, false, nil)
+		})
+
+		It("errors the check", func() {
+			Eventually(fakeCheck.FinishCallCount).Should(Equal(1))
+			Expect(fakeCheck.FinishArgsForCall(0)).To(HaveOccurred())
+			Expect(fakeScanner.RunCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a rate limit is configured", func() {
+		BeforeEach(func() {
+			checksPerSecond = 5
+		})
+
+		It("acquires checks subject to the limit", func() {
+			Eventually(fakeCheckFactory.AcquireCheckCallCount).Should(BeNumerically(">=", 1))
+			window, limit := fakeCheckFactory.AcquireCheckArgsForCall(0)
+			Expect(window).To(Equal(time.Second))
+			Expect(limit).To(Equal(5))
+		})
+
+		Context("when the limit is below one check per second", func() {
+			BeforeEach(func() {
+				checksPerSecond = 0.1
+			})
+
+			It("allows a single check within a longer window", func() {
+				Eventually(fakeCheckFactory.AcquireCheckCallCount).Should(BeNumerically(">=", 1))
+				window, limit := fakeCheckFactory.AcquireCheckArgsForCall(0)
+				Expect(window).To(Equal(10 * time.Second))
+				Expect(limit).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when running in noop mode", func() {
+		BeforeEach(func() {
+			noop = true
+		})
+
+		It("does not run any checks", func() {
+			Consistently(fakeCheckFactory.AcquireCheckCallCount).Should(Equal(0))
+		})
+	})
+})
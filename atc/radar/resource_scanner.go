@@ -14,6 +14,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -243,7 +244,7 @@ func (scanner *resourceScanner) check(
 	source atc.Source,
 	saveGiven bool,
 	timeout time.Duration,
-) error {
+) (err error) {
 	pipelinePaused, err := scanner.dbPipeline.CheckPaused()
 	if err != nil {
 		logger.Error("failed-to-check-if-pipeline-paused", err)
@@ -265,6 +266,16 @@ func (scanner *resourceScanner) check(
 		return errPipelineRemoved
 	}
 
+	spanCtx, span := tracing.StartSpan(context.Background(), "check", tracing.Attrs{
+		"team":     scanner.dbPipeline.TeamName(),
+		"pipeline": savedResource.PipelineName(),
+		"resource": savedResource.Name(),
+		"type":     savedResource.Type(),
+	})
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	metadata := resource.TrackerMetadata{
 		ResourceName: savedResource.Name(),
 		PipelineName: savedResource.PipelineName(),
@@ -286,8 +297,12 @@ func (scanner *resourceScanner) check(
 		ResourceTypes: resourceTypes,
 	}
 
+	historyEntry := db.CheckHistoryEntry{
+		StartTime: scanner.clock.Now(),
+	}
+
 	res, err := scanner.resourceFactory.NewResource(
-		context.Background(),
+		spanCtx,
 		logger,
 		db.NewResourceConfigCheckSessionContainerOwner(resourceConfig, ContainerExpiries),
 		db.ContainerMetadata{
@@ -311,14 +326,19 @@ func (scanner *resourceScanner) check(
 			logger.Error("failed-to-set-check-error-on-resource-config", chkErr)
 		}
 
+		historyEntry.CheckError = err.Error()
+		scanner.saveCheckHistoryEntry(logger, savedResource, historyEntry)
+
 		return err
 	}
 
+	historyEntry.WorkerName = res.Container().WorkerName()
+
 	logger.Debug("checking", lager.Data{
 		"from": fromVersion,
 	})
 
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := context.WithTimeout(spanCtx, timeout)
 	defer cancel()
 
 	newVersions, err := res.Check(ctx, source, fromVersion)
@@ -326,6 +346,21 @@ func (scanner *resourceScanner) check(
 		err = fmt.Errorf("Timed out after %v while checking for new versions - perhaps increase your resource check timeout?", timeout)
 	}
 
+	historyEntry.Versions = newVersions
+	if err != nil {
+		historyEntry.CheckError = err.Error()
+
+		if rErr, ok := err.(resource.ErrResourceScriptFailed); ok {
+			historyEntry.ExitStatus = &rErr.ExitStatus
+			historyEntry.Stderr = rErr.Stderr
+		}
+	} else {
+		exitStatus := 0
+		historyEntry.ExitStatus = &exitStatus
+	}
+
+	scanner.saveCheckHistoryEntry(logger, savedResource, historyEntry)
+
 	resourceConfig.SetCheckError(err)
 	metric.ResourceCheck{
 		PipelineName: scanner.dbPipeline.Name(),
@@ -406,4 +441,13 @@ func (scanner *resourceScanner) setResourceCheckError(logger lager.Logger, saved
 	}
 }
 
+func (scanner *resourceScanner) saveCheckHistoryEntry(logger lager.Logger, savedResource db.Resource, entry db.CheckHistoryEntry) {
+	entry.EndTime = scanner.clock.Now()
+
+	err := savedResource.SaveCheckHistoryEntry(entry)
+	if err != nil {
+		logger.Error("failed-to-save-check-history-entry", err)
+	}
+}
+
 var errPipelineRemoved = errors.New("pipeline removed")
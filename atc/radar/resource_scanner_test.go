@@ -18,6 +18,7 @@ import (
 	"github.com/concourse/concourse/atc/radar"
 	"github.com/concourse/concourse/atc/radar/radarfakes"
 	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
 
 	. "github.com/concourse/concourse/atc/radar"
 	"github.com/concourse/concourse/atc/resource"
@@ -132,6 +133,10 @@ var _ = Describe("ResourceScanner", func() {
 		BeforeEach(func() {
 			fakeResource = new(rfakes.FakeResource)
 			fakeResourceFactory.NewResourceReturns(fakeResource, nil)
+
+			fakeContainer := new(workerfakes.FakeContainer)
+			fakeContainer.WorkerNameReturns("some-worker")
+			fakeResource.ContainerReturns(fakeContainer)
 		})
 
 		JustBeforeEach(func() {
@@ -357,6 +362,18 @@ var _ = Describe("ResourceScanner", func() {
 					}))
 				})
 
+				It("records the check and the versions it found", func() {
+					Expect(fakeDBResource.SaveCheckHistoryEntryCallCount()).To(Equal(1))
+
+					entry := fakeDBResource.SaveCheckHistoryEntryArgsForCall(0)
+					Expect(entry.StartTime).To(Equal(epoch))
+					Expect(entry.EndTime).To(Equal(epoch))
+					Expect(entry.WorkerName).To(Equal("some-worker"))
+					Expect(*entry.ExitStatus).To(Equal(0))
+					Expect(entry.CheckError).To(BeEmpty())
+					Expect(entry.Versions).To(Equal(nextVersions))
+				})
+
 				Context("when saving versions fails", func() {
 					BeforeEach(func() {
 						fakeResourceConfig.SaveVersionsReturns(errors.New("failed"))
@@ -382,7 +399,10 @@ var _ = Describe("ResourceScanner", func() {
 			})
 
 			Context("when checking fails with ErrResourceScriptFailed", func() {
-				scriptFail := resource.ErrResourceScriptFailed{}
+				scriptFail := resource.ErrResourceScriptFailed{
+					ExitStatus: 2,
+					Stderr:     "some-stderr",
+				}
 
 				BeforeEach(func() {
 					fakeResource.CheckReturns(nil, scriptFail)
@@ -391,6 +411,16 @@ var _ = Describe("ResourceScanner", func() {
 				It("returns no error", func() {
 					Expect(runErr).NotTo(HaveOccurred())
 				})
+
+				It("records the exit status and stderr of the check", func() {
+					Expect(fakeDBResource.SaveCheckHistoryEntryCallCount()).To(Equal(1))
+
+					entry := fakeDBResource.SaveCheckHistoryEntryArgsForCall(0)
+					Expect(entry.WorkerName).To(Equal("some-worker"))
+					Expect(*entry.ExitStatus).To(Equal(2))
+					Expect(entry.Stderr).To(Equal("some-stderr"))
+					Expect(entry.CheckError).To(Equal(scriptFail.Error()))
+				})
 			})
 
 			Context("when the pipeline is paused", func() {
@@ -460,6 +490,10 @@ var _ = Describe("ResourceScanner", func() {
 		BeforeEach(func() {
 			fakeResource = new(rfakes.FakeResource)
 			fakeResourceFactory.NewResourceReturns(fakeResource, nil)
+
+			fakeContainer := new(workerfakes.FakeContainer)
+			fakeContainer.WorkerNameReturns("some-worker")
+			fakeResource.ContainerReturns(fakeContainer)
 		})
 
 		JustBeforeEach(func() {
@@ -584,6 +618,15 @@ var _ = Describe("ResourceScanner", func() {
 					resourceErr := fakeResourceConfig.SetCheckErrorArgsForCall(0)
 					Expect(resourceErr).To(MatchError("catastrophe"))
 				})
+
+				It("records the check without a worker or exit status", func() {
+					Expect(fakeDBResource.SaveCheckHistoryEntryCallCount()).To(Equal(1))
+
+					entry := fakeDBResource.SaveCheckHistoryEntryArgsForCall(0)
+					Expect(entry.WorkerName).To(BeEmpty())
+					Expect(entry.ExitStatus).To(BeNil())
+					Expect(entry.CheckError).To(Equal("catastrophe"))
+				})
 			})
 
 			Context("when creating the resource checker fails with no global workers", func() {
@@ -904,6 +947,10 @@ var _ = Describe("ResourceScanner", func() {
 		BeforeEach(func() {
 			fakeResource = new(rfakes.FakeResource)
 			fakeResourceFactory.NewResourceReturns(fakeResource, nil)
+
+			fakeContainer := new(workerfakes.FakeContainer)
+			fakeContainer.WorkerNameReturns("some-worker")
+			fakeResource.ContainerReturns(fakeContainer)
 			fromVersion = nil
 		})
 
@@ -11,6 +11,7 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -197,7 +198,7 @@ func (scanner *resourceTypeScanner) check(
 	versionedResourceTypes creds.VersionedResourceTypes,
 	source atc.Source,
 	saveGiven bool,
-) error {
+) (err error) {
 	pipelinePaused, err := scanner.dbPipeline.CheckPaused()
 	if err != nil {
 		logger.Error("failed-to-check-if-pipeline-paused", err)
@@ -209,6 +210,16 @@ func (scanner *resourceTypeScanner) check(
 		return nil
 	}
 
+	ctx, span := tracing.StartSpan(context.Background(), "check", tracing.Attrs{
+		"team":          scanner.dbPipeline.TeamName(),
+		"pipeline":      scanner.dbPipeline.Name(),
+		"resource_type": savedResourceType.Name(),
+		"type":          savedResourceType.Type(),
+	})
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	containerSpec := worker.ContainerSpec{
 		ImageSpec: worker.ImageSpec{
 			ResourceType: savedResourceType.Type(),
@@ -224,7 +235,7 @@ func (scanner *resourceTypeScanner) check(
 	}
 
 	res, err := scanner.resourceFactory.NewResource(
-		context.Background(),
+		ctx,
 		logger,
 		db.NewResourceConfigCheckSessionContainerOwner(resourceConfig, ContainerExpiries),
 		db.ContainerMetadata{
@@ -250,7 +261,7 @@ func (scanner *resourceTypeScanner) check(
 		return err
 	}
 
-	newVersions, err := res.Check(context.TODO(), source, fromVersion)
+	newVersions, err := res.Check(ctx, source, fromVersion)
 	resourceConfig.SetCheckError(err)
 	if err != nil {
 		if rErr, ok := err.(resource.ErrResourceScriptFailed); ok {
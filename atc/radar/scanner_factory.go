@@ -4,15 +4,25 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/resource"
 )
 
+//go:generate counterfeiter . Scanner
+
+type Scanner interface {
+	Run(lager.Logger, string) (time.Duration, error)
+	Scan(lager.Logger, string) error
+	ScanFromVersion(lager.Logger, string, atc.Version) error
+}
+
 // ScannerFactory is the same interface as resourceserver/server.go
 // They are in two places because there would be cyclic dependencies otherwise
 
-// go:generate counterfeiter . ScannerFactory
+//go:generate counterfeiter . ScannerFactory
 type ScannerFactory interface {
 	NewResourceScanner(dbPipeline db.Pipeline) Scanner
 	NewResourceTypeScanner(dbPipeline db.Pipeline) Scanner
@@ -28,7 +28,7 @@ type FetchSourceProviderFactory interface {
 //go:generate counterfeiter . FetchSourceProvider
 
 type FetchSourceProvider interface {
-	Get() (FetchSource, error)
+	Get(context.Context) (FetchSource, error)
 }
 
 //go:generate counterfeiter . FetchSource
@@ -91,7 +91,7 @@ type fetchSourceProvider struct {
 	dbResourceCacheFactory db.ResourceCacheFactory
 }
 
-func (f *fetchSourceProvider) Get() (FetchSource, error) {
+func (f *fetchSourceProvider) Get(ctx context.Context) (FetchSource, error) {
 	resourceSpec := worker.WorkerSpec{
 		ResourceType:  string(f.resourceInstance.ResourceType()),
 		Tags:          f.tags,
@@ -99,7 +99,7 @@ func (f *fetchSourceProvider) Get() (FetchSource, error) {
 		ResourceTypes: f.resourceTypes,
 	}
 
-	chosenWorker, err := f.workerClient.Satisfying(f.logger.Session("fetch-source-provider"), resourceSpec)
+	chosenWorker, err := f.workerClient.Satisfying(ctx, f.logger.Session("fetch-source-provider"), f.imageFetchingDelegate, resourceSpec)
 	if err != nil {
 		f.logger.Error("no-workers-satisfying-spec", err)
 		return nil, err
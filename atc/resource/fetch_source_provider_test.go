@@ -1,6 +1,7 @@
 package resource_test
 
 import (
+	"context"
 	"errors"
 
 	"code.cloudfoundry.org/lager"
@@ -78,10 +79,11 @@ var _ = Describe("FetchSourceProvider", func() {
 
 	Describe("Get", func() {
 		It("tries to find satisfying worker", func() {
-			_, err := fetchSourceProvider.Get()
+			_, err := fetchSourceProvider.Get(context.TODO())
 			Expect(err).NotTo(HaveOccurred())
 			Expect(fakeWorkerClient.SatisfyingCallCount()).To(Equal(1))
-			_, workerSpec := fakeWorkerClient.SatisfyingArgsForCall(0)
+			_, _, delegate, workerSpec := fakeWorkerClient.SatisfyingArgsForCall(0)
+			Expect(delegate).To(Equal(fakeBuildStepDelegate))
 			Expect(workerSpec).To(Equal(worker.WorkerSpec{
 				ResourceType:  "some-resource-type",
 				Tags:          tags,
@@ -99,7 +101,7 @@ var _ = Describe("FetchSourceProvider", func() {
 			})
 
 			It("returns resource instance source", func() {
-				source, err := fetchSourceProvider.Get()
+				source, err := fetchSourceProvider.Get(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 
 				expectedSource := resource.NewResourceInstanceFetchSource(
@@ -127,7 +129,7 @@ var _ = Describe("FetchSourceProvider", func() {
 			})
 
 			It("returns an error", func() {
-				_, err := fetchSourceProvider.Get()
+				_, err := fetchSourceProvider.Get(context.TODO())
 				Expect(err).To(HaveOccurred())
 				Expect(err).To(Equal(workerNotFoundErr))
 			})
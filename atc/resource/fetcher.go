@@ -75,7 +75,7 @@ func (f *fetcher) Fetch(
 		imageFetchingDelegate,
 	)
 
-	source, err := sourceProvider.Get()
+	source, err := sourceProvider.Get(ctx)
 	if err != nil {
 		return nil, err
 	}
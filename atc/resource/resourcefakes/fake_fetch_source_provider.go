@@ -2,15 +2,17 @@
 package resourcefakes
 
 import (
+	context "context"
 	sync "sync"
 
 	resource "github.com/concourse/concourse/atc/resource"
 )
 
 type FakeFetchSourceProvider struct {
-	GetStub        func() (resource.FetchSource, error)
+	GetStub        func(context.Context) (resource.FetchSource, error)
 	getMutex       sync.RWMutex
 	getArgsForCall []struct {
+		arg1 context.Context
 	}
 	getReturns struct {
 		result1 resource.FetchSource
@@ -24,15 +26,16 @@ type FakeFetchSourceProvider struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeFetchSourceProvider) Get() (resource.FetchSource, error) {
+func (fake *FakeFetchSourceProvider) Get(arg1 context.Context) (resource.FetchSource, error) {
 	fake.getMutex.Lock()
 	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
 	fake.getArgsForCall = append(fake.getArgsForCall, struct {
-	}{})
-	fake.recordInvocation("Get", []interface{}{})
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("Get", []interface{}{arg1})
 	fake.getMutex.Unlock()
 	if fake.GetStub != nil {
-		return fake.GetStub()
+		return fake.GetStub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -47,12 +50,19 @@ func (fake *FakeFetchSourceProvider) GetCallCount() int {
 	return len(fake.getArgsForCall)
 }
 
-func (fake *FakeFetchSourceProvider) GetCalls(stub func() (resource.FetchSource, error)) {
+func (fake *FakeFetchSourceProvider) GetCalls(stub func(context.Context) (resource.FetchSource, error)) {
 	fake.getMutex.Lock()
 	defer fake.getMutex.Unlock()
 	fake.GetStub = stub
 }
 
+func (fake *FakeFetchSourceProvider) GetArgsForCall(i int) context.Context {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeFetchSourceProvider) GetReturns(result1 resource.FetchSource, result2 error) {
 	fake.getMutex.Lock()
 	defer fake.getMutex.Unlock()
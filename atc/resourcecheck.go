@@ -8,3 +8,14 @@ type CheckResponseBody struct {
 	ExitStatus int    `json:"exit_status"`
 	Stderr     string `json:"stderr"`
 }
+
+type ResourceCheckHistoryEntry struct {
+	ID         int       `json:"id"`
+	StartTime  int64     `json:"start_time"`
+	EndTime    int64     `json:"end_time"`
+	WorkerName string    `json:"worker_name,omitempty"`
+	ExitStatus *int      `json:"exit_status,omitempty"`
+	Stderr     string    `json:"stderr,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	Versions   []Version `json:"versions,omitempty"`
+}
@@ -10,35 +10,43 @@ const (
 	GetBuildPlan        = "GetBuildPlan"
 	CreateBuild         = "CreateBuild"
 	ListBuilds          = "ListBuilds"
+	ListBuildQueue      = "ListBuildQueue"
 	BuildEvents         = "BuildEvents"
 	BuildResources      = "BuildResources"
+	ListBuildArtifacts  = "ListBuildArtifacts"
+	GetBuildArtifact    = "GetBuildArtifact"
 	AbortBuild          = "AbortBuild"
 	GetBuildPreparation = "GetBuildPreparation"
 
-	GetJob         = "GetJob"
-	CreateJobBuild = "CreateJobBuild"
-	ListAllJobs    = "ListAllJobs"
-	ListJobs       = "ListJobs"
-	ListJobBuilds  = "ListJobBuilds"
-	ListJobInputs  = "ListJobInputs"
-	GetJobBuild    = "GetJobBuild"
-	PauseJob       = "PauseJob"
-	UnpauseJob     = "UnpauseJob"
-	GetVersionsDB  = "GetVersionsDB"
-	JobBadge       = "JobBadge"
-	MainJobBadge   = "MainJobBadge"
+	GetJob             = "GetJob"
+	CreateJobBuild     = "CreateJobBuild"
+	RerunJobBuild      = "RerunJobBuild"
+	ApproveJobBuild    = "ApproveJobBuild"
+	RejectJobBuild     = "RejectJobBuild"
+	ListAllJobs        = "ListAllJobs"
+	ListJobs           = "ListJobs"
+	ListJobBuilds      = "ListJobBuilds"
+	ListJobInputs      = "ListJobInputs"
+	GetJobBuild        = "GetJobBuild"
+	ListJobBuildInputs = "ListJobBuildInputs"
+	PauseJob           = "PauseJob"
+	UnpauseJob         = "UnpauseJob"
+	GetVersionsDB      = "GetVersionsDB"
+	JobBadge           = "JobBadge"
+	MainJobBadge       = "MainJobBadge"
 
 	ClearTaskCache = "ClearTaskCache"
 
-	ListAllResources     = "ListAllResources"
-	ListResources        = "ListResources"
-	ListResourceTypes    = "ListResourceTypes"
-	GetResource          = "GetResource"
-	PauseResource        = "PauseResource"
-	UnpauseResource      = "UnpauseResource"
-	CheckResource        = "CheckResource"
-	CheckResourceWebHook = "CheckResourceWebHook"
-	CheckResourceType    = "CheckResourceType"
+	ListAllResources         = "ListAllResources"
+	ListResources            = "ListResources"
+	ListResourceTypes        = "ListResourceTypes"
+	GetResource              = "GetResource"
+	PauseResource            = "PauseResource"
+	UnpauseResource          = "UnpauseResource"
+	CheckResource            = "CheckResource"
+	CheckResourceWebHook     = "CheckResourceWebHook"
+	CheckResourceType        = "CheckResourceType"
+	ListResourceCheckHistory = "ListResourceCheckHistory"
 
 	ListResourceVersions          = "ListResourceVersions"
 	GetResourceVersion            = "GetResourceVersion"
@@ -66,6 +74,8 @@ const (
 	CreatePipelineBuild = "CreatePipelineBuild"
 	PipelineBadge       = "PipelineBadge"
 
+	ListNotificationDeliveries = "ListNotificationDeliveries"
+
 	RegisterWorker  = "RegisterWorker"
 	LandWorker      = "LandWorker"
 	RetireWorker    = "RetireWorker"
@@ -113,12 +123,15 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/builds", Method: "POST", Name: CreateBuild},
 
 	{Path: "/api/v1/builds", Method: "GET", Name: ListBuilds},
+	{Path: "/api/v1/build-queue", Method: "GET", Name: ListBuildQueue},
 	{Path: "/api/v1/builds/:build_id", Method: "GET", Name: GetBuild},
 	{Path: "/api/v1/builds/:build_id/plan", Method: "GET", Name: GetBuildPlan},
 	{Path: "/api/v1/builds/:build_id/plan/:plan_id/input", Method: "PUT", Name: SendInputToBuildPlan},
 	{Path: "/api/v1/builds/:build_id/plan/:plan_id/output", Method: "GET", Name: ReadOutputFromBuildPlan},
 	{Path: "/api/v1/builds/:build_id/events", Method: "GET", Name: BuildEvents},
 	{Path: "/api/v1/builds/:build_id/resources", Method: "GET", Name: BuildResources},
+	{Path: "/api/v1/builds/:build_id/artifacts", Method: "GET", Name: ListBuildArtifacts},
+	{Path: "/api/v1/builds/:build_id/artifacts/:artifact_name", Method: "GET", Name: GetBuildArtifact},
 	{Path: "/api/v1/builds/:build_id/abort", Method: "PUT", Name: AbortBuild},
 	{Path: "/api/v1/builds/:build_id/preparation", Method: "GET", Name: GetBuildPreparation},
 
@@ -129,6 +142,10 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds", Method: "POST", Name: CreateJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/inputs", Method: "GET", Name: ListJobInputs},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", Method: "GET", Name: GetJobBuild},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name/inputs", Method: "GET", Name: ListJobBuildInputs},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", Method: "POST", Name: RerunJobBuild},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name/approve", Method: "PUT", Name: ApproveJobBuild},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name/reject", Method: "PUT", Name: RejectJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/pause", Method: "PUT", Name: PauseJob},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/unpause", Method: "PUT", Name: UnpauseJob},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/badge", Method: "GET", Name: JobBadge},
@@ -150,6 +167,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/builds", Method: "GET", Name: ListPipelineBuilds},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/builds", Method: "POST", Name: CreatePipelineBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/badge", Method: "GET", Name: PipelineBadge},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/notification-deliveries", Method: "GET", Name: ListNotificationDeliveries},
 
 	{Path: "/api/v1/resources", Method: "GET", Name: ListAllResources},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources", Method: "GET", Name: ListResources},
@@ -158,6 +176,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check", Method: "POST", Name: CheckResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check/webhook", Method: "POST", Name: CheckResourceWebHook},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resource-types/:resource_type_name/check", Method: "POST", Name: CheckResourceType},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check-history", Method: "GET", Name: ListResourceCheckHistory},
 
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions", Method: "GET", Name: ListResourceVersions},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id", Method: "GET", Name: GetResourceVersion},
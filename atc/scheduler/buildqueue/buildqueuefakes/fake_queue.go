@@ -0,0 +1,191 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package buildqueuefakes
+
+import (
+	sync "sync"
+
+	lager "code.cloudfoundry.org/lager"
+	atc "github.com/concourse/concourse/atc"
+	db "github.com/concourse/concourse/atc/db"
+	buildqueue "github.com/concourse/concourse/atc/scheduler/buildqueue"
+)
+
+type FakeQueue struct {
+	AdmitStub        func(lager.Logger, db.Job, db.Build) (bool, error)
+	admitMutex       sync.RWMutex
+	admitArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 db.Job
+		arg3 db.Build
+	}
+	admitReturns struct {
+		result1 bool
+		result2 error
+	}
+	admitReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	SnapshotStub        func() (atc.BuildQueue, error)
+	snapshotMutex       sync.RWMutex
+	snapshotArgsForCall []struct {
+	}
+	snapshotReturns struct {
+		result1 atc.BuildQueue
+		result2 error
+	}
+	snapshotReturnsOnCall map[int]struct {
+		result1 atc.BuildQueue
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeQueue) Admit(arg1 lager.Logger, arg2 db.Job, arg3 db.Build) (bool, error) {
+	fake.admitMutex.Lock()
+	ret, specificReturn := fake.admitReturnsOnCall[len(fake.admitArgsForCall)]
+	fake.admitArgsForCall = append(fake.admitArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 db.Job
+		arg3 db.Build
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Admit", []interface{}{arg1, arg2, arg3})
+	fake.admitMutex.Unlock()
+	if fake.AdmitStub != nil {
+		return fake.AdmitStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.admitReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeQueue) AdmitCallCount() int {
+	fake.admitMutex.RLock()
+	defer fake.admitMutex.RUnlock()
+	return len(fake.admitArgsForCall)
+}
+
+func (fake *FakeQueue) AdmitCalls(stub func(lager.Logger, db.Job, db.Build) (bool, error)) {
+	fake.admitMutex.Lock()
+	defer fake.admitMutex.Unlock()
+	fake.AdmitStub = stub
+}
+
+func (fake *FakeQueue) AdmitArgsForCall(i int) (lager.Logger, db.Job, db.Build) {
+	fake.admitMutex.RLock()
+	defer fake.admitMutex.RUnlock()
+	argsForCall := fake.admitArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeQueue) AdmitReturns(result1 bool, result2 error) {
+	fake.admitMutex.Lock()
+	defer fake.admitMutex.Unlock()
+	fake.AdmitStub = nil
+	fake.admitReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQueue) AdmitReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.admitMutex.Lock()
+	defer fake.admitMutex.Unlock()
+	fake.AdmitStub = nil
+	if fake.admitReturnsOnCall == nil {
+		fake.admitReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.admitReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQueue) Snapshot() (atc.BuildQueue, error) {
+	fake.snapshotMutex.Lock()
+	ret, specificReturn := fake.snapshotReturnsOnCall[len(fake.snapshotArgsForCall)]
+	fake.snapshotArgsForCall = append(fake.snapshotArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Snapshot", []interface{}{})
+	fake.snapshotMutex.Unlock()
+	if fake.SnapshotStub != nil {
+		return fake.SnapshotStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.snapshotReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeQueue) SnapshotCallCount() int {
+	fake.snapshotMutex.RLock()
+	defer fake.snapshotMutex.RUnlock()
+	return len(fake.snapshotArgsForCall)
+}
+
+func (fake *FakeQueue) SnapshotCalls(stub func() (atc.BuildQueue, error)) {
+	fake.snapshotMutex.Lock()
+	defer fake.snapshotMutex.Unlock()
+	fake.SnapshotStub = stub
+}
+
+func (fake *FakeQueue) SnapshotReturns(result1 atc.BuildQueue, result2 error) {
+	fake.snapshotMutex.Lock()
+	defer fake.snapshotMutex.Unlock()
+	fake.SnapshotStub = nil
+	fake.snapshotReturns = struct {
+		result1 atc.BuildQueue
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQueue) SnapshotReturnsOnCall(i int, result1 atc.BuildQueue, result2 error) {
+	fake.snapshotMutex.Lock()
+	defer fake.snapshotMutex.Unlock()
+	fake.SnapshotStub = nil
+	if fake.snapshotReturnsOnCall == nil {
+		fake.snapshotReturnsOnCall = make(map[int]struct {
+			result1 atc.BuildQueue
+			result2 error
+		})
+	}
+	fake.snapshotReturnsOnCall[i] = struct {
+		result1 atc.BuildQueue
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQueue) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.admitMutex.RLock()
+	defer fake.admitMutex.RUnlock()
+	fake.snapshotMutex.RLock()
+	defer fake.snapshotMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeQueue) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ buildqueue.Queue = new(FakeQueue)
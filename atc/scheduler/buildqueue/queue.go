@@ -0,0 +1,313 @@
+package buildqueue
+
+import (
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+const TeamMaxInFlightReached = "team has reached its max in flight"
+
+// Policy configures how the queued builds of each team share the cluster.
+type Policy struct {
+	// TeamWeights gives teams a larger share of the running builds. Teams
+	// without a weight have a weight of 1.
+	TeamWeights map[string]int
+
+	// MaxInFlightPerTeam caps the running builds of every team. Zero means
+	// no cap.
+	MaxInFlightPerTeam int
+
+	// TeamMaxInFlight overrides MaxInFlightPerTeam for individual teams.
+	TeamMaxInFlight map[string]int
+}
+
+func (p Policy) Weight(teamName string) int {
+	if weight, found := p.TeamWeights[teamName]; found && weight > 0 {
+		return weight
+	}
+
+	return 1
+}
+
+// Limited reports whether any team's running builds are capped. Otherwise
+// every build may start straight away, so builds are not queued at all.
+func (p Policy) Limited() bool {
+	if p.MaxInFlightPerTeam > 0 {
+		return true
+	}
+
+	for _, maxInFlight := range p.TeamMaxInFlight {
+		if maxInFlight > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p Policy) MaxInFlight(teamName string) int {
+	if maxInFlight, found := p.TeamMaxInFlight[teamName]; found {
+		return maxInFlight
+	}
+
+	return p.MaxInFlightPerTeam
+}
+
+//go:generate counterfeiter . Queue
+
+// A Queue decides which of the builds that are ready to start across the
+// cluster may start next.
+type Queue interface {
+	// Admit queues the build if it is not queued already and reports whether
+	// it may start. A build may start if the policy allows it to once the
+	// builds ahead of it in the queue have started. An admitted build is
+	// removed from the queue and marked as scheduled.
+	Admit(logger lager.Logger, job db.Job, build db.Build) (bool, error)
+
+	Snapshot() (atc.BuildQueue, error)
+}
+
+// NewQueue constructs a Queue. Builds which have not been seen waiting
+// within the staleness window, e.g. because their pipeline has been paused,
+// no longer hold a place in the queue.
+func NewQueue(buildQueue db.BuildQueue, policy Policy, staleness time.Duration) Queue {
+	return &queue{
+		buildQueue: buildQueue,
+		policy:     policy,
+		staleness:  staleness,
+	}
+}
+
+type queue struct {
+	buildQueue db.BuildQueue
+	policy     Policy
+	staleness  time.Duration
+}
+
+func (q *queue) Admit(logger lager.Logger, job db.Job, build db.Build) (bool, error) {
+	if !q.policy.Limited() {
+		return true, nil
+	}
+
+	logger = logger.Session("admit", lager.Data{
+		"build-id": build.ID(),
+		"team":     build.TeamName(),
+	})
+
+	admitted, err := q.buildQueue.Admit(build.ID(), job.Config().Priority, q.staleness, q.admits)
+	if err != nil {
+		logger.Error("failed-to-admit-build", err)
+		return false, err
+	}
+
+	if !admitted {
+		logger.Debug("waiting-in-queue")
+	}
+
+	return admitted, nil
+}
+
+// admits reports whether the build is among those the policy allows to start
+// right now.
+func (q *queue) admits(buildID int, queued []db.QueuedBuild, active map[string]int) bool {
+	startable, _ := admissionOrder(q.teams(queued, active))
+	for _, build := range startable {
+		if build.BuildID == buildID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (q *queue) Snapshot() (atc.BuildQueue, error) {
+	queued, err := q.buildQueue.QueuedBuilds(q.staleness)
+	if err != nil {
+		return atc.BuildQueue{}, err
+	}
+
+	active, err := q.buildQueue.ActiveBuildsByTeam()
+	if err != nil {
+		return atc.BuildQueue{}, err
+	}
+
+	teams := q.teams(queued, active)
+
+	snapshot := atc.BuildQueue{
+		Teams:  []atc.BuildQueueTeam{},
+		Builds: []atc.BuildQueueEntry{},
+	}
+
+	for _, team := range teams {
+		snapshot.Teams = append(snapshot.Teams, atc.BuildQueueTeam{
+			Name:        team.name,
+			Active:      team.active,
+			Queued:      len(team.builds),
+			Weight:      team.weight,
+			MaxInFlight: team.maxInFlight,
+		})
+	}
+
+	startable, blocked := admissionOrder(teams)
+
+	for _, build := range startable {
+		snapshot.Builds = append(snapshot.Builds, entry(build, len(snapshot.Builds)+1, ""))
+	}
+
+	for _, build := range blocked {
+		snapshot.Builds = append(snapshot.Builds, entry(build, len(snapshot.Builds)+1, TeamMaxInFlightReached))
+	}
+
+	return snapshot, nil
+}
+
+func (q *queue) teams(queued []db.QueuedBuild, active map[string]int) []*teamQueue {
+	byName := map[string]*teamQueue{}
+	team := func(name string) *teamQueue {
+		if _, found := byName[name]; !found {
+			byName[name] = &teamQueue{
+				name:        name,
+				active:      active[name],
+				weight:      q.policy.Weight(name),
+				maxInFlight: q.policy.MaxInFlight(name),
+			}
+		}
+
+		return byName[name]
+	}
+
+	for name := range active {
+		team(name)
+	}
+
+	for _, build := range queued {
+		t := team(build.TeamName)
+		t.builds = append(t.builds, build)
+	}
+
+	teams := []*teamQueue{}
+	for _, t := range byName {
+		sort.SliceStable(t.builds, func(i, j int) bool {
+			return t.builds[i].Priority > t.builds[j].Priority
+		})
+
+		teams = append(teams, t)
+	}
+
+	sort.Slice(teams, func(i, j int) bool {
+		return teams[i].name < teams[j].name
+	})
+
+	return teams
+}
+
+// admissionOrder simulates starting the queued builds one at a time, assuming
+// no running builds finish in the meantime. It returns the builds which may
+// start in the order they start in, and the builds which are held back by
+// their team's cap.
+func admissionOrder(teams []*teamQueue) ([]db.QueuedBuild, []db.QueuedBuild) {
+	simulated := make([]*teamQueue, len(teams))
+	for i, team := range teams {
+		copied := *team
+		simulated[i] = &copied
+	}
+
+	startable := []db.QueuedBuild{}
+	for {
+		candidates := nextTeams(simulated)
+		if len(candidates) == 0 {
+			break
+		}
+
+		team := candidates[0]
+		for _, candidate := range candidates[1:] {
+			if queuedBefore(candidate.builds[0], team.builds[0]) {
+				team = candidate
+			}
+		}
+
+		startable = append(startable, team.builds[0])
+
+		team.builds = team.builds[1:]
+		team.active++
+	}
+
+	blocked := []db.QueuedBuild{}
+	for _, team := range simulated {
+		blocked = append(blocked, team.builds...)
+	}
+
+	return startable, blocked
+}
+
+type teamQueue struct {
+	name        string
+	active      int
+	weight      int
+	maxInFlight int
+
+	// highest priority first, then oldest first
+	builds []db.QueuedBuild
+}
+
+func (t *teamQueue) capped() bool {
+	return t.maxInFlight > 0 && t.active >= t.maxInFlight
+}
+
+// lessLoaded reports whether the team is running fewer builds for its weight
+// than the other team.
+func (t *teamQueue) lessLoaded(other *teamQueue) bool {
+	return t.active*other.weight < other.active*t.weight
+}
+
+// nextTeams returns the teams whose next queued build may start now: those
+// with the fewest running builds for their weight among the teams that have
+// builds queued and have not reached their cap.
+func nextTeams(teams []*teamQueue) []*teamQueue {
+	next := []*teamQueue{}
+	for _, team := range teams {
+		if len(team.builds) == 0 || team.capped() {
+			continue
+		}
+
+		if len(next) > 0 {
+			if next[0].lessLoaded(team) {
+				continue
+			}
+
+			if team.lessLoaded(next[0]) {
+				next = next[:0]
+			}
+		}
+
+		next = append(next, team)
+	}
+
+	return next
+}
+
+func queuedBefore(a, b db.QueuedBuild) bool {
+	if a.QueueTime.Equal(b.QueueTime) {
+		return a.BuildID < b.BuildID
+	}
+
+	return a.QueueTime.Before(b.QueueTime)
+}
+
+func entry(build db.QueuedBuild, position int, blocked string) atc.BuildQueueEntry {
+	return atc.BuildQueueEntry{
+		Position:     position,
+		BuildID:      build.BuildID,
+		BuildName:    build.BuildName,
+		TeamName:     build.TeamName,
+		PipelineName: build.PipelineName,
+		JobName:      build.JobName,
+		Priority:     build.Priority,
+		QueuedAt:     build.QueueTime.Unix(),
+		Blocked:      blocked,
+	}
+}
@@ -0,0 +1,258 @@
+package buildqueue_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/scheduler/buildqueue"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Queue", func() {
+	var (
+		fakeBuildQueue *dbfakes.FakeBuildQueue
+		policy         buildqueue.Policy
+		queue          buildqueue.Queue
+
+		queueTime time.Time
+		queued    []db.QueuedBuild
+		queuedErr error
+		active    map[string]int
+	)
+
+	queuedBuild := func(id int, team string, priority int, age time.Duration) db.QueuedBuild {
+		return db.QueuedBuild{
+			BuildID:   id,
+			BuildName: "1",
+			TeamName:  team,
+			JobName:   "some-job",
+			Priority:  priority,
+			QueueTime: queueTime.Add(-age),
+		}
+	}
+
+	BeforeEach(func() {
+		fakeBuildQueue = new(dbfakes.FakeBuildQueue)
+		policy = buildqueue.Policy{}
+
+		queueTime = time.Unix(1552924800, 0)
+		queued = nil
+		queuedErr = nil
+		active = map[string]int{}
+	})
+
+	JustBeforeEach(func() {
+		fakeBuildQueue.QueuedBuildsReturns(queued, queuedErr)
+		fakeBuildQueue.ActiveBuildsByTeamReturns(active, nil)
+
+		queue = buildqueue.NewQueue(fakeBuildQueue, policy, time.Minute)
+	})
+
+	Describe("Admit", func() {
+		var (
+			job   *dbfakes.FakeJob
+			build *dbfakes.FakeBuild
+
+			admitErr error
+			admitted bool
+		)
+
+		BeforeEach(func() {
+			job = new(dbfakes.FakeJob)
+			job.ConfigReturns(atc.JobConfig{Name: "some-job", Priority: 5})
+
+			build = new(dbfakes.FakeBuild)
+			build.IDReturns(1)
+			build.TeamNameReturns("team-a")
+
+			policy.MaxInFlightPerTeam = 10
+		})
+
+		JustBeforeEach(func() {
+			fakeBuildQueue.AdmitStub = func(buildID int, _ int, _ time.Duration, admit db.AdmissionPolicy) (bool, error) {
+				if queuedErr != nil {
+					return false, queuedErr
+				}
+
+				return admit(buildID, queued, active), nil
+			}
+
+			admitted, admitErr = queue.Admit(lagertest.NewTestLogger("test"), job, build)
+		})
+
+		It("queues the build with the job's priority, considering builds seen within the staleness window", func() {
+			Expect(fakeBuildQueue.AdmitCallCount()).To(Equal(1))
+			buildID, priority, staleness, _ := fakeBuildQueue.AdmitArgsForCall(0)
+			Expect(buildID).To(Equal(1))
+			Expect(priority).To(Equal(5))
+			Expect(staleness).To(Equal(time.Minute))
+		})
+
+		Context("when no team's builds are capped", func() {
+			BeforeEach(func() {
+				policy = buildqueue.Policy{TeamWeights: map[string]int{"team-a": 2}}
+			})
+
+			It("admits the build without queueing it", func() {
+				Expect(admitErr).ToNot(HaveOccurred())
+				Expect(admitted).To(BeTrue())
+				Expect(fakeBuildQueue.AdmitCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the build is the only one queued", func() {
+			BeforeEach(func() {
+				queued = []db.QueuedBuild{queuedBuild(1, "team-a", 5, 0)}
+			})
+
+			It("admits the build", func() {
+				Expect(admitErr).ToNot(HaveOccurred())
+				Expect(admitted).To(BeTrue())
+			})
+
+			Context("when the team has reached the cluster-wide cap", func() {
+				BeforeEach(func() {
+					policy.MaxInFlightPerTeam = 2
+					active["team-a"] = 2
+				})
+
+				It("keeps the build queued", func() {
+					Expect(admitErr).ToNot(HaveOccurred())
+					Expect(admitted).To(BeFalse())
+				})
+
+				Context("when the team has its own cap", func() {
+					BeforeEach(func() {
+						policy.TeamMaxInFlight = map[string]int{"team-a": 3}
+					})
+
+					It("admits the build", func() {
+						Expect(admitted).To(BeTrue())
+					})
+				})
+			})
+		})
+
+		Context("when other builds of the same team are ahead of it", func() {
+			BeforeEach(func() {
+				queued = []db.QueuedBuild{
+					queuedBuild(2, "team-a", 5, time.Minute),
+					queuedBuild(1, "team-a", 5, 0),
+					queuedBuild(3, "team-a", 10, 0),
+				}
+			})
+
+			It("admits the build if the team's cap leaves room for the builds ahead of it", func() {
+				Expect(admitted).To(BeTrue())
+			})
+
+			Context("when the team's cap only leaves room for the builds ahead of it", func() {
+				BeforeEach(func() {
+					active["team-a"] = 8
+				})
+
+				It("keeps the build queued", func() {
+					Expect(admitted).To(BeFalse())
+				})
+			})
+		})
+
+		Context("when another team has builds queued", func() {
+			BeforeEach(func() {
+				policy.MaxInFlightPerTeam = 4
+				active["team-a"] = 3
+				active["team-b"] = 3
+
+				queued = []db.QueuedBuild{
+					queuedBuild(2, "team-b", 100, time.Minute),
+					queuedBuild(3, "team-b", 100, time.Minute),
+					queuedBuild(1, "team-a", 5, 0),
+				}
+			})
+
+			It("admits the build regardless of the other team's builds", func() {
+				Expect(admitted).To(BeTrue())
+			})
+		})
+
+		Context("when admitting the build fails", func() {
+			BeforeEach(func() {
+				queuedErr = errors.New("nope")
+			})
+
+			It("returns the error", func() {
+				Expect(admitErr).To(HaveOccurred())
+				Expect(admitted).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("Snapshot", func() {
+		var snapshot atc.BuildQueue
+
+		BeforeEach(func() {
+			policy = buildqueue.Policy{
+				TeamWeights:     map[string]int{"team-b": 2},
+				TeamMaxInFlight: map[string]int{"team-c": 1},
+			}
+
+			active = map[string]int{"team-a": 1, "team-c": 1, "team-d": 4}
+			queued = []db.QueuedBuild{
+				queuedBuild(1, "team-a", 0, 5*time.Minute),
+				queuedBuild(2, "team-b", 0, 4*time.Minute),
+				queuedBuild(3, "team-b", 0, 3*time.Minute),
+				queuedBuild(4, "team-c", 0, 2*time.Minute),
+				queuedBuild(5, "team-a", 1, time.Minute),
+			}
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			snapshot, err = queue.Snapshot()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("summarizes each team", func() {
+			Expect(snapshot.Teams).To(Equal([]atc.BuildQueueTeam{
+				{Name: "team-a", Active: 1, Queued: 2, Weight: 1},
+				{Name: "team-b", Active: 0, Queued: 2, Weight: 2},
+				{Name: "team-c", Active: 1, Queued: 1, Weight: 1, MaxInFlight: 1},
+				{Name: "team-d", Active: 4, Queued: 0, Weight: 1},
+			}))
+		})
+
+		It("orders the builds the way they will be admitted", func() {
+			ids := []int{}
+			positions := []int{}
+			for _, build := range snapshot.Builds {
+				ids = append(ids, build.BuildID)
+				positions = append(positions, build.Position)
+			}
+
+			Expect(ids).To(Equal([]int{2, 3, 5, 1, 4}))
+			Expect(positions).To(Equal([]int{1, 2, 3, 4, 5}))
+		})
+
+		It("marks builds of teams at their cap as blocked", func() {
+			Expect(snapshot.Builds[4].Blocked).To(Equal(buildqueue.TeamMaxInFlightReached))
+			Expect(snapshot.Builds[0].Blocked).To(BeEmpty())
+		})
+
+		It("includes the details of each build", func() {
+			Expect(snapshot.Builds[0]).To(Equal(atc.BuildQueueEntry{
+				Position:  1,
+				BuildID:   2,
+				BuildName: "1",
+				TeamName:  "team-b",
+				JobName:   "some-job",
+				QueuedAt:  queueTime.Add(-4 * time.Minute).Unix(),
+			}))
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/engine"
+	"github.com/concourse/concourse/atc/scheduler/buildqueue"
 	"github.com/concourse/concourse/atc/scheduler/inputmapper"
 	"github.com/concourse/concourse/atc/scheduler/maxinflight"
 )
@@ -34,6 +35,7 @@ func NewBuildStarter(
 	scanner Scanner,
 	inputMapper inputmapper.InputMapper,
 	execEngine engine.Engine,
+	buildQueue buildqueue.Queue,
 ) BuildStarter {
 	return &buildStarter{
 		pipeline:           pipeline,
@@ -42,6 +44,7 @@ func NewBuildStarter(
 		scanner:            scanner,
 		inputMapper:        inputMapper,
 		execEngine:         execEngine,
+		buildQueue:         buildQueue,
 	}
 }
 
@@ -52,6 +55,7 @@ type buildStarter struct {
 	execEngine         engine.Engine
 	scanner            Scanner
 	inputMapper        inputmapper.InputMapper
+	buildQueue         buildqueue.Queue
 }
 
 func (s *buildStarter) TryStartPendingBuildsForJob(
@@ -95,45 +99,71 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		return false, nil
 	}
 
-	if nextPendingBuild.IsManuallyTriggered() {
-		jobBuildInputs := job.Config().Inputs()
-		for _, input := range jobBuildInputs {
-			scanLog := logger.Session("scan", lager.Data{
-				"input":    input.Name,
-				"resource": input.Resource,
-			})
-
-			err := s.scanner.Scan(scanLog, input.Resource)
-			if err != nil {
-				return false, err
+	var buildInputs []db.BuildInput
+	if nextPendingBuild.RerunOf() != 0 {
+		buildInputs, err = nextPendingBuild.RerunInputs()
+		if err != nil {
+			logger.Error("failed-to-get-rerun-build-inputs", err)
+
+			if _, ok := err.(db.BuildInputsNotFoundError); ok {
+				// Don't use ErrorBuild because it logs a build event, and this build hasn't started
+				err := nextPendingBuild.Finish(db.BuildStatusErrored)
+				if err != nil {
+					logger.Error("failed-to-mark-build-as-errored", err)
+				}
+				return false, nil
 			}
-		}
 
-		versions, err := s.pipeline.LoadVersionsDB()
-		if err != nil {
-			logger.Error("failed-to-load-versions-db", err)
 			return false, err
 		}
 
-		_, err = s.inputMapper.SaveNextInputMapping(logger, versions, job, resources)
+		dbResourceTypes, err := s.pipeline.ResourceTypes()
 		if err != nil {
 			return false, err
 		}
+		resourceTypes = dbResourceTypes.Deserialize()
+	} else {
+		if nextPendingBuild.IsManuallyTriggered() {
+			jobBuildInputs := job.Config().Inputs()
+			for _, input := range jobBuildInputs {
+				scanLog := logger.Session("scan", lager.Data{
+					"input":    input.Name,
+					"resource": input.Resource,
+				})
+
+				err := s.scanner.Scan(scanLog, input.Resource)
+				if err != nil {
+					return false, err
+				}
+			}
 
-		dbResourceTypes, err := s.pipeline.ResourceTypes()
+			versions, err := s.pipeline.LoadVersionsDB()
+			if err != nil {
+				logger.Error("failed-to-load-versions-db", err)
+				return false, err
+			}
+
+			_, err = s.inputMapper.SaveNextInputMapping(logger, versions, job, resources)
+			if err != nil {
+				return false, err
+			}
+
+			dbResourceTypes, err := s.pipeline.ResourceTypes()
+			if err != nil {
+				return false, err
+			}
+			resourceTypes = dbResourceTypes.Deserialize()
+		}
+
+		var found bool
+		buildInputs, found, err = job.GetNextBuildInputs()
 		if err != nil {
+			logger.Error("failed-to-get-next-build-inputs", err)
 			return false, err
 		}
-		resourceTypes = dbResourceTypes.Deserialize()
-	}
-
-	buildInputs, found, err := job.GetNextBuildInputs()
-	if err != nil {
-		logger.Error("failed-to-get-next-build-inputs", err)
-		return false, err
-	}
-	if !found {
-		return false, nil
+		if !found {
+			return false, nil
+		}
 	}
 
 	pipelinePaused, err := s.pipeline.CheckPaused()
@@ -149,6 +179,16 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		return false, nil
 	}
 
+	admitted, err := s.buildQueue.Admit(logger, job, nextPendingBuild)
+	if err != nil {
+		logger.Error("failed-to-admit-build", err)
+		return false, err
+	}
+
+	if !admitted {
+		return false, nil
+	}
+
 	updated, err := nextPendingBuild.Schedule()
 	if err != nil {
 		logger.Error("failed-to-update-build-to-scheduled", err)
@@ -12,6 +12,7 @@ import (
 	"github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/engine/enginefakes"
 	"github.com/concourse/concourse/atc/scheduler"
+	"github.com/concourse/concourse/atc/scheduler/buildqueue/buildqueuefakes"
 	"github.com/concourse/concourse/atc/scheduler/inputmapper/inputmapperfakes"
 	"github.com/concourse/concourse/atc/scheduler/maxinflight/maxinflightfakes"
 	"github.com/concourse/concourse/atc/scheduler/schedulerfakes"
@@ -29,6 +30,7 @@ var _ = Describe("BuildStarter", func() {
 		pendingBuilds   []db.Build
 		fakeScanner     *schedulerfakes.FakeScanner
 		fakeInputMapper *inputmapperfakes.FakeInputMapper
+		fakeBuildQueue  *buildqueuefakes.FakeQueue
 
 		buildStarter scheduler.BuildStarter
 
@@ -42,8 +44,10 @@ var _ = Describe("BuildStarter", func() {
 		fakeEngine = new(enginefakes.FakeEngine)
 		fakeScanner = new(schedulerfakes.FakeScanner)
 		fakeInputMapper = new(inputmapperfakes.FakeInputMapper)
+		fakeBuildQueue = new(buildqueuefakes.FakeQueue)
+		fakeBuildQueue.AdmitReturns(true, nil)
 
-		buildStarter = scheduler.NewBuildStarter(fakePipeline, fakeUpdater, fakeFactory, fakeScanner, fakeInputMapper, fakeEngine)
+		buildStarter = scheduler.NewBuildStarter(fakePipeline, fakeUpdater, fakeFactory, fakeScanner, fakeInputMapper, fakeEngine, fakeBuildQueue)
 
 		disaster = errors.New("bad thing")
 	})
@@ -284,6 +288,85 @@ var _ = Describe("BuildStarter", func() {
 			})
 		})
 
+		Context("when rerunning a build", func() {
+			var rerunInputs []db.BuildInput
+
+			BeforeEach(func() {
+				job = new(dbfakes.FakeJob)
+				job.NameReturns("some-job")
+				job.ConfigReturns(atc.JobConfig{Plan: atc.PlanSequence{{Get: "input-1"}, {Get: "input-2"}}})
+
+				rerunInputs = []db.BuildInput{
+					{Name: "input-1", Version: atc.Version{"version": "1"}, ResourceID: 1},
+					{Name: "input-2", Version: atc.Version{"version": "2"}, ResourceID: 2},
+				}
+
+				createdBuild.RerunOfReturns(42)
+				createdBuild.RerunInputsReturns(rerunInputs, nil)
+				createdBuild.ScheduleReturns(true, nil)
+
+				fakePipeline.ResourceTypesReturns(db.ResourceTypes{}, nil)
+				fakeEngine.CreateBuildReturns(new(enginefakes.FakeBuild), nil)
+			})
+
+			JustBeforeEach(func() {
+				tryStartErr = buildStarter.TryStartPendingBuildsForJob(
+					lagertest.NewTestLogger("test"),
+					job,
+					db.Resources{resource},
+					versionedResourceTypes,
+					pendingBuilds,
+				)
+			})
+
+			It("does not check resources or resolve new inputs", func() {
+				Expect(fakeScanner.ScanCallCount()).To(BeZero())
+				Expect(fakeInputMapper.SaveNextInputMappingCallCount()).To(BeZero())
+				Expect(job.GetNextBuildInputsCallCount()).To(BeZero())
+			})
+
+			It("uses the inputs of the original build", func() {
+				Expect(tryStartErr).NotTo(HaveOccurred())
+
+				Expect(createdBuild.UseInputsCallCount()).To(Equal(1))
+				Expect(createdBuild.UseInputsArgsForCall(0)).To(Equal(rerunInputs))
+
+				Expect(fakeFactory.CreateCallCount()).To(Equal(1))
+				_, _, _, actualInputs := fakeFactory.CreateArgsForCall(0)
+				Expect(actualInputs).To(Equal(rerunInputs))
+			})
+
+			Context("when getting the inputs of the original build fails", func() {
+				BeforeEach(func() {
+					createdBuild.RerunInputsReturns(nil, disaster)
+				})
+
+				It("returns the error", func() {
+					Expect(tryStartErr).To(Equal(disaster))
+				})
+
+				It("doesn't try to mark the build as scheduled", func() {
+					Expect(createdBuild.ScheduleCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when versions of the original build's inputs can no longer be found", func() {
+				BeforeEach(func() {
+					createdBuild.RerunInputsReturns(nil, db.BuildInputsNotFoundError{Names: []string{"input-2"}})
+				})
+
+				It("errors the build", func() {
+					Expect(tryStartErr).NotTo(HaveOccurred())
+					Expect(createdBuild.FinishCallCount()).To(Equal(1))
+					Expect(createdBuild.FinishArgsForCall(0)).To(Equal(db.BuildStatusErrored))
+				})
+
+				It("doesn't try to mark the build as scheduled", func() {
+					Expect(createdBuild.ScheduleCallCount()).To(BeZero())
+				})
+			})
+		})
+
 		Context("when not manually triggered", func() {
 			BeforeEach(func() {
 				job = new(dbfakes.FakeJob)
@@ -623,6 +706,34 @@ var _ = Describe("BuildStarter", func() {
 
 						itDoesntReturnAnErrorOrMarkTheBuildAsScheduled()
 						itUpdatedMaxInFlightForTheFirstBuild()
+
+						It("does not queue the build", func() {
+							Expect(fakeBuildQueue.AdmitCallCount()).To(BeZero())
+						})
+					})
+
+					Context("when admitting the build to the build queue fails", func() {
+						BeforeEach(func() {
+							fakeBuildQueue.AdmitReturns(false, disaster)
+						})
+
+						itReturnsTheError()
+						itUpdatedMaxInFlightForTheFirstBuild()
+					})
+
+					Context("when the build has to wait in the build queue", func() {
+						BeforeEach(func() {
+							fakeBuildQueue.AdmitReturns(false, nil)
+						})
+
+						itDoesntReturnAnErrorOrMarkTheBuildAsScheduled()
+
+						It("asks the build queue about the first pending build", func() {
+							Expect(fakeBuildQueue.AdmitCallCount()).To(Equal(1))
+							_, actualJob, actualBuild := fakeBuildQueue.AdmitArgsForCall(0)
+							Expect(actualJob).To(Equal(job))
+							Expect(actualBuild).To(Equal(pendingBuilds[0]))
+						})
 					})
 				})
 			})
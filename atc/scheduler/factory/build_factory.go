@@ -99,6 +99,10 @@ func (factory *buildFactory) constructPlanFromConfig(
 	resourceTypes atc.VersionedResourceTypes,
 	inputs []db.BuildInput,
 ) (atc.Plan, error) {
+	if len(planConfig.Across) > 0 {
+		return factory.across(planConfig, resources, resourceTypes, inputs)
+	}
+
 	var plan atc.Plan
 	var err error
 
@@ -122,13 +126,78 @@ func (factory *buildFactory) constructPlanFromConfig(
 		plan = factory.planFactory.NewPlan(retryStep)
 	}
 
-	return factory.applyHooks(constructionParams{
+	plan, err = factory.applyHooks(constructionParams{
 		plan:          plan,
 		hooks:         planConfig.Hooks(),
 		resources:     resources,
 		resourceTypes: resourceTypes,
 		inputs:        inputs,
 	})
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	if planConfig.If != "" {
+		plan = factory.planFactory.NewPlan(atc.ConditionalPlan{
+			Condition: planConfig.If,
+			Step:      plan,
+		})
+	}
+
+	return plan, nil
+}
+
+func (factory *buildFactory) across(
+	planConfig atc.PlanConfig,
+	resources atc.ResourceConfigs,
+	resourceTypes atc.VersionedResourceTypes,
+	inputs []db.BuildInput,
+) (atc.Plan, error) {
+	vars := make([]atc.AcrossVar, len(planConfig.Across))
+	for i, v := range planConfig.Across {
+		vars[i] = atc.AcrossVar{
+			Var:    v.Var,
+			Values: v.Values,
+		}
+	}
+
+	stepConfig := planConfig
+	stepConfig.Across = nil
+	stepConfig.MaxInFlight = 0
+
+	steps := []atc.VarScopedPlan{}
+	for _, values := range atc.AcrossCombinations(planConfig.Across) {
+		scope := map[string]interface{}{}
+		for i, v := range planConfig.Across {
+			scope[v.Var] = values[i]
+		}
+
+		interpolated, err := stepConfig.InterpolateLocalVars(scope)
+		if err != nil {
+			return atc.Plan{}, err
+		}
+
+		step, err := factory.constructPlanFromConfig(
+			interpolated,
+			resources,
+			resourceTypes,
+			inputs,
+		)
+		if err != nil {
+			return atc.Plan{}, err
+		}
+
+		steps = append(steps, atc.VarScopedPlan{
+			Step:   step,
+			Values: values,
+		})
+	}
+
+	return factory.planFactory.NewPlan(atc.AcrossPlan{
+		Vars:        vars,
+		Steps:       steps,
+		MaxInFlight: planConfig.MaxInFlight,
+	}), nil
 }
 
 func (factory *buildFactory) constructUnhookedPlan(
@@ -245,8 +314,39 @@ func (factory *buildFactory) constructUnhookedPlan(
 			OutputMapping:     planConfig.OutputMapping,
 			ImageArtifactName: planConfig.ImageArtifactName,
 
+			ContainerPlacementStrategy: planConfig.ContainerPlacementStrategy,
+
+			Artifacts: planConfig.Artifacts,
+
 			VersionedResourceTypes: resourceTypes,
 		})
+
+	case planConfig.LoadVar != "":
+		plan = factory.planFactory.NewPlan(atc.LoadVarPlan{
+			Name:   planConfig.LoadVar,
+			File:   planConfig.TaskConfigPath,
+			Format: planConfig.Format,
+			Reveal: planConfig.Reveal,
+		})
+
+	case planConfig.Approval != "":
+		role := planConfig.Role
+		if role == "" {
+			role = atc.DefaultApprovalRole
+		}
+
+		plan = factory.planFactory.NewPlan(atc.ApprovalPlan{
+			Name: planConfig.Approval,
+			Role: role,
+		})
+
+	case planConfig.SetPipeline != "":
+		plan = factory.planFactory.NewPlan(atc.SetPipelinePlan{
+			Name: planConfig.SetPipeline,
+			File: planConfig.TaskConfigPath,
+			Vars: planConfig.TaskVars,
+		})
+
 	case planConfig.Try != nil:
 		nextStep, err := factory.constructPlanFromConfig(
 			*planConfig.Try,
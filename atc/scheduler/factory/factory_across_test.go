@@ -0,0 +1,137 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory Across Step", func() {
+	var (
+		resourceTypes atc.VersionedResourceTypes
+
+		buildFactory        factory.BuildFactory
+		actualPlanFactory   atc.PlanFactory
+		expectedPlanFactory atc.PlanFactory
+	)
+
+	BeforeEach(func() {
+		actualPlanFactory = atc.NewPlanFactory(321)
+		expectedPlanFactory = atc.NewPlanFactory(321)
+		buildFactory = factory.NewBuildFactory(42, actualPlanFactory)
+
+		resourceTypes = atc.VersionedResourceTypes{
+			{
+				ResourceType: atc.ResourceType{
+					Name:   "some-custom-resource",
+					Type:   "registry-image",
+					Source: atc.Source{"some": "custom-source"},
+				},
+				Version: atc.Version{"some": "version"},
+			},
+		}
+	})
+
+	Context("When there is a task across a matrix of vars", func() {
+		It("builds a step for every combination", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task: "test-((.:go))",
+						Across: []atc.AcrossVarConfig{
+							{Var: "go", Values: []interface{}{"1.11", "1.12"}},
+							{Var: "os", Values: []interface{}{"linux", "darwin"}},
+						},
+						MaxInFlight: 2,
+						Params: atc.Params{
+							"GOOS": "((.:os))",
+							"KEY":  "((some-secret))",
+						},
+					},
+				},
+			}, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			scoped := func(goVersion, os string) atc.VarScopedPlan {
+				return atc.VarScopedPlan{
+					Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+						Name: "test-" + goVersion,
+						Params: atc.Params{
+							"GOOS": os,
+							"KEY":  "((some-secret))",
+						},
+						VersionedResourceTypes: resourceTypes,
+					}),
+					Values: []interface{}{goVersion, os},
+				}
+			}
+
+			steps := []atc.VarScopedPlan{
+				scoped("1.11", "linux"),
+				scoped("1.11", "darwin"),
+				scoped("1.12", "linux"),
+				scoped("1.12", "darwin"),
+			}
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Vars: []atc.AcrossVar{
+					{Var: "go", Values: []interface{}{"1.11", "1.12"}},
+					{Var: "os", Values: []interface{}{"linux", "darwin"}},
+				},
+				Steps:       steps,
+				MaxInFlight: 2,
+			})
+
+			Expect(actual).To(Equal(expected))
+		})
+	})
+
+	Context("When a step across vars has a condition", func() {
+		It("interpolates the condition for every combination", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task: "some-task",
+						If:   `"((.:platform))" == "linux"`,
+						Across: []atc.AcrossVarConfig{
+							{Var: "platform", Values: []interface{}{"linux", "windows"}},
+						},
+					},
+				},
+			}, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			linux := expectedPlanFactory.NewPlan(atc.TaskPlan{
+				Name:                   "some-task",
+				VersionedResourceTypes: resourceTypes,
+			})
+			linuxConditional := expectedPlanFactory.NewPlan(atc.ConditionalPlan{
+				Condition: `"linux" == "linux"`,
+				Step:      linux,
+			})
+
+			windows := expectedPlanFactory.NewPlan(atc.TaskPlan{
+				Name:                   "some-task",
+				VersionedResourceTypes: resourceTypes,
+			})
+			windowsConditional := expectedPlanFactory.NewPlan(atc.ConditionalPlan{
+				Condition: `"windows" == "linux"`,
+				Step:      windows,
+			})
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Vars: []atc.AcrossVar{
+					{Var: "platform", Values: []interface{}{"linux", "windows"}},
+				},
+				Steps: []atc.VarScopedPlan{
+					{Step: linuxConditional, Values: []interface{}{"linux"}},
+					{Step: windowsConditional, Values: []interface{}{"windows"}},
+				},
+			})
+
+			Expect(actual).To(Equal(expected))
+		})
+	})
+})
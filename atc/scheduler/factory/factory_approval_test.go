@@ -0,0 +1,90 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory Approval Step", func() {
+	var (
+		buildFactory        factory.BuildFactory
+		actualPlanFactory   atc.PlanFactory
+		expectedPlanFactory atc.PlanFactory
+	)
+
+	BeforeEach(func() {
+		actualPlanFactory = atc.NewPlanFactory(321)
+		expectedPlanFactory = atc.NewPlanFactory(321)
+		buildFactory = factory.NewBuildFactory(42, actualPlanFactory)
+	})
+
+	Context("When there is an approval step", func() {
+		It("builds correctly", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Approval: "deploy-to-prod",
+						Role:     "owner",
+					},
+				},
+			}, nil, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.ApprovalPlan{
+				Name: "deploy-to-prod",
+				Role: "owner",
+			})
+
+			Expect(actual).To(Equal(expected))
+		})
+	})
+
+	Context("When an approval step does not specify a role", func() {
+		It("requires the default role", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Approval: "deploy-to-prod",
+					},
+				},
+			}, nil, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.ApprovalPlan{
+				Name: "deploy-to-prod",
+				Role: "member",
+			})
+
+			Expect(actual).To(Equal(expected))
+		})
+	})
+
+	Context("When an approval step has a timeout", func() {
+		It("wraps the approval in a timeout", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Approval: "deploy-to-prod",
+						Timeout:  "1h",
+					},
+				},
+			}, nil, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			approvalPlan := expectedPlanFactory.NewPlan(atc.ApprovalPlan{
+				Name: "deploy-to-prod",
+				Role: "member",
+			})
+
+			expected := expectedPlanFactory.NewPlan(atc.TimeoutPlan{
+				Duration: "1h",
+				Step:     approvalPlan,
+			})
+
+			Expect(actual).To(Equal(expected))
+		})
+	})
+})
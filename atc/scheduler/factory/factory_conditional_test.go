@@ -0,0 +1,97 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory Conditional Step", func() {
+	var (
+		resourceTypes atc.VersionedResourceTypes
+
+		buildFactory        factory.BuildFactory
+		actualPlanFactory   atc.PlanFactory
+		expectedPlanFactory atc.PlanFactory
+	)
+
+	BeforeEach(func() {
+		actualPlanFactory = atc.NewPlanFactory(321)
+		expectedPlanFactory = atc.NewPlanFactory(321)
+		buildFactory = factory.NewBuildFactory(42, actualPlanFactory)
+
+		resourceTypes = atc.VersionedResourceTypes{
+			{
+				ResourceType: atc.ResourceType{
+					Name:   "some-custom-resource",
+					Type:   "registry-image",
+					Source: atc.Source{"some": "custom-source"},
+				},
+				Version: atc.Version{"some": "version"},
+			},
+		}
+	})
+
+	Context("When there is a task with a condition", func() {
+		It("builds correctly", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task: "first task",
+						If:   `var("enabled")`,
+					},
+				},
+			}, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.ConditionalPlan{
+				Condition: `var("enabled")`,
+				Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "first task",
+					VersionedResourceTypes: resourceTypes,
+				}),
+			})
+
+			Expect(actual).To(Equal(expected))
+		})
+	})
+
+	Context("When there is a task with a condition, a timeout and hooks", func() {
+		It("wraps the hooked step in the condition", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task:    "first task",
+						If:      `var("enabled")`,
+						Timeout: "10s",
+						Success: &atc.PlanConfig{
+							Task: "second task",
+						},
+					},
+				},
+			}, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.ConditionalPlan{
+				Condition: `var("enabled")`,
+				Step: expectedPlanFactory.NewPlan(atc.OnSuccessPlan{
+					Step: expectedPlanFactory.NewPlan(atc.TimeoutPlan{
+						Duration: "10s",
+						Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+							Name:                   "first task",
+							VersionedResourceTypes: resourceTypes,
+						}),
+					}),
+					Next: expectedPlanFactory.NewPlan(atc.TaskPlan{
+						Name:                   "second task",
+						VersionedResourceTypes: resourceTypes,
+					}),
+				}),
+			})
+
+			Expect(actual).To(Equal(expected))
+		})
+	})
+})
@@ -0,0 +1,48 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory LoadVar Step", func() {
+	var (
+		buildFactory        factory.BuildFactory
+		actualPlanFactory   atc.PlanFactory
+		expectedPlanFactory atc.PlanFactory
+	)
+
+	BeforeEach(func() {
+		actualPlanFactory = atc.NewPlanFactory(321)
+		expectedPlanFactory = atc.NewPlanFactory(321)
+		buildFactory = factory.NewBuildFactory(42, actualPlanFactory)
+	})
+
+	Context("When there is a load_var step", func() {
+		It("builds correctly", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						LoadVar:        "some-var",
+						TaskConfigPath: "some-repo/version",
+						Format:         "raw",
+						Reveal:         true,
+					},
+				},
+			}, nil, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.LoadVarPlan{
+				Name:   "some-var",
+				File:   "some-repo/version",
+				Format: "raw",
+				Reveal: true,
+			})
+
+			Expect(actual).To(Equal(expected))
+		})
+	})
+})
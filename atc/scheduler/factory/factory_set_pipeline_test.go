@@ -0,0 +1,46 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory SetPipeline Step", func() {
+	var (
+		buildFactory        factory.BuildFactory
+		actualPlanFactory   atc.PlanFactory
+		expectedPlanFactory atc.PlanFactory
+	)
+
+	BeforeEach(func() {
+		actualPlanFactory = atc.NewPlanFactory(321)
+		expectedPlanFactory = atc.NewPlanFactory(321)
+		buildFactory = factory.NewBuildFactory(42, actualPlanFactory)
+	})
+
+	Context("When there is a set_pipeline step", func() {
+		It("builds correctly", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						SetPipeline:    "some-pipeline",
+						TaskConfigPath: "some-repo/pipeline.yml",
+						TaskVars:       atc.Params{"some": "var"},
+					},
+				},
+			}, nil, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.SetPipelinePlan{
+				Name: "some-pipeline",
+				File: "some-repo/pipeline.yml",
+				Vars: atc.Params{"some": "var"},
+			})
+
+			Expect(actual).To(Equal(expected))
+		})
+	})
+})
@@ -181,5 +181,32 @@ var _ = Describe("Factory Task", func() {
 				Expect(actual).To(testhelpers.MatchPlan(expected))
 			})
 		})
+
+		Context("when artifacts are specified", func() {
+			BeforeEach(func() {
+				input = atc.JobConfig{
+					Plan: atc.PlanSequence{
+						{
+							Task:           "some-task",
+							TaskConfigPath: "some/config/path.yml",
+							Artifacts:      []string{"test-reports"},
+						},
+					},
+				}
+			})
+
+			It("creates build plan with the artifacts to persist", func() {
+				actual, err := buildFactory.Create(input, resources, resourceTypes, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				expected := expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "some-task",
+					ConfigPath:             "some/config/path.yml",
+					Artifacts:              []string{"test-reports"},
+					VersionedResourceTypes: resourceTypes,
+				})
+				Expect(actual).To(testhelpers.MatchPlan(expected))
+			})
+		})
 	})
 })
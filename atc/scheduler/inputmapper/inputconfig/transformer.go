@@ -51,6 +51,16 @@ func (i *transformer) TransformInputConfigs(db *algorithm.VersionsDB, jobName st
 			pinnedVersionID = id
 		}
 
+		var versionFilter *algorithm.VersionFilter
+		if input.VersionFilter != nil {
+			filter, err := algorithm.NewVersionFilter(*input.VersionFilter)
+			if err != nil {
+				return nil, err
+			}
+
+			versionFilter = filter
+		}
+
 		jobs := algorithm.JobSet{}
 		for _, passedJobName := range input.Passed {
 			jobs[db.JobIDs[passedJobName]] = struct{}{}
@@ -60,6 +70,7 @@ func (i *transformer) TransformInputConfigs(db *algorithm.VersionsDB, jobName st
 			Name:            input.Name,
 			UseEveryVersion: input.Version.Every,
 			PinnedVersionID: pinnedVersionID,
+			VersionFilter:   versionFilter,
 			ResourceID:      db.ResourceIDs[input.Resource],
 			Passed:          jobs,
 			JobID:           db.JobIDs[jobName],
@@ -85,6 +85,38 @@ var _ = Describe("Transformer", func() {
 				})
 			})
 
+			Context("when an input has a version filter", func() {
+				BeforeEach(func() {
+					jobInputs = []atc.JobInput{{
+						Name:          "job-input-1",
+						Resource:      "r1",
+						VersionFilter: &atc.VersionFilterConfig{Semver: "~1.2"},
+					}}
+				})
+
+				It("compiles the filter", func() {
+					Expect(tranformErr).ToNot(HaveOccurred())
+					Expect(algorithmInputs).To(HaveLen(1))
+					Expect(algorithmInputs[0].VersionFilter).ToNot(BeNil())
+
+					ok, _ := algorithmInputs[0].VersionFilter.Check(atc.Version{"tag": "1.2.3"})
+					Expect(ok).To(BeTrue())
+
+					ok, _ = algorithmInputs[0].VersionFilter.Check(atc.Version{"tag": "1.3.0"})
+					Expect(ok).To(BeFalse())
+				})
+
+				Context("when the filter is invalid", func() {
+					BeforeEach(func() {
+						jobInputs[0].VersionFilter = &atc.VersionFilterConfig{Regex: "(oops"}
+					})
+
+					It("returns an error", func() {
+						Expect(tranformErr).To(HaveOccurred())
+					})
+				})
+			})
+
 			Context("when an input has version: every", func() {
 				BeforeEach(func() {
 					jobInputs = []atc.JobInput{{
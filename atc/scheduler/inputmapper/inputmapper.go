@@ -57,11 +57,21 @@ func (i *inputMapper) SaveNextInputMapping(
 		return nil, err
 	}
 
+	resolutionErrors := map[string]string{}
+	for _, inputConfig := range inputConfigs {
+		if !hasInputConfig(algorithmInputConfigs, inputConfig.Name) {
+			// the transformer omits inputs whose pinned version can't be found
+			resolutionErrors[inputConfig.Name] = string(algorithm.PinnedVersionNotFound)
+		}
+	}
+
 	independentMapping := algorithm.InputMapping{}
 	for _, inputConfig := range algorithmInputConfigs {
 		singletonMapping, ok := algorithm.InputConfigs{inputConfig}.Resolve(versions)
 		if ok {
 			independentMapping[inputConfig.Name] = singletonMapping[inputConfig.Name]
+		} else {
+			resolutionErrors[inputConfig.Name] = string(inputConfig.ExplainFailure(versions))
 		}
 	}
 
@@ -72,6 +82,8 @@ func (i *inputMapper) SaveNextInputMapping(
 	}
 
 	if len(independentMapping) < len(inputConfigs) {
+		i.saveInputResolutionErrors(logger, job, resolutionErrors)
+
 		// this is necessary to prevent builds from running with missing pinned versions
 		err := job.DeleteNextInputMapping()
 		if err != nil {
@@ -83,6 +95,14 @@ func (i *inputMapper) SaveNextInputMapping(
 
 	resolvedMapping, ok := algorithmInputConfigs.Resolve(versions)
 	if !ok {
+		for _, inputConfig := range algorithmInputConfigs {
+			if len(inputConfig.Passed) != 0 {
+				resolutionErrors[inputConfig.Name] = string(algorithmInputConfigs.ExplainConflict(versions, inputConfig.Name))
+			}
+		}
+
+		i.saveInputResolutionErrors(logger, job, resolutionErrors)
+
 		err := job.DeleteNextInputMapping()
 		if err != nil {
 			logger.Error("failed-to-delete-next-input-mapping-after-failed-resolve", err)
@@ -97,5 +117,44 @@ func (i *inputMapper) SaveNextInputMapping(
 		return nil, err
 	}
 
+	i.saveInputResolutionErrors(logger, job, resolutionErrors)
+
 	return resolvedMapping, nil
 }
+
+func (i *inputMapper) saveInputResolutionErrors(logger lager.Logger, job db.Job, resolutionErrors map[string]string) {
+	// the errors rarely change between scheduler ticks, so avoid rewriting
+	// the job every time
+	if sameResolutionErrors(job.InputResolutionErrors(), resolutionErrors) {
+		return
+	}
+
+	err := job.SaveInputResolutionErrors(resolutionErrors)
+	if err != nil {
+		logger.Error("failed-to-save-input-resolution-errors", err)
+	}
+}
+
+func sameResolutionErrors(a map[string]string, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for name, reason := range a {
+		if other, found := b[name]; !found || other != reason {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hasInputConfig(configs algorithm.InputConfigs, name string) bool {
+	for _, config := range configs {
+		if config.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -193,6 +193,23 @@ var _ = Describe("Inputmapper", func() {
 						It("didn't delete the mapping", func() {
 							Expect(fakeJob.DeleteNextInputMappingCallCount()).To(BeZero())
 						})
+
+						It("does not rewrite the job's input resolution errors when there were none", func() {
+							Expect(fakeJob.SaveInputResolutionErrorsCallCount()).To(BeZero())
+						})
+
+						Context("when inputs previously failed to resolve", func() {
+							BeforeEach(func() {
+								fakeJob.InputResolutionErrorsReturns(map[string]string{
+									"b": string(algorithm.NoVersionsAvailable),
+								})
+							})
+
+							It("clears the input resolution errors", func() {
+								Expect(fakeJob.SaveInputResolutionErrorsCallCount()).To(Equal(1))
+								Expect(fakeJob.SaveInputResolutionErrorsArgsForCall(0)).To(BeEmpty())
+							})
+						})
 					})
 				})
 			})
@@ -254,6 +271,27 @@ var _ = Describe("Inputmapper", func() {
 					Expect(fakeJob.SaveNextInputMappingCallCount()).To(BeZero())
 				})
 
+				It("saved why the inputs could not be resolved together", func() {
+					Expect(fakeJob.SaveInputResolutionErrorsCallCount()).To(Equal(1))
+					Expect(fakeJob.SaveInputResolutionErrorsArgsForCall(0)).To(Equal(map[string]string{
+						"a": "no version passed job 'upstream' in the same build as a usable version of input 'b'",
+						"b": "no version passed job 'upstream' in the same build as a usable version of input 'a'",
+					}))
+				})
+
+				Context("when the same errors have already been saved", func() {
+					BeforeEach(func() {
+						fakeJob.InputResolutionErrorsReturns(map[string]string{
+							"a": "no version passed job 'upstream' in the same build as a usable version of input 'b'",
+							"b": "no version passed job 'upstream' in the same build as a usable version of input 'a'",
+						})
+					})
+
+					It("does not save them again", func() {
+						Expect(fakeJob.SaveInputResolutionErrorsCallCount()).To(BeZero())
+					})
+				})
+
 				It("returns an empty mapping and no error", func() {
 					Expect(mappingErr).NotTo(HaveOccurred())
 					Expect(inputMapping).To(BeEmpty())
@@ -297,6 +335,13 @@ var _ = Describe("Inputmapper", func() {
 				}))
 			})
 
+			It("saved why the input could not be resolved", func() {
+				Expect(fakeJob.SaveInputResolutionErrorsCallCount()).To(Equal(1))
+				Expect(fakeJob.SaveInputResolutionErrorsArgsForCall(0)).To(Equal(map[string]string{
+					"no-versions": string(algorithm.NoVersionsAvailable),
+				}))
+			})
+
 			It("deleted the next input mapping", func() {
 				Expect(fakeJob.DeleteNextInputMappingCallCount()).To(Equal(1))
 				Expect(fakeJob.SaveNextInputMappingCallCount()).To(BeZero())
@@ -338,6 +383,13 @@ var _ = Describe("Inputmapper", func() {
 				}))
 			})
 
+			It("saved that the pinned version was not found", func() {
+				Expect(fakeJob.SaveInputResolutionErrorsCallCount()).To(Equal(1))
+				Expect(fakeJob.SaveInputResolutionErrorsArgsForCall(0)).To(Equal(map[string]string{
+					"a": string(algorithm.PinnedVersionNotFound),
+				}))
+			})
+
 			It("deleted the next input mapping", func() {
 				Expect(fakeJob.DeleteNextInputMappingCallCount()).To(Equal(1))
 				Expect(fakeJob.SaveNextInputMappingCallCount()).To(BeZero())
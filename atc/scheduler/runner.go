@@ -1,6 +1,7 @@
 package scheduler
 
 import (
+	"context"
 	"errors"
 	"os"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/algorithm"
 	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/tracing"
 )
 
 //go:generate counterfeiter . BuildScheduler
@@ -30,6 +32,14 @@ type BuildScheduler interface {
 		resourceTypes atc.VersionedResourceTypes,
 	) (db.Build, Waiter, error)
 
+	RerunImmediately(
+		logger lager.Logger,
+		job db.Job,
+		build db.Build,
+		resources db.Resources,
+		resourceTypes atc.VersionedResourceTypes,
+	) (db.Build, Waiter, error)
+
 	SaveNextInputMapping(logger lager.Logger, job db.Job, resource db.Resources) error
 }
 
@@ -73,7 +83,7 @@ dance:
 	return nil
 }
 
-func (runner *Runner) tick(logger lager.Logger) error {
+func (runner *Runner) tick(logger lager.Logger) (err error) {
 	if runner.Noop {
 		return nil
 	}
@@ -90,6 +100,14 @@ func (runner *Runner) tick(logger lager.Logger) error {
 
 	defer schedulingLock.Release()
 
+	_, span := tracing.StartSpan(context.Background(), "schedule", tracing.Attrs{
+		"team":     runner.Pipeline.TeamName(),
+		"pipeline": runner.Pipeline.Name(),
+	})
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	start := time.Now()
 
 	defer func() {
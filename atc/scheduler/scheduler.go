@@ -113,6 +113,37 @@ func (s *Scheduler) TriggerImmediately(
 		logger.Error("failed-to-create-job-build", err)
 		return nil, nil, err
 	}
+
+	return build, s.startPendingBuilds(logger, job, resources, resourceTypes), nil
+}
+
+func (s *Scheduler) RerunImmediately(
+	logger lager.Logger,
+	job db.Job,
+	buildToRerun db.Build,
+	resources db.Resources,
+	resourceTypes atc.VersionedResourceTypes,
+) (db.Build, Waiter, error) {
+	logger = logger.Session("rerun-immediately", lager.Data{
+		"job_name":   job.Name(),
+		"build_name": buildToRerun.Name(),
+	})
+
+	build, err := job.RerunBuild(buildToRerun)
+	if err != nil {
+		logger.Error("failed-to-create-job-rerun-build", err)
+		return nil, nil, err
+	}
+
+	return build, s.startPendingBuilds(logger, job, resources, resourceTypes), nil
+}
+
+func (s *Scheduler) startPendingBuilds(
+	logger lager.Logger,
+	job db.Job,
+	resources db.Resources,
+	resourceTypes atc.VersionedResourceTypes,
+) Waiter {
 	wg := new(sync.WaitGroup)
 	wg.Add(1)
 
@@ -132,7 +163,7 @@ func (s *Scheduler) TriggerImmediately(
 		}
 	}()
 
-	return build, wg, nil
+	return wg
 }
 
 func (s *Scheduler) SaveNextInputMapping(logger lager.Logger, job db.Job, resources db.Resources) error {
@@ -346,6 +346,80 @@ var _ = Describe("Scheduler", func() {
 		})
 	})
 
+	Describe("RerunImmediately", func() {
+		var (
+			fakeJob           *dbfakes.FakeJob
+			fakeBuild         *dbfakes.FakeBuild
+			rerunBuild        db.Build
+			rerunErr          error
+			nextPendingBuilds []db.Build
+		)
+
+		BeforeEach(func() {
+			fakeJob = new(dbfakes.FakeJob)
+			fakeJob.NameReturns("some-job")
+
+			fakeBuild = new(dbfakes.FakeBuild)
+			fakeBuild.IDReturns(42)
+			fakeBuild.NameReturns("1")
+		})
+
+		JustBeforeEach(func() {
+			var waiter Waiter
+			rerunBuild, waiter, rerunErr = scheduler.RerunImmediately(
+				lagertest.NewTestLogger("test"),
+				fakeJob,
+				fakeBuild,
+				db.Resources{},
+				atc.VersionedResourceTypes{},
+			)
+			if waiter != nil {
+				waiter.Wait()
+			}
+		})
+
+		Context("when creating the rerun build fails", func() {
+			BeforeEach(func() {
+				fakeJob.RerunBuildReturns(nil, disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(rerunErr).To(Equal(disaster))
+			})
+
+			It("does not try to start pending builds for job", func() {
+				Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when creating the rerun build succeeds", func() {
+			var createdBuild *dbfakes.FakeBuild
+
+			BeforeEach(func() {
+				createdBuild = new(dbfakes.FakeBuild)
+				createdBuild.RerunOfReturns(42)
+				fakeJob.RerunBuildReturns(createdBuild, nil)
+
+				nextPendingBuilds = []db.Build{createdBuild}
+				fakeJob.GetPendingBuildsReturns(nextPendingBuilds, nil)
+			})
+
+			It("reruns the given build", func() {
+				Expect(rerunErr).NotTo(HaveOccurred())
+				Expect(rerunBuild).To(Equal(createdBuild))
+
+				Expect(fakeJob.RerunBuildCallCount()).To(Equal(1))
+				Expect(fakeJob.RerunBuildArgsForCall(0)).To(Equal(fakeBuild))
+			})
+
+			It("tries to start the pending builds for the job", func() {
+				Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(Equal(1))
+				_, _, _, _, b := fakeBuildStarter.TryStartPendingBuildsForJobArgsForCall(0)
+				Expect(b).To(Equal(nextPendingBuilds))
+			})
+		})
+	})
+
 	Describe("SaveNextInputMapping", func() {
 		var saveErr error
 		var fakeJob *dbfakes.FakeJob
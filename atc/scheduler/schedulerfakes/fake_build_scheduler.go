@@ -13,6 +13,25 @@ import (
 )
 
 type FakeBuildScheduler struct {
+	RerunImmediatelyStub        func(lager.Logger, db.Job, db.Build, db.Resources, atc.VersionedResourceTypes) (db.Build, scheduler.Waiter, error)
+	rerunImmediatelyMutex       sync.RWMutex
+	rerunImmediatelyArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 db.Job
+		arg3 db.Build
+		arg4 db.Resources
+		arg5 atc.VersionedResourceTypes
+	}
+	rerunImmediatelyReturns struct {
+		result1 db.Build
+		result2 scheduler.Waiter
+		result3 error
+	}
+	rerunImmediatelyReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 scheduler.Waiter
+		result3 error
+	}
 	SaveNextInputMappingStub        func(lager.Logger, db.Job, db.Resources) error
 	saveNextInputMappingMutex       sync.RWMutex
 	saveNextInputMappingArgsForCall []struct {
@@ -65,6 +84,76 @@ type FakeBuildScheduler struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeBuildScheduler) RerunImmediately(arg1 lager.Logger, arg2 db.Job, arg3 db.Build, arg4 db.Resources, arg5 atc.VersionedResourceTypes) (db.Build, scheduler.Waiter, error) {
+	fake.rerunImmediatelyMutex.Lock()
+	ret, specificReturn := fake.rerunImmediatelyReturnsOnCall[len(fake.rerunImmediatelyArgsForCall)]
+	fake.rerunImmediatelyArgsForCall = append(fake.rerunImmediatelyArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 db.Job
+		arg3 db.Build
+		arg4 db.Resources
+		arg5 atc.VersionedResourceTypes
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("RerunImmediately", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.rerunImmediatelyMutex.Unlock()
+	if fake.RerunImmediatelyStub != nil {
+		return fake.RerunImmediatelyStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.rerunImmediatelyReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuildScheduler) RerunImmediatelyCallCount() int {
+	fake.rerunImmediatelyMutex.RLock()
+	defer fake.rerunImmediatelyMutex.RUnlock()
+	return len(fake.rerunImmediatelyArgsForCall)
+}
+
+func (fake *FakeBuildScheduler) RerunImmediatelyCalls(stub func(lager.Logger, db.Job, db.Build, db.Resources, atc.VersionedResourceTypes) (db.Build, scheduler.Waiter, error)) {
+	fake.rerunImmediatelyMutex.Lock()
+	defer fake.rerunImmediatelyMutex.Unlock()
+	fake.RerunImmediatelyStub = stub
+}
+
+func (fake *FakeBuildScheduler) RerunImmediatelyArgsForCall(i int) (lager.Logger, db.Job, db.Build, db.Resources, atc.VersionedResourceTypes) {
+	fake.rerunImmediatelyMutex.RLock()
+	defer fake.rerunImmediatelyMutex.RUnlock()
+	argsForCall := fake.rerunImmediatelyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeBuildScheduler) RerunImmediatelyReturns(result1 db.Build, result2 scheduler.Waiter, result3 error) {
+	fake.rerunImmediatelyMutex.Lock()
+	defer fake.rerunImmediatelyMutex.Unlock()
+	fake.RerunImmediatelyStub = nil
+	fake.rerunImmediatelyReturns = struct {
+		result1 db.Build
+		result2 scheduler.Waiter
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuildScheduler) RerunImmediatelyReturnsOnCall(i int, result1 db.Build, result2 scheduler.Waiter, result3 error) {
+	fake.rerunImmediatelyMutex.Lock()
+	defer fake.rerunImmediatelyMutex.Unlock()
+	fake.RerunImmediatelyStub = nil
+	if fake.rerunImmediatelyReturnsOnCall == nil {
+		fake.rerunImmediatelyReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 scheduler.Waiter
+			result3 error
+		})
+	}
+	fake.rerunImmediatelyReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 scheduler.Waiter
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeBuildScheduler) SaveNextInputMapping(arg1 lager.Logger, arg2 db.Job, arg3 db.Resources) error {
 	fake.saveNextInputMappingMutex.Lock()
 	ret, specificReturn := fake.saveNextInputMappingReturnsOnCall[len(fake.saveNextInputMappingArgsForCall)]
@@ -271,6 +360,8 @@ func (fake *FakeBuildScheduler) TriggerImmediatelyReturnsOnCall(i int, result1 d
 func (fake *FakeBuildScheduler) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.rerunImmediatelyMutex.RLock()
+	defer fake.rerunImmediatelyMutex.RUnlock()
 	fake.saveNextInputMappingMutex.RLock()
 	defer fake.saveNextInputMappingMutex.RUnlock()
 	fake.scheduleMutex.RLock()
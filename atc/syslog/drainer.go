@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"time"
 
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/event"
@@ -26,15 +27,20 @@ type drainer struct {
 	hostname     string
 	transport    string `yaml:"transport"`
 	address      string `yaml:"address"`
+	format       string
 	caCerts      []string
 	buildFactory db.BuildFactory
 }
 
-func NewDrainer(transport string, address string, hostname string, caCerts []string, buildFactory db.BuildFactory) Drainer {
+// NewDrainer returns a Drainer which sends the events of completed builds to
+// a syslog server in the given format, which is one of FormatPlain,
+// FormatRFC5424 or FormatJSON.
+func NewDrainer(transport string, address string, hostname string, format string, caCerts []string, buildFactory db.BuildFactory) Drainer {
 	return &drainer{
 		hostname:     hostname,
 		transport:    transport,
 		address:      address,
+		format:       format,
 		buildFactory: buildFactory,
 		caCerts:      caCerts,
 	}
@@ -50,24 +56,13 @@ func (d *drainer) Run(ctx context.Context) error {
 	}
 
 	if len(builds) > 0 {
-		var certpool *x509.CertPool
-		if d.transport == "tls" {
-			certpool, err = x509.SystemCertPool()
-			if err != nil {
-				return err
-			}
-
-			for _, cert := range d.caCerts {
-				content, err := ioutil.ReadFile(cert)
-				if err != nil {
-					return err
-				}
+		certpool, err := d.certPool()
+		if err != nil {
+			return err
+		}
 
-				ok := certpool.AppendCertsFromPEM(content)
-				if !ok {
-					return errors.New("syslog drainer certificate error")
-				}
-			}
+		if d.format == FormatRFC5424 || d.format == FormatJSON {
+			return d.drainStructured(logger, builds, certpool)
 		}
 
 		syslog, err := sl.Dial(
@@ -141,3 +136,127 @@ func (d *drainer) Run(ctx context.Context) error {
 	}
 	return nil
 }
+
+func (d *drainer) certPool() (*x509.CertPool, error) {
+	if d.transport != "tls" {
+		return nil, nil
+	}
+
+	certpool, err := x509.SystemCertPool()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cert := range d.caCerts {
+		content, err := ioutil.ReadFile(cert)
+		if err != nil {
+			return nil, err
+		}
+
+		ok := certpool.AppendCertsFromPEM(content)
+		if !ok {
+			return nil, errors.New("syslog drainer certificate error")
+		}
+	}
+
+	return certpool, nil
+}
+
+// drainStructured sends both the log and status events of each build,
+// annotated with the metadata of the build and the step which emitted them.
+func (d *drainer) drainStructured(logger lager.Logger, builds []db.Build, certpool *x509.CertPool) error {
+	writer, err := dialStructured(d.format, d.transport, d.address, certpool)
+	if err != nil {
+		logger.Error("Syslog drainer connecting to server error.", err)
+		return err
+	}
+
+	defer writer.Close()
+
+	for _, build := range builds {
+		err := d.drainBuildStructured(logger, writer, build)
+		if err != nil {
+			return err
+		}
+
+		err = build.SetDrained(true)
+		if err != nil {
+			logger.Error("Syslog drainer setting drained on build error.", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *drainer) drainBuildStructured(logger lager.Logger, writer *structuredWriter, build db.Build) error {
+	events, err := build.Events(0)
+	if err != nil {
+		logger.Error("Syslog drainer getting build events error.", err)
+		return err
+	}
+
+	defer db.Close(events)
+
+	steps := stepNames(build)
+
+	for {
+		ev, err := events.Next()
+		if err != nil {
+			if err == db.ErrEndOfBuildEventStream {
+				return nil
+			}
+			logger.Error("Syslog drainer getting next event error.", err)
+			return err
+		}
+
+		r := record{
+			Hostname: d.hostname,
+			Event:    string(ev.Event),
+			Team:     build.TeamName(),
+			Pipeline: build.PipelineName(),
+			Job:      build.JobName(),
+			Build:    build.Name(),
+			BuildID:  build.ID(),
+		}
+
+		switch ev.Event {
+		case event.EventTypeLog:
+			var log event.Log
+
+			err := json.Unmarshal(*ev.Data, &log)
+			if err != nil {
+				logger.Error("Syslog drainer unmarshalling log error.", err)
+				return err
+			}
+
+			r.Time = time.Unix(log.Time, 0).UTC()
+			r.Step = steps[log.Origin.ID]
+			r.Origin = string(log.Origin.ID)
+			r.Source = string(log.Origin.Source)
+			r.Message = log.Payload
+
+		case event.EventTypeStatus:
+			var status event.Status
+
+			err := json.Unmarshal(*ev.Data, &status)
+			if err != nil {
+				logger.Error("Syslog drainer unmarshalling status error.", err)
+				return err
+			}
+
+			r.Time = time.Unix(status.Time, 0).UTC()
+			r.Status = string(status.Status)
+			r.Message = "build " + string(status.Status)
+
+		default:
+			continue
+		}
+
+		err = writer.Write(r)
+		if err != nil {
+			logger.Error("Syslog drainer sending to server error.", err)
+			return err
+		}
+	}
+}
@@ -8,13 +8,16 @@ import (
 	"net"
 	"os"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/square/certstrap/pkix"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/event"
@@ -81,7 +84,7 @@ func (server *testServer) ServeTCP() {
 
 		time.Sleep(100 * time.Millisecond)
 
-		buf := make([]byte, 1024)
+		buf := make([]byte, 4096)
 		n, err := conn.Read(buf)
 
 		// expect bad certificate from 'bad cert' test
@@ -129,6 +132,41 @@ func newFakeBuild(id int) db.Build {
 	return fakeBuild
 }
 
+func newFakePipelineBuild(id int) db.Build {
+	return newFakePipelineBuildWithPlan(id, json.RawMessage(`{"id":"some-do-id","do":[{"id":"some-plan-id","task":{"name":"some-task","privileged":false}}]}`))
+}
+
+func newFakePipelineBuildWithPlan(id int, plan json.RawMessage) db.Build {
+	fakeEventSource := new(dbfakes.FakeEventSource)
+
+	msg1 := json.RawMessage(`{"time":1533744538,"payload":"build ` + strconv.Itoa(id) + ` log","origin":{"id":"some-plan-id","source":"stdout"}}`)
+
+	fakeEventSource.NextReturnsOnCall(0, event.Envelope{
+		Data:  &msg1,
+		Event: "log",
+	}, nil)
+
+	msg2 := json.RawMessage(`{"time":1533744539,"status":"succeeded"}`)
+
+	fakeEventSource.NextReturnsOnCall(1, event.Envelope{
+		Data:  &msg2,
+		Event: "status",
+	}, nil)
+
+	fakeEventSource.NextReturns(event.Envelope{}, db.ErrEndOfBuildEventStream)
+
+	fakeBuild := new(dbfakes.FakeBuild)
+	fakeBuild.EventsReturns(fakeEventSource, nil)
+	fakeBuild.IDReturns(id)
+	fakeBuild.NameReturns("42")
+	fakeBuild.TeamNameReturns("some-team")
+	fakeBuild.PipelineNameReturns("some-pipeline")
+	fakeBuild.JobNameReturns("some-job")
+	fakeBuild.PublicPlanReturns(&plan)
+
+	return fakeBuild
+}
+
 var _ = Describe("Drainer", func() {
 	var fakeBuildFactory *dbfakes.FakeBuildFactory
 	var server *testServer
@@ -191,14 +229,14 @@ var _ = Describe("Drainer", func() {
 			})
 
 			It("connects to remote server given correct cert", func() {
-				testDrainer := syslog.NewDrainer("tls", server.Addr, "test", []string{caFilePath}, fakeBuildFactory)
+				testDrainer := syslog.NewDrainer("tls", server.Addr, "test", syslog.FormatPlain, []string{caFilePath}, fakeBuildFactory)
 
 				err := testDrainer.Run(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 			})
 
 			It("fails connects to remote server given incorrect cert", func() {
-				testDrainer := syslog.NewDrainer("tls", server.Addr, "test", []string{"testdata/incorrect-cert.pem"}, fakeBuildFactory)
+				testDrainer := syslog.NewDrainer("tls", server.Addr, "test", syslog.FormatPlain, []string{"testdata/incorrect-cert.pem"}, fakeBuildFactory)
 
 				err := testDrainer.Run(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -212,7 +250,7 @@ var _ = Describe("Drainer", func() {
 			})
 
 			It("drains all build events by tcp", func() {
-				testDrainer := syslog.NewDrainer("tcp", server.Addr, "test", []string{}, fakeBuildFactory)
+				testDrainer := syslog.NewDrainer("tcp", server.Addr, "test", syslog.FormatPlain, []string{}, fakeBuildFactory)
 				err := testDrainer.Run(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 
@@ -222,6 +260,105 @@ var _ = Describe("Drainer", func() {
 				Expect(got).NotTo(ContainSubstring("build 123 status"))
 				Expect(got).NotTo(ContainSubstring("build 345 status"))
 			}, 0.2)
+
+			Context("when the format is rfc5424", func() {
+				var build db.Build
+
+				BeforeEach(func() {
+					build = newFakePipelineBuild(123)
+					fakeBuildFactory.GetDrainableBuildsReturns([]db.Build{build}, nil)
+				})
+
+				It("sends log and status events with the build's metadata as structured data", func() {
+					testDrainer := syslog.NewDrainer("tcp", server.Addr, "test", syslog.FormatRFC5424, []string{}, fakeBuildFactory)
+					err := testDrainer.Run(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					got := <-server.Messages
+					Expect(got).To(ContainSubstring(
+						`<14>1 2018-08-08T16:08:58Z test concourse 123 log [concourse@32473 team="some-team" pipeline="some-pipeline" job="some-job" build="42" build_id="123" step="some-task" origin="some-plan-id" source="stdout"] build 123 log`,
+					))
+					Expect(got).To(ContainSubstring(
+						`<14>1 2018-08-08T16:08:59Z test concourse 123 status [concourse@32473 team="some-team" pipeline="some-pipeline" job="some-job" build="42" build_id="123" status="succeeded"] build succeeded`,
+					))
+				}, 0.2)
+
+				DescribeTable("names the step which logged, whatever its type",
+					func(plan atc.Plan) {
+						plan.ID = "some-plan-id"
+
+						fakeBuildFactory.GetDrainableBuildsReturns([]db.Build{newFakePipelineBuildWithPlan(123, *plan.Public())}, nil)
+
+						testDrainer := syslog.NewDrainer("tcp", server.Addr, "test", syslog.FormatRFC5424, []string{}, fakeBuildFactory)
+						err := testDrainer.Run(context.TODO())
+						Expect(err).NotTo(HaveOccurred())
+
+						got := <-server.Messages
+						Expect(got).To(ContainSubstring(`step="some-step"`))
+					},
+					Entry("get", atc.Plan{Get: &atc.GetPlan{Name: "some-step"}}),
+					Entry("put", atc.Plan{Put: &atc.PutPlan{Name: "some-step"}}),
+					Entry("dependent get", atc.Plan{DependentGet: &atc.DependentGetPlan{Name: "some-step"}}),
+					Entry("task", atc.Plan{Task: &atc.TaskPlan{Name: "some-step"}}),
+					Entry("set_pipeline", atc.Plan{SetPipeline: &atc.SetPipelinePlan{Name: "some-step"}}),
+					Entry("load_var", atc.Plan{LoadVar: &atc.LoadVarPlan{Name: "some-step"}}),
+					Entry("approval", atc.Plan{Approval: &atc.ApprovalPlan{Name: "some-step"}}),
+				)
+
+				It("marks the build as drained", func() {
+					testDrainer := syslog.NewDrainer("tcp", server.Addr, "test", syslog.FormatRFC5424, []string{}, fakeBuildFactory)
+					err := testDrainer.Run(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					fakeBuild := build.(*dbfakes.FakeBuild)
+					Expect(fakeBuild.SetDrainedCallCount()).To(Equal(1))
+					Expect(fakeBuild.SetDrainedArgsForCall(0)).To(BeTrue())
+				})
+			})
+
+			Context("when the format is json", func() {
+				BeforeEach(func() {
+					fakeBuildFactory.GetDrainableBuildsReturns([]db.Build{newFakePipelineBuild(123)}, nil)
+				})
+
+				It("sends log and status events as JSON lines", func() {
+					testDrainer := syslog.NewDrainer("tcp", server.Addr, "test", syslog.FormatJSON, []string{}, fakeBuildFactory)
+					err := testDrainer.Run(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					got := <-server.Messages
+					lines := strings.Split(strings.TrimSpace(got), "\n")
+					Expect(lines).To(HaveLen(2))
+
+					Expect(lines[0]).To(MatchJSON(`{
+						"time": "2018-08-08T16:08:58Z",
+						"hostname": "test",
+						"event": "log",
+						"team": "some-team",
+						"pipeline": "some-pipeline",
+						"job": "some-job",
+						"build": "42",
+						"build_id": 123,
+						"step": "some-task",
+						"origin": "some-plan-id",
+						"source": "stdout",
+						"message": "build 123 log"
+					}`))
+
+					Expect(lines[1]).To(MatchJSON(`{
+						"time": "2018-08-08T16:08:59Z",
+						"hostname": "test",
+						"event": "status",
+						"team": "some-team",
+						"pipeline": "some-pipeline",
+						"job": "some-job",
+						"build": "42",
+						"build_id": 123,
+						"status": "succeeded",
+						"message": "build succeeded"
+					}`))
+				}, 0.2)
+			})
 		})
 	})
 })
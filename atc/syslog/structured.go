@@ -0,0 +1,254 @@
+package syslog
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+	sl "github.com/papertrail/remote_syslog2/syslog"
+)
+
+const (
+	// FormatPlain sends each log line as an RFC5424 message whose tag
+	// identifies the build, without any structured data.
+	FormatPlain = "plain"
+
+	// FormatRFC5424 sends log lines and build status changes as RFC5424
+	// messages carrying the build's metadata as structured data.
+	FormatRFC5424 = "rfc5424"
+
+	// FormatJSON sends log lines and build status changes as JSON objects,
+	// one per line.
+	FormatJSON = "json"
+)
+
+// StructuredDataID is the SD-ID under which build metadata is sent in the
+// RFC5424 format. 32473 is the enterprise number reserved for documentation
+// by RFC5612.
+const StructuredDataID = "concourse@32473"
+
+const appName = "concourse"
+
+// A record is a single build event, along with the metadata of the build it
+// belongs to.
+type record struct {
+	Time     time.Time `json:"time"`
+	Hostname string    `json:"hostname"`
+	Event    string    `json:"event"`
+
+	Team     string `json:"team"`
+	Pipeline string `json:"pipeline,omitempty"`
+	Job      string `json:"job,omitempty"`
+	Build    string `json:"build"`
+	BuildID  int    `json:"build_id"`
+
+	Step   string `json:"step,omitempty"`
+	Origin string `json:"origin,omitempty"`
+	Source string `json:"source,omitempty"`
+	Status string `json:"status,omitempty"`
+
+	Message string `json:"message"`
+}
+
+func (r record) severity() sl.Priority {
+	switch atc.BuildStatus(r.Status) {
+	case atc.StatusErrored:
+		return sl.SevErr
+	case atc.StatusFailed, atc.StatusAborted:
+		return sl.SevWarning
+	}
+
+	if r.Source == string(event.OriginSourceStderr) {
+		return sl.SevNotice
+	}
+
+	return sl.SevInfo
+}
+
+// rfc5424 formats the record as an RFC5424 message. The build's metadata is
+// sent as structured data, omitting any fields which are not set.
+func (r record) rfc5424() string {
+	params := []struct {
+		name  string
+		value string
+	}{
+		{"team", r.Team},
+		{"pipeline", r.Pipeline},
+		{"job", r.Job},
+		{"build", r.Build},
+		{"build_id", strconv.Itoa(r.BuildID)},
+		{"step", r.Step},
+		{"origin", r.Origin},
+		{"source", r.Source},
+		{"status", r.Status},
+	}
+
+	sd := "[" + StructuredDataID
+	for _, param := range params {
+		if param.value == "" {
+			continue
+		}
+
+		sd += " " + param.name + `="` + escapeParamValue(param.value) + `"`
+	}
+	sd += "]"
+
+	return fmt.Sprintf(
+		"<%d>1 %s %s %s %d %s %s %s",
+		sl.LogUser<<3|r.severity(),
+		r.Time.Format(time.RFC3339),
+		nilValue(r.Hostname),
+		appName,
+		r.BuildID,
+		r.Event,
+		sd,
+		r.Message,
+	)
+}
+
+// escapeParamValue escapes the characters which must be escaped in
+// structured data parameter values, per RFC5424 section 6.3.3.
+func escapeParamValue(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		`]`, `\]`,
+	).Replace(value)
+}
+
+func nilValue(value string) string {
+	if value == "" {
+		return "-"
+	}
+
+	return value
+}
+
+// A structuredWriter sends records to a syslog server in either the RFC5424
+// or JSON format.
+type structuredWriter struct {
+	format    string
+	transport string
+	conn      net.Conn
+}
+
+func dialStructured(format string, transport string, address string, certpool *x509.CertPool) (*structuredWriter, error) {
+	var conn net.Conn
+	var err error
+
+	switch transport {
+	case "tls":
+		conn, err = tls.DialWithDialer(
+			&net.Dialer{Timeout: 30 * time.Second},
+			"tcp",
+			address,
+			&tls.Config{RootCAs: certpool},
+		)
+	case "tcp", "udp":
+		conn, err = net.DialTimeout(transport, address, 30*time.Second)
+	default:
+		err = fmt.Errorf("unknown syslog transport: %s", transport)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &structuredWriter{
+		format:    format,
+		transport: transport,
+		conn:      conn,
+	}, nil
+}
+
+func (w *structuredWriter) Write(r record) error {
+	var msg string
+	switch w.format {
+	case FormatJSON:
+		payload, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+
+		msg = string(payload) + "\n"
+
+	case FormatRFC5424:
+		msg = r.rfc5424()
+
+		// log lines may contain newlines, so messages sent over a stream are
+		// framed by octet counting as described in RFC6587
+		if w.transport != "udp" {
+			msg = strconv.Itoa(len(msg)) + " " + msg
+		}
+	}
+
+	err := w.conn.SetWriteDeadline(time.Now().Add(30 * time.Second))
+	if err != nil {
+		return err
+	}
+
+	_, err = w.conn.Write([]byte(msg))
+	return err
+}
+
+func (w *structuredWriter) Close() error {
+	return w.conn.Close()
+}
+
+// stepNames maps the plan IDs of a build's steps to their names, which are
+// otherwise not known to the events they emit.
+func stepNames(build db.Build) map[event.OriginID]string {
+	names := map[event.OriginID]string{}
+
+	plan := build.PublicPlan()
+	if plan == nil {
+		return names
+	}
+
+	var tree interface{}
+	err := json.Unmarshal(*plan, &tree)
+	if err != nil {
+		return names
+	}
+
+	collectStepNames(tree, names)
+
+	return names
+}
+
+// collectStepNames finds the named steps in the public plan. A step's config
+// is the only object nested directly within its plan which has a name, so no
+// step type needs to be known here.
+func collectStepNames(tree interface{}, names map[event.OriginID]string) {
+	switch node := tree.(type) {
+	case []interface{}:
+		for _, child := range node {
+			collectStepNames(child, names)
+		}
+
+	case map[string]interface{}:
+		if id, ok := node["id"].(string); ok {
+			for _, value := range node {
+				config, ok := value.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				if name, ok := config["name"].(string); ok {
+					names[event.OriginID(id)] = name
+				}
+			}
+		}
+
+		for _, child := range node {
+			collectStepNames(child, names)
+		}
+	}
+}
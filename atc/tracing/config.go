@@ -0,0 +1,46 @@
+package tracing
+
+import (
+	"errors"
+	"net/http"
+)
+
+type Config struct {
+	ServiceName string            `long:"service-name" default:"concourse-web" description:"Service name to attach to traces."`
+	Attributes  map[string]string `long:"attribute" description:"A key-value attribute to attach to traces. Can be specified multiple times." value-name:"NAME:VALUE"`
+
+	Jaeger JaegerConfig `namespace:"jaeger"`
+	OTLP   OTLPConfig   `namespace:"otlp"`
+}
+
+type JaegerConfig struct {
+	Endpoint string `long:"endpoint" description:"URL of a Jaeger collector's HTTP endpoint to which to export traces, e.g. http://jaeger:14268/api/traces."`
+}
+
+type OTLPConfig struct {
+	Endpoint string            `long:"endpoint" description:"URL of an OpenTelemetry collector to which to export traces over OTLP/HTTP, e.g. http://collector:4318."`
+	Headers  map[string]string `long:"header" description:"A header to send with each export request, e.g. for authentication. Can be specified multiple times." value-name:"NAME:VALUE"`
+}
+
+func (config Config) IsConfigured() bool {
+	return config.Jaeger.Endpoint != "" || config.OTLP.Endpoint != ""
+}
+
+// Exporter returns the exporter for the configured tracing backend.
+func (config Config) Exporter() (Exporter, error) {
+	if config.Jaeger.Endpoint != "" && config.OTLP.Endpoint != "" {
+		return nil, errors.New("only one of the Jaeger and OTLP exporters may be configured")
+	}
+
+	httpClient := &http.Client{Timeout: exportTimeout}
+
+	if config.Jaeger.Endpoint != "" {
+		return NewJaegerExporter(config.Jaeger.Endpoint, config.ServiceName, config.Attributes, httpClient), nil
+	}
+
+	if config.OTLP.Endpoint != "" {
+		return NewOTLPExporter(config.OTLP.Endpoint, config.OTLP.Headers, config.ServiceName, config.Attributes, httpClient), nil
+	}
+
+	return nil, errors.New("no tracing exporter configured")
+}
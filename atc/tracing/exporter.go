@@ -0,0 +1,127 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter . Exporter
+
+// An Exporter sends finished spans to a tracing backend.
+type Exporter interface {
+	ExportSpans(context.Context, []SpanData) error
+}
+
+const (
+	batchSize     = 512
+	queueSize     = 2048
+	flushInterval = 5 * time.Second
+	exportTimeout = 30 * time.Second
+)
+
+var (
+	// configuredMutex guards configured and spans, which are read whenever a
+	// span is started or ended
+	configuredMutex sync.RWMutex
+	configured      bool
+	spans           chan SpanData
+
+	flushed sync.WaitGroup
+)
+
+// Configured is true if spans are being exported.
+func Configured() bool {
+	configuredMutex.RLock()
+	defer configuredMutex.RUnlock()
+
+	return configured
+}
+
+// Configure starts exporting the spans ended from now on to exporter. Spans
+// are exported in batches in the background.
+func Configure(logger lager.Logger, exporter Exporter) {
+	configuredMutex.Lock()
+	defer configuredMutex.Unlock()
+
+	spans = make(chan SpanData, queueSize)
+	configured = true
+
+	flushed.Add(1)
+	go exportLoop(logger, exporter, spans)
+}
+
+// Deconfigure stops tracing, waiting for spans which have already ended to be
+// exported.
+func Deconfigure() {
+	configuredMutex.Lock()
+	if !configured {
+		configuredMutex.Unlock()
+		return
+	}
+
+	configured = false
+	close(spans)
+	configuredMutex.Unlock()
+
+	flushed.Wait()
+}
+
+func export(data SpanData) {
+	configuredMutex.RLock()
+	defer configuredMutex.RUnlock()
+
+	if !configured {
+		return
+	}
+
+	select {
+	case spans <- data:
+	default:
+		// drop the span rather than hold up the traced operation
+	}
+}
+
+func exportLoop(logger lager.Logger, exporter Exporter, spans <-chan SpanData) {
+	defer flushed.Done()
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	batch := []SpanData{}
+
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+		defer cancel()
+
+		err := exporter.ExportSpans(ctx, batch)
+		if err != nil {
+			logger.Error("failed-to-export-spans", err, lager.Data{"spans": len(batch)})
+		}
+
+		batch = []SpanData{}
+	}
+
+	for {
+		select {
+		case data, ok := <-spans:
+			if !ok {
+				flush()
+				return
+			}
+
+			batch = append(batch, data)
+			if len(batch) >= batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
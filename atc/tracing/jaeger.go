@@ -0,0 +1,104 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"net/http"
+	"sort"
+
+	"github.com/uber/jaeger-client-go/thrift"
+	"github.com/uber/jaeger-client-go/thrift-gen/jaeger"
+)
+
+// JaegerExporter sends spans to a Jaeger collector's HTTP endpoint, encoded
+// as a Thrift jaeger.Batch using the binary protocol.
+type JaegerExporter struct {
+	endpoint    string
+	serviceName string
+	tags        Attrs
+	httpClient  *http.Client
+}
+
+// NewJaegerExporter returns an exporter which posts spans to the collector's
+// endpoint, e.g. http://jaeger:14268/api/traces, tagged with the given
+// service name and attributes.
+func NewJaegerExporter(endpoint string, serviceName string, attributes Attrs, httpClient *http.Client) *JaegerExporter {
+	return &JaegerExporter{
+		endpoint:    endpoint,
+		serviceName: serviceName,
+		tags:        attributes,
+		httpClient:  httpClient,
+	}
+}
+
+func (exporter *JaegerExporter) ExportSpans(ctx context.Context, spans []SpanData) error {
+	batch := &jaeger.Batch{
+		Process: &jaeger.Process{
+			ServiceName: exporter.serviceName,
+			Tags:        jaegerTags(exporter.tags, ""),
+		},
+	}
+
+	for _, span := range spans {
+		batch.Spans = append(batch.Spans, jaegerSpan(span))
+	}
+
+	body, err := thrift.NewTSerializer().Write(batch)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest("POST", exporter.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/x-thrift")
+
+	return send(exporter.httpClient, request.WithContext(ctx))
+}
+
+func jaegerSpan(span SpanData) *jaeger.Span {
+	return &jaeger.Span{
+		TraceIdLow:    int64(binary.BigEndian.Uint64(span.TraceID[8:])),
+		TraceIdHigh:   int64(binary.BigEndian.Uint64(span.TraceID[:8])),
+		SpanId:        int64(binary.BigEndian.Uint64(span.SpanID[:])),
+		ParentSpanId:  int64(binary.BigEndian.Uint64(span.ParentSpanID[:])),
+		OperationName: span.Name,
+		Flags:         1, // sampled
+		StartTime:     span.StartTime.UnixNano() / 1000,
+		Duration:      span.EndTime.Sub(span.StartTime).Nanoseconds() / 1000,
+		Tags:          jaegerTags(span.Attributes, span.Error),
+	}
+}
+
+// jaegerTags converts the attributes to tags, tagging the span with
+// error=true and the error's message if errMessage is not empty.
+func jaegerTags(attrs Attrs, errMessage string) []*jaeger.Tag {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	tags := []*jaeger.Tag{}
+	for _, k := range keys {
+		tags = append(tags, jaegerStringTag(k, attrs[k]))
+	}
+
+	if errMessage != "" {
+		isError := true
+		tags = append(tags,
+			&jaeger.Tag{Key: "error", VType: jaeger.TagType_BOOL, VBool: &isError},
+			jaegerStringTag("error.message", errMessage),
+		)
+	}
+
+	return tags
+}
+
+func jaegerStringTag(key string, value string) *jaeger.Tag {
+	return &jaeger.Tag{Key: key, VType: jaeger.TagType_STRING, VStr: &value}
+}
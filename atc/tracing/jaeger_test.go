@@ -0,0 +1,103 @@
+package tracing_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc/tracing"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/uber/jaeger-client-go/thrift"
+	"github.com/uber/jaeger-client-go/thrift-gen/jaeger"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JaegerExporter", func() {
+	var (
+		server   *ghttp.Server
+		exporter *tracing.JaegerExporter
+		body     []byte
+		err      error
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/api/traces"),
+				ghttp.VerifyContentType("application/x-thrift"),
+				func(w http.ResponseWriter, r *http.Request) {
+					var readErr error
+					body, readErr = ioutil.ReadAll(r.Body)
+					Expect(readErr).ToNot(HaveOccurred())
+				},
+				ghttp.RespondWith(http.StatusAccepted, nil),
+			),
+		)
+
+		exporter = tracing.NewJaegerExporter(
+			server.URL()+"/api/traces",
+			"concourse-web",
+			nil,
+			http.DefaultClient,
+		)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	JustBeforeEach(func() {
+		err = exporter.ExportSpans(context.TODO(), []tracing.SpanData{
+			{
+				Name:       "task",
+				TraceID:    tracing.TraceID{0x01, 15: 0x02},
+				SpanID:     tracing.SpanID{0x03, 7: 0x04},
+				StartTime:  time.Unix(1, 0),
+				EndTime:    time.Unix(3, 0),
+				Attributes: tracing.Attrs{"name": "unit"},
+				Error:      "disaster",
+			},
+		})
+	})
+
+	It("sends the spans as a thrift-encoded batch", func() {
+		Expect(err).ToNot(HaveOccurred())
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+
+		buf := thrift.NewTMemoryBuffer()
+		_, err := buf.Write(body)
+		Expect(err).ToNot(HaveOccurred())
+
+		batch := new(jaeger.Batch)
+		Expect(batch.Read(thrift.NewTBinaryProtocolTransport(buf))).To(Succeed())
+
+		name, unit, message, isError := "name", "unit", "disaster", true
+		Expect(batch).To(Equal(&jaeger.Batch{
+			Process: &jaeger.Process{
+				ServiceName: "concourse-web",
+				Tags:        []*jaeger.Tag{},
+			},
+			Spans: []*jaeger.Span{
+				{
+					TraceIdLow:    2,
+					TraceIdHigh:   0x0100000000000000,
+					SpanId:        0x0300000000000004,
+					ParentSpanId:  0,
+					OperationName: "task",
+					Flags:         1,
+					StartTime:     1000000,
+					Duration:      2000000,
+					Tags: []*jaeger.Tag{
+						{Key: name, VType: jaeger.TagType_STRING, VStr: &unit},
+						{Key: "error", VType: jaeger.TagType_BOOL, VBool: &isError},
+						{Key: "error.message", VType: jaeger.TagType_STRING, VStr: &message},
+					},
+				},
+			},
+		}))
+	})
+})
@@ -0,0 +1,188 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// OTLPExporter sends spans to an OpenTelemetry collector using the OTLP/HTTP
+// protocol with JSON encoding.
+//
+// The request is encoded here rather than with the OpenTelemetry exporter,
+// which requires far newer gRPC and protobuf modules than the rest of the
+// tree can be built with.
+type OTLPExporter struct {
+	endpoint   string
+	headers    map[string]string
+	resource   Attrs
+	httpClient *http.Client
+}
+
+// NewOTLPExporter returns an exporter which posts spans to the collector's
+// /v1/traces endpoint, tagged with the given service name and attributes.
+func NewOTLPExporter(endpoint string, headers map[string]string, serviceName string, attributes Attrs, httpClient *http.Client) *OTLPExporter {
+	resource := Attrs{"service.name": serviceName}
+	for k, v := range attributes {
+		resource[k] = v
+	}
+
+	return &OTLPExporter{
+		endpoint:   strings.TrimRight(endpoint, "/") + "/v1/traces",
+		headers:    headers,
+		resource:   resource,
+		httpClient: httpClient,
+	}
+}
+
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+const (
+	otlpSpanKindInternal = 1
+	otlpStatusCodeError  = 2
+)
+
+func (exporter *OTLPExporter) ExportSpans(ctx context.Context, spans []SpanData) error {
+	otlpSpans := make([]otlpSpan, len(spans))
+	for i, span := range spans {
+		otlpSpans[i] = otlpSpan{
+			TraceID:           span.TraceID.String(),
+			SpanID:            span.SpanID.String(),
+			Name:              span.Name,
+			Kind:              otlpSpanKindInternal,
+			StartTimeUnixNano: strconv.FormatInt(span.StartTime.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(span.EndTime.UnixNano(), 10),
+			Attributes:        otlpAttributes(span.Attributes),
+		}
+
+		if !span.ParentSpanID.IsZero() {
+			otlpSpans[i].ParentSpanID = span.ParentSpanID.String()
+		}
+
+		if span.Error != "" {
+			otlpSpans[i].Status = otlpStatus{
+				Code:    otlpStatusCodeError,
+				Message: span.Error,
+			}
+		}
+	}
+
+	body, err := json.Marshal(otlpRequest{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{
+					Attributes: otlpAttributes(exporter.resource),
+				},
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{Name: "concourse"},
+						Spans: otlpSpans,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest("POST", exporter.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	for name, value := range exporter.headers {
+		request.Header.Set(name, value)
+	}
+
+	return send(exporter.httpClient, request.WithContext(ctx))
+}
+
+func otlpAttributes(attrs Attrs) []otlpKeyValue {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	kvs := make([]otlpKeyValue, len(keys))
+	for i, k := range keys {
+		kvs[i] = otlpKeyValue{
+			Key:   k,
+			Value: otlpAnyValue{StringValue: attrs[k]},
+		}
+	}
+
+	return kvs
+}
+
+func send(httpClient *http.Client, request *http.Request) error {
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(response.Body, 4096))
+	_ = response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response: %s", response.Status)
+	}
+
+	return nil
+}
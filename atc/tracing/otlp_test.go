@@ -0,0 +1,145 @@
+package tracing_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc/tracing"
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OTLPExporter", func() {
+	var (
+		server   *ghttp.Server
+		exporter *tracing.OTLPExporter
+		span     tracing.SpanData
+		err      error
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+
+		exporter = tracing.NewOTLPExporter(
+			server.URL()+"/",
+			map[string]string{"Authorization": "Bearer some-token"},
+			"concourse-web",
+			tracing.Attrs{"deployment": "ci"},
+			http.DefaultClient,
+		)
+
+		span = tracing.SpanData{
+			Name:         "get",
+			TraceID:      tracing.TraceID{0x01, 15: 0x02},
+			SpanID:       tracing.SpanID{0x03, 7: 0x04},
+			ParentSpanID: tracing.SpanID{0x05, 7: 0x06},
+			StartTime:    time.Unix(1, 0),
+			EndTime:      time.Unix(2, 500),
+			Attributes:   tracing.Attrs{"name": "some-resource"},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	JustBeforeEach(func() {
+		err = exporter.ExportSpans(context.TODO(), []tracing.SpanData{span})
+	})
+
+	Context("when the collector accepts the spans", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/traces"),
+					ghttp.VerifyContentType("application/json"),
+					ghttp.VerifyHeaderKV("Authorization", "Bearer some-token"),
+					ghttp.VerifyJSON(`{
+						"resourceSpans": [{
+							"resource": {
+								"attributes": [
+									{"key": "deployment", "value": {"stringValue": "ci"}},
+									{"key": "service.name", "value": {"stringValue": "concourse-web"}}
+								]
+							},
+							"scopeSpans": [{
+								"scope": {"name": "concourse"},
+								"spans": [{
+									"traceId": "01000000000000000000000000000002",
+									"spanId": "0300000000000004",
+									"parentSpanId": "0500000000000006",
+									"name": "get",
+									"kind": 1,
+									"startTimeUnixNano": "1000000000",
+									"endTimeUnixNano": "2000000500",
+									"attributes": [
+										{"key": "name", "value": {"stringValue": "some-resource"}}
+									],
+									"status": {}
+								}]
+							}]
+						}]
+					}`),
+					ghttp.RespondWith(http.StatusOK, `{}`),
+				),
+			)
+		})
+
+		It("sends the spans", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Context("when the span ended with an error", func() {
+		BeforeEach(func() {
+			span.Error = "disaster"
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					func(w http.ResponseWriter, r *http.Request) {
+						var request struct {
+							ResourceSpans []struct {
+								ScopeSpans []struct {
+									Spans []struct {
+										Status struct {
+											Code    int    `json:"code"`
+											Message string `json:"message"`
+										} `json:"status"`
+									} `json:"spans"`
+								} `json:"scopeSpans"`
+							} `json:"resourceSpans"`
+						}
+
+						Expect(json.NewDecoder(r.Body).Decode(&request)).To(Succeed())
+
+						status := request.ResourceSpans[0].ScopeSpans[0].Spans[0].Status
+						Expect(status.Code).To(Equal(2))
+						Expect(status.Message).To(Equal("disaster"))
+					},
+					ghttp.RespondWith(http.StatusOK, `{}`),
+				),
+			)
+		})
+
+		It("sends the span with an error status", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Context("when the collector rejects the spans", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusBadRequest, nil))
+		})
+
+		It("returns an error", func() {
+			Expect(err).To(MatchError(errors.New("unexpected response: 400 Bad Request")))
+		})
+	})
+})
@@ -0,0 +1,170 @@
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Attrs are the key-value pairs attached to a span.
+type Attrs map[string]string
+
+// TraceID identifies a trace, i.e. all of the spans started within the same
+// root span.
+type TraceID [16]byte
+
+func (id TraceID) String() string { return hex.EncodeToString(id[:]) }
+
+// SpanID identifies a span within its trace.
+type SpanID [8]byte
+
+func (id SpanID) String() string { return hex.EncodeToString(id[:]) }
+
+// IsZero is true for the parent ID of a root span.
+func (id SpanID) IsZero() bool { return id == SpanID{} }
+
+// SpanData is a finished span, as handed to an Exporter.
+type SpanData struct {
+	Name         string
+	TraceID      TraceID
+	SpanID       SpanID
+	ParentSpanID SpanID
+	StartTime    time.Time
+	EndTime      time.Time
+	Attributes   Attrs
+
+	// Error is the message of the error the span ended with, if any.
+	Error string
+}
+
+// Span is an operation being traced. Spans are started with StartSpan and
+// must be ended with End.
+//
+// A nil Span is valid, and is returned when tracing is not configured.
+type Span struct {
+	lock  sync.Mutex
+	data  SpanData
+	ended bool
+}
+
+// SetAttribute attaches a key-value pair to the span.
+func (span *Span) SetAttribute(key string, value string) {
+	if span == nil {
+		return
+	}
+
+	span.lock.Lock()
+	span.data.Attributes[key] = value
+	span.lock.Unlock()
+}
+
+// End finishes the span and queues it to be exported. Ending a span more
+// than once has no effect.
+func (span *Span) End() {
+	End(span, nil)
+}
+
+// TraceID returns the ID of the trace the span belongs to.
+func (span *Span) TraceID() TraceID {
+	if span == nil {
+		return TraceID{}
+	}
+
+	return span.data.TraceID
+}
+
+// SpanID returns the ID of the span.
+func (span *Span) SpanID() SpanID {
+	if span == nil {
+		return SpanID{}
+	}
+
+	return span.data.SpanID
+}
+
+// End finishes the span, recording err if it is not nil.
+func End(span *Span, err error) {
+	if span == nil {
+		return
+	}
+
+	span.lock.Lock()
+	if span.ended {
+		span.lock.Unlock()
+		return
+	}
+
+	span.ended = true
+	span.data.EndTime = time.Now()
+	if err != nil {
+		span.data.Error = err.Error()
+	}
+
+	data := span.data
+	data.Attributes = Attrs{}
+	for k, v := range span.data.Attributes {
+		data.Attributes[k] = v
+	}
+	span.lock.Unlock()
+
+	export(data)
+}
+
+type spanContextKey struct{}
+
+// StartSpan starts a span as a child of the span in ctx, or as the root of a
+// new trace if ctx has none. The returned context carries the new span.
+//
+// If tracing is not configured, ctx is returned as-is along with a nil span.
+func StartSpan(ctx context.Context, name string, attrs Attrs) (context.Context, *Span) {
+	if !Configured() {
+		return ctx, nil
+	}
+
+	span := &Span{
+		data: SpanData{
+			Name:       name,
+			StartTime:  time.Now(),
+			Attributes: Attrs{},
+		},
+	}
+
+	for k, v := range attrs {
+		span.data.Attributes[k] = v
+	}
+
+	if parent := FromContext(ctx); parent != nil {
+		span.data.TraceID = parent.TraceID()
+		span.data.ParentSpanID = parent.SpanID()
+	} else {
+		_, _ = rand.Read(span.data.TraceID[:])
+	}
+
+	_, _ = rand.Read(span.data.SpanID[:])
+
+	return context.WithValue(ctx, spanContextKey{}, span), span
+}
+
+// FromContext returns the span carried by ctx, or nil if there is none.
+func FromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanContextKey{}).(*Span)
+	return span
+}
+
+// TraceParentEnv is the environment variable through which the trace context
+// is passed to processes, e.g. tasks, so that they can continue the trace.
+const TraceParentEnv = "TRACEPARENT"
+
+// TraceParent returns the W3C Trace Context 'traceparent' value identifying
+// the span in ctx, or "" if there is none.
+func TraceParent(ctx context.Context) string {
+	span := FromContext(ctx)
+	if span == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("00-%s-%s-01", span.TraceID(), span.SpanID())
+}
@@ -0,0 +1,101 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/tracing"
+	"github.com/concourse/concourse/atc/tracing/tracingfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tracing", func() {
+	var fakeExporter *tracingfakes.FakeExporter
+
+	BeforeEach(func() {
+		fakeExporter = new(tracingfakes.FakeExporter)
+	})
+
+	exported := func() []tracing.SpanData {
+		tracing.Deconfigure()
+
+		spans := []tracing.SpanData{}
+		for i := 0; i < fakeExporter.ExportSpansCallCount(); i++ {
+			_, batch := fakeExporter.ExportSpansArgsForCall(i)
+			spans = append(spans, batch...)
+		}
+
+		return spans
+	}
+
+	Context("when tracing is not configured", func() {
+		It("does not start spans", func() {
+			ctx, span := tracing.StartSpan(context.Background(), "some-span", nil)
+			Expect(span).To(BeNil())
+			Expect(tracing.FromContext(ctx)).To(BeNil())
+			Expect(tracing.TraceParent(ctx)).To(BeEmpty())
+
+			span.SetAttribute("some", "attribute")
+			span.End()
+		})
+	})
+
+	Context("when tracing is configured", func() {
+		BeforeEach(func() {
+			tracing.Configure(lagertest.NewTestLogger("test"), fakeExporter)
+		})
+
+		AfterEach(func() {
+			tracing.Deconfigure()
+		})
+
+		It("exports ended spans", func() {
+			_, span := tracing.StartSpan(context.Background(), "some-span", tracing.Attrs{"team": "main"})
+			span.SetAttribute("build", "42")
+			span.End()
+
+			spans := exported()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].Name).To(Equal("some-span"))
+			Expect(spans[0].Attributes).To(Equal(tracing.Attrs{"team": "main", "build": "42"}))
+			Expect(spans[0].ParentSpanID.IsZero()).To(BeTrue())
+			Expect(spans[0].EndTime).To(BeTemporally(">=", spans[0].StartTime))
+			Expect(spans[0].Error).To(BeEmpty())
+		})
+
+		It("starts spans as children of the span in the context", func() {
+			ctx, parent := tracing.StartSpan(context.Background(), "parent", nil)
+			_, child := tracing.StartSpan(ctx, "child", nil)
+			child.End()
+			parent.End()
+
+			spans := exported()
+			Expect(spans).To(HaveLen(2))
+			Expect(spans[0].TraceID).To(Equal(spans[1].TraceID))
+			Expect(spans[0].ParentSpanID).To(Equal(spans[1].SpanID))
+			Expect(spans[0].SpanID).ToNot(Equal(spans[1].SpanID))
+		})
+
+		It("records the error a span ended with", func() {
+			_, span := tracing.StartSpan(context.Background(), "some-span", nil)
+			tracing.End(span, errors.New("disaster"))
+			span.End()
+
+			spans := exported()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].Error).To(Equal("disaster"))
+		})
+
+		It("identifies the span in the context as a W3C traceparent", func() {
+			ctx, span := tracing.StartSpan(context.Background(), "some-span", nil)
+
+			traceParent := tracing.TraceParent(ctx)
+			Expect(traceParent).To(MatchRegexp(`^00-[0-9a-f]{32}-[0-9a-f]{16}-01$`))
+			Expect(traceParent).To(ContainSubstring(span.TraceID().String()))
+			Expect(traceParent).To(HaveSuffix(span.SpanID().String() + "-01"))
+		})
+	})
+})
@@ -0,0 +1,118 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package tracingfakes
+
+import (
+	context "context"
+	sync "sync"
+
+	tracing "github.com/concourse/concourse/atc/tracing"
+)
+
+type FakeExporter struct {
+	ExportSpansStub        func(context.Context, []tracing.SpanData) error
+	exportSpansMutex       sync.RWMutex
+	exportSpansArgsForCall []struct {
+		arg1 context.Context
+		arg2 []tracing.SpanData
+	}
+	exportSpansReturns struct {
+		result1 error
+	}
+	exportSpansReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeExporter) ExportSpans(arg1 context.Context, arg2 []tracing.SpanData) error {
+	var arg2Copy []tracing.SpanData
+	if arg2 != nil {
+		arg2Copy = make([]tracing.SpanData, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.exportSpansMutex.Lock()
+	ret, specificReturn := fake.exportSpansReturnsOnCall[len(fake.exportSpansArgsForCall)]
+	fake.exportSpansArgsForCall = append(fake.exportSpansArgsForCall, struct {
+		arg1 context.Context
+		arg2 []tracing.SpanData
+	}{arg1, arg2Copy})
+	fake.recordInvocation("ExportSpans", []interface{}{arg1, arg2Copy})
+	fake.exportSpansMutex.Unlock()
+	if fake.ExportSpansStub != nil {
+		return fake.ExportSpansStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.exportSpansReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeExporter) ExportSpansCallCount() int {
+	fake.exportSpansMutex.RLock()
+	defer fake.exportSpansMutex.RUnlock()
+	return len(fake.exportSpansArgsForCall)
+}
+
+func (fake *FakeExporter) ExportSpansCalls(stub func(context.Context, []tracing.SpanData) error) {
+	fake.exportSpansMutex.Lock()
+	defer fake.exportSpansMutex.Unlock()
+	fake.ExportSpansStub = stub
+}
+
+func (fake *FakeExporter) ExportSpansArgsForCall(i int) (context.Context, []tracing.SpanData) {
+	fake.exportSpansMutex.RLock()
+	defer fake.exportSpansMutex.RUnlock()
+	argsForCall := fake.exportSpansArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeExporter) ExportSpansReturns(result1 error) {
+	fake.exportSpansMutex.Lock()
+	defer fake.exportSpansMutex.Unlock()
+	fake.ExportSpansStub = nil
+	fake.exportSpansReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeExporter) ExportSpansReturnsOnCall(i int, result1 error) {
+	fake.exportSpansMutex.Lock()
+	defer fake.exportSpansMutex.Unlock()
+	fake.ExportSpansStub = nil
+	if fake.exportSpansReturnsOnCall == nil {
+		fake.exportSpansReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.exportSpansReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeExporter) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.exportSpansMutex.RLock()
+	defer fake.exportSpansMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeExporter) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ tracing.Exporter = new(FakeExporter)
@@ -3,9 +3,15 @@ package atc
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"regexp"
 	"sort"
 	"strings"
+	"text/template"
 	"time"
+
+	"github.com/Masterminds/semver"
+	"github.com/concourse/concourse/atc/condition"
 )
 
 func formatErr(groupName string, err error) string {
@@ -43,6 +49,16 @@ func (c Config) Validate() ([]Warning, []string) {
 		errorMessages = append(errorMessages, formatErr("resource types", resourceTypesErr))
 	}
 
+	varSourcesErr := validateVarSources(c)
+	if varSourcesErr != nil {
+		errorMessages = append(errorMessages, formatErr("var sources", varSourcesErr))
+	}
+
+	notificationsErr := validateNotifications(c)
+	if notificationsErr != nil {
+		errorMessages = append(errorMessages, formatErr("notifications", notificationsErr))
+	}
+
 	jobWarnings, jobsErr := validateJobs(c)
 	if jobsErr != nil {
 		errorMessages = append(errorMessages, formatErr("jobs", jobsErr))
@@ -161,6 +177,98 @@ func validateResourceTypes(c Config) error {
 	return compositeErr(errorMessages)
 }
 
+func validateVarSources(c Config) error {
+	errorMessages := []string{}
+
+	names := map[string]int{}
+
+	for i, source := range c.VarSources {
+		var identifier string
+		if source.Name == "" {
+			identifier = fmt.Sprintf("var_sources[%d]", i)
+		} else {
+			identifier = fmt.Sprintf("var_sources.%s", source.Name)
+		}
+
+		if other, exists := names[source.Name]; exists {
+			errorMessages = append(errorMessages,
+				fmt.Sprintf(
+					"var_sources[%d] and var_sources[%d] have the same name ('%s')",
+					other, i, source.Name))
+		} else if source.Name != "" {
+			names[source.Name] = i
+		}
+
+		if source.Name == "" {
+			errorMessages = append(errorMessages, identifier+" has no name")
+		} else if !varSourceNameRegexp.MatchString(source.Name) {
+			errorMessages = append(errorMessages, identifier+" has an invalid name (must only contain letters, numbers, '-' and '_')")
+		}
+
+		if source.Type == "" {
+			errorMessages = append(errorMessages, identifier+" has no type")
+		}
+	}
+
+	return compositeErr(errorMessages)
+}
+
+func validateNotifications(c Config) error {
+	errorMessages := []string{}
+
+	names := map[string]int{}
+
+	for i, notification := range c.Notifications {
+		var identifier string
+		if notification.Name == "" {
+			identifier = fmt.Sprintf("notifications[%d]", i)
+		} else {
+			identifier = fmt.Sprintf("notifications.%s", notification.Name)
+		}
+
+		if other, exists := names[notification.Name]; exists {
+			errorMessages = append(errorMessages,
+				fmt.Sprintf(
+					"notifications[%d] and notifications[%d] have the same name ('%s')",
+					other, i, notification.Name))
+		} else if notification.Name != "" {
+			names[notification.Name] = i
+		}
+
+		if notification.Name == "" {
+			errorMessages = append(errorMessages, identifier+" has no name")
+		}
+
+		if notification.URL == "" {
+			errorMessages = append(errorMessages, identifier+" has no url")
+		} else if !strings.Contains(notification.URL, "((") {
+			webhookURL, err := url.Parse(notification.URL)
+			if err != nil || (webhookURL.Scheme != "http" && webhookURL.Scheme != "https") || webhookURL.Host == "" {
+				errorMessages = append(errorMessages, identifier+" has an invalid url (must be an absolute http or https URL)")
+			}
+		}
+
+		for _, event := range notification.Events {
+			if !isNotificationEvent(event) {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s has an unknown event '%s' (must be one of started, succeeded, failed, errored, aborted)", identifier, event))
+			}
+		}
+
+		if notification.Attempts < 0 {
+			errorMessages = append(errorMessages, identifier+" has a negative number of attempts")
+		}
+
+		if notification.Payload != "" {
+			_, err := template.New(notification.Name).Parse(notification.Payload)
+			if err != nil {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s has an invalid payload template: %s", identifier, err))
+			}
+		}
+	}
+
+	return compositeErr(errorMessages)
+}
+
 func validateResourcesUnused(c Config) []string {
 	usedResources := usedResources(c)
 
@@ -319,6 +427,18 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 		foundTypes.Find("task")
 	}
 
+	if plan.SetPipeline != "" {
+		foundTypes.Find("set_pipeline")
+	}
+
+	if plan.LoadVar != "" {
+		foundTypes.Find("load_var")
+	}
+
+	if plan.Approval != "" {
+		foundTypes.Find("approval")
+	}
+
 	if plan.Do != nil {
 		foundTypes.Find("do")
 	}
@@ -359,10 +479,14 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 		identifier = fmt.Sprintf("%s.get.%s", identifier, plan.Get)
 
 		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"privileged", "config", "file"},
+			[]string{"privileged", "config", "file", "artifacts"},
 			plan, identifier)...,
 		)
 
+		if plan.VersionFilter != nil {
+			errorMessages = append(errorMessages, validateVersionFilter(identifier, *plan.VersionFilter)...)
+		}
+
 		if plan.Resource != "" {
 			_, found := c.Resources.Lookup(plan.Resource)
 			if !found {
@@ -434,7 +558,7 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 		identifier = fmt.Sprintf("%s.put.%s", identifier, plan.Put)
 
 		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"passed", "trigger", "privileged", "config", "file"},
+			[]string{"passed", "trigger", "privileged", "config", "file", "version_filter", "container_placement_strategy", "artifacts"},
 			plan, identifier)...,
 		)
 
@@ -490,8 +614,62 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 			}
 		}
 
+		for _, strategy := range plan.ContainerPlacementStrategy {
+			if !isContainerPlacementStrategy(strategy) {
+				errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has an unknown container placement strategy '%s' (must be one of %s)", strategy, strings.Join(ContainerPlacementStrategies, ", ")))
+			}
+		}
+
+		errorMessages = append(errorMessages, validateArtifacts(identifier, plan)...)
+
+		errorMessages = append(errorMessages, validateInapplicableFields(
+			[]string{"resource", "passed", "trigger", "version_filter"},
+			plan, identifier)...,
+		)
+
+	case plan.SetPipeline != "":
+		identifier = fmt.Sprintf("%s.set_pipeline.%s", identifier, plan.SetPipeline)
+
+		if plan.TaskConfigPath == "" {
+			errorMessages = append(errorMessages, identifier+" does not specify a config file")
+		}
+
+		errorMessages = append(errorMessages, validateInapplicableFields(
+			[]string{"resource", "passed", "trigger", "privileged", "config", "version_filter", "container_placement_strategy", "artifacts"},
+			plan, identifier)...,
+		)
+
+	case plan.LoadVar != "":
+		identifier = fmt.Sprintf("%s.load_var.%s", identifier, plan.LoadVar)
+
+		if !localVarNameRegexp.MatchString(plan.LoadVar) {
+			errorMessages = append(errorMessages, identifier+" is not a valid var name")
+		}
+
+		if plan.TaskConfigPath == "" {
+			errorMessages = append(errorMessages, identifier+" does not specify a file")
+		}
+
+		switch plan.Format {
+		case "", "raw", "json", "yaml":
+		default:
+			errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has an unknown format '%s' (must be raw, json or yaml)", plan.Format))
+		}
+
 		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"resource", "passed", "trigger"},
+			[]string{"resource", "passed", "trigger", "privileged", "config", "vars", "version_filter", "container_placement_strategy", "artifacts"},
+			plan, identifier)...,
+		)
+
+	case plan.Approval != "":
+		identifier = fmt.Sprintf("%s.approval.%s", identifier, plan.Approval)
+
+		if plan.Role != "" && !isRole(plan.Role) {
+			errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has an unknown role '%s' (must be one of %s)", plan.Role, strings.Join(Roles, ", ")))
+		}
+
+		errorMessages = append(errorMessages, validateInapplicableFields(
+			[]string{"resource", "passed", "trigger", "privileged", "config", "file", "vars", "version_filter", "container_placement_strategy", "artifacts"},
 			plan, identifier)...,
 		)
 
@@ -538,6 +716,42 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 		}
 	}
 
+	if plan.If != "" {
+		_, err := condition.Parse(plan.If)
+		if err != nil {
+			subIdentifier := fmt.Sprintf("%s.if", identifier)
+			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid condition: %s", err))
+		}
+	}
+
+	if len(plan.Across) > 0 {
+		seenVars := map[string]bool{}
+
+		for i, v := range plan.Across {
+			subIdentifier := fmt.Sprintf("%s.across[%d]", identifier, i)
+
+			if v.Var == "" {
+				errorMessages = append(errorMessages, subIdentifier+" has no var name")
+			} else if seenVars[v.Var] {
+				errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" repeats var '%s'", v.Var))
+			}
+
+			if len(v.Values) == 0 {
+				errorMessages = append(errorMessages, subIdentifier+" has no values")
+			}
+
+			seenVars[v.Var] = true
+		}
+	}
+
+	if plan.MaxInFlight < 0 {
+		subIdentifier := fmt.Sprintf("%s.max_in_flight", identifier)
+		errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid value (%d)", plan.MaxInFlight))
+	} else if plan.MaxInFlight > 0 && len(plan.Across) == 0 {
+		subIdentifier := fmt.Sprintf("%s.max_in_flight", identifier)
+		errorMessages = append(errorMessages, subIdentifier+" is only valid on steps using 'across'")
+	}
+
 	if plan.Attempts < 0 {
 		subIdentifier := fmt.Sprintf("%s.attempts", identifier)
 		errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid number of attempts (%d)", plan.Attempts))
@@ -546,6 +760,39 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 	return warnings, errorMessages
 }
 
+func validateVersionFilter(identifier string, filter VersionFilterConfig) []string {
+	errorMessages := []string{}
+
+	if filter.Semver == "" && filter.Regex == "" {
+		errorMessages = append(
+			errorMessages,
+			identifier+".version_filter must specify semver or regex",
+		)
+	}
+
+	if filter.Semver != "" {
+		_, err := semver.NewConstraint(filter.Semver)
+		if err != nil {
+			errorMessages = append(
+				errorMessages,
+				fmt.Sprintf("%s.version_filter.semver is not a valid constraint: %s", identifier, err),
+			)
+		}
+	}
+
+	if filter.Regex != "" {
+		_, err := regexp.Compile(filter.Regex)
+		if err != nil {
+			errorMessages = append(
+				errorMessages,
+				fmt.Sprintf("%s.version_filter.regex is not a valid regular expression: %s", identifier, err),
+			)
+		}
+	}
+
+	return errorMessages
+}
+
 func validateInapplicableFields(inapplicableFields []string, plan PlanConfig, identifier string) []string {
 	errorMessages := []string{}
 	foundInapplicableFields := []string{}
@@ -576,6 +823,22 @@ func validateInapplicableFields(inapplicableFields []string, plan PlanConfig, id
 			if plan.TaskConfigPath != "" {
 				foundInapplicableFields = append(foundInapplicableFields, field)
 			}
+		case "vars":
+			if len(plan.TaskVars) != 0 {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
+		case "version_filter":
+			if plan.VersionFilter != nil {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
+		case "container_placement_strategy":
+			if len(plan.ContainerPlacementStrategy) != 0 {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
+		case "artifacts":
+			if len(plan.Artifacts) != 0 {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
 		}
 	}
 
@@ -600,3 +863,47 @@ func compositeErr(errorMessages []string) error {
 
 	return errors.New(strings.Join(errorMessages, "\n"))
 }
+
+// validateArtifacts checks that a task's artifacts are named at most once
+// and, if its configuration is known, that each is one of its outputs.
+func validateArtifacts(identifier string, plan PlanConfig) []string {
+	errorMessages := []string{}
+
+	seen := map[string]bool{}
+	for _, artifact := range plan.Artifacts {
+		if seen[artifact] {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s specifies artifact '%s' more than once", identifier, artifact))
+			continue
+		}
+
+		seen[artifact] = true
+
+		if plan.TaskConfig == nil {
+			continue
+		}
+
+		isOutput := false
+		for _, output := range plan.TaskConfig.Outputs {
+			if output.Name == artifact {
+				isOutput = true
+				break
+			}
+		}
+
+		if !isOutput {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s specifies artifact '%s' which is not one of its outputs", identifier, artifact))
+		}
+	}
+
+	return errorMessages
+}
+
+func isContainerPlacementStrategy(name string) bool {
+	for _, strategy := range ContainerPlacementStrategies {
+		if name == strategy {
+			return true
+		}
+	}
+
+	return false
+}
@@ -423,6 +423,157 @@ var _ = Describe("ValidateConfig", func() {
 		})
 	})
 
+	Describe("invalid var sources", func() {
+		BeforeEach(func() {
+			config.VarSources = VarSourceConfigs{
+				{
+					Name:   "some-source",
+					Type:   "vault",
+					Config: map[string]interface{}{"url": "https://vault.example.com"},
+				},
+			}
+		})
+
+		Context("when the var sources are valid", func() {
+			It("returns no errors", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
+		Context("when a var source has no name or type", func() {
+			BeforeEach(func() {
+				config.VarSources = append(config.VarSources, VarSourceConfig{})
+			})
+
+			It("returns an error describing both errors", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid var sources:"))
+				Expect(errorMessages[0]).To(ContainSubstring("var_sources[1] has no name"))
+				Expect(errorMessages[0]).To(ContainSubstring("var_sources[1] has no type"))
+			})
+		})
+
+		Context("when a var source has an invalid name", func() {
+			BeforeEach(func() {
+				config.VarSources[0].Name = "some.source"
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("var_sources.some.source has an invalid name"))
+			})
+		})
+
+		Context("when two var sources have the same name", func() {
+			BeforeEach(func() {
+				config.VarSources = append(config.VarSources, config.VarSources...)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("var_sources[0] and var_sources[1] have the same name ('some-source')"))
+			})
+		})
+	})
+
+	Describe("invalid notifications", func() {
+		BeforeEach(func() {
+			config.Notifications = NotificationConfigs{
+				{
+					Name:    "some-webhook",
+					URL:     "https://hooks.example.com/concourse",
+					Events:  []NotificationEvent{NotificationEventFailed, NotificationEventErrored},
+					Secret:  "((webhook-secret))",
+					Payload: `{"text":"{{.Build.JobName}} #{{.Build.Name}} {{.Event}}"}`,
+				},
+			}
+		})
+
+		Context("when the notifications are valid", func() {
+			It("returns no errors", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
+		Context("when the url is a var", func() {
+			BeforeEach(func() {
+				config.Notifications[0].URL = "((webhook-url))"
+			})
+
+			It("returns no errors", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
+		Context("when a notification has no name or url", func() {
+			BeforeEach(func() {
+				config.Notifications = append(config.Notifications, NotificationConfig{})
+			})
+
+			It("returns an error describing both errors", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid notifications:"))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications[1] has no name"))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications[1] has no url"))
+			})
+		})
+
+		Context("when two notifications have the same name", func() {
+			BeforeEach(func() {
+				config.Notifications = append(config.Notifications, config.Notifications...)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications[0] and notifications[1] have the same name ('some-webhook')"))
+			})
+		})
+
+		Context("when the url is not an absolute http url", func() {
+			BeforeEach(func() {
+				config.Notifications[0].URL = "hooks.example.com/concourse"
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.some-webhook has an invalid url"))
+			})
+		})
+
+		Context("when an event is unknown", func() {
+			BeforeEach(func() {
+				config.Notifications[0].Events = []NotificationEvent{"exploded"}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.some-webhook has an unknown event 'exploded'"))
+			})
+		})
+
+		Context("when the number of attempts is negative", func() {
+			BeforeEach(func() {
+				config.Notifications[0].Attempts = -1
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.some-webhook has a negative number of attempts"))
+			})
+		})
+
+		Context("when the payload is not a valid template", func() {
+			BeforeEach(func() {
+				config.Notifications[0].Payload = "{{.Build"
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.some-webhook has an invalid payload template"))
+			})
+		})
+	})
+
 	Describe("validating a job", func() {
 		var job JobConfig
 
@@ -616,6 +767,203 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a get plan has a valid version filter", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get: "some-resource",
+						VersionFilter: &VersionFilterConfig{
+							Field:  "tag",
+							Semver: ">= 1.2, < 2",
+							Regex:  "^v",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
+
+			Context("when a get plan has a version filter with no semver or regex", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get:           "some-resource",
+						VersionFilter: &VersionFilterConfig{Field: "tag"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource.version_filter must specify semver or regex"))
+				})
+			})
+
+			Context("when a get plan has a version filter with an invalid semver constraint", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get:           "some-resource",
+						VersionFilter: &VersionFilterConfig{Semver: "not a constraint"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource.version_filter.semver is not a valid constraint"))
+				})
+			})
+
+			Context("when a get plan has a version filter with an invalid regex", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get:           "some-resource",
+						VersionFilter: &VersionFilterConfig{Regex: "(unclosed"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource.version_filter.regex is not a valid regular expression"))
+				})
+			})
+
+			Context("when a put plan has a version filter", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:           "some-resource",
+						VersionFilter: &VersionFilterConfig{Regex: "^v"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource has invalid fields specified (version_filter)"))
+				})
+			})
+
+			Context("when a put plan has a container placement strategy", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:                        "some-resource",
+						ContainerPlacementStrategy: []string{"random"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource has invalid fields specified (container_placement_strategy)"))
+				})
+			})
+
+			Context("when a task plan has container placement strategies", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Task:                       "lol",
+						TaskConfigPath:             "task.yml",
+						ContainerPlacementStrategy: []string{"volume-locality", "fewest-build-containers", "random"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+
+				Context("when one of them is unknown", func() {
+					BeforeEach(func() {
+						config.Jobs[len(config.Jobs)-1].Plan[0].ContainerPlacementStrategy = []string{"volume-locality", "nearest"}
+					})
+
+					It("returns an error", func() {
+						Expect(errorMessages).To(HaveLen(1))
+						Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.lol has an unknown container placement strategy 'nearest' (must be one of volume-locality, fewest-build-containers, least-build-containers, resource-aware, random)"))
+					})
+				})
+			})
+
+			Context("when a task plan has artifacts", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Task: "lol",
+						TaskConfig: &TaskConfig{
+							Platform:  "linux",
+							RootfsURI: "some-image",
+							Run:       TaskRunConfig{Path: "ls"},
+							Outputs:   []TaskOutputConfig{{Name: "some-output"}, {Name: "other-output"}},
+						},
+						Artifacts: []string{"some-output", "other-output"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+
+				Context("when one of them is not an output", func() {
+					BeforeEach(func() {
+						config.Jobs[len(config.Jobs)-1].Plan[0].Artifacts = []string{"some-output", "bogus-output"}
+					})
+
+					It("returns an error", func() {
+						Expect(errorMessages).To(HaveLen(1))
+						Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.lol specifies artifact 'bogus-output' which is not one of its outputs"))
+					})
+				})
+
+				Context("when one of them is given twice", func() {
+					BeforeEach(func() {
+						config.Jobs[len(config.Jobs)-1].Plan[0].Artifacts = []string{"some-output", "some-output"}
+					})
+
+					It("returns an error", func() {
+						Expect(errorMessages).To(HaveLen(1))
+						Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.lol specifies artifact 'some-output' more than once"))
+					})
+				})
+
+				Context("when the task's config is loaded from a file", func() {
+					BeforeEach(func() {
+						config.Jobs[len(config.Jobs)-1].Plan[0].TaskConfig = nil
+						config.Jobs[len(config.Jobs)-1].Plan[0].TaskConfigPath = "task.yml"
+						config.Jobs[len(config.Jobs)-1].Plan[0].Artifacts = []string{"any-output"}
+					})
+
+					It("does not return an error", func() {
+						Expect(errorMessages).To(HaveLen(0))
+					})
+				})
+			})
+
+			Context("when a put plan has artifacts", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:       "some-resource",
+						Artifacts: []string{"some-output"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource has invalid fields specified (artifacts)"))
+				})
+			})
+
 			Context("when a task plan has invalid fields specified", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -1082,6 +1430,270 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a plan has an invalid condition in a step", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get: "some-resource",
+						If:  `var("deploy") ==`,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource.if has an invalid condition: unexpected end of expression"))
+				})
+			})
+
+			Context("when a plan has a valid condition in a step", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get: "some-resource",
+						If:  `var("deploy") == "true" && file("some-resource/enabled")`,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(BeEmpty())
+				})
+			})
+
+			Context("when a set_pipeline step has no file", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						SetPipeline: "some-pipeline",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].set_pipeline.some-pipeline does not specify a config file"))
+				})
+			})
+
+			Context("when a set_pipeline step has inapplicable fields", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						SetPipeline:    "some-pipeline",
+						TaskConfigPath: "some-resource/pipeline.yml",
+						Privileged:     true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].set_pipeline.some-pipeline has invalid fields specified (privileged)"))
+				})
+			})
+
+			Context("when a load_var step has no file", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						LoadVar: "some-var",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].load_var.some-var does not specify a file"))
+				})
+			})
+
+			Context("when a load_var step has an invalid name", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						LoadVar:        "some var",
+						TaskConfigPath: "some-resource/version",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].load_var.some var is not a valid var name"))
+				})
+			})
+
+			Context("when a load_var step has an unknown format", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						LoadVar:        "some-var",
+						TaskConfigPath: "some-resource/version",
+						Format:         "toml",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].load_var.some-var has an unknown format 'toml' (must be raw, json or yaml)"))
+				})
+			})
+
+			Context("when a load_var step has vars", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						LoadVar:        "some-var",
+						TaskConfigPath: "some-resource/version",
+						TaskVars:       Params{"some": "var"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].load_var.some-var has invalid fields specified (vars)"))
+				})
+			})
+
+			Context("when a plan has a valid load_var step", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						LoadVar:        "some-var",
+						TaskConfigPath: "some-resource/version.json",
+						Format:         "json",
+						Reveal:         true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(BeEmpty())
+				})
+			})
+
+			Context("when an approval step has an unknown role", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Approval: "deploy-to-prod",
+						Role:     "admin",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].approval.deploy-to-prod has an unknown role 'admin' (must be one of owner, member, viewer)"))
+				})
+			})
+
+			Context("when an approval step has a file", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Approval:       "deploy-to-prod",
+						TaskConfigPath: "some-resource/approval.yml",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].approval.deploy-to-prod has invalid fields specified (file)"))
+				})
+			})
+
+			Context("when a plan has a valid approval step", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Approval: "deploy-to-prod",
+						Role:     "owner",
+						Timeout:  "1h",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(BeEmpty())
+				})
+			})
+
+			Context("when a plan has a valid set_pipeline step", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						SetPipeline:    "some-pipeline",
+						TaskConfigPath: "some-resource/pipeline.yml",
+						TaskVars:       Params{"some": "var"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(BeEmpty())
+				})
+			})
+
+			Context("when a plan has an invalid across step", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get: "some-resource",
+						Across: []AcrossVarConfig{
+							{Var: "go", Values: []interface{}{"1.11"}},
+							{Var: "go"},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource.across[1] repeats var 'go'"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource.across[1] has no values"))
+				})
+			})
+
+			Context("when a plan has max_in_flight without across", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get:         "some-resource",
+						MaxInFlight: 2,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource.max_in_flight is only valid on steps using 'across'"))
+				})
+			})
+
+			Context("when a plan has a valid across step", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get: "some-resource",
+						Across: []AcrossVarConfig{
+							{Var: "go", Values: []interface{}{"1.11", "1.12"}},
+							{Var: "os", Values: []interface{}{"linux"}},
+						},
+						MaxInFlight: 1,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(BeEmpty())
+				})
+			})
+
 			Context("when a plan has an invalid step within a try", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
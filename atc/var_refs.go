@@ -0,0 +1,89 @@
+package atc
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+)
+
+var localVarRegexp = regexp.MustCompile(`\(\(\.:([-/\.\w\pL]+)\)\)`)
+var localVarNameRegexp = regexp.MustCompile(`^[-/\.\w\pL]+$`)
+
+var sourcedVarRegexp = regexp.MustCompile(`\(\(([-\w\pL]+):([-/\.\w\pL]+)\)\)`)
+var varSourceNameRegexp = regexp.MustCompile(`^[-\w\pL]+$`)
+
+// InterpolateLocalVarRefs returns a copy of the given node (as decoded from
+// JSON or YAML) with each reference to a local var, e.g. ((.:name)), replaced
+// with the value returned by lookup. References for which lookup returns
+// false are left alone.
+func InterpolateLocalVarRefs(node interface{}, lookup func(string) (interface{}, bool)) interface{} {
+	return interpolateVarRefs(node, localVarRegexp, func(match []string) (interface{}, bool) {
+		return lookup(match[1])
+	})
+}
+
+// InterpolateSourcedVarRefs returns a copy of the given node (as decoded from
+// JSON or YAML) with each reference to a var from a named var source, e.g.
+// ((source:path.field)), replaced with the value returned by lookup for the
+// source name and path. References for which lookup returns false are left
+// alone.
+func InterpolateSourcedVarRefs(node interface{}, lookup func(string, string) (interface{}, bool)) interface{} {
+	return interpolateVarRefs(node, sourcedVarRegexp, func(match []string) (interface{}, bool) {
+		return lookup(match[1], match[2])
+	})
+}
+
+func interpolateVarRefs(node interface{}, re *regexp.Regexp, lookup func([]string) (interface{}, bool)) interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		interpolated := make(map[string]interface{}, len(n))
+		for key, val := range n {
+			interpolated[key] = interpolateVarRefs(val, re, lookup)
+		}
+
+		return interpolated
+
+	case map[interface{}]interface{}:
+		interpolated := make(map[interface{}]interface{}, len(n))
+		for key, val := range n {
+			interpolated[key] = interpolateVarRefs(val, re, lookup)
+		}
+
+		return interpolated
+
+	case []interface{}:
+		interpolated := make([]interface{}, len(n))
+		for i, val := range n {
+			interpolated[i] = interpolateVarRefs(val, re, lookup)
+		}
+
+		return interpolated
+
+	case string:
+		if match := re.FindStringSubmatch(n); match != nil && match[0] == n {
+			if value, found := lookup(match); found {
+				return value
+			}
+		}
+
+		return re.ReplaceAllStringFunc(n, func(ref string) string {
+			value, found := lookup(re.FindStringSubmatch(ref))
+			if !found {
+				return ref
+			}
+
+			if str, ok := value.(string); ok {
+				return str
+			}
+
+			formatted, err := json.Marshal(value)
+			if err != nil {
+				return fmt.Sprintf("%v", value)
+			}
+
+			return string(formatted)
+		})
+	}
+
+	return node
+}
@@ -19,6 +19,12 @@ type Worker struct {
 	ActiveContainers int `json:"active_containers"`
 	ActiveVolumes    int `json:"active_volumes"`
 
+	// MaxContainers, FreeMemory and FreeDisk are reported by the TSA on each
+	// heartbeat. A MaxContainers of zero means the capacity is unknown.
+	MaxContainers int   `json:"max_containers,omitempty"`
+	FreeMemory    int64 `json:"free_memory,omitempty"`
+	FreeDisk      int64 `json:"free_disk,omitempty"`
+
 	ResourceTypes []WorkerResourceType `json:"resource_types"`
 
 	Platform  string   `json:"platform"`
@@ -31,6 +37,24 @@ type Worker struct {
 	State     string   `json:"state"`
 }
 
+// The container placement strategies which can be chained together to choose
+// the worker a container is placed on, cluster-wide or for a single task.
+const (
+	PlacementStrategyVolumeLocality        = "volume-locality"
+	PlacementStrategyFewestBuildContainers = "fewest-build-containers"
+	PlacementStrategyLeastBuildContainers  = "least-build-containers"
+	PlacementStrategyResourceAware         = "resource-aware"
+	PlacementStrategyRandom                = "random"
+)
+
+var ContainerPlacementStrategies = []string{
+	PlacementStrategyVolumeLocality,
+	PlacementStrategyFewestBuildContainers,
+	PlacementStrategyLeastBuildContainers,
+	PlacementStrategyResourceAware,
+	PlacementStrategyRandom,
+}
+
 var ErrInvalidWorkerVersion = errors.New("invalid worker version, only numeric characters are allowed")
 var ErrMissingWorkerGardenAddress = errors.New("missing garden address")
 var ErrNoWorkers = errors.New(`no workers available for checking
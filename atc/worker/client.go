@@ -32,7 +32,7 @@ type Client interface {
 
 	FindResourceTypeByPath(path string) (atc.WorkerResourceType, bool)
 
-	Satisfying(lager.Logger, WorkerSpec) (Worker, error)
+	Satisfying(context.Context, lager.Logger, ImageFetchingDelegate, WorkerSpec) (Worker, error)
 }
 
 //go:generate counterfeiter . InputSource
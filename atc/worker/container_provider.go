@@ -14,6 +14,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/tracing"
 )
 
 const creatingContainerRetryDelay = 1 * time.Second
@@ -188,6 +189,7 @@ func (p *containerProvider) FindOrCreateContainer(
 			logger.Debug("creating-container-in-garden")
 
 			gardenContainer, err = p.createGardenContainer(
+				ctx,
 				logger,
 				creatingContainer,
 				containerSpec,
@@ -302,6 +304,7 @@ func (p *containerProvider) constructGardenWorkerContainer(
 }
 
 func (p *containerProvider) createGardenContainer(
+	ctx context.Context,
 	logger lager.Logger,
 	creatingContainer db.CreatingContainer,
 	spec ContainerSpec,
@@ -408,7 +411,15 @@ func (p *containerProvider) createGardenContainer(
 				"dest-volume": inputVolume.Handle(),
 				"dest-worker": inputVolume.WorkerName(),
 			}
+
+			_, span := tracing.StartSpan(ctx, "stream-to", tracing.Attrs{
+				"dest-volume": inputVolume.Handle(),
+				"dest-worker": inputVolume.WorkerName(),
+				"path":        cleanedInputPath,
+			})
+
 			err = inputSource.Source().StreamTo(logger.Session("stream-to", destData), inputVolume)
+			tracing.End(span, err)
 			if err != nil {
 				return nil, err
 			}
@@ -494,7 +505,12 @@ func (p *containerProvider) createGardenContainer(
 		env = append(env, fmt.Sprintf("no_proxy=%s", p.noProxy))
 	}
 
-	return p.gardenClient.Create(garden.ContainerSpec{
+	_, span := tracing.StartSpan(ctx, "garden-create", tracing.Attrs{
+		"container": creatingContainer.Handle(),
+		"worker":    p.worker.Name(),
+	})
+
+	gardenContainer, err := p.gardenClient.Create(garden.ContainerSpec{
 		Handle:     creatingContainer.Handle(),
 		RootFSPath: fetchedImage.URL,
 		Privileged: fetchedImage.Privileged,
@@ -503,6 +519,9 @@ func (p *containerProvider) createGardenContainer(
 		Env:        env,
 		Properties: gardenProperties,
 	})
+	tracing.End(span, err)
+
+	return gardenContainer, err
 }
 
 func getDestinationPathsFromInputs(inputs []InputSource) []string {
@@ -45,6 +45,10 @@ type ContainerSpec struct {
 
 	// Optional user to run processes as. Overwrites the one specified in the docker image.
 	User string
+
+	// Optional chain of placement strategies to choose the worker with,
+	// overriding the pool's strategy.
+	PlacementStrategy []string
 }
 
 // OutputPaths is a mapping from output name to its path in the container.
@@ -10,11 +10,35 @@ import (
 	"github.com/concourse/baggageclaim"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
 const RawRootFSScheme = "raw"
 
+// tracedImage fetches its image within a tracing span.
+type tracedImage struct {
+	worker.Image
+
+	worker string
+}
+
+func (i tracedImage) FetchForContainer(
+	ctx context.Context,
+	logger lager.Logger,
+	container db.CreatingContainer,
+) (worker.FetchedImage, error) {
+	ctx, span := tracing.StartSpan(ctx, "fetch-image", tracing.Attrs{
+		"worker":    i.worker,
+		"container": container.Handle(),
+	})
+
+	fetchedImage, err := i.Image.FetchForContainer(ctx, logger, container)
+	tracing.End(span, err)
+
+	return fetchedImage, err
+}
+
 type imageProvidedByPreviousStepOnSameWorker struct {
 	artifactVolume worker.Volume
 	imageSpec      worker.ImageSpec
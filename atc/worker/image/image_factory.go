@@ -32,6 +32,26 @@ func (f *imageFactory) GetImage(
 	teamID int,
 	delegate worker.ImageFetchingDelegate,
 	resourceTypes creds.VersionedResourceTypes,
+) (worker.Image, error) {
+	image, err := f.getImage(logger, workerClient, volumeClient, imageSpec, teamID, delegate, resourceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	return tracedImage{
+		Image:  image,
+		worker: workerClient.Name(),
+	}, nil
+}
+
+func (f *imageFactory) getImage(
+	logger lager.Logger,
+	workerClient worker.Worker,
+	volumeClient worker.VolumeClient,
+	imageSpec worker.ImageSpec,
+	teamID int,
+	delegate worker.ImageFetchingDelegate,
+	resourceTypes creds.VersionedResourceTypes,
 ) (worker.Image, error) {
 	if imageSpec.ImageArtifactSource != nil {
 		artifactVolume, existsOnWorker, err := imageSpec.ImageArtifactSource.VolumeOn(logger, workerClient)
@@ -48,6 +48,10 @@ type ImageFetchingDelegate interface {
 	Stdout() io.Writer
 	Stderr() io.Writer
 	ImageVersionDetermined(db.UsedResourceCache) error
+
+	// WaitingForWorker is called when no running worker satisfies the spec
+	// and the pool is going to wait for one to register.
+	WaitingForWorker(lager.Logger, WorkerSpec)
 }
 
 type ImageMetadata struct {
@@ -60,3 +64,4 @@ type NoopImageFetchingDelegate struct{}
 func (NoopImageFetchingDelegate) Stdout() io.Writer                                 { return ioutil.Discard }
 func (NoopImageFetchingDelegate) Stderr() io.Writer                                 { return ioutil.Discard }
 func (NoopImageFetchingDelegate) ImageVersionDetermined(db.UsedResourceCache) error { return nil }
+func (NoopImageFetchingDelegate) WaitingForWorker(lager.Logger, WorkerSpec)         {}
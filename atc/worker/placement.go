@@ -1,41 +1,92 @@
 package worker
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"math/rand"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 )
 
+// A ContainerPlacementStrategy narrows down the workers a container may be
+// placed on to the ones it prefers. It never returns an empty set of
+// candidates without an error. The container is placed on a random candidate.
 type ContainerPlacementStrategy interface {
-	Choose(lager.Logger, []Worker, ContainerSpec) (Worker, error)
+	Candidates(lager.Logger, []Worker, ContainerSpec) ([]Worker, error)
 }
 
-type VolumeLocalityPlacementStrategy struct {
-	rand *rand.Rand
+// ContainerPlacementStrategyOptions configures the strategies which may be
+// chained together by name.
+type ContainerPlacementStrategyOptions struct {
+	ResourceAware ResourceAwarePlacementOptions
 }
 
-func NewVolumeLocalityPlacementStrategy() ContainerPlacementStrategy {
-	return &VolumeLocalityPlacementStrategy{
-		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+// NewContainerPlacementStrategy chains together the named strategies, each
+// narrowing down the candidates left by the previous one.
+func NewContainerPlacementStrategy(names []string, options ContainerPlacementStrategyOptions) (ContainerPlacementStrategy, error) {
+	strategies := []ContainerPlacementStrategy{}
+	for _, name := range names {
+		switch strings.TrimSpace(name) {
+		case atc.PlacementStrategyVolumeLocality:
+			strategies = append(strategies, NewVolumeLocalityPlacementStrategy())
+		case atc.PlacementStrategyFewestBuildContainers, atc.PlacementStrategyLeastBuildContainers:
+			strategies = append(strategies, NewLeastBuildContainersPlacementStrategy())
+		case atc.PlacementStrategyResourceAware:
+			strategies = append(strategies, NewResourceAwarePlacementStrategy(options.ResourceAware))
+		case atc.PlacementStrategyRandom:
+			strategies = append(strategies, NewRandomPlacementStrategy())
+		default:
+			return nil, fmt.Errorf("unknown container placement strategy: %s", name)
+		}
+	}
+
+	if len(strategies) == 1 {
+		return strategies[0], nil
+	}
+
+	return NewChainedPlacementStrategy(strategies...), nil
+}
+
+type ChainedPlacementStrategy struct {
+	strategies []ContainerPlacementStrategy
+}
+
+func NewChainedPlacementStrategy(strategies ...ContainerPlacementStrategy) ContainerPlacementStrategy {
+	return &ChainedPlacementStrategy{
+		strategies: strategies,
 	}
 }
 
-func (strategy *VolumeLocalityPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+func (strategy *ChainedPlacementStrategy) Candidates(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	candidates := workers
+	for _, s := range strategy.strategies {
+		var err error
+		candidates, err = s.Candidates(logger, candidates, spec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return candidates, nil
+}
+
+type VolumeLocalityPlacementStrategy struct{}
+
+func NewVolumeLocalityPlacementStrategy() ContainerPlacementStrategy {
+	return &VolumeLocalityPlacementStrategy{}
+}
+
+func (strategy *VolumeLocalityPlacementStrategy) Candidates(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
 	workersByCount := map[int][]Worker{}
 	var highestCount int
 	for _, w := range workers {
-		candidateInputCount := 0
-
-		for _, inputSource := range spec.Inputs {
-			_, found, err := inputSource.Source().VolumeOn(logger, w)
-			if err != nil {
-				return nil, err
-			}
-
-			if found {
-				candidateInputCount++
-			}
+		candidateInputCount, err := inputsOnWorker(logger, w, spec)
+		if err != nil {
+			return nil, err
 		}
 
 		workersByCount[candidateInputCount] = append(workersByCount[candidateInputCount], w)
@@ -45,22 +96,16 @@ func (strategy *VolumeLocalityPlacementStrategy) Choose(logger lager.Logger, wor
 		}
 	}
 
-	highestLocalityWorkers := workersByCount[highestCount]
-
-	return highestLocalityWorkers[strategy.rand.Intn(len(highestLocalityWorkers))], nil
+	return workersByCount[highestCount], nil
 }
 
-type LeastBuildContainersPlacementStrategy struct {
-	rand *rand.Rand
-}
+type LeastBuildContainersPlacementStrategy struct{}
 
 func NewLeastBuildContainersPlacementStrategy() ContainerPlacementStrategy {
-	return &LeastBuildContainersPlacementStrategy{
-		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
-	}
+	return &LeastBuildContainersPlacementStrategy{}
 }
 
-func (strategy *LeastBuildContainersPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+func (strategy *LeastBuildContainersPlacementStrategy) Candidates(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
 	workersByWork := map[int][]Worker{}
 	var minWork int
 	for i, w := range workers {
@@ -71,8 +116,7 @@ func (strategy *LeastBuildContainersPlacementStrategy) Choose(logger lager.Logge
 		}
 	}
 
-	leastBusyWorkers := workersByWork[minWork]
-	return leastBusyWorkers[strategy.rand.Intn(len(leastBusyWorkers))], nil
+	return workersByWork[minWork], nil
 }
 
 type RandomPlacementStrategy struct {
@@ -85,6 +129,162 @@ func NewRandomPlacementStrategy() ContainerPlacementStrategy {
 	}
 }
 
-func (strategy *RandomPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
-	return workers[strategy.rand.Intn(len(workers))], nil
+func (strategy *RandomPlacementStrategy) Candidates(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	return []Worker{workers[strategy.rand.Intn(len(workers))]}, nil
+}
+
+// ErrAllWorkersOverloaded is returned by the resource-aware placement strategy
+// when every compatible worker is over one of the configured thresholds.
+var ErrAllWorkersOverloaded = errors.New("all compatible workers are over the placement thresholds")
+
+// ResourceAwarePlacementOptions configures how the resource-aware placement
+// strategy weighs and rejects workers.
+type ResourceAwarePlacementOptions struct {
+	// LocalityWeight and LoadWeight scale the fraction of the step's inputs
+	// already on a worker and the fraction of the worker's capacity left
+	// free, respectively.
+	LocalityWeight float64
+	LoadWeight     float64
+
+	// MaxContainerUtilization is the fraction of its max containers above
+	// which a worker is not chosen. Zero disables the threshold.
+	MaxContainerUtilization float64
+
+	// MinFreeMemory and MinFreeDisk, in bytes, are how much a worker must
+	// have left to be chosen. Zero disables the threshold.
+	MinFreeMemory int64
+	MinFreeDisk   int64
+}
+
+// ResourceAwarePlacementStrategy prefers the workers with the best combination
+// of input volume locality and free capacity, as reported by the workers'
+// heartbeats. Workers which do not report their capacity are never rejected
+// and are scored as half loaded.
+type ResourceAwarePlacementStrategy struct {
+	options ResourceAwarePlacementOptions
+}
+
+func NewResourceAwarePlacementStrategy(options ResourceAwarePlacementOptions) ContainerPlacementStrategy {
+	return &ResourceAwarePlacementStrategy{
+		options: options,
+	}
+}
+
+func (strategy *ResourceAwarePlacementStrategy) Candidates(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	candidates := []Worker{}
+	for _, w := range workers {
+		if strategy.overloaded(w) {
+			logger.Debug("worker-overloaded", lager.Data{"worker": w.Name()})
+			continue
+		}
+
+		candidates = append(candidates, w)
+	}
+
+	if len(candidates) == 0 {
+		return nil, ErrAllWorkersOverloaded
+	}
+
+	var mostFreeMemory, mostFreeDisk int64
+	for _, w := range candidates {
+		if w.MaxContainers() == 0 {
+			continue
+		}
+
+		if w.FreeMemory() > mostFreeMemory {
+			mostFreeMemory = w.FreeMemory()
+		}
+
+		if w.FreeDisk() > mostFreeDisk {
+			mostFreeDisk = w.FreeDisk()
+		}
+	}
+
+	var bestWorkers []Worker
+	var bestScore float64
+	for i, w := range candidates {
+		inputCount, err := inputsOnWorker(logger, w, spec)
+		if err != nil {
+			return nil, err
+		}
+
+		var locality float64
+		if len(spec.Inputs) > 0 {
+			locality = float64(inputCount) / float64(len(spec.Inputs))
+		}
+
+		score := strategy.options.LocalityWeight*locality +
+			strategy.options.LoadWeight*headroom(w, mostFreeMemory, mostFreeDisk)
+
+		if i == 0 || score > bestScore {
+			bestWorkers = []Worker{w}
+			bestScore = score
+		} else if score == bestScore {
+			bestWorkers = append(bestWorkers, w)
+		}
+	}
+
+	return bestWorkers, nil
+}
+
+func (strategy *ResourceAwarePlacementStrategy) overloaded(w Worker) bool {
+	if w.MaxContainers() == 0 {
+		return false
+	}
+
+	if strategy.options.MaxContainerUtilization > 0 &&
+		float64(w.ActiveContainers())/float64(w.MaxContainers()) >= strategy.options.MaxContainerUtilization {
+		return true
+	}
+
+	if w.FreeMemory() < strategy.options.MinFreeMemory {
+		return true
+	}
+
+	return w.FreeDisk() < strategy.options.MinFreeDisk
+}
+
+// headroom is the fraction of a worker's capacity left free, averaged across
+// its containers, and its memory and disk relative to the candidate with the
+// most free.
+func headroom(w Worker, mostFreeMemory int64, mostFreeDisk int64) float64 {
+	if w.MaxContainers() == 0 {
+		return 0.5
+	}
+
+	free := []float64{
+		1 - float64(w.ActiveContainers())/float64(w.MaxContainers()),
+	}
+
+	if mostFreeMemory > 0 {
+		free = append(free, float64(w.FreeMemory())/float64(mostFreeMemory))
+	}
+
+	if mostFreeDisk > 0 {
+		free = append(free, float64(w.FreeDisk())/float64(mostFreeDisk))
+	}
+
+	var total float64
+	for _, f := range free {
+		total += math.Max(f, 0)
+	}
+
+	return total / float64(len(free))
+}
+
+func inputsOnWorker(logger lager.Logger, w Worker, spec ContainerSpec) (int, error) {
+	count := 0
+
+	for _, inputSource := range spec.Inputs {
+		_, found, err := inputSource.Source().VolumeOn(logger, w)
+		if err != nil {
+			return 0, err
+		}
+
+		if found {
+			count++
+		}
+	}
+
+	return count, nil
 }
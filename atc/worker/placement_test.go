@@ -1,6 +1,8 @@
 package worker_test
 
 import (
+	"errors"
+
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	. "github.com/concourse/concourse/atc/worker"
@@ -18,8 +20,8 @@ var (
 	spec    ContainerSpec
 	workers []Worker
 
-	chosenWorker Worker
-	chooseErr    error
+	candidates    []Worker
+	candidatesErr error
 
 	compatibleWorkerOneCache1 *workerfakes.FakeWorker
 	compatibleWorkerOneCache2 *workerfakes.FakeWorker
@@ -31,7 +33,7 @@ var (
 )
 
 var _ = Describe("LeastBuildContainersPlacementStrategy", func() {
-	Describe("Choose", func() {
+	Describe("Candidates", func() {
 		var compatibleWorker1 *workerfakes.FakeWorker
 		var compatibleWorker2 *workerfakes.FakeWorker
 		var compatibleWorker3 *workerfakes.FakeWorker
@@ -59,13 +61,13 @@ var _ = Describe("LeastBuildContainersPlacementStrategy", func() {
 			})
 
 			It("picks that worker", func() {
-				chosenWorker, chooseErr = strategy.Choose(
+				candidates, candidatesErr = strategy.Candidates(
 					logger,
 					workers,
 					spec,
 				)
-				Expect(chooseErr).ToNot(HaveOccurred())
-				Expect(chosenWorker).To(Equal(compatibleWorker1))
+				Expect(candidatesErr).ToNot(HaveOccurred())
+				Expect(candidates).To(ConsistOf(compatibleWorker1))
 			})
 		})
 
@@ -78,15 +80,13 @@ var _ = Describe("LeastBuildContainersPlacementStrategy", func() {
 			})
 
 			It("picks the one with least amount of containers", func() {
-				Consistently(func() Worker {
-					chosenWorker, chooseErr = strategy.Choose(
-						logger,
-						workers,
-						spec,
-					)
-					Expect(chooseErr).ToNot(HaveOccurred())
-					return chosenWorker
-				}).Should(Equal(compatibleWorker3))
+				candidates, candidatesErr = strategy.Candidates(
+					logger,
+					workers,
+					spec,
+				)
+				Expect(candidatesErr).ToNot(HaveOccurred())
+				Expect(candidates).To(ConsistOf(compatibleWorker3))
 			})
 
 			Context("when there is more than one worker with the same number of build containers", func() {
@@ -95,16 +95,14 @@ var _ = Describe("LeastBuildContainersPlacementStrategy", func() {
 					compatibleWorker1.BuildContainersReturns(10)
 				})
 
-				It("picks any of them", func() {
-					Consistently(func() Worker {
-						chosenWorker, chooseErr = strategy.Choose(
-							logger,
-							workers,
-							spec,
-						)
-						Expect(chooseErr).ToNot(HaveOccurred())
-						return chosenWorker
-					}).Should(Or(Equal(compatibleWorker1), Equal(compatibleWorker3)))
+				It("picks all of them", func() {
+					candidates, candidatesErr = strategy.Candidates(
+						logger,
+						workers,
+						spec,
+					)
+					Expect(candidatesErr).ToNot(HaveOccurred())
+					Expect(candidates).To(ConsistOf(compatibleWorker1, compatibleWorker3))
 				})
 			})
 		})
@@ -112,9 +110,9 @@ var _ = Describe("LeastBuildContainersPlacementStrategy", func() {
 })
 
 var _ = Describe("VolumeLocalityPlacementStrategy", func() {
-	Describe("Choose", func() {
+	Describe("Candidates", func() {
 		JustBeforeEach(func() {
-			chosenWorker, chooseErr = strategy.Choose(
+			candidates, candidatesErr = strategy.Candidates(
 				logger,
 				workers,
 				spec,
@@ -187,8 +185,8 @@ var _ = Describe("VolumeLocalityPlacementStrategy", func() {
 			})
 
 			It("creates it on the worker with the most caches", func() {
-				Expect(chooseErr).ToNot(HaveOccurred())
-				Expect(chosenWorker).To(Equal(compatibleWorkerTwoCaches))
+				Expect(candidatesErr).ToNot(HaveOccurred())
+				Expect(candidates).To(ConsistOf(compatibleWorkerTwoCaches))
 			})
 		})
 
@@ -202,27 +200,9 @@ var _ = Describe("VolumeLocalityPlacementStrategy", func() {
 				}
 			})
 
-			It("creates it on a random one of the two", func() {
-				Expect(chooseErr).ToNot(HaveOccurred())
-				Expect(chosenWorker).To(SatisfyAny(Equal(compatibleWorkerOneCache1), Equal(compatibleWorkerOneCache2)))
-
-				workerChoiceCounts := map[Worker]int{}
-
-				for i := 0; i < 100; i++ {
-					worker, err := strategy.Choose(
-						logger,
-						workers,
-						spec,
-					)
-					Expect(err).ToNot(HaveOccurred())
-					Expect(chosenWorker).To(SatisfyAny(Equal(compatibleWorkerOneCache1), Equal(compatibleWorkerOneCache2)))
-					workerChoiceCounts[worker]++
-				}
-
-				Expect(workerChoiceCounts[compatibleWorkerOneCache1]).ToNot(BeZero())
-				Expect(workerChoiceCounts[compatibleWorkerOneCache2]).ToNot(BeZero())
-				Expect(workerChoiceCounts[compatibleWorkerNoCaches1]).To(BeZero())
-				Expect(workerChoiceCounts[compatibleWorkerNoCaches2]).To(BeZero())
+			It("picks all of them", func() {
+				Expect(candidatesErr).ToNot(HaveOccurred())
+				Expect(candidates).To(ConsistOf(compatibleWorkerOneCache1, compatibleWorkerOneCache2))
 			})
 		})
 
@@ -234,34 +214,18 @@ var _ = Describe("VolumeLocalityPlacementStrategy", func() {
 				}
 			})
 
-			It("creates it on a random one of them", func() {
-				Expect(chooseErr).ToNot(HaveOccurred())
-				Expect(chosenWorker).To(SatisfyAny(Equal(compatibleWorkerNoCaches1), Equal(compatibleWorkerNoCaches2)))
-
-				workerChoiceCounts := map[Worker]int{}
-
-				for i := 0; i < 100; i++ {
-					worker, err := strategy.Choose(
-						logger,
-						workers,
-						spec,
-					)
-					Expect(err).ToNot(HaveOccurred())
-					Expect(chosenWorker).To(SatisfyAny(Equal(compatibleWorkerNoCaches1), Equal(compatibleWorkerNoCaches2)))
-					workerChoiceCounts[worker]++
-				}
-
-				Expect(workerChoiceCounts[compatibleWorkerNoCaches1]).ToNot(BeZero())
-				Expect(workerChoiceCounts[compatibleWorkerNoCaches2]).ToNot(BeZero())
+			It("picks all of them", func() {
+				Expect(candidatesErr).ToNot(HaveOccurred())
+				Expect(candidates).To(ConsistOf(compatibleWorkerNoCaches1, compatibleWorkerNoCaches2))
 			})
 		})
 	})
 })
 
 var _ = Describe("RandomPlacementStrategy", func() {
-	Describe("Choose", func() {
+	Describe("Candidates", func() {
 		JustBeforeEach(func() {
-			chosenWorker, chooseErr = strategy.Choose(
+			candidates, candidatesErr = strategy.Candidates(
 				logger,
 				workers,
 				spec,
@@ -277,21 +241,22 @@ var _ = Describe("RandomPlacementStrategy", func() {
 			}
 		})
 
-		It("creates it on a random one of them", func() {
-			Expect(chooseErr).ToNot(HaveOccurred())
-			Expect(chosenWorker).To(SatisfyAny(Equal(compatibleWorkerNoCaches1), Equal(compatibleWorkerNoCaches2)))
+		It("picks a random one of them", func() {
+			Expect(candidatesErr).ToNot(HaveOccurred())
+			Expect(candidates).To(HaveLen(1))
+			Expect(candidates[0]).To(SatisfyAny(Equal(compatibleWorkerNoCaches1), Equal(compatibleWorkerNoCaches2)))
 
 			workerChoiceCounts := map[Worker]int{}
 
 			for i := 0; i < 100; i++ {
-				worker, err := strategy.Choose(
+				chosen, err := strategy.Candidates(
 					logger,
 					workers,
 					spec,
 				)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(chosenWorker).To(SatisfyAny(Equal(compatibleWorkerNoCaches1), Equal(compatibleWorkerNoCaches2)))
-				workerChoiceCounts[worker]++
+				Expect(chosen).To(HaveLen(1))
+				workerChoiceCounts[chosen[0]]++
 			}
 
 			Expect(workerChoiceCounts[compatibleWorkerNoCaches1]).ToNot(BeZero())
@@ -299,3 +264,251 @@ var _ = Describe("RandomPlacementStrategy", func() {
 		})
 	})
 })
+
+var _ = Describe("ResourceAwarePlacementStrategy", func() {
+	Describe("Candidates", func() {
+		var (
+			options ResourceAwarePlacementOptions
+
+			localBusyWorker  *workerfakes.FakeWorker
+			remoteIdleWorker *workerfakes.FakeWorker
+			remoteBusyWorker *workerfakes.FakeWorker
+		)
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("resource-aware-placement-test")
+
+			options = ResourceAwarePlacementOptions{
+				LocalityWeight: 1,
+				LoadWeight:     1,
+			}
+
+			localBusyWorker = new(workerfakes.FakeWorker)
+			localBusyWorker.NameReturns("local-busy")
+			localBusyWorker.ActiveContainersReturns(80)
+			localBusyWorker.MaxContainersReturns(100)
+			localBusyWorker.FreeMemoryReturns(1024)
+			localBusyWorker.FreeDiskReturns(1024)
+
+			remoteIdleWorker = new(workerfakes.FakeWorker)
+			remoteIdleWorker.NameReturns("remote-idle")
+			remoteIdleWorker.ActiveContainersReturns(10)
+			remoteIdleWorker.MaxContainersReturns(100)
+			remoteIdleWorker.FreeMemoryReturns(4096)
+			remoteIdleWorker.FreeDiskReturns(4096)
+
+			remoteBusyWorker = new(workerfakes.FakeWorker)
+			remoteBusyWorker.NameReturns("remote-busy")
+			remoteBusyWorker.ActiveContainersReturns(90)
+			remoteBusyWorker.MaxContainersReturns(100)
+			remoteBusyWorker.FreeMemoryReturns(512)
+			remoteBusyWorker.FreeDiskReturns(512)
+
+			fakeInput := new(workerfakes.FakeInputSource)
+			fakeInputAS := new(workerfakes.FakeArtifactSource)
+			fakeInputAS.VolumeOnStub = func(logger lager.Logger, worker Worker) (Volume, bool, error) {
+				if worker == localBusyWorker {
+					return new(workerfakes.FakeVolume), true, nil
+				}
+
+				return nil, false, nil
+			}
+			fakeInput.SourceReturns(fakeInputAS)
+
+			spec = ContainerSpec{
+				ImageSpec: ImageSpec{ResourceType: "some-type"},
+
+				TeamID: 4567,
+
+				Inputs: []InputSource{fakeInput},
+			}
+
+			workers = []Worker{localBusyWorker, remoteIdleWorker, remoteBusyWorker}
+		})
+
+		JustBeforeEach(func() {
+			strategy = NewResourceAwarePlacementStrategy(options)
+
+			candidates, candidatesErr = strategy.Candidates(
+				logger,
+				workers,
+				spec,
+			)
+		})
+
+		It("picks the worker with the best combination of locality and free capacity", func() {
+			Expect(candidatesErr).ToNot(HaveOccurred())
+			Expect(candidates).To(ConsistOf(localBusyWorker))
+		})
+
+		Context("when load is weighted over locality", func() {
+			BeforeEach(func() {
+				options.LoadWeight = 3
+			})
+
+			It("picks the least loaded worker", func() {
+				Expect(candidatesErr).ToNot(HaveOccurred())
+				Expect(candidates).To(ConsistOf(remoteIdleWorker))
+			})
+		})
+
+		Context("when a worker is over the container utilization threshold", func() {
+			BeforeEach(func() {
+				options.MaxContainerUtilization = 0.8
+			})
+
+			It("does not pick it", func() {
+				Expect(candidatesErr).ToNot(HaveOccurred())
+				Expect(candidates).To(ConsistOf(remoteIdleWorker))
+			})
+		})
+
+		Context("when a worker has less memory or disk free than required", func() {
+			BeforeEach(func() {
+				options.MinFreeMemory = 1024
+				options.MinFreeDisk = 2048
+			})
+
+			It("does not pick it", func() {
+				Expect(candidatesErr).ToNot(HaveOccurred())
+				Expect(candidates).To(ConsistOf(remoteIdleWorker))
+			})
+		})
+
+		Context("when every worker is over a threshold", func() {
+			BeforeEach(func() {
+				options.MinFreeMemory = 8192
+			})
+
+			It("returns ErrAllWorkersOverloaded", func() {
+				Expect(candidatesErr).To(Equal(ErrAllWorkersOverloaded))
+			})
+
+			Context("when a worker does not report its capacity", func() {
+				var unknownWorker *workerfakes.FakeWorker
+
+				BeforeEach(func() {
+					unknownWorker = new(workerfakes.FakeWorker)
+					workers = append(workers, unknownWorker)
+				})
+
+				It("picks it", func() {
+					Expect(candidatesErr).ToNot(HaveOccurred())
+					Expect(candidates).To(ConsistOf(unknownWorker))
+				})
+			})
+		})
+
+		Context("when getting the input's volume fails", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeInput := new(workerfakes.FakeInputSource)
+				fakeInputAS := new(workerfakes.FakeArtifactSource)
+				fakeInputAS.VolumeOnReturns(nil, false, disaster)
+				fakeInput.SourceReturns(fakeInputAS)
+
+				spec.Inputs = []InputSource{fakeInput}
+			})
+
+			It("returns the error", func() {
+				Expect(candidatesErr).To(Equal(disaster))
+			})
+		})
+	})
+})
+
+var _ = Describe("ChainedPlacementStrategy", func() {
+	Describe("Candidates", func() {
+		var (
+			fakeStrategy1 *workerfakes.FakeContainerPlacementStrategy
+			fakeStrategy2 *workerfakes.FakeContainerPlacementStrategy
+
+			worker1 *workerfakes.FakeWorker
+			worker2 *workerfakes.FakeWorker
+			worker3 *workerfakes.FakeWorker
+		)
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("chained-placement-test")
+
+			worker1 = new(workerfakes.FakeWorker)
+			worker2 = new(workerfakes.FakeWorker)
+			worker3 = new(workerfakes.FakeWorker)
+
+			fakeStrategy1 = new(workerfakes.FakeContainerPlacementStrategy)
+			fakeStrategy1.CandidatesReturns([]Worker{worker1, worker2}, nil)
+
+			fakeStrategy2 = new(workerfakes.FakeContainerPlacementStrategy)
+			fakeStrategy2.CandidatesReturns([]Worker{worker2}, nil)
+
+			spec = ContainerSpec{TeamID: 4567}
+			workers = []Worker{worker1, worker2, worker3}
+
+			strategy = NewChainedPlacementStrategy(fakeStrategy1, fakeStrategy2)
+		})
+
+		JustBeforeEach(func() {
+			candidates, candidatesErr = strategy.Candidates(logger, workers, spec)
+		})
+
+		It("narrows down the candidates with each strategy in turn", func() {
+			Expect(candidatesErr).ToNot(HaveOccurred())
+			Expect(candidates).To(ConsistOf(worker2))
+
+			_, actualWorkers, actualSpec := fakeStrategy1.CandidatesArgsForCall(0)
+			Expect(actualWorkers).To(Equal(workers))
+			Expect(actualSpec).To(Equal(spec))
+
+			_, actualWorkers, _ = fakeStrategy2.CandidatesArgsForCall(0)
+			Expect(actualWorkers).To(ConsistOf(worker1, worker2))
+		})
+
+		Context("when a strategy fails", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeStrategy1.CandidatesReturns(nil, disaster)
+			})
+
+			It("returns the error without trying the rest", func() {
+				Expect(candidatesErr).To(Equal(disaster))
+				Expect(fakeStrategy2.CandidatesCallCount()).To(BeZero())
+			})
+		})
+	})
+})
+
+var _ = Describe("NewContainerPlacementStrategy", func() {
+	var (
+		busyWorker *workerfakes.FakeWorker
+		idleWorker *workerfakes.FakeWorker
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("new-placement-test")
+
+		busyWorker = new(workerfakes.FakeWorker)
+		busyWorker.BuildContainersReturns(10)
+
+		idleWorker = new(workerfakes.FakeWorker)
+		idleWorker.BuildContainersReturns(1)
+
+		spec = ContainerSpec{TeamID: 4567}
+		workers = []Worker{busyWorker, idleWorker}
+	})
+
+	It("chains together the named strategies", func() {
+		strategy, err := NewContainerPlacementStrategy([]string{"volume-locality", "fewest-build-containers", "random"}, ContainerPlacementStrategyOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		candidates, candidatesErr = strategy.Candidates(logger, workers, spec)
+		Expect(candidatesErr).ToNot(HaveOccurred())
+		Expect(candidates).To(ConsistOf(idleWorker))
+	})
+
+	It("errors on an unknown strategy", func() {
+		_, err := NewContainerPlacementStrategy([]string{"volume-locality", "bogus"}, ContainerPlacementStrategyOptions{})
+		Expect(err).To(MatchError("unknown container placement strategy: bogus"))
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"strconv"
 	"time"
 
 	"code.cloudfoundry.org/clock"
@@ -12,6 +13,8 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/tracing"
 )
 
 //go:generate counterfeiter . WorkerProvider
@@ -52,22 +55,44 @@ func (err NoCompatibleWorkersError) Error() string {
 	return fmt.Sprintf("no workers satisfying: %s", err.Spec.Description())
 }
 
+// WorkerPollingInterval is how often a step that is waiting for a compatible
+// worker looks for one again.
+const WorkerPollingInterval = 5 * time.Second
+
 type pool struct {
+	clock    clock.Clock
 	provider WorkerProvider
 
-	rand     *rand.Rand
-	strategy ContainerPlacementStrategy
+	rand            *rand.Rand
+	strategy        ContainerPlacementStrategy
+	strategyOptions ContainerPlacementStrategyOptions
+
+	waitTimeout time.Duration
 }
 
-func NewPool(provider WorkerProvider, strategy ContainerPlacementStrategy) Client {
+// NewPool constructs a Client which places containers on the running workers.
+// Containers are placed using the given strategy unless their spec chains
+// together its own, configured with strategyOptions. If waitTimeout is
+// non-zero, steps which no running worker can satisfy wait up to that long
+// for a compatible worker to register instead of erroring.
+func NewPool(
+	clock clock.Clock,
+	provider WorkerProvider,
+	strategy ContainerPlacementStrategy,
+	strategyOptions ContainerPlacementStrategyOptions,
+	waitTimeout time.Duration,
+) Client {
 	return &pool{
-		provider: provider,
-		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
-		strategy: strategy,
+		clock:           clock,
+		provider:        provider,
+		rand:            rand.New(rand.NewSource(time.Now().UnixNano())),
+		strategy:        strategy,
+		strategyOptions: strategyOptions,
+		waitTimeout:     waitTimeout,
 	}
 }
 
-func (pool *pool) allSatisfying(logger lager.Logger, spec WorkerSpec) ([]Worker, error) {
+func (pool *pool) allSatisfying(ctx context.Context, logger lager.Logger, delegate ImageFetchingDelegate, spec WorkerSpec) ([]Worker, error) {
 	workers, err := pool.provider.RunningWorkers(logger)
 	if err != nil {
 		return nil, err
@@ -80,7 +105,7 @@ func (pool *pool) allSatisfying(logger lager.Logger, spec WorkerSpec) ([]Worker,
 	compatibleTeamWorkers := []Worker{}
 	compatibleGeneralWorkers := []Worker{}
 	for _, worker := range workers {
-		satisfyingWorker, err := worker.Satisfying(logger, spec)
+		satisfyingWorker, err := worker.Satisfying(ctx, logger, delegate, spec)
 		if err == nil {
 			if worker.IsOwnedByTeam() {
 				compatibleTeamWorkers = append(compatibleTeamWorkers, satisfyingWorker)
@@ -107,8 +132,67 @@ func (pool *pool) allSatisfying(logger lager.Logger, spec WorkerSpec) ([]Worker,
 	}
 }
 
-func (pool *pool) Satisfying(logger lager.Logger, spec WorkerSpec) (Worker, error) {
-	compatibleWorkers, err := pool.allSatisfying(logger, spec)
+// waitForSatisfying returns the workers satisfying the spec. When there are
+// none it keeps looking until one registers, the wait times out or the context
+// is cancelled, unless the pool is not configured to wait.
+func (pool *pool) waitForSatisfying(
+	ctx context.Context,
+	logger lager.Logger,
+	delegate ImageFetchingDelegate,
+	spec WorkerSpec,
+) ([]Worker, error) {
+	compatibleWorkers, err := pool.allSatisfying(ctx, logger, delegate, spec)
+	if pool.waitTimeout == 0 || !isWorkerUnavailable(err) {
+		return compatibleWorkers, err
+	}
+
+	logger = logger.Session("wait-for-worker", lager.Data{"spec": spec.Description()})
+	logger.Info("waiting")
+
+	delegate.WaitingForWorker(logger, spec)
+
+	metric.StepsWaiting.Inc()
+	defer metric.StepsWaiting.Dec()
+
+	timeout := pool.clock.NewTimer(pool.waitTimeout)
+	defer timeout.Stop()
+
+	ticker := pool.clock.NewTicker(WorkerPollingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+
+		case <-timeout.C():
+			logger.Info("timed-out")
+			return nil, err
+
+		case <-ticker.C():
+			compatibleWorkers, err = pool.allSatisfying(ctx, logger, delegate, spec)
+			if !isWorkerUnavailable(err) {
+				return compatibleWorkers, err
+			}
+		}
+	}
+}
+
+func isWorkerUnavailable(err error) bool {
+	if _, ok := err.(NoCompatibleWorkersError); ok {
+		return true
+	}
+
+	return err == ErrNoWorkers || err == ErrNoGlobalWorkers
+}
+
+func (pool *pool) Satisfying(
+	ctx context.Context,
+	logger lager.Logger,
+	delegate ImageFetchingDelegate,
+	spec WorkerSpec,
+) (Worker, error) {
+	compatibleWorkers, err := pool.waitForSatisfying(ctx, logger, delegate, spec)
 	if err != nil {
 		return nil, err
 	}
@@ -136,12 +220,7 @@ func (pool *pool) FindOrCreateContainer(
 	}
 
 	if !found {
-		compatibleWorkers, err := pool.allSatisfying(logger, workerSpec)
-		if err != nil {
-			return nil, err
-		}
-
-		worker, err = pool.strategy.Choose(logger, compatibleWorkers, containerSpec)
+		worker, err = pool.placeContainer(ctx, logger, delegate, containerSpec, workerSpec)
 		if err != nil {
 			return nil, err
 		}
@@ -159,6 +238,47 @@ func (pool *pool) FindOrCreateContainer(
 	)
 }
 
+// placeContainer chooses the worker on which to create a new container.
+func (pool *pool) placeContainer(
+	ctx context.Context,
+	logger lager.Logger,
+	delegate ImageFetchingDelegate,
+	containerSpec ContainerSpec,
+	workerSpec WorkerSpec,
+) (worker Worker, err error) {
+	ctx, span := tracing.StartSpan(ctx, "placement", tracing.Attrs{
+		"team_id":  strconv.Itoa(workerSpec.TeamID),
+		"platform": workerSpec.Platform,
+	})
+	defer func() {
+		if worker != nil {
+			span.SetAttribute("worker", worker.Name())
+		}
+
+		tracing.End(span, err)
+	}()
+
+	compatibleWorkers, err := pool.waitForSatisfying(ctx, logger, delegate, workerSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	strategy := pool.strategy
+	if len(containerSpec.PlacementStrategy) != 0 {
+		strategy, err = NewContainerPlacementStrategy(containerSpec.PlacementStrategy, pool.strategyOptions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	candidates, err := strategy.Candidates(logger, compatibleWorkers, containerSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	return candidates[pool.rand.Intn(len(candidates))], nil
+}
+
 func (pool *pool) FindContainerByHandle(logger lager.Logger, teamID int, handle string) (Container, bool, error) {
 	worker, found, err := pool.provider.FindWorkerForContainer(
 		logger.Session("find-worker"),
@@ -3,7 +3,9 @@ package worker_test
 import (
 	"context"
 	"errors"
+	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/cloudfoundry/bosh-cli/director/template"
@@ -11,6 +13,7 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/metric"
 	. "github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 
@@ -23,6 +26,7 @@ var _ = Describe("Pool", func() {
 		logger       *lagertest.TestLogger
 		fakeProvider *workerfakes.FakeWorkerProvider
 		fakeStrategy *workerfakes.FakeContainerPlacementStrategy
+		fakeClock    *fakeclock.FakeClock
 		pool         Client
 	)
 
@@ -31,12 +35,15 @@ var _ = Describe("Pool", func() {
 		fakeProvider = new(workerfakes.FakeWorkerProvider)
 		fakeStrategy = new(workerfakes.FakeContainerPlacementStrategy)
 
-		pool = NewPool(fakeProvider, fakeStrategy)
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+
+		pool = NewPool(fakeClock, fakeProvider, fakeStrategy, ContainerPlacementStrategyOptions{}, 0)
 	})
 
 	Describe("Satisfying", func() {
 		var (
-			spec WorkerSpec
+			spec         WorkerSpec
+			fakeDelegate *workerfakes.FakeImageFetchingDelegate
 
 			satisfyingErr    error
 			satisfyingWorker Worker
@@ -65,10 +72,12 @@ var _ = Describe("Pool", func() {
 				Tags:          []string{"step", "tags"},
 				ResourceTypes: resourceTypes,
 			}
+
+			fakeDelegate = new(workerfakes.FakeImageFetchingDelegate)
 		})
 
 		JustBeforeEach(func() {
-			satisfyingWorker, satisfyingErr = pool.Satisfying(logger, spec)
+			satisfyingWorker, satisfyingErr = pool.Satisfying(context.Background(), logger, fakeDelegate, spec)
 		})
 
 		Context("with multiple workers", func() {
@@ -96,22 +105,22 @@ var _ = Describe("Pool", func() {
 
 			It("checks that the workers satisfy the given spec", func() {
 				Expect(workerA.SatisfyingCallCount()).To(Equal(1))
-				_, actualSpec := workerA.SatisfyingArgsForCall(0)
+				_, _, _, actualSpec := workerA.SatisfyingArgsForCall(0)
 				Expect(actualSpec).To(Equal(spec))
 
 				Expect(workerB.SatisfyingCallCount()).To(Equal(1))
-				_, actualSpec = workerB.SatisfyingArgsForCall(0)
+				_, _, _, actualSpec = workerB.SatisfyingArgsForCall(0)
 				Expect(actualSpec).To(Equal(spec))
 
 				Expect(workerC.SatisfyingCallCount()).To(Equal(1))
-				_, actualSpec = workerC.SatisfyingArgsForCall(0)
+				_, _, _, actualSpec = workerC.SatisfyingArgsForCall(0)
 				Expect(actualSpec).To(Equal(spec))
 			})
 
 			It("returns a random worker satisfying the spec", func() {
 				chosenCount := map[Worker]int{workerA: 0, workerB: 0, workerC: 0}
 				for i := 0; i < 100; i++ {
-					satisfyingWorker, satisfyingErr = pool.Satisfying(logger, spec)
+					satisfyingWorker, satisfyingErr = pool.Satisfying(context.Background(), logger, fakeDelegate, spec)
 					Expect(satisfyingErr).NotTo(HaveOccurred())
 					chosenCount[satisfyingWorker]++
 				}
@@ -131,6 +140,10 @@ var _ = Describe("Pool", func() {
 						Spec: spec,
 					}))
 				})
+
+				It("does not wait for a compatible worker", func() {
+					Expect(fakeDelegate.WaitingForWorkerCallCount()).To(BeZero())
+				})
 			})
 
 			Context("with no workers", func() {
@@ -365,25 +378,25 @@ var _ = Describe("Pool", func() {
 					workerC.SatisfyingReturns(nil, errors.New("nope"))
 
 					fakeProvider.RunningWorkersReturns([]Worker{workerA, workerB, workerC}, nil)
-					fakeStrategy.ChooseReturns(workerA, nil)
+					fakeStrategy.CandidatesReturns([]Worker{workerA}, nil)
 				})
 
 				It("checks that the workers satisfy the given worker spec", func() {
 					Expect(workerA.SatisfyingCallCount()).To(Equal(1))
-					_, actualSpec := workerA.SatisfyingArgsForCall(0)
+					_, _, _, actualSpec := workerA.SatisfyingArgsForCall(0)
 					Expect(actualSpec).To(Equal(workerSpec))
 
 					Expect(workerB.SatisfyingCallCount()).To(Equal(1))
-					_, actualSpec = workerB.SatisfyingArgsForCall(0)
+					_, _, _, actualSpec = workerB.SatisfyingArgsForCall(0)
 					Expect(actualSpec).To(Equal(workerSpec))
 
 					Expect(workerC.SatisfyingCallCount()).To(Equal(1))
-					_, actualSpec = workerC.SatisfyingArgsForCall(0)
+					_, _, _, actualSpec = workerC.SatisfyingArgsForCall(0)
 					Expect(actualSpec).To(Equal(workerSpec))
 				})
 
 				It("returns all workers satisfying the spec", func() {
-					_, satisfyingWorkers, _ := fakeStrategy.ChooseArgsForCall(0)
+					_, satisfyingWorkers, _ := fakeStrategy.CandidatesArgsForCall(0)
 					Expect(satisfyingWorkers).To(ConsistOf(workerA, workerB))
 				})
 
@@ -423,11 +436,11 @@ var _ = Describe("Pool", func() {
 					generalWorker.SatisfyingReturns(generalWorker, nil)
 					generalWorker.IsOwnedByTeamReturns(false)
 					fakeProvider.RunningWorkersReturns([]Worker{generalWorker, teamWorker1, teamWorker2, teamWorker3}, nil)
-					fakeStrategy.ChooseReturns(teamWorker1, nil)
+					fakeStrategy.CandidatesReturns([]Worker{teamWorker1}, nil)
 				})
 
 				It("returns only the team workers that satisfy the spec", func() {
-					_, satisfyingWorkers, _ := fakeStrategy.ChooseArgsForCall(0)
+					_, satisfyingWorkers, _ := fakeStrategy.CandidatesArgsForCall(0)
 					Expect(satisfyingWorkers).To(ConsistOf(teamWorker1, teamWorker2))
 				})
 			})
@@ -448,11 +461,11 @@ var _ = Describe("Pool", func() {
 					generalWorker2 = new(workerfakes.FakeWorker)
 					generalWorker2.SatisfyingReturns(nil, errors.New("nope"))
 					fakeProvider.RunningWorkersReturns([]Worker{generalWorker1, generalWorker2, teamWorker}, nil)
-					fakeStrategy.ChooseReturns(generalWorker1, nil)
+					fakeStrategy.CandidatesReturns([]Worker{generalWorker1}, nil)
 				})
 
 				It("returns the general workers that satisfy the spec", func() {
-					_, satisfyingWorkers, _ := fakeStrategy.ChooseArgsForCall(0)
+					_, satisfyingWorkers, _ := fakeStrategy.CandidatesArgsForCall(0)
 					Expect(satisfyingWorkers).To(ConsistOf(generalWorker1))
 				})
 			})
@@ -531,17 +544,55 @@ var _ = Describe("Pool", func() {
 
 			Context("when strategy returns a worker", func() {
 				BeforeEach(func() {
-					fakeStrategy.ChooseReturns(compatibleWorker, nil)
+					fakeStrategy.CandidatesReturns([]Worker{compatibleWorker}, nil)
 				})
 
 				It("chooses a worker", func() {
 					Expect(createErr).ToNot(HaveOccurred())
-					Expect(fakeStrategy.ChooseCallCount()).To(Equal(1))
+					Expect(fakeStrategy.CandidatesCallCount()).To(Equal(1))
 					Expect(compatibleWorker.FindOrCreateContainerCallCount()).To(Equal(1))
 					Expect(createdContainer).To(Equal(fakeContainer))
 				})
 			})
 
+			Context("when the strategy leaves several candidates", func() {
+				var otherCompatibleWorker *workerfakes.FakeWorker
+
+				BeforeEach(func() {
+					otherCompatibleWorker = new(workerfakes.FakeWorker)
+					otherCompatibleWorker.FindOrCreateContainerReturns(fakeContainer, nil)
+
+					fakeStrategy.CandidatesReturns([]Worker{compatibleWorker, otherCompatibleWorker}, nil)
+				})
+
+				It("creates the container on one of them", func() {
+					Expect(createErr).ToNot(HaveOccurred())
+					Expect(compatibleWorker.FindOrCreateContainerCallCount() + otherCompatibleWorker.FindOrCreateContainerCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the container spec chains its own placement strategies", func() {
+				BeforeEach(func() {
+					spec.PlacementStrategy = []string{"volume-locality", "fewest-build-containers"}
+				})
+
+				It("places the container without the pool's strategy", func() {
+					Expect(createErr).ToNot(HaveOccurred())
+					Expect(fakeStrategy.CandidatesCallCount()).To(BeZero())
+					Expect(compatibleWorker.FindOrCreateContainerCallCount()).To(Equal(1))
+				})
+
+				Context("when a strategy is unknown", func() {
+					BeforeEach(func() {
+						spec.PlacementStrategy = []string{"bogus"}
+					})
+
+					It("returns an error", func() {
+						Expect(createErr).To(MatchError("unknown container placement strategy: bogus"))
+					})
+				})
+			})
+
 			Context("when strategy errors", func() {
 				var (
 					strategyError error
@@ -549,7 +600,7 @@ var _ = Describe("Pool", func() {
 
 				BeforeEach(func() {
 					strategyError = errors.New("strategical explosion")
-					fakeStrategy.ChooseReturns(nil, strategyError)
+					fakeStrategy.CandidatesReturns(nil, strategyError)
 				})
 
 				It("returns an error", func() {
@@ -558,4 +609,124 @@ var _ = Describe("Pool", func() {
 			})
 		})
 	})
+
+	Describe("waiting for a compatible worker", func() {
+		var (
+			ctx          context.Context
+			cancel       context.CancelFunc
+			fakeDelegate *workerfakes.FakeImageFetchingDelegate
+			spec         WorkerSpec
+
+			incompatibleWorker *workerfakes.FakeWorker
+			compatibleWorker   *workerfakes.FakeWorker
+
+			satisfyingWorkers chan Worker
+			satisfyingErrs    chan error
+		)
+
+		BeforeEach(func() {
+			pool = NewPool(fakeClock, fakeProvider, fakeStrategy, ContainerPlacementStrategyOptions{}, time.Minute)
+
+			ctx, cancel = context.WithCancel(context.Background())
+			fakeDelegate = new(workerfakes.FakeImageFetchingDelegate)
+
+			spec = WorkerSpec{
+				Platform: "some-platform",
+				TeamID:   123,
+				Tags:     []string{"some-tag"},
+			}
+
+			incompatibleWorker = new(workerfakes.FakeWorker)
+			incompatibleWorker.SatisfyingReturns(nil, ErrMismatchedTags)
+
+			compatibleWorker = new(workerfakes.FakeWorker)
+			compatibleWorker.SatisfyingReturns(compatibleWorker, nil)
+
+			fakeProvider.RunningWorkersReturns([]Worker{incompatibleWorker}, nil)
+
+			satisfyingWorkers = make(chan Worker, 1)
+			satisfyingErrs = make(chan error, 1)
+		})
+
+		JustBeforeEach(func() {
+			workers, errs := satisfyingWorkers, satisfyingErrs
+
+			go func() {
+				defer GinkgoRecover()
+
+				satisfyingWorker, err := pool.Satisfying(ctx, logger, fakeDelegate, spec)
+				workers <- satisfyingWorker
+				errs <- err
+			}()
+		})
+
+		AfterEach(func() {
+			cancel()
+			Eventually(metric.StepsWaiting.Max).Should(BeZero())
+		})
+
+		It("tells the delegate the step is waiting", func() {
+			Eventually(fakeDelegate.WaitingForWorkerCallCount).Should(Equal(1))
+			_, actualSpec := fakeDelegate.WaitingForWorkerArgsForCall(0)
+			Expect(actualSpec).To(Equal(spec))
+		})
+
+		It("counts the step as waiting", func() {
+			Eventually(fakeClock.WatcherCount).Should(Equal(2))
+			Expect(metric.StepsWaiting.Max()).To(Equal(1))
+		})
+
+		Context("when a compatible worker registers", func() {
+			JustBeforeEach(func() {
+				Eventually(fakeClock.WatcherCount).Should(Equal(2))
+
+				fakeProvider.RunningWorkersReturns([]Worker{incompatibleWorker, compatibleWorker}, nil)
+				fakeClock.Increment(WorkerPollingInterval)
+			})
+
+			It("returns the worker", func() {
+				Eventually(satisfyingWorkers).Should(Receive(Equal(compatibleWorker)))
+				Expect(<-satisfyingErrs).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when no compatible worker registers before the timeout", func() {
+			JustBeforeEach(func() {
+				Eventually(fakeClock.WatcherCount).Should(Equal(2))
+
+				fakeClock.Increment(time.Minute)
+			})
+
+			It("returns a NoCompatibleWorkersError", func() {
+				Eventually(satisfyingErrs).Should(Receive(Equal(NoCompatibleWorkersError{
+					Spec: spec,
+				})))
+			})
+		})
+
+		Context("when the step is aborted while waiting", func() {
+			JustBeforeEach(func() {
+				Eventually(fakeClock.WatcherCount).Should(Equal(2))
+
+				cancel()
+			})
+
+			It("returns the context's error", func() {
+				Eventually(satisfyingErrs).Should(Receive(Equal(context.Canceled)))
+			})
+		})
+
+		Context("when getting the workers fails", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeProvider.RunningWorkersReturns(nil, disaster)
+			})
+
+			It("returns the error without waiting", func() {
+				Eventually(satisfyingErrs).Should(Receive(Equal(disaster)))
+				Expect(fakeDelegate.WaitingForWorkerCallCount()).To(BeZero())
+			})
+		})
+	})
 })
@@ -31,6 +31,9 @@ type Worker interface {
 	ActiveContainers() int
 	ActiveVolumes() int
 	BuildContainers() int
+	MaxContainers() int
+	FreeMemory() int64
+	FreeDisk() int64
 
 	Description() string
 	Name() string
@@ -193,6 +196,18 @@ func (worker *gardenWorker) ActiveVolumes() int {
 	return worker.dbWorker.ActiveVolumes()
 }
 
+func (worker *gardenWorker) MaxContainers() int {
+	return worker.dbWorker.MaxContainers()
+}
+
+func (worker *gardenWorker) FreeMemory() int64 {
+	return worker.dbWorker.FreeMemory()
+}
+
+func (worker *gardenWorker) FreeDisk() int64 {
+	return worker.dbWorker.FreeDisk()
+}
+
 func (worker *gardenWorker) Name() string {
 	return worker.dbWorker.Name()
 }
@@ -215,7 +230,7 @@ func (worker *gardenWorker) BuildContainers() int {
 	return worker.buildContainers
 }
 
-func (worker *gardenWorker) Satisfying(logger lager.Logger, spec WorkerSpec) (Worker, error) {
+func (worker *gardenWorker) Satisfying(_ context.Context, logger lager.Logger, _ ImageFetchingDelegate, spec WorkerSpec) (Worker, error) {
 	workerTeamID := worker.dbWorker.TeamID()
 	workerResourceTypes := worker.dbWorker.ResourceTypes()
 
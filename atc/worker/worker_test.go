@@ -1,6 +1,7 @@
 package worker_test
 
 import (
+	"context"
 	"time"
 
 	"code.cloudfoundry.org/garden/gardenfakes"
@@ -250,7 +251,7 @@ var _ = Describe("Worker", func() {
 		})
 
 		JustBeforeEach(func() {
-			satisfyingWorker, satisfyingErr = gardenWorker.Satisfying(logger, spec)
+			satisfyingWorker, satisfyingErr = gardenWorker.Satisfying(context.TODO(), logger, new(wfakes.FakeImageFetchingDelegate), spec)
 		})
 
 		Context("when the platform is compatible", func() {
@@ -79,11 +79,13 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
-	SatisfyingStub        func(lager.Logger, worker.WorkerSpec) (worker.Worker, error)
+	SatisfyingStub        func(context.Context, lager.Logger, worker.ImageFetchingDelegate, worker.WorkerSpec) (worker.Worker, error)
 	satisfyingMutex       sync.RWMutex
 	satisfyingArgsForCall []struct {
-		arg1 lager.Logger
-		arg2 worker.WorkerSpec
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 worker.ImageFetchingDelegate
+		arg4 worker.WorkerSpec
 	}
 	satisfyingReturns struct {
 		result1 worker.Worker
@@ -365,17 +367,19 @@ func (fake *FakeClient) LookupVolumeReturnsOnCall(i int, result1 worker.Volume,
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClient) Satisfying(arg1 lager.Logger, arg2 worker.WorkerSpec) (worker.Worker, error) {
+func (fake *FakeClient) Satisfying(arg1 context.Context, arg2 lager.Logger, arg3 worker.ImageFetchingDelegate, arg4 worker.WorkerSpec) (worker.Worker, error) {
 	fake.satisfyingMutex.Lock()
 	ret, specificReturn := fake.satisfyingReturnsOnCall[len(fake.satisfyingArgsForCall)]
 	fake.satisfyingArgsForCall = append(fake.satisfyingArgsForCall, struct {
-		arg1 lager.Logger
-		arg2 worker.WorkerSpec
-	}{arg1, arg2})
-	fake.recordInvocation("Satisfying", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 worker.ImageFetchingDelegate
+		arg4 worker.WorkerSpec
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("Satisfying", []interface{}{arg1, arg2, arg3, arg4})
 	fake.satisfyingMutex.Unlock()
 	if fake.SatisfyingStub != nil {
-		return fake.SatisfyingStub(arg1, arg2)
+		return fake.SatisfyingStub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -390,17 +394,17 @@ func (fake *FakeClient) SatisfyingCallCount() int {
 	return len(fake.satisfyingArgsForCall)
 }
 
-func (fake *FakeClient) SatisfyingCalls(stub func(lager.Logger, worker.WorkerSpec) (worker.Worker, error)) {
+func (fake *FakeClient) SatisfyingCalls(stub func(context.Context, lager.Logger, worker.ImageFetchingDelegate, worker.WorkerSpec) (worker.Worker, error)) {
 	fake.satisfyingMutex.Lock()
 	defer fake.satisfyingMutex.Unlock()
 	fake.SatisfyingStub = stub
 }
 
-func (fake *FakeClient) SatisfyingArgsForCall(i int) (lager.Logger, worker.WorkerSpec) {
+func (fake *FakeClient) SatisfyingArgsForCall(i int) (context.Context, lager.Logger, worker.ImageFetchingDelegate, worker.WorkerSpec) {
 	fake.satisfyingMutex.RLock()
 	defer fake.satisfyingMutex.RUnlock()
 	argsForCall := fake.satisfyingArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeClient) SatisfyingReturns(result1 worker.Worker, result2 error) {
@@ -9,91 +9,91 @@ import (
 )
 
 type FakeContainerPlacementStrategy struct {
-	ChooseStub        func(lager.Logger, []worker.Worker, worker.ContainerSpec) (worker.Worker, error)
-	chooseMutex       sync.RWMutex
-	chooseArgsForCall []struct {
+	CandidatesStub        func(lager.Logger, []worker.Worker, worker.ContainerSpec) ([]worker.Worker, error)
+	candidatesMutex       sync.RWMutex
+	candidatesArgsForCall []struct {
 		arg1 lager.Logger
 		arg2 []worker.Worker
 		arg3 worker.ContainerSpec
 	}
-	chooseReturns struct {
-		result1 worker.Worker
+	candidatesReturns struct {
+		result1 []worker.Worker
 		result2 error
 	}
-	chooseReturnsOnCall map[int]struct {
-		result1 worker.Worker
+	candidatesReturnsOnCall map[int]struct {
+		result1 []worker.Worker
 		result2 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeContainerPlacementStrategy) Choose(arg1 lager.Logger, arg2 []worker.Worker, arg3 worker.ContainerSpec) (worker.Worker, error) {
+func (fake *FakeContainerPlacementStrategy) Candidates(arg1 lager.Logger, arg2 []worker.Worker, arg3 worker.ContainerSpec) ([]worker.Worker, error) {
 	var arg2Copy []worker.Worker
 	if arg2 != nil {
 		arg2Copy = make([]worker.Worker, len(arg2))
 		copy(arg2Copy, arg2)
 	}
-	fake.chooseMutex.Lock()
-	ret, specificReturn := fake.chooseReturnsOnCall[len(fake.chooseArgsForCall)]
-	fake.chooseArgsForCall = append(fake.chooseArgsForCall, struct {
+	fake.candidatesMutex.Lock()
+	ret, specificReturn := fake.candidatesReturnsOnCall[len(fake.candidatesArgsForCall)]
+	fake.candidatesArgsForCall = append(fake.candidatesArgsForCall, struct {
 		arg1 lager.Logger
 		arg2 []worker.Worker
 		arg3 worker.ContainerSpec
 	}{arg1, arg2Copy, arg3})
-	fake.recordInvocation("Choose", []interface{}{arg1, arg2Copy, arg3})
-	fake.chooseMutex.Unlock()
-	if fake.ChooseStub != nil {
-		return fake.ChooseStub(arg1, arg2, arg3)
+	fake.recordInvocation("Candidates", []interface{}{arg1, arg2Copy, arg3})
+	fake.candidatesMutex.Unlock()
+	if fake.CandidatesStub != nil {
+		return fake.CandidatesStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.chooseReturns
+	fakeReturns := fake.candidatesReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeContainerPlacementStrategy) ChooseCallCount() int {
-	fake.chooseMutex.RLock()
-	defer fake.chooseMutex.RUnlock()
-	return len(fake.chooseArgsForCall)
+func (fake *FakeContainerPlacementStrategy) CandidatesCallCount() int {
+	fake.candidatesMutex.RLock()
+	defer fake.candidatesMutex.RUnlock()
+	return len(fake.candidatesArgsForCall)
 }
 
-func (fake *FakeContainerPlacementStrategy) ChooseCalls(stub func(lager.Logger, []worker.Worker, worker.ContainerSpec) (worker.Worker, error)) {
-	fake.chooseMutex.Lock()
-	defer fake.chooseMutex.Unlock()
-	fake.ChooseStub = stub
+func (fake *FakeContainerPlacementStrategy) CandidatesCalls(stub func(lager.Logger, []worker.Worker, worker.ContainerSpec) ([]worker.Worker, error)) {
+	fake.candidatesMutex.Lock()
+	defer fake.candidatesMutex.Unlock()
+	fake.CandidatesStub = stub
 }
 
-func (fake *FakeContainerPlacementStrategy) ChooseArgsForCall(i int) (lager.Logger, []worker.Worker, worker.ContainerSpec) {
-	fake.chooseMutex.RLock()
-	defer fake.chooseMutex.RUnlock()
-	argsForCall := fake.chooseArgsForCall[i]
+func (fake *FakeContainerPlacementStrategy) CandidatesArgsForCall(i int) (lager.Logger, []worker.Worker, worker.ContainerSpec) {
+	fake.candidatesMutex.RLock()
+	defer fake.candidatesMutex.RUnlock()
+	argsForCall := fake.candidatesArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeContainerPlacementStrategy) ChooseReturns(result1 worker.Worker, result2 error) {
-	fake.chooseMutex.Lock()
-	defer fake.chooseMutex.Unlock()
-	fake.ChooseStub = nil
-	fake.chooseReturns = struct {
-		result1 worker.Worker
+func (fake *FakeContainerPlacementStrategy) CandidatesReturns(result1 []worker.Worker, result2 error) {
+	fake.candidatesMutex.Lock()
+	defer fake.candidatesMutex.Unlock()
+	fake.CandidatesStub = nil
+	fake.candidatesReturns = struct {
+		result1 []worker.Worker
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeContainerPlacementStrategy) ChooseReturnsOnCall(i int, result1 worker.Worker, result2 error) {
-	fake.chooseMutex.Lock()
-	defer fake.chooseMutex.Unlock()
-	fake.ChooseStub = nil
-	if fake.chooseReturnsOnCall == nil {
-		fake.chooseReturnsOnCall = make(map[int]struct {
-			result1 worker.Worker
+func (fake *FakeContainerPlacementStrategy) CandidatesReturnsOnCall(i int, result1 []worker.Worker, result2 error) {
+	fake.candidatesMutex.Lock()
+	defer fake.candidatesMutex.Unlock()
+	fake.CandidatesStub = nil
+	if fake.candidatesReturnsOnCall == nil {
+		fake.candidatesReturnsOnCall = make(map[int]struct {
+			result1 []worker.Worker
 			result2 error
 		})
 	}
-	fake.chooseReturnsOnCall[i] = struct {
-		result1 worker.Worker
+	fake.candidatesReturnsOnCall[i] = struct {
+		result1 []worker.Worker
 		result2 error
 	}{result1, result2}
 }
@@ -101,8 +101,8 @@ func (fake *FakeContainerPlacementStrategy) ChooseReturnsOnCall(i int, result1 w
 func (fake *FakeContainerPlacementStrategy) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
-	fake.chooseMutex.RLock()
-	defer fake.chooseMutex.RUnlock()
+	fake.candidatesMutex.RLock()
+	defer fake.candidatesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
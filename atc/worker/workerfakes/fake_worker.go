@@ -166,6 +166,26 @@ type FakeWorker struct {
 		result2 bool
 		result3 error
 	}
+	FreeDiskStub        func() int64
+	freeDiskMutex       sync.RWMutex
+	freeDiskArgsForCall []struct {
+	}
+	freeDiskReturns struct {
+		result1 int64
+	}
+	freeDiskReturnsOnCall map[int]struct {
+		result1 int64
+	}
+	FreeMemoryStub        func() int64
+	freeMemoryMutex       sync.RWMutex
+	freeMemoryArgsForCall []struct {
+	}
+	freeMemoryReturns struct {
+		result1 int64
+	}
+	freeMemoryReturnsOnCall map[int]struct {
+		result1 int64
+	}
 	GardenClientStub        func() garden.Client
 	gardenClientMutex       sync.RWMutex
 	gardenClientArgsForCall []struct {
@@ -214,6 +234,16 @@ type FakeWorker struct {
 		result2 bool
 		result3 error
 	}
+	MaxContainersStub        func() int
+	maxContainersMutex       sync.RWMutex
+	maxContainersArgsForCall []struct {
+	}
+	maxContainersReturns struct {
+		result1 int
+	}
+	maxContainersReturnsOnCall map[int]struct {
+		result1 int
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -234,11 +264,13 @@ type FakeWorker struct {
 	resourceTypesReturnsOnCall map[int]struct {
 		result1 []atc.WorkerResourceType
 	}
-	SatisfyingStub        func(lager.Logger, worker.WorkerSpec) (worker.Worker, error)
+	SatisfyingStub        func(context.Context, lager.Logger, worker.ImageFetchingDelegate, worker.WorkerSpec) (worker.Worker, error)
 	satisfyingMutex       sync.RWMutex
 	satisfyingArgsForCall []struct {
-		arg1 lager.Logger
-		arg2 worker.WorkerSpec
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 worker.ImageFetchingDelegate
+		arg4 worker.WorkerSpec
 	}
 	satisfyingReturns struct {
 		result1 worker.Worker
@@ -936,6 +968,110 @@ func (fake *FakeWorker) FindVolumeForTaskCacheReturnsOnCall(i int, result1 worke
 	}{result1, result2, result3}
 }
 
+func (fake *FakeWorker) FreeDisk() int64 {
+	fake.freeDiskMutex.Lock()
+	ret, specificReturn := fake.freeDiskReturnsOnCall[len(fake.freeDiskArgsForCall)]
+	fake.freeDiskArgsForCall = append(fake.freeDiskArgsForCall, struct {
+	}{})
+	fake.recordInvocation("FreeDisk", []interface{}{})
+	fake.freeDiskMutex.Unlock()
+	if fake.FreeDiskStub != nil {
+		return fake.FreeDiskStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.freeDiskReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) FreeDiskCallCount() int {
+	fake.freeDiskMutex.RLock()
+	defer fake.freeDiskMutex.RUnlock()
+	return len(fake.freeDiskArgsForCall)
+}
+
+func (fake *FakeWorker) FreeDiskCalls(stub func() int64) {
+	fake.freeDiskMutex.Lock()
+	defer fake.freeDiskMutex.Unlock()
+	fake.FreeDiskStub = stub
+}
+
+func (fake *FakeWorker) FreeDiskReturns(result1 int64) {
+	fake.freeDiskMutex.Lock()
+	defer fake.freeDiskMutex.Unlock()
+	fake.FreeDiskStub = nil
+	fake.freeDiskReturns = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakeWorker) FreeDiskReturnsOnCall(i int, result1 int64) {
+	fake.freeDiskMutex.Lock()
+	defer fake.freeDiskMutex.Unlock()
+	fake.FreeDiskStub = nil
+	if fake.freeDiskReturnsOnCall == nil {
+		fake.freeDiskReturnsOnCall = make(map[int]struct {
+			result1 int64
+		})
+	}
+	fake.freeDiskReturnsOnCall[i] = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakeWorker) FreeMemory() int64 {
+	fake.freeMemoryMutex.Lock()
+	ret, specificReturn := fake.freeMemoryReturnsOnCall[len(fake.freeMemoryArgsForCall)]
+	fake.freeMemoryArgsForCall = append(fake.freeMemoryArgsForCall, struct {
+	}{})
+	fake.recordInvocation("FreeMemory", []interface{}{})
+	fake.freeMemoryMutex.Unlock()
+	if fake.FreeMemoryStub != nil {
+		return fake.FreeMemoryStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.freeMemoryReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) FreeMemoryCallCount() int {
+	fake.freeMemoryMutex.RLock()
+	defer fake.freeMemoryMutex.RUnlock()
+	return len(fake.freeMemoryArgsForCall)
+}
+
+func (fake *FakeWorker) FreeMemoryCalls(stub func() int64) {
+	fake.freeMemoryMutex.Lock()
+	defer fake.freeMemoryMutex.Unlock()
+	fake.FreeMemoryStub = stub
+}
+
+func (fake *FakeWorker) FreeMemoryReturns(result1 int64) {
+	fake.freeMemoryMutex.Lock()
+	defer fake.freeMemoryMutex.Unlock()
+	fake.FreeMemoryStub = nil
+	fake.freeMemoryReturns = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakeWorker) FreeMemoryReturnsOnCall(i int, result1 int64) {
+	fake.freeMemoryMutex.Lock()
+	defer fake.freeMemoryMutex.Unlock()
+	fake.FreeMemoryStub = nil
+	if fake.freeMemoryReturnsOnCall == nil {
+		fake.freeMemoryReturnsOnCall = make(map[int]struct {
+			result1 int64
+		})
+	}
+	fake.freeMemoryReturnsOnCall[i] = struct {
+		result1 int64
+	}{result1}
+}
+
 func (fake *FakeWorker) GardenClient() garden.Client {
 	fake.gardenClientMutex.Lock()
 	ret, specificReturn := fake.gardenClientReturnsOnCall[len(fake.gardenClientArgsForCall)]
@@ -1168,6 +1304,58 @@ func (fake *FakeWorker) LookupVolumeReturnsOnCall(i int, result1 worker.Volume,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeWorker) MaxContainers() int {
+	fake.maxContainersMutex.Lock()
+	ret, specificReturn := fake.maxContainersReturnsOnCall[len(fake.maxContainersArgsForCall)]
+	fake.maxContainersArgsForCall = append(fake.maxContainersArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MaxContainers", []interface{}{})
+	fake.maxContainersMutex.Unlock()
+	if fake.MaxContainersStub != nil {
+		return fake.MaxContainersStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.maxContainersReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) MaxContainersCallCount() int {
+	fake.maxContainersMutex.RLock()
+	defer fake.maxContainersMutex.RUnlock()
+	return len(fake.maxContainersArgsForCall)
+}
+
+func (fake *FakeWorker) MaxContainersCalls(stub func() int) {
+	fake.maxContainersMutex.Lock()
+	defer fake.maxContainersMutex.Unlock()
+	fake.MaxContainersStub = stub
+}
+
+func (fake *FakeWorker) MaxContainersReturns(result1 int) {
+	fake.maxContainersMutex.Lock()
+	defer fake.maxContainersMutex.Unlock()
+	fake.MaxContainersStub = nil
+	fake.maxContainersReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeWorker) MaxContainersReturnsOnCall(i int, result1 int) {
+	fake.maxContainersMutex.Lock()
+	defer fake.maxContainersMutex.Unlock()
+	fake.MaxContainersStub = nil
+	if fake.maxContainersReturnsOnCall == nil {
+		fake.maxContainersReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.maxContainersReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeWorker) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1272,17 +1460,19 @@ func (fake *FakeWorker) ResourceTypesReturnsOnCall(i int, result1 []atc.WorkerRe
 	}{result1}
 }
 
-func (fake *FakeWorker) Satisfying(arg1 lager.Logger, arg2 worker.WorkerSpec) (worker.Worker, error) {
+func (fake *FakeWorker) Satisfying(arg1 context.Context, arg2 lager.Logger, arg3 worker.ImageFetchingDelegate, arg4 worker.WorkerSpec) (worker.Worker, error) {
 	fake.satisfyingMutex.Lock()
 	ret, specificReturn := fake.satisfyingReturnsOnCall[len(fake.satisfyingArgsForCall)]
 	fake.satisfyingArgsForCall = append(fake.satisfyingArgsForCall, struct {
-		arg1 lager.Logger
-		arg2 worker.WorkerSpec
-	}{arg1, arg2})
-	fake.recordInvocation("Satisfying", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 worker.ImageFetchingDelegate
+		arg4 worker.WorkerSpec
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("Satisfying", []interface{}{arg1, arg2, arg3, arg4})
 	fake.satisfyingMutex.Unlock()
 	if fake.SatisfyingStub != nil {
-		return fake.SatisfyingStub(arg1, arg2)
+		return fake.SatisfyingStub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -1297,17 +1487,17 @@ func (fake *FakeWorker) SatisfyingCallCount() int {
 	return len(fake.satisfyingArgsForCall)
 }
 
-func (fake *FakeWorker) SatisfyingCalls(stub func(lager.Logger, worker.WorkerSpec) (worker.Worker, error)) {
+func (fake *FakeWorker) SatisfyingCalls(stub func(context.Context, lager.Logger, worker.ImageFetchingDelegate, worker.WorkerSpec) (worker.Worker, error)) {
 	fake.satisfyingMutex.Lock()
 	defer fake.satisfyingMutex.Unlock()
 	fake.SatisfyingStub = stub
 }
 
-func (fake *FakeWorker) SatisfyingArgsForCall(i int) (lager.Logger, worker.WorkerSpec) {
+func (fake *FakeWorker) SatisfyingArgsForCall(i int) (context.Context, lager.Logger, worker.ImageFetchingDelegate, worker.WorkerSpec) {
 	fake.satisfyingMutex.RLock()
 	defer fake.satisfyingMutex.RUnlock()
 	argsForCall := fake.satisfyingArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeWorker) SatisfyingReturns(result1 worker.Worker, result2 error) {
@@ -1465,6 +1655,10 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.findVolumeForResourceCacheMutex.RUnlock()
 	fake.findVolumeForTaskCacheMutex.RLock()
 	defer fake.findVolumeForTaskCacheMutex.RUnlock()
+	fake.freeDiskMutex.RLock()
+	defer fake.freeDiskMutex.RUnlock()
+	fake.freeMemoryMutex.RLock()
+	defer fake.freeMemoryMutex.RUnlock()
 	fake.gardenClientMutex.RLock()
 	defer fake.gardenClientMutex.RUnlock()
 	fake.isOwnedByTeamMutex.RLock()
@@ -1473,6 +1667,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.isVersionCompatibleMutex.RUnlock()
 	fake.lookupVolumeMutex.RLock()
 	defer fake.lookupVolumeMutex.RUnlock()
+	fake.maxContainersMutex.RLock()
+	defer fake.maxContainersMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.resourceTypesMutex.RLock()
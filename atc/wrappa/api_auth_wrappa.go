@@ -56,7 +56,9 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 
 		// pipeline and job are public or authorized
 		case atc.GetBuildPreparation,
-			atc.BuildEvents:
+			atc.BuildEvents,
+			atc.ListBuildArtifacts,
+			atc.GetBuildArtifact:
 			newHandler = wrappa.checkBuildReadAccessHandlerFactory.CheckIfPrivateJobHandler(handler, rejector)
 
 		// resource belongs to authorized team
@@ -100,6 +102,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.HijackContainer,
 			atc.ListContainers,
 			atc.ListWorkers,
+			atc.ListBuildQueue,
 			atc.RegisterWorker,
 			atc.HeartbeatWorker,
 			atc.DeleteWorker,
@@ -118,7 +121,12 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		// authorized (requested team matches resource team)
 		case atc.CheckResource,
 			atc.CheckResourceType,
+			atc.ListResourceCheckHistory,
+			atc.ListNotificationDeliveries,
 			atc.CreateJobBuild,
+			atc.RerunJobBuild,
+			atc.ApproveJobBuild,
+			atc.RejectJobBuild,
 			atc.CreatePipelineBuild,
 			atc.DeletePipeline,
 			atc.DisableResourceVersion,
@@ -129,6 +137,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetCC,
 			atc.GetVersionsDB,
 			atc.ListJobInputs,
+			atc.ListJobBuildInputs,
 			atc.OrderPipelines,
 			atc.PauseJob,
 			atc.PausePipeline,
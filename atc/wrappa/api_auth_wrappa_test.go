@@ -159,6 +159,8 @@ var _ = Describe("APIAuthWrappa", func() {
 
 				// authorized or public pipeline and public job
 				atc.BuildEvents:         checksIfPrivateJob(inputHandlers[atc.BuildEvents]),
+				atc.ListBuildArtifacts:  checksIfPrivateJob(inputHandlers[atc.ListBuildArtifacts]),
+				atc.GetBuildArtifact:    checksIfPrivateJob(inputHandlers[atc.GetBuildArtifact]),
 				atc.GetBuildPreparation: checksIfPrivateJob(inputHandlers[atc.GetBuildPreparation]),
 
 				// resource belongs to authorized team
@@ -201,6 +203,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ListVolumes:     authenticated(inputHandlers[atc.ListVolumes]),
 				atc.ListTeamBuilds:  authenticated(inputHandlers[atc.ListTeamBuilds]),
 				atc.ListWorkers:     authenticated(inputHandlers[atc.ListWorkers]),
+				atc.ListBuildQueue:  authenticated(inputHandlers[atc.ListBuildQueue]),
 				atc.RegisterWorker:  authenticated(inputHandlers[atc.RegisterWorker]),
 				atc.HeartbeatWorker: authenticated(inputHandlers[atc.HeartbeatWorker]),
 				atc.DeleteWorker:    authenticated(inputHandlers[atc.DeleteWorker]),
@@ -214,29 +217,35 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetInfoCreds: authenticatedAndAdmin(inputHandlers[atc.GetInfoCreds]),
 
 				// authorized (requested team matches resource team)
-				atc.CheckResource:          authorized(inputHandlers[atc.CheckResource]),
-				atc.CheckResourceType:      authorized(inputHandlers[atc.CheckResourceType]),
-				atc.CreateJobBuild:         authorized(inputHandlers[atc.CreateJobBuild]),
-				atc.DeletePipeline:         authorized(inputHandlers[atc.DeletePipeline]),
-				atc.DisableResourceVersion: authorized(inputHandlers[atc.DisableResourceVersion]),
-				atc.EnableResourceVersion:  authorized(inputHandlers[atc.EnableResourceVersion]),
-				atc.PinResourceVersion:     authorized(inputHandlers[atc.PinResourceVersion]),
-				atc.UnpinResource:          authorized(inputHandlers[atc.UnpinResource]),
-				atc.GetConfig:              authorized(inputHandlers[atc.GetConfig]),
-				atc.GetCC:                  authorized(inputHandlers[atc.GetCC]),
-				atc.GetVersionsDB:          authorized(inputHandlers[atc.GetVersionsDB]),
-				atc.ListJobInputs:          authorized(inputHandlers[atc.ListJobInputs]),
-				atc.OrderPipelines:         authorized(inputHandlers[atc.OrderPipelines]),
-				atc.PauseJob:               authorized(inputHandlers[atc.PauseJob]),
-				atc.PausePipeline:          authorized(inputHandlers[atc.PausePipeline]),
-				atc.RenamePipeline:         authorized(inputHandlers[atc.RenamePipeline]),
-				atc.SaveConfig:             authorized(inputHandlers[atc.SaveConfig]),
-				atc.UnpauseJob:             authorized(inputHandlers[atc.UnpauseJob]),
-				atc.UnpausePipeline:        authorized(inputHandlers[atc.UnpausePipeline]),
-				atc.ExposePipeline:         authorized(inputHandlers[atc.ExposePipeline]),
-				atc.HidePipeline:           authorized(inputHandlers[atc.HidePipeline]),
-				atc.CreatePipelineBuild:    authorized(inputHandlers[atc.CreatePipelineBuild]),
-				atc.ClearTaskCache:         authorized(inputHandlers[atc.ClearTaskCache]),
+				atc.CheckResource:              authorized(inputHandlers[atc.CheckResource]),
+				atc.CheckResourceType:          authorized(inputHandlers[atc.CheckResourceType]),
+				atc.ListResourceCheckHistory:   authorized(inputHandlers[atc.ListResourceCheckHistory]),
+				atc.ListNotificationDeliveries: authorized(inputHandlers[atc.ListNotificationDeliveries]),
+				atc.CreateJobBuild:             authorized(inputHandlers[atc.CreateJobBuild]),
+				atc.RerunJobBuild:              authorized(inputHandlers[atc.RerunJobBuild]),
+				atc.ApproveJobBuild:            authorized(inputHandlers[atc.ApproveJobBuild]),
+				atc.RejectJobBuild:             authorized(inputHandlers[atc.RejectJobBuild]),
+				atc.DeletePipeline:             authorized(inputHandlers[atc.DeletePipeline]),
+				atc.DisableResourceVersion:     authorized(inputHandlers[atc.DisableResourceVersion]),
+				atc.EnableResourceVersion:      authorized(inputHandlers[atc.EnableResourceVersion]),
+				atc.PinResourceVersion:         authorized(inputHandlers[atc.PinResourceVersion]),
+				atc.UnpinResource:              authorized(inputHandlers[atc.UnpinResource]),
+				atc.GetConfig:                  authorized(inputHandlers[atc.GetConfig]),
+				atc.GetCC:                      authorized(inputHandlers[atc.GetCC]),
+				atc.GetVersionsDB:              authorized(inputHandlers[atc.GetVersionsDB]),
+				atc.ListJobInputs:              authorized(inputHandlers[atc.ListJobInputs]),
+				atc.ListJobBuildInputs:         authorized(inputHandlers[atc.ListJobBuildInputs]),
+				atc.OrderPipelines:             authorized(inputHandlers[atc.OrderPipelines]),
+				atc.PauseJob:                   authorized(inputHandlers[atc.PauseJob]),
+				atc.PausePipeline:              authorized(inputHandlers[atc.PausePipeline]),
+				atc.RenamePipeline:             authorized(inputHandlers[atc.RenamePipeline]),
+				atc.SaveConfig:                 authorized(inputHandlers[atc.SaveConfig]),
+				atc.UnpauseJob:                 authorized(inputHandlers[atc.UnpauseJob]),
+				atc.UnpausePipeline:            authorized(inputHandlers[atc.UnpausePipeline]),
+				atc.ExposePipeline:             authorized(inputHandlers[atc.ExposePipeline]),
+				atc.HidePipeline:               authorized(inputHandlers[atc.HidePipeline]),
+				atc.CreatePipelineBuild:        authorized(inputHandlers[atc.CreatePipelineBuild]),
+				atc.ClearTaskCache:             authorized(inputHandlers[atc.ClearTaskCache]),
 			}
 		})
 
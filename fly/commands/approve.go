@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type ApproveCommand struct {
+	Job    flaghelpers.JobFlag `short:"j" long:"job"    required:"true" value-name:"PIPELINE/JOB" description:"Name of the job of the build waiting for approval"`
+	Build  string              `short:"b" long:"build"  required:"true" description:"Name of the build waiting for approval"`
+	Step   string              `short:"s" long:"step"   description:"Name of the approval step to decide, if the build is waiting for more than one"`
+	Reject bool                `short:"r" long:"reject" description:"Reject the build instead of approving it"`
+}
+
+func (command *ApproveCommand) Execute(args []string) error {
+	pipelineName, jobName := command.Job.PipelineName, command.Job.JobName
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var approval atc.BuildApproval
+	if command.Reject {
+		approval, err = target.Team().RejectJobBuild(pipelineName, jobName, command.Build, command.Step)
+	} else {
+		approval, err = target.Team().ApproveJobBuild(pipelineName, jobName, command.Build, command.Step)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s '%s' of %s/%s #%s\n", approval.Status, approval.Name, pipelineName, jobName, command.Build)
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type BuildQueueCommand struct {
+	Teams bool `long:"teams" description:"Show how many builds each team is running and has queued instead of the queued builds"`
+	Json  bool `long:"json"  description:"Print command result as JSON"`
+}
+
+func (command *BuildQueueCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	queue, err := target.Client().BuildQueue()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(queue)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	if command.Teams {
+		return command.renderTeams(queue.Teams)
+	}
+
+	return command.renderBuilds(queue.Builds)
+}
+
+func (command *BuildQueueCommand) renderBuilds(builds []atc.BuildQueueEntry) error {
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "position", Color: color.New(color.Bold)},
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "team", Color: color.New(color.Bold)},
+			{Contents: "pipeline/job", Color: color.New(color.Bold)},
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "priority", Color: color.New(color.Bold)},
+			{Contents: "queued", Color: color.New(color.Bold)},
+			{Contents: "blocked", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, build := range builds {
+		var nameCell ui.TableCell
+		if build.JobName == "" {
+			nameCell.Contents = "one-off"
+			nameCell.Color = ui.OffColor
+		} else {
+			nameCell.Contents = build.PipelineName + "/" + build.JobName
+		}
+
+		blockedCell := ui.TableCell{Contents: build.Blocked, Color: ui.PendingColor}
+		if build.Blocked == "" {
+			blockedCell.Contents = "n/a"
+			blockedCell.Color = ui.OffColor
+		}
+
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: strconv.Itoa(build.Position)},
+			{Contents: strconv.Itoa(build.BuildID)},
+			{Contents: build.TeamName},
+			nameCell,
+			{Contents: build.BuildName},
+			{Contents: strconv.Itoa(build.Priority)},
+			{Contents: time.Unix(build.QueuedAt, 0).Format(timeDateLayout)},
+			blockedCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func (command *BuildQueueCommand) renderTeams(teams []atc.BuildQueueTeam) error {
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "team", Color: color.New(color.Bold)},
+			{Contents: "running", Color: color.New(color.Bold)},
+			{Contents: "queued", Color: color.New(color.Bold)},
+			{Contents: "weight", Color: color.New(color.Bold)},
+			{Contents: "max in flight", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, team := range teams {
+		maxInFlightCell := ui.TableCell{Contents: strconv.Itoa(team.MaxInFlight)}
+		if team.MaxInFlight == 0 {
+			maxInFlightCell.Contents = "none"
+			maxInFlightCell.Color = ui.OffColor
+		}
+
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: team.Name},
+			{Contents: strconv.Itoa(team.Active)},
+			{Contents: strconv.Itoa(team.Queued)},
+			{Contents: strconv.Itoa(team.Weight)},
+			maxInFlightCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
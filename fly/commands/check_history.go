@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type CheckHistoryCommand struct {
+	Count    int                      `short:"c" long:"count" default:"50" description:"Number of checks you want to limit the return to"`
+	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of a resource to get the check history of"`
+	Json     bool                     `long:"json" description:"Print command result as JSON, including the stderr of each check"`
+}
+
+func (command *CheckHistoryCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	entries, found, err := target.Team().ResourceCheckHistory(command.Resource.PipelineName, command.Resource.ResourceName, command.Count)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("pipeline/resource not found")
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(entries)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "start", Color: color.New(color.Bold)},
+			{Contents: "end", Color: color.New(color.Bold)},
+			{Contents: "duration", Color: color.New(color.Bold)},
+			{Contents: "worker", Color: color.New(color.Bold)},
+			{Contents: "versions", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, entry := range entries {
+		startTimeCell, endTimeCell, durationCell := populateTimeCells(time.Unix(entry.StartTime, 0), time.Unix(entry.EndTime, 0))
+
+		var statusCell ui.TableCell
+		switch {
+		case entry.ExitStatus == nil:
+			statusCell.Contents = "errored"
+			statusCell.Color = ui.ErroredColor
+		case *entry.ExitStatus == 0:
+			statusCell.Contents = "succeeded"
+			statusCell.Color = ui.SucceededColor
+		default:
+			statusCell.Contents = fmt.Sprintf("failed (exit status %d)", *entry.ExitStatus)
+			statusCell.Color = ui.FailedColor
+		}
+
+		workerCell := ui.TableCell{Contents: entry.WorkerName}
+		if entry.WorkerName == "" {
+			workerCell.Contents = "none"
+			workerCell.Color = ui.OffColor
+		}
+
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: strconv.Itoa(entry.ID)},
+			statusCell,
+			startTimeCell,
+			endTimeCell,
+			durationCell,
+			workerCell,
+			{Contents: strconv.Itoa(len(entry.Versions))},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/go-archive/tgzfs"
+)
+
+type DownloadArtifactCommand struct {
+	Job      flaghelpers.JobFlag `short:"j" long:"job"      required:"true" value-name:"PIPELINE/JOB" description:"Name of the job of the build which persisted the artifact"`
+	Build    string              `short:"b" long:"build"    required:"true" description:"Name of the build which persisted the artifact"`
+	Artifact string              `short:"o" long:"artifact" required:"true" description:"Name of the task output which was persisted as an artifact"`
+	Dir      string              `short:"d" long:"dir"                      description:"Directory into which to extract the artifact (default: ./ARTIFACT)"`
+}
+
+func (command *DownloadArtifactCommand) Execute(args []string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	build, found, err := target.Team().JobBuild(command.Job.PipelineName, command.Job.JobName, command.Build)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("build not found")
+	}
+
+	artifact, found, err := target.Client().GetBuildArtifact(build.ID, command.Artifact)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("artifact '%s' not found", command.Artifact)
+	}
+
+	defer artifact.Close()
+
+	dir := command.Dir
+	if dir == "" {
+		dir = command.Artifact
+	}
+
+	err = tgzfs.Extract(artifact, dir)
+	if err != nil {
+		return fmt.Errorf("failed to extract artifact: %s", err)
+	}
+
+	fmt.Printf("downloaded artifact '%s' to %s\n", command.Artifact, dir)
+
+	return nil
+}
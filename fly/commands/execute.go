@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"os"
@@ -21,18 +22,19 @@ import (
 )
 
 type ExecuteCommand struct {
-	TaskConfig     atc.PathFlag                       `short:"c" long:"config" required:"true"                description:"The task config to execute"`
-	Privileged     bool                               `short:"p" long:"privileged"                            description:"Run the task with full privileges"`
-	IncludeIgnored bool                               `          long:"include-ignored"                       description:"Including .gitignored paths. Disregards .gitignore entries and uploads everything"`
-	Inputs         []flaghelpers.InputPairFlag        `short:"i" long:"input"       value-name:"NAME=PATH"    description:"An input to provide to the task (can be specified multiple times)"`
-	InputMappings  []flaghelpers.VariablePairFlag     `short:"m" long:"input-mapping"       value-name:"[NAME=STRING]"    description:"Map a resource to a different name as task input"`
-	InputsFrom     flaghelpers.JobFlag                `short:"j" long:"inputs-from" value-name:"PIPELINE/JOB" description:"A job to base the inputs on"`
-	Outputs        []flaghelpers.OutputPairFlag       `short:"o" long:"output"      value-name:"NAME=PATH"    description:"An output to fetch from the task (can be specified multiple times)"`
-	Image          string                             `long:"image" description:"Image resource for the one-off build"`
-	Tags           []string                           `          long:"tag"         value-name:"TAG"          description:"A tag for a specific environment (can be specified multiple times)"`
-	Var            []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       value-name:"[NAME=STRING]"  description:"Specify a string value to set for a variable in the pipeline"`
-	YAMLVar        []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  value-name:"[NAME=YAML]"    description:"Specify a YAML value to set for a variable in the pipeline"`
-	VarsFrom       []atc.PathFlag                     `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
+	TaskConfig      atc.PathFlag                       `short:"c" long:"config" required:"true"                description:"The task config to execute"`
+	Privileged      bool                               `short:"p" long:"privileged"                            description:"Run the task with full privileges"`
+	IncludeIgnored  bool                               `          long:"include-ignored"                       description:"Including .gitignored paths. Disregards .gitignore entries and uploads everything"`
+	Inputs          []flaghelpers.InputPairFlag        `short:"i" long:"input"       value-name:"NAME=PATH"    description:"An input to provide to the task (can be specified multiple times)"`
+	InputMappings   []flaghelpers.VariablePairFlag     `short:"m" long:"input-mapping"       value-name:"[NAME=STRING]"    description:"Map a resource to a different name as task input"`
+	InputsFrom      flaghelpers.JobFlag                `short:"j" long:"inputs-from" value-name:"PIPELINE/JOB" description:"A job to base the inputs on"`
+	InputsFromBuild flaghelpers.JobBuildFlag           `long:"inputs-from-build" value-name:"PIPELINE/JOB/BUILD" description:"A build of a job to base the inputs on, using the exact versions it used"`
+	Outputs         []flaghelpers.OutputPairFlag       `short:"o" long:"output"      value-name:"NAME=PATH"    description:"An output to fetch from the task (can be specified multiple times)"`
+	Image           string                             `long:"image" description:"Image resource for the one-off build"`
+	Tags            []string                           `          long:"tag"         value-name:"TAG"          description:"A tag for a specific environment (can be specified multiple times)"`
+	Var             []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       value-name:"[NAME=STRING]"  description:"Specify a string value to set for a variable in the pipeline"`
+	YAMLVar         []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  value-name:"[NAME=YAML]"    description:"Specify a YAML value to set for a variable in the pipeline"`
+	VarsFrom        []atc.PathFlag                     `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
 }
 
 func (command *ExecuteCommand) Execute(args []string) error {
@@ -46,6 +48,18 @@ func (command *ExecuteCommand) Execute(args []string) error {
 		return err
 	}
 
+	inputsFrom := command.InputsFrom
+	if command.InputsFromBuild.PipelineName != "" {
+		if inputsFrom.PipelineName != "" {
+			return errors.New("--inputs-from and --inputs-from-build cannot be used together")
+		}
+
+		inputsFrom = flaghelpers.JobFlag{
+			PipelineName: command.InputsFromBuild.PipelineName,
+			JobName:      command.InputsFromBuild.JobName,
+		}
+	}
+
 	includeIgnored := command.IncludeIgnored
 
 	taskTemplate := templatehelpers.NewYamlTemplateWithParams(command.TaskConfig, command.VarsFrom, command.Var, command.YAMLVar)
@@ -71,7 +85,8 @@ func (command *ExecuteCommand) Execute(args []string) error {
 		command.Inputs,
 		inputMappings,
 		command.Image,
-		command.InputsFrom,
+		inputsFrom,
+		command.InputsFromBuild.BuildName,
 	)
 	if err != nil {
 		return err
@@ -113,8 +128,8 @@ func (command *ExecuteCommand) Execute(args []string) error {
 	var build atc.Build
 	var buildURL *url.URL
 
-	if command.InputsFrom.PipelineName != "" {
-		build, err = target.Team().CreatePipelineBuild(command.InputsFrom.PipelineName, plan)
+	if inputsFrom.PipelineName != "" {
+		build, err = target.Team().CreatePipelineBuild(inputsFrom.PipelineName, plan)
 		if err != nil {
 			return err
 		}
@@ -51,9 +51,12 @@ type FlyCommand struct {
 	FormatPipeline   FormatPipelineCommand   `command:"format-pipeline"     alias:"fp"   description:"Format a pipeline config"`
 	OrderPipelines   OrderPipelinesCommand   `command:"order-pipelines"     alias:"op"   description:"Orders pipelines"`
 
+	NotificationDeliveries NotificationDeliveriesCommand `command:"notification-deliveries" alias:"nd" description:"List the recent deliveries of a pipeline's notifications"`
+
 	Resources        ResourcesCommand        `command:"resources"           alias:"rs"   description:"List the resources in the pipeline"`
 	ResourceVersions ResourceVersionsCommand `command:"resource-versions"   alias:"rvs"  description:"List the versions of a resource"`
 	CheckResource    CheckResourceCommand    `command:"check-resource"      alias:"cr"   description:"Check a resource"`
+	CheckHistory     CheckHistoryCommand     `command:"check-history"       alias:"ch"   description:"List the recent checks of a resource"`
 
 	CheckResourceType CheckResourceTypeCommand `command:"check-resource-type" alias:"crt"  description:"Check a resource-type"`
 
@@ -61,8 +64,13 @@ type FlyCommand struct {
 
 	Builds     BuildsCommand     `command:"builds"      alias:"bs" description:"List builds data"`
 	AbortBuild AbortBuildCommand `command:"abort-build" alias:"ab" description:"Abort a build"`
+	BuildQueue BuildQueueCommand `command:"build-queue" alias:"bq" description:"List the builds waiting for their turn to start"`
+
+	DownloadArtifact DownloadArtifactCommand `command:"download-artifact" alias:"da" description:"Download an artifact persisted by a build"`
 
 	TriggerJob TriggerJobCommand `command:"trigger-job" alias:"tj" description:"Start a job in a pipeline"`
+	RerunBuild RerunBuildCommand `command:"rerun-build" alias:"rb" description:"Start a new build of a job with the same inputs as an earlier build"`
+	Approve    ApproveCommand    `command:"approve"     alias:"ap" description:"Approve or reject a build waiting at an approval step"`
 
 	Volumes VolumesCommand `command:"volumes" alias:"vs" description:"List the active volumes"`
 
@@ -26,6 +26,7 @@ func DetermineInputs(
 	jobInputMappings map[string]string,
 	jobInputImage string,
 	inputsFrom flaghelpers.JobFlag,
+	inputsFromBuild string,
 ) ([]Input, *atc.ImageResource, error) {
 	err := CheckForUnknownInputMappings(localInputMappings, taskInputs)
 	if err != nil {
@@ -54,7 +55,7 @@ func DetermineInputs(
 		return nil, nil, err
 	}
 
-	inputsFromJob, imageResourceFromJob, err := FetchInputsFromJob(fact, team, inputsFrom, jobInputImage)
+	inputsFromJob, imageResourceFromJob, err := FetchInputsFromJob(fact, team, inputsFrom, inputsFromBuild, jobInputImage)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -146,19 +147,32 @@ func GenerateLocalInputs(fact atc.PlanFactory, inputMappings []flaghelpers.Input
 	return kvMap, nil
 }
 
-func FetchInputsFromJob(fact atc.PlanFactory, team concourse.Team, inputsFrom flaghelpers.JobFlag, imageName string) (map[string]Input, *atc.ImageResource, error) {
+// FetchInputsFromJob fetches the inputs the job's next build would use or,
+// when buildName is given, the exact inputs that build of the job used.
+func FetchInputsFromJob(fact atc.PlanFactory, team concourse.Team, inputsFrom flaghelpers.JobFlag, buildName string, imageName string) (map[string]Input, *atc.ImageResource, error) {
 	kvMap := map[string]Input{}
 
 	if inputsFrom.PipelineName == "" && inputsFrom.JobName == "" {
 		return kvMap, nil, nil
 	}
 
-	buildInputs, found, err := team.BuildInputsForJob(inputsFrom.PipelineName, inputsFrom.JobName)
+	var buildInputs []atc.BuildInput
+	var found bool
+	var err error
+	if buildName != "" {
+		buildInputs, found, err = team.BuildInputsForJobBuild(inputsFrom.PipelineName, inputsFrom.JobName, buildName)
+	} else {
+		buildInputs, found, err = team.BuildInputsForJob(inputsFrom.PipelineName, inputsFrom.JobName)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
 
 	if !found {
+		if buildName != "" {
+			return nil, nil, fmt.Errorf("build inputs for %s/%s/%s not found", inputsFrom.PipelineName, inputsFrom.JobName, buildName)
+		}
+
 		return nil, nil, fmt.Errorf("build inputs for %s/%s not found", inputsFrom.PipelineName, inputsFrom.JobName)
 	}
 
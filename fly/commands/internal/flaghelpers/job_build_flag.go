@@ -0,0 +1,40 @@
+package flaghelpers
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type JobBuildFlag struct {
+	PipelineName string
+	JobName      string
+	BuildName    string
+}
+
+func (build *JobBuildFlag) UnmarshalFlag(value string) error {
+	vs := strings.SplitN(value, "/", -1)
+
+	if len(vs) != 3 {
+		return errors.New("argument format should be <pipeline>/<job>/<build>")
+	}
+
+	if vs[0] == "" {
+		return concourse.NameRequiredError("pipeline")
+	}
+
+	if vs[1] == "" {
+		return concourse.NameRequiredError("job")
+	}
+
+	if vs[2] == "" {
+		return concourse.NameRequiredError("build")
+	}
+
+	build.PipelineName = vs[0]
+	build.JobName = vs[1]
+	build.BuildName = vs[2]
+
+	return nil
+}
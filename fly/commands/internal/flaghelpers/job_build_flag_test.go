@@ -0,0 +1,38 @@
+package flaghelpers_test
+
+import (
+	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JobBuildFlag", func() {
+	It("parses the pipeline, job and build names", func() {
+		buildFlag := &JobBuildFlag{}
+
+		err := buildFlag.UnmarshalFlag("some-pipeline/some-job/3")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(buildFlag.PipelineName).To(Equal("some-pipeline"))
+		Expect(buildFlag.JobName).To(Equal("some-job"))
+		Expect(buildFlag.BuildName).To(Equal("3"))
+	})
+
+	Context("when the build is not specified", func() {
+		It("displays an error message", func() {
+			buildFlag := &JobBuildFlag{}
+
+			err := buildFlag.UnmarshalFlag("some-pipeline/some-job")
+			Expect(err).To(MatchError("argument format should be <pipeline>/<job>/<build>"))
+		})
+	})
+
+	Context("when the build name is empty", func() {
+		It("displays an error message", func() {
+			buildFlag := &JobBuildFlag{}
+
+			err := buildFlag.UnmarshalFlag("some-pipeline/some-job/")
+			Expect(err).To(MatchError("build name required"))
+		})
+	})
+})
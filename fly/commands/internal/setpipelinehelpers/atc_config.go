@@ -156,6 +156,26 @@ func diff(existingConfig atc.Config, newConfig atc.Config) bool {
 		}
 	}
 
+	varSourceDiffs := diffIndices(VarSourceIndex(existingConfig.VarSources), VarSourceIndex(newConfig.VarSources))
+	if len(varSourceDiffs) > 0 {
+		diffExists = true
+		fmt.Println("var sources:")
+
+		for _, diff := range varSourceDiffs {
+			diff.Render(indent, "var source")
+		}
+	}
+
+	notificationDiffs := diffIndices(NotificationIndex(existingConfig.Notifications), NotificationIndex(newConfig.Notifications))
+	if len(notificationDiffs) > 0 {
+		diffExists = true
+		fmt.Println("notifications:")
+
+		for _, diff := range notificationDiffs {
+			diff.Render(indent, "notification")
+		}
+	}
+
 	jobDiffs := diffIndices(JobIndex(existingConfig.Jobs), JobIndex(newConfig.Jobs))
 	if len(jobDiffs) > 0 {
 		diffExists = true
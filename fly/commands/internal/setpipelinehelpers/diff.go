@@ -114,6 +114,36 @@ func (index ResourceTypeIndex) FindEquivalent(obj interface{}) (interface{}, boo
 	return atc.ResourceTypes(index).Lookup(name(obj))
 }
 
+type VarSourceIndex atc.VarSourceConfigs
+
+func (index VarSourceIndex) Slice() []interface{} {
+	slice := make([]interface{}, len(index))
+	for i, object := range index {
+		slice[i] = object
+	}
+
+	return slice
+}
+
+func (index VarSourceIndex) FindEquivalent(obj interface{}) (interface{}, bool) {
+	return atc.VarSourceConfigs(index).Lookup(name(obj))
+}
+
+type NotificationIndex atc.NotificationConfigs
+
+func (index NotificationIndex) Slice() []interface{} {
+	slice := make([]interface{}, len(index))
+	for i, object := range index {
+		slice[i] = object
+	}
+
+	return slice
+}
+
+func (index NotificationIndex) FindEquivalent(obj interface{}) (interface{}, bool) {
+	return atc.NotificationConfigs(index).Lookup(name(obj))
+}
+
 func groupDiffIndices(oldIndex GroupIndex, newIndex GroupIndex) Diffs {
 	diffs := Diffs{}
 
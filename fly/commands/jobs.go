@@ -1,7 +1,9 @@
 package commands
 
 import (
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
@@ -44,7 +46,7 @@ func (command *JobsCommand) Execute([]string) error {
 		return nil
 	}
 
-	headers = []string{"name", "paused", "status", "next"}
+	headers = []string{"name", "paused", "status", "next", "blocked"}
 	table := ui.Table{Headers: ui.TableRow{}}
 	for _, h := range headers {
 		table.Headers = append(table.Headers, ui.TableCell{Contents: h, Color: color.New(color.Bold)})
@@ -102,8 +104,33 @@ func (command *JobsCommand) Execute([]string) error {
 		}
 		row = append(row, nextColumn)
 
+		blockers := jobBlockers(p)
+		if len(blockers) > 0 {
+			row = append(row, ui.TableCell{Contents: strings.Join(blockers, "; "), Color: ui.PendingColor})
+		} else {
+			row = append(row, ui.TableCell{Contents: "n/a"})
+		}
+
 		table.Data = append(table.Data, row)
 	}
 
 	return table.Render(os.Stdout, Fly.PrintTableHeaders)
 }
+
+// jobBlockers describes what is preventing a new build of the job from
+// starting.
+func jobBlockers(job atc.Job) []string {
+	blockers := []string{}
+
+	if job.MaxInFlightReached {
+		blockers = append(blockers, "max in flight reached")
+	}
+
+	for _, input := range job.Inputs {
+		if input.ResolveError != "" {
+			blockers = append(blockers, fmt.Sprintf("%s: %s", input.Name, input.ResolveError))
+		}
+	}
+
+	return blockers
+}
@@ -0,0 +1,109 @@
+package commands
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type NotificationDeliveriesCommand struct {
+	Count    int                      `short:"c" long:"count" default:"50" description:"Number of deliveries you want to limit the return to"`
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Name of a pipeline to get the notification deliveries of"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *NotificationDeliveriesCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	deliveries, found, err := target.Team().NotificationDeliveries(string(command.Pipeline), command.Count)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("pipeline not found")
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(deliveries)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "notification", Color: color.New(color.Bold)},
+			{Contents: "event", Color: color.New(color.Bold)},
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "attempts", Color: color.New(color.Bold)},
+			{Contents: "last attempt", Color: color.New(color.Bold)},
+			{Contents: "response", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, delivery := range deliveries {
+		buildCell := ui.TableCell{Contents: delivery.BuildName}
+		if delivery.JobName != "" {
+			buildCell.Contents = delivery.JobName + "/" + delivery.BuildName
+		}
+
+		statusCell := ui.TableCell{Contents: string(delivery.Status)}
+		switch delivery.Status {
+		case atc.NotificationDeliveryPending:
+			statusCell.Color = ui.PendingColor
+		case atc.NotificationDeliveryDelivered:
+			statusCell.Color = ui.SucceededColor
+		case atc.NotificationDeliveryFailed:
+			statusCell.Color = ui.FailedColor
+		}
+
+		lastAttemptCell := ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+		if delivery.LastAttemptAt != 0 {
+			lastAttemptCell = ui.TableCell{Contents: time.Unix(delivery.LastAttemptAt, 0).Local().Format(timeDateLayout)}
+		}
+
+		var responseCell ui.TableCell
+		switch {
+		case delivery.Error != "":
+			responseCell.Contents = delivery.Error
+			responseCell.Color = ui.FailedColor
+		case delivery.ResponseStatus != 0:
+			responseCell.Contents = strconv.Itoa(delivery.ResponseStatus)
+		default:
+			responseCell.Contents = "none"
+			responseCell.Color = ui.OffColor
+		}
+
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: strconv.Itoa(delivery.ID)},
+			{Contents: delivery.Notification},
+			{Contents: string(delivery.Event)},
+			buildCell,
+			statusCell,
+			{Contents: strconv.Itoa(delivery.Attempts)},
+			lastAttemptCell,
+			responseCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
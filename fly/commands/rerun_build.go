@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/eventstream"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+)
+
+type RerunBuildCommand struct {
+	Job   flaghelpers.JobFlag `short:"j" long:"job"   required:"true" value-name:"PIPELINE/JOB" description:"Name of the job of the build to rerun"`
+	Build string              `short:"b" long:"build" required:"true" description:"Name of the build to rerun with the same inputs"`
+	Watch bool                `short:"w" long:"watch" description:"Start watching the build output"`
+}
+
+func (command *RerunBuildCommand) Execute(args []string) error {
+	pipelineName, jobName := command.Job.PipelineName, command.Job.JobName
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	build, err := target.Team().RerunJobBuild(pipelineName, jobName, command.Build)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("started %s/%s #%s (rerun of #%s)\n", pipelineName, jobName, build.Name, command.Build)
+
+	if command.Watch {
+		terminate := make(chan os.Signal, 1)
+
+		go func(terminate <-chan os.Signal) {
+			<-terminate
+			fmt.Fprintf(ui.Stderr, "\ndetached, build is still running...\n")
+			fmt.Fprintf(ui.Stderr, "re-attach to it with:\n\n")
+			fmt.Fprintf(ui.Stderr, "    %s", ui.Embolden("fly -t %s watch -j %s/%s -b %s\n\n", Fly.Target, pipelineName, jobName, build.Name))
+			os.Exit(2)
+		}(terminate)
+
+		signal.Notify(terminate, syscall.SIGINT, syscall.SIGTERM)
+
+		fmt.Println("")
+		eventSource, err := target.Client().BuildEvents(fmt.Sprintf("%d", build.ID))
+		if err != nil {
+			return err
+		}
+
+		renderOptions := eventstream.RenderOptions{}
+
+		exitCode := eventstream.Render(os.Stdout, eventSource, renderOptions)
+
+		eventSource.Close()
+
+		os.Exit(exitCode)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/concourse/concourse/go-concourse/concourse/eventstream"
@@ -55,6 +56,28 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 		case event.FinishTask:
 			exitStatus = e.ExitStatus
 
+		case event.WaitingForWorker:
+			pendingCol := ui.PendingColor.SprintFunc()
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "%s\n", pendingCol("waiting for a worker satisfying "+e.Requirements))
+
+		case event.WaitingForApproval:
+			pendingCol := ui.PendingColor.SprintFunc()
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "%s\n", pendingCol(fmt.Sprintf("waiting for approval '%s' by a team %s", e.Name, e.Role)))
+
+		case event.FinishApproval:
+			dstImpl.SetTimestamp(e.Time)
+
+			switch e.Status {
+			case atc.ApprovalStatusApproved:
+				fmt.Fprintf(dstImpl, "%s\n", ui.SucceededColor.SprintFunc()("approved by "+e.DecidedBy))
+			case atc.ApprovalStatusRejected:
+				fmt.Fprintf(dstImpl, "%s\n", ui.FailedColor.SprintFunc()("rejected by "+e.DecidedBy))
+			default:
+				fmt.Fprintf(dstImpl, "%s\n", ui.ErroredColor.SprintFunc()("approval "+string(e.Status)))
+			}
+
 		case event.Error:
 			errCol := ui.ErroredColor.SprintFunc()
 			dstImpl.SetTimestamp(0)
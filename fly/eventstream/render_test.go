@@ -97,6 +97,86 @@ var _ = Describe("V1.0 Renderer", func() {
 		})
 	})
 
+	Context("when a WaitingForWorker event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.WaitingForWorker{
+				Time:         time.Now().Unix(),
+				Requirements: "platform 'linux', tag 'gpu'",
+			}
+		})
+
+		It("prints the requirements nobody satisfies yet, followed by a linebreak", func() {
+			Expect(out.Contents()).To(ContainSubstring(ui.PendingColor.SprintFunc()("waiting for a worker satisfying platform 'linux', tag 'gpu'") + "\n"))
+		})
+
+		Context("and time configuration is enabled", func() {
+			BeforeEach(func() {
+				options.ShowTimestamp = true
+			})
+
+			It("timestamp is prefixed", func() {
+				Expect(out).To(gbytes.Say(`\d{2}\:\d{2}\:\d{2}\s{2}\w*`))
+			})
+		})
+	})
+
+	Context("when a WaitingForApproval event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.WaitingForApproval{
+				Time: time.Now().Unix(),
+				Name: "ship-it",
+				Role: "member",
+			}
+		})
+
+		It("prints the approval being waited for, followed by a linebreak", func() {
+			Expect(out.Contents()).To(ContainSubstring(ui.PendingColor.SprintFunc()("waiting for approval 'ship-it' by a team member") + "\n"))
+		})
+	})
+
+	Context("when a FinishApproval event is received", func() {
+		Context("when approved", func() {
+			BeforeEach(func() {
+				receivedEvents <- event.FinishApproval{
+					Time:      time.Now().Unix(),
+					Status:    atc.ApprovalStatusApproved,
+					DecidedBy: "some-user",
+				}
+			})
+
+			It("prints who approved it", func() {
+				Expect(out.Contents()).To(ContainSubstring(ui.SucceededColor.SprintFunc()("approved by some-user") + "\n"))
+			})
+		})
+
+		Context("when rejected", func() {
+			BeforeEach(func() {
+				receivedEvents <- event.FinishApproval{
+					Time:      time.Now().Unix(),
+					Status:    atc.ApprovalStatusRejected,
+					DecidedBy: "some-user",
+				}
+			})
+
+			It("prints who rejected it", func() {
+				Expect(out.Contents()).To(ContainSubstring(ui.FailedColor.SprintFunc()("rejected by some-user") + "\n"))
+			})
+		})
+
+		Context("when expired", func() {
+			BeforeEach(func() {
+				receivedEvents <- event.FinishApproval{
+					Time:   time.Now().Unix(),
+					Status: atc.ApprovalStatusExpired,
+				}
+			})
+
+			It("prints that it expired", func() {
+				Expect(out.Contents()).To(ContainSubstring(ui.ErroredColor.SprintFunc()("approval expired") + "\n"))
+			})
+		})
+	})
+
 	Context("when an InitializeTask event is received", func() {
 		BeforeEach(func() {
 			receivedEvents <- event.InitializeTask{
@@ -0,0 +1,135 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/tedsuo/rata"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("approve", func() {
+		var params rata.Params
+
+		BeforeEach(func() {
+			params = rata.Params{"pipeline_name": "awesome-pipeline", "job_name": "awesome-job", "build_name": "42", "team_name": "main"}
+		})
+
+		Context("when the build is waiting for approval", func() {
+			var path string
+
+			BeforeEach(func() {
+				var err error
+				path, err = atc.Routes.CreatePathForRoute(atc.ApproveJobBuild, params)
+				Expect(err).NotTo(HaveOccurred())
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", path),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.BuildApproval{
+							BuildID:   58,
+							Name:      "ship-it",
+							Role:      "member",
+							Status:    atc.ApprovalStatusApproved,
+							DecidedBy: "some-user",
+						}),
+					),
+				)
+			})
+
+			It("approves the build", func() {
+				Expect(func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "approve", "-j", "awesome-pipeline/awesome-job", "-b", "42")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gbytes.Say(`approved 'ship-it' of awesome-pipeline/awesome-job #42`))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+				}).To(Change(func() int {
+					return len(atcServer.ReceivedRequests())
+				}).By(2))
+			})
+		})
+
+		Context("when rejecting a named step", func() {
+			BeforeEach(func() {
+				path, err := atc.Routes.CreatePathForRoute(atc.RejectJobBuild, params)
+				Expect(err).NotTo(HaveOccurred())
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", path, "step=ship-it"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.BuildApproval{
+							BuildID: 58,
+							Name:    "ship-it",
+							Role:    "member",
+							Status:  atc.ApprovalStatusRejected,
+						}),
+					),
+				)
+			})
+
+			It("rejects the build", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "approve", "-j", "awesome-pipeline/awesome-job", "-b", "42", "--step", "ship-it", "--reject")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gbytes.Say(`rejected 'ship-it' of awesome-pipeline/awesome-job #42`))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+			})
+		})
+
+		Context("when the user does not have the required role", func() {
+			BeforeEach(func() {
+				path, err := atc.Routes.CreatePathForRoute(atc.ApproveJobBuild, params)
+				Expect(err).NotTo(HaveOccurred())
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", path),
+						ghttp.RespondWith(http.StatusForbidden, nil),
+					),
+				)
+			})
+
+			It("prints an error message", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "approve", "-j", "awesome-pipeline/awesome-job", "-b", "42")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say(`error: forbidden`))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+
+		Context("when the build is not specified", func() {
+			It("errors", func() {
+				reqsBefore := len(atcServer.ReceivedRequests())
+				flyCmd := exec.Command(flyPath, "-t", targetName, "approve", "-j", "awesome-pipeline/awesome-job")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(reqsBefore))
+			})
+		})
+	})
+})
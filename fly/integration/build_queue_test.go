@@ -0,0 +1,194 @@
+package integration_test
+
+import (
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("build-queue", func() {
+		var (
+			flyCmd    *exec.Cmd
+			queueTime time.Time
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "build-queue")
+			queueTime = time.Unix(1552924800, 0)
+		})
+
+		Context("when the queue is returned from the API", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/build-queue"),
+						ghttp.RespondWithJSONEncoded(200, atc.BuildQueue{
+							Teams: []atc.BuildQueueTeam{
+								{Name: "main", Active: 2, Queued: 1, Weight: 2},
+								{Name: "other-team", Active: 4, Queued: 1, Weight: 1, MaxInFlight: 4},
+							},
+							Builds: []atc.BuildQueueEntry{
+								{
+									Position:     1,
+									BuildID:      12,
+									BuildName:    "3",
+									TeamName:     "main",
+									PipelineName: "some-pipeline",
+									JobName:      "some-job",
+									Priority:     10,
+									QueuedAt:     queueTime.Unix(),
+								},
+								{
+									Position:     2,
+									BuildID:      13,
+									BuildName:    "7",
+									TeamName:     "other-team",
+									PipelineName: "other-pipeline",
+									JobName:      "other-job",
+									QueuedAt:     queueTime.Unix() - 60,
+									Blocked:      "team has reached its max in flight",
+								},
+							},
+						}),
+					),
+				)
+			})
+
+			It("lists the queued builds", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "position", Color: color.New(color.Bold)},
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "team", Color: color.New(color.Bold)},
+						{Contents: "pipeline/job", Color: color.New(color.Bold)},
+						{Contents: "build", Color: color.New(color.Bold)},
+						{Contents: "priority", Color: color.New(color.Bold)},
+						{Contents: "queued", Color: color.New(color.Bold)},
+						{Contents: "blocked", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: "1"},
+							{Contents: "12"},
+							{Contents: "main"},
+							{Contents: "some-pipeline/some-job"},
+							{Contents: "3"},
+							{Contents: "10"},
+							{Contents: queueTime.Local().Format("2006-01-02@15:04:05-0700")},
+							{Contents: "n/a", Color: ui.OffColor},
+						},
+						{
+							{Contents: "2"},
+							{Contents: "13"},
+							{Contents: "other-team"},
+							{Contents: "other-pipeline/other-job"},
+							{Contents: "7"},
+							{Contents: "0"},
+							{Contents: queueTime.Add(-time.Minute).Local().Format("2006-01-02@15:04:05-0700")},
+							{Contents: "team has reached its max in flight", Color: ui.PendingColor},
+						},
+					},
+				}))
+			})
+
+			Context("when --teams is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--teams")
+				})
+
+				It("lists the teams", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out).To(PrintTable(ui.Table{
+						Headers: ui.TableRow{
+							{Contents: "team", Color: color.New(color.Bold)},
+							{Contents: "running", Color: color.New(color.Bold)},
+							{Contents: "queued", Color: color.New(color.Bold)},
+							{Contents: "weight", Color: color.New(color.Bold)},
+							{Contents: "max in flight", Color: color.New(color.Bold)},
+						},
+						Data: []ui.TableRow{
+							{{Contents: "main"}, {Contents: "2"}, {Contents: "1"}, {Contents: "2"}, {Contents: "none", Color: ui.OffColor}},
+							{{Contents: "other-team"}, {Contents: "4"}, {Contents: "1"}, {Contents: "1"}, {Contents: "4"}},
+						},
+					}))
+				})
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints the queue as JSON", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`{
+						"teams": [
+							{"name": "main", "active": 2, "queued": 1, "weight": 2},
+							{"name": "other-team", "active": 4, "queued": 1, "weight": 1, "max_in_flight": 4}
+						],
+						"builds": [
+							{
+								"position": 1,
+								"build_id": 12,
+								"build_name": "3",
+								"team_name": "main",
+								"pipeline_name": "some-pipeline",
+								"job_name": "some-job",
+								"priority": 10,
+								"queued_at": 1552924800
+							},
+							{
+								"position": 2,
+								"build_id": 13,
+								"build_name": "7",
+								"team_name": "other-team",
+								"pipeline_name": "other-pipeline",
+								"job_name": "other-job",
+								"queued_at": 1552924740,
+								"blocked": "team has reached its max in flight"
+							}
+						]
+					}`))
+				})
+			})
+		})
+
+		Context("and the api returns an internal server error", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/build-queue"),
+						ghttp.RespondWith(500, ""),
+					),
+				)
+			})
+
+			It("writes an error message to stderr", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Eventually(sess.Err).Should(gbytes.Say("Unexpected Response"))
+			})
+		})
+	})
+})
@@ -0,0 +1,216 @@
+package integration_test
+
+import (
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("check-history", func() {
+		var (
+			flyCmd *exec.Cmd
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "check-history", "-r", "pipeline/foo")
+		})
+
+		Context("when the check history is returned from the API", func() {
+			var (
+				failedStartTime    time.Time
+				succeededStartTime time.Time
+			)
+
+			BeforeEach(func() {
+				failedStartTime = time.Unix(1500000100, 0)
+				succeededStartTime = time.Unix(1500000000, 0)
+
+				exitStatus := 1
+				successStatus := 0
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/resources/foo/check-history", "limit=50"),
+						ghttp.RespondWithJSONEncoded(200, []atc.ResourceCheckHistoryEntry{
+							{
+								ID:        3,
+								StartTime: failedStartTime.Unix() + 100,
+								EndTime:   failedStartTime.Unix() + 101,
+								Error:     "no workers",
+							},
+							{
+								ID:         2,
+								StartTime:  failedStartTime.Unix(),
+								EndTime:    failedStartTime.Unix() + 5,
+								WorkerName: "some-worker",
+								ExitStatus: &exitStatus,
+								Stderr:     "some-stderr",
+								Error:      "resource script failed",
+							},
+							{
+								ID:         1,
+								StartTime:  succeededStartTime.Unix(),
+								EndTime:    succeededStartTime.Unix() + 2,
+								WorkerName: "some-worker",
+								ExitStatus: &successStatus,
+								Versions:   []atc.Version{{"ref": "v1"}, {"ref": "v2"}},
+							},
+						}),
+					),
+				)
+			})
+
+			It("lists the checks", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "status", Color: color.New(color.Bold)},
+						{Contents: "start", Color: color.New(color.Bold)},
+						{Contents: "end", Color: color.New(color.Bold)},
+						{Contents: "duration", Color: color.New(color.Bold)},
+						{Contents: "worker", Color: color.New(color.Bold)},
+						{Contents: "versions", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: "3"},
+							{Contents: "errored", Color: ui.ErroredColor},
+							{Contents: failedStartTime.Add(100 * time.Second).Local().Format("2006-01-02@15:04:05-0700")},
+							{Contents: failedStartTime.Add(101 * time.Second).Local().Format("2006-01-02@15:04:05-0700")},
+							{Contents: "1s"},
+							{Contents: "none", Color: ui.OffColor},
+							{Contents: "0"},
+						},
+						{
+							{Contents: "2"},
+							{Contents: "failed (exit status 1)", Color: ui.FailedColor},
+							{Contents: failedStartTime.Local().Format("2006-01-02@15:04:05-0700")},
+							{Contents: failedStartTime.Add(5 * time.Second).Local().Format("2006-01-02@15:04:05-0700")},
+							{Contents: "5s"},
+							{Contents: "some-worker"},
+							{Contents: "0"},
+						},
+						{
+							{Contents: "1"},
+							{Contents: "succeeded", Color: ui.SucceededColor},
+							{Contents: succeededStartTime.Local().Format("2006-01-02@15:04:05-0700")},
+							{Contents: succeededStartTime.Add(2 * time.Second).Local().Format("2006-01-02@15:04:05-0700")},
+							{Contents: "2s"},
+							{Contents: "some-worker"},
+							{Contents: "2"},
+						},
+					},
+				}))
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints the checks including their stderr as JSON", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`[
+						{
+							"id": 3,
+							"start_time": 1500000200,
+							"end_time": 1500000201,
+							"error": "no workers"
+						},
+						{
+							"id": 2,
+							"start_time": 1500000100,
+							"end_time": 1500000105,
+							"worker_name": "some-worker",
+							"exit_status": 1,
+							"stderr": "some-stderr",
+							"error": "resource script failed"
+						},
+						{
+							"id": 1,
+							"start_time": 1500000000,
+							"end_time": 1500000002,
+							"worker_name": "some-worker",
+							"exit_status": 0,
+							"versions": [{"ref": "v1"}, {"ref": "v2"}]
+						}
+					]`))
+				})
+			})
+		})
+
+		Context("when a count is given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "-c", "2")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/resources/foo/check-history", "limit=2"),
+						ghttp.RespondWithJSONEncoded(200, []atc.ResourceCheckHistoryEntry{}),
+					),
+				)
+			})
+
+			It("asks for that many checks", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
+		Context("when the resource is not found", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/resources/foo/check-history"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("writes an error message to stderr", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Eventually(sess.Err).Should(gbytes.Say("pipeline/resource not found"))
+			})
+		})
+
+		Context("and the api returns an internal server error", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/resources/foo/check-history"),
+						ghttp.RespondWith(500, ""),
+					),
+				)
+			})
+
+			It("writes an error message to stderr", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Eventually(sess.Err).Should(gbytes.Say("Unexpected Response"))
+			})
+		})
+	})
+})
@@ -0,0 +1,162 @@
+package integration_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/tedsuo/rata"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("download-artifact", func() {
+		var (
+			tmpdir       string
+			buildPath    string
+			artifactPath string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpdir, err = ioutil.TempDir("", "fly-download-artifact")
+			Expect(err).NotTo(HaveOccurred())
+
+			buildPath, err = atc.Routes.CreatePathForRoute(atc.GetJobBuild, rata.Params{"pipeline_name": "awesome-pipeline", "job_name": "awesome-job", "build_name": "42", "team_name": "main"})
+			Expect(err).NotTo(HaveOccurred())
+
+			artifactPath, err = atc.Routes.CreatePathForRoute(atc.GetBuildArtifact, rata.Params{"build_id": "128", "artifact_name": "test-reports"})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpdir)).To(Succeed())
+		})
+
+		Context("when the build and artifact exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", buildPath),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 128, Name: "42"}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", artifactPath),
+						func(w http.ResponseWriter, req *http.Request) {
+							gw := gzip.NewWriter(w)
+							tw := tar.NewWriter(gw)
+
+							tarContents := []byte("tar-contents")
+
+							err := tw.WriteHeader(&tar.Header{
+								Name: "some-file",
+								Mode: 0644,
+								Size: int64(len(tarContents)),
+							})
+							Expect(err).NotTo(HaveOccurred())
+
+							_, err = tw.Write(tarContents)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(tw.Close()).To(Succeed())
+							Expect(gw.Close()).To(Succeed())
+						},
+					),
+				)
+			})
+
+			It("extracts the artifact into the given directory", func() {
+				dir := filepath.Join(tmpdir, "reports")
+
+				flyCmd := exec.Command(flyPath, "-t", targetName, "download-artifact", "-j", "awesome-pipeline/awesome-job", "-b", "42", "-o", "test-reports", "-d", dir)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gbytes.Say("downloaded artifact 'test-reports' to " + dir))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				contents, err := ioutil.ReadFile(filepath.Join(dir, "some-file"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("tar-contents"))
+			})
+
+			It("defaults to a directory named after the artifact", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "download-artifact", "-j", "awesome-pipeline/awesome-job", "-b", "42", "-o", "test-reports")
+				flyCmd.Dir = tmpdir
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				contents, err := ioutil.ReadFile(filepath.Join(tmpdir, "test-reports", "some-file"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("tar-contents"))
+			})
+		})
+
+		Context("when the artifact does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", buildPath),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 128, Name: "42"}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", artifactPath),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("prints an error message", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "download-artifact", "-j", "awesome-pipeline/awesome-job", "-b", "42", "-o", "test-reports", "-d", tmpdir)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say(`error: artifact 'test-reports' not found`))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+
+		Context("when the build does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", buildPath),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("prints an error message", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "download-artifact", "-j", "awesome-pipeline/awesome-job", "-b", "42", "-o", "test-reports", "-d", tmpdir)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say(`error: build not found`))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+	})
+})
@@ -54,6 +54,15 @@ var _ = Describe("Fly CLI", func() {
 					NextBuild:     nextBuild,
 				}
 			}
+			blockedJob := atc.Job{
+				Name:               "job-4",
+				MaxInFlightReached: true,
+				Inputs: []atc.JobInput{
+					{Name: "some-input", Resource: "some-resource"},
+					{Name: "other-input", Resource: "other-resource", ResolveError: "no version passed job 'job-1'"},
+				},
+			}
+
 			BeforeEach(func() {
 				pipelineName := "pipeline"
 				flyCmd = exec.Command(flyPath, "-t", targetName, "jobs", "--pipeline", pipelineName)
@@ -64,6 +73,7 @@ var _ = Describe("Fly CLI", func() {
 							createJob(1, false, "succeeded", "started"),
 							createJob(2, true, "failed", ""),
 							createJob(3, false, "", ""),
+							blockedJob,
 						}),
 					),
 				)
@@ -131,6 +141,21 @@ var _ = Describe("Fly CLI", func() {
                 "inputs": null,
                 "outputs": null,
                 "groups": null
+              },
+              {
+                "id": 0,
+                "name": "job-4",
+                "pipeline_name": "",
+                "team_name": "",
+                "next_build": null,
+                "finished_build": null,
+                "max_in_flight_reached": true,
+                "inputs": [
+                  {"name": "some-input", "resource": "some-resource", "trigger": false},
+                  {"name": "other-input", "resource": "other-resource", "trigger": false, "resolve_error": "no version passed job 'job-1'"}
+                ],
+                "outputs": null,
+                "groups": null
               }
             ]`))
 				})
@@ -143,9 +168,10 @@ var _ = Describe("Fly CLI", func() {
 
 				Expect(sess.Out).To(PrintTable(ui.Table{
 					Data: []ui.TableRow{
-						{{Contents: "job-1"}, {Contents: "no"}, {Contents: "succeeded"}, {Contents: "started"}},
-						{{Contents: "job-2"}, {Contents: "yes", Color: color.New(color.FgCyan)}, {Contents: "failed"}, {Contents: "n/a"}},
-						{{Contents: "job-3"}, {Contents: "no"}, {Contents: "n/a"}, {Contents: "n/a"}},
+						{{Contents: "job-1"}, {Contents: "no"}, {Contents: "succeeded"}, {Contents: "started"}, {Contents: "n/a"}},
+						{{Contents: "job-2"}, {Contents: "yes", Color: color.New(color.FgCyan)}, {Contents: "failed"}, {Contents: "n/a"}, {Contents: "n/a"}},
+						{{Contents: "job-3"}, {Contents: "no"}, {Contents: "n/a"}, {Contents: "n/a"}, {Contents: "n/a"}},
+						{{Contents: "job-4"}, {Contents: "no"}, {Contents: "n/a"}, {Contents: "n/a"}, {Contents: "max in flight reached; other-input: no version passed job 'job-1'"}},
 					},
 				}))
 			})
@@ -0,0 +1,223 @@
+package integration_test
+
+import (
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("notification-deliveries", func() {
+		var (
+			flyCmd *exec.Cmd
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "notification-deliveries", "-p", "pipeline")
+		})
+
+		Context("when the deliveries are returned from the API", func() {
+			var lastAttemptAt time.Time
+
+			BeforeEach(func() {
+				lastAttemptAt = time.Unix(1500000100, 0)
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/notification-deliveries", "limit=50"),
+						ghttp.RespondWithJSONEncoded(200, []atc.NotificationDelivery{
+							{
+								ID:           3,
+								Notification: "chat",
+								Event:        atc.NotificationEventFailed,
+								BuildID:      12,
+								BuildName:    "3",
+								JobName:      "some-job",
+								Status:       atc.NotificationDeliveryPending,
+								CreatedAt:    1500000200,
+							},
+							{
+								ID:             2,
+								Notification:   "chat",
+								Event:          atc.NotificationEventStarted,
+								BuildID:        12,
+								BuildName:      "3",
+								JobName:        "some-job",
+								Status:         atc.NotificationDeliveryFailed,
+								Attempts:       5,
+								ResponseStatus: 502,
+								Error:          "unexpected response: 502 Bad Gateway",
+								CreatedAt:      1500000000,
+								LastAttemptAt:  lastAttemptAt.Unix(),
+							},
+							{
+								ID:             1,
+								Notification:   "audit",
+								Event:          atc.NotificationEventStarted,
+								BuildID:        12,
+								BuildName:      "3",
+								JobName:        "some-job",
+								Status:         atc.NotificationDeliveryDelivered,
+								Attempts:       1,
+								ResponseStatus: 204,
+								CreatedAt:      1500000000,
+								LastAttemptAt:  lastAttemptAt.Unix(),
+							},
+						}),
+					),
+				)
+			})
+
+			It("lists the deliveries", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "notification", Color: color.New(color.Bold)},
+						{Contents: "event", Color: color.New(color.Bold)},
+						{Contents: "build", Color: color.New(color.Bold)},
+						{Contents: "status", Color: color.New(color.Bold)},
+						{Contents: "attempts", Color: color.New(color.Bold)},
+						{Contents: "last attempt", Color: color.New(color.Bold)},
+						{Contents: "response", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: "3"},
+							{Contents: "chat"},
+							{Contents: "failed"},
+							{Contents: "some-job/3"},
+							{Contents: "pending", Color: ui.PendingColor},
+							{Contents: "0"},
+							{Contents: "n/a", Color: ui.OffColor},
+							{Contents: "none", Color: ui.OffColor},
+						},
+						{
+							{Contents: "2"},
+							{Contents: "chat"},
+							{Contents: "started"},
+							{Contents: "some-job/3"},
+							{Contents: "failed", Color: ui.FailedColor},
+							{Contents: "5"},
+							{Contents: lastAttemptAt.Local().Format("2006-01-02@15:04:05-0700")},
+							{Contents: "unexpected response: 502 Bad Gateway", Color: ui.FailedColor},
+						},
+						{
+							{Contents: "1"},
+							{Contents: "audit"},
+							{Contents: "started"},
+							{Contents: "some-job/3"},
+							{Contents: "delivered", Color: ui.SucceededColor},
+							{Contents: "1"},
+							{Contents: lastAttemptAt.Local().Format("2006-01-02@15:04:05-0700")},
+							{Contents: "204"},
+						},
+					},
+				}))
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints the deliveries as JSON", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`[
+						{
+							"id": 3,
+							"notification": "chat",
+							"event": "failed",
+							"build_id": 12,
+							"build_name": "3",
+							"job_name": "some-job",
+							"status": "pending",
+							"attempts": 0,
+							"created_at": 1500000200
+						},
+						{
+							"id": 2,
+							"notification": "chat",
+							"event": "started",
+							"build_id": 12,
+							"build_name": "3",
+							"job_name": "some-job",
+							"status": "failed",
+							"attempts": 5,
+							"response_status": 502,
+							"error": "unexpected response: 502 Bad Gateway",
+							"created_at": 1500000000,
+							"last_attempt_at": 1500000100
+						},
+						{
+							"id": 1,
+							"notification": "audit",
+							"event": "started",
+							"build_id": 12,
+							"build_name": "3",
+							"job_name": "some-job",
+							"status": "delivered",
+							"attempts": 1,
+							"response_status": 204,
+							"created_at": 1500000000,
+							"last_attempt_at": 1500000100
+						}
+					]`))
+				})
+			})
+		})
+
+		Context("when a count is given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "-c", "2")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/notification-deliveries", "limit=2"),
+						ghttp.RespondWithJSONEncoded(200, []atc.NotificationDelivery{}),
+					),
+				)
+			})
+
+			It("asks for that many deliveries", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
+		Context("when the pipeline is not found", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/notification-deliveries"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("writes an error message to stderr", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Eventually(sess.Err).Should(gbytes.Say("pipeline not found"))
+			})
+		})
+	})
+})
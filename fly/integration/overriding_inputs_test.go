@@ -150,6 +150,31 @@ run:
 				}),
 			),
 		)
+		atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/builds/3/inputs",
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/builds/3/inputs"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.BuildInput{
+					{
+						Name:     "some-input",
+						Type:     "git",
+						Resource: "some-resource",
+						Source:   atc.Source{"uri": "https://internet.com"},
+						Params:   atc.Params{"some": "params"},
+						Version:  atc.Version{"some": "version"},
+						Tags:     atc.Tags{"tag-1", "tag-2"},
+					},
+					{
+						Name:     "some-other-input",
+						Type:     "git",
+						Resource: "some-other-resource",
+						Source:   atc.Source{"uri": "https://example.com"},
+						Params:   atc.Params{"some": "other-params"},
+						Version:  atc.Version{"some": "other-older-version"},
+						Tags:     atc.Tags{"tag-1", "tag-2"},
+					},
+				}),
+			),
+		)
 		atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/resource-types",
 			ghttp.CombineHandlers(
 				ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/resource-types"),
@@ -261,4 +286,70 @@ run:
 		<-sess.Exited
 		Expect(sess).To(gexec.Exit(0))
 	})
+
+	Context("when basing inputs on a build of a job", func() {
+		BeforeEach(func() {
+			planFactory := atc.NewPlanFactory(0)
+
+			expectedPlan = planFactory.NewPlan(atc.DoPlan{
+				planFactory.NewPlan(atc.AggregatePlan{
+					planFactory.NewPlan(atc.UserArtifactPlan{
+						Name: "some-input",
+					}),
+					planFactory.NewPlan(atc.GetPlan{
+						Name:    "some-other-input",
+						Type:    "git",
+						Source:  atc.Source{"uri": "https://example.com"},
+						Params:  atc.Params{"some": "other-params"},
+						Version: &atc.Version{"some": "other-older-version"},
+						Tags:    atc.Tags{"tag-1", "tag-2"},
+					}),
+				}),
+				planFactory.NewPlan(atc.TaskPlan{
+					Name:   "one-off",
+					Config: (*expectedPlan.Do)[1].Task.Config,
+				}),
+			})
+		})
+
+		It("uses the exact versions the build used", func() {
+			flyCmd := exec.Command(
+				flyPath, "-t", targetName, "e",
+				"--inputs-from-build", "some-pipeline/some-job/3",
+				"--input", fmt.Sprintf("some-input=%s", buildDir),
+				"--config", filepath.Join(buildDir, "task.yml"),
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(streaming).Should(BeClosed())
+			Eventually(uploading).Should(BeClosed())
+
+			events <- event.Log{Payload: "sup"}
+			close(events)
+
+			Eventually(sess.Out).Should(gbytes.Say("sup"))
+
+			<-sess.Exited
+			Expect(sess).To(gexec.Exit(0))
+		})
+
+		It("cannot be combined with --inputs-from", func() {
+			flyCmd := exec.Command(
+				flyPath, "-t", targetName, "e",
+				"--inputs-from", "some-pipeline/some-job",
+				"--inputs-from-build", "some-pipeline/some-job/3",
+				"--config", filepath.Join(buildDir, "task.yml"),
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess.Err).Should(gbytes.Say("--inputs-from and --inputs-from-build cannot be used together"))
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+		})
+	})
 })
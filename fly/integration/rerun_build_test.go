@@ -0,0 +1,94 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/tedsuo/rata"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("rerun-build", func() {
+		Context("when the pipeline, job and build are specified", func() {
+			var (
+				path string
+				err  error
+			)
+			BeforeEach(func() {
+				path, err = atc.Routes.CreatePathForRoute(atc.RerunJobBuild, rata.Params{"pipeline_name": "awesome-pipeline", "job_name": "awesome-job", "build_name": "42", "team_name": "main"})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("when the build exists", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("POST", path),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 58, Name: "43", RerunOf: 57, RerunOfName: "42"}),
+						),
+					)
+				})
+
+				It("starts the rerun", func() {
+					Expect(func() {
+						flyCmd := exec.Command(flyPath, "-t", targetName, "rerun-build", "-j", "awesome-pipeline/awesome-job", "-b", "42")
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						Eventually(sess).Should(gbytes.Say(`started awesome-pipeline/awesome-job #43 \(rerun of #42\)`))
+
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(0))
+					}).To(Change(func() int {
+						return len(atcServer.ReceivedRequests())
+					}).By(2))
+				})
+			})
+
+			Context("when the build doesn't exist", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("POST", path),
+							ghttp.RespondWith(http.StatusNotFound, nil),
+						),
+					)
+				})
+
+				It("prints an error message", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "rerun-build", "-j", "awesome-pipeline/awesome-job", "-b", "42")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say(`error: resource not found`))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when the build is not specified", func() {
+			It("errors", func() {
+				reqsBefore := len(atcServer.ReceivedRequests())
+				flyCmd := exec.Command(flyPath, "-t", targetName, "rerun-build", "-j", "awesome-pipeline/awesome-job")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(reqsBefore))
+			})
+		})
+	})
+})
@@ -0,0 +1,56 @@
+package concourse
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (client *client) ListBuildArtifacts(buildID int) ([]atc.BuildArtifact, bool, error) {
+	params := rata.Params{
+		"build_id": strconv.Itoa(buildID),
+	}
+
+	var artifacts []atc.BuildArtifact
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListBuildArtifacts,
+		Params:      params,
+	}, &internal.Response{
+		Result: &artifacts,
+	})
+
+	switch err.(type) {
+	case nil:
+		return artifacts, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
+
+func (client *client) GetBuildArtifact(buildID int, name string) (io.ReadCloser, bool, error) {
+	params := rata.Params{
+		"build_id":      strconv.Itoa(buildID),
+		"artifact_name": name,
+	}
+
+	response := internal.Response{}
+	err := client.connection.Send(internal.Request{
+		RequestName:        atc.GetBuildArtifact,
+		Params:             params,
+		ReturnResponseBody: true,
+	}, &response)
+
+	switch err.(type) {
+	case nil:
+		return response.Result.(io.ReadCloser), true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
@@ -0,0 +1,102 @@
+package concourse_test
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Build Artifacts", func() {
+	Describe("ListBuildArtifacts", func() {
+		expectedURL := "/api/v1/builds/6/artifacts"
+
+		Context("when the build exists", func() {
+			expectedArtifacts := []atc.BuildArtifact{
+				{BuildID: 6, Name: "some-output", CreatedAt: 1},
+				{BuildID: 6, Name: "other-output", CreatedAt: 2},
+			}
+
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedArtifacts),
+					),
+				)
+			})
+
+			It("returns the build's artifacts", func() {
+				artifacts, found, err := client.ListBuildArtifacts(6)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(artifacts).To(Equal(expectedArtifacts))
+			})
+		})
+
+		Context("when the build does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := client.ListBuildArtifacts(6)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("GetBuildArtifact", func() {
+		expectedURL := "/api/v1/builds/6/artifacts/some-output"
+
+		Context("when the artifact exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusOK, "some-tarball"),
+					),
+				)
+			})
+
+			It("returns the artifact's contents", func() {
+				artifact, found, err := client.GetBuildArtifact(6, "some-output")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				defer artifact.Close()
+
+				contents, err := ioutil.ReadAll(artifact)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-tarball"))
+			})
+		})
+
+		Context("when the artifact does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := client.GetBuildArtifact(6, "some-output")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -33,6 +33,32 @@ func (team *team) BuildInputsForJob(pipelineName string, jobName string) ([]atc.
 	}
 }
 
+func (team *team) BuildInputsForJobBuild(pipelineName string, jobName string, buildName string) ([]atc.BuildInput, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"job_name":      jobName,
+		"build_name":    buildName,
+		"team_name":     team.name,
+	}
+
+	var buildInputs []atc.BuildInput
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListJobBuildInputs,
+		Params:      params,
+	}, &internal.Response{
+		Result: &buildInputs,
+	})
+
+	switch err.(type) {
+	case nil:
+		return buildInputs, true, nil
+	case internal.ResourceNotFoundError:
+		return buildInputs, false, nil
+	default:
+		return buildInputs, false, err
+	}
+}
+
 func (team *team) BuildsWithVersionAsInput(pipelineName string, resourceName string, resourceVersionID int) ([]atc.Build, bool, error) {
 	params := rata.Params{
 		"pipeline_name":              pipelineName,
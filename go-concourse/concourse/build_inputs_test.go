@@ -63,6 +63,55 @@ var _ = Describe("ATC Handler Build Inputs", func() {
 		})
 	})
 
+	Describe("BuildInputsForJobBuild", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/builds/3/inputs"
+
+		Context("when pipeline/job/build exists", func() {
+			var expectedBuildInputs []atc.BuildInput
+
+			BeforeEach(func() {
+				expectedBuildInputs = []atc.BuildInput{
+					{
+						Name:     "myfirstinput",
+						Resource: "myfirstinput",
+						Version:  atc.Version{"ref": "abc"},
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuildInputs),
+					),
+				)
+			})
+
+			It("returns the inputs the build used", func() {
+				buildInputs, found, err := team.BuildInputsForJobBuild("mypipeline", "myjob", "3")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(buildInputs).To(Equal(expectedBuildInputs))
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when pipeline/job/build does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false in the found value and no error", func() {
+				_, found, err := team.BuildInputsForJobBuild("mypipeline", "myjob", "3")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("BuildsWithVersionAsInput", func() {
 		expectedURL := "/api/v1/teams/some-team/pipelines/some-pipeline/resources/myresource/versions/2/input_to"
 
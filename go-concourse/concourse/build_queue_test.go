@@ -0,0 +1,41 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Build Queue", func() {
+	Describe("BuildQueue", func() {
+		var expectedQueue atc.BuildQueue
+
+		BeforeEach(func() {
+			expectedQueue = atc.BuildQueue{
+				Teams: []atc.BuildQueueTeam{
+					{Name: "some-team", Active: 2, Queued: 1, Weight: 1},
+				},
+				Builds: []atc.BuildQueueEntry{
+					{Position: 1, BuildID: 3, BuildName: "1", TeamName: "some-team", PipelineName: "some-pipeline", JobName: "some-job", QueuedAt: 1552924800},
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/build-queue"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedQueue),
+				),
+			)
+		})
+
+		It("returns the build queue", func() {
+			queue, err := client.BuildQueue()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(queue).To(Equal(expectedQueue))
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
@@ -53,6 +54,58 @@ func (team *team) CreateJobBuild(pipelineName string, jobName string) (atc.Build
 	return build, err
 }
 
+func (team *team) RerunJobBuild(pipelineName string, jobName string, buildName string) (atc.Build, error) {
+	params := rata.Params{
+		"build_name":    buildName,
+		"job_name":      jobName,
+		"pipeline_name": pipelineName,
+		"team_name":     team.name,
+	}
+
+	var build atc.Build
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.RerunJobBuild,
+		Params:      params,
+	}, &internal.Response{
+		Result: &build,
+	})
+
+	return build, err
+}
+
+func (team *team) ApproveJobBuild(pipelineName string, jobName string, buildName string, stepName string) (atc.BuildApproval, error) {
+	return team.decideJobBuildApproval(atc.ApproveJobBuild, pipelineName, jobName, buildName, stepName)
+}
+
+func (team *team) RejectJobBuild(pipelineName string, jobName string, buildName string, stepName string) (atc.BuildApproval, error) {
+	return team.decideJobBuildApproval(atc.RejectJobBuild, pipelineName, jobName, buildName, stepName)
+}
+
+func (team *team) decideJobBuildApproval(requestName string, pipelineName string, jobName string, buildName string, stepName string) (atc.BuildApproval, error) {
+	params := rata.Params{
+		"build_name":    buildName,
+		"job_name":      jobName,
+		"pipeline_name": pipelineName,
+		"team_name":     team.name,
+	}
+
+	query := url.Values{}
+	if stepName != "" {
+		query.Set("step", stepName)
+	}
+
+	var approval atc.BuildApproval
+	err := team.connection.Send(internal.Request{
+		RequestName: requestName,
+		Params:      params,
+		Query:       query,
+	}, &internal.Response{
+		Result: &approval,
+	})
+
+	return approval, err
+}
+
 func (team *team) JobBuild(pipelineName, jobName, buildName string) (atc.Build, bool, error) {
 	if pipelineName == "" {
 		return atc.Build{}, false, NameRequiredError("pipeline")
@@ -96,6 +96,109 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 	})
 
+	Describe("RerunJobBuild", func() {
+		var expectedBuild atc.Build
+
+		BeforeEach(func() {
+			expectedBuild = atc.Build{
+				ID:          124,
+				Name:        "mynewbuild",
+				Status:      "pending",
+				JobName:     "myjob",
+				APIURL:      "api/v1/builds/124",
+				RerunOf:     123,
+				RerunOfName: "mybuild",
+			}
+			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/builds/mybuild"
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+			)
+		})
+
+		It("takes a pipeline, a job and a build and reruns the build", func() {
+			build, err := team.RerunJobBuild("mypipeline", "myjob", "mybuild")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build).To(Equal(expectedBuild))
+		})
+	})
+
+	Describe("ApproveJobBuild", func() {
+		var expectedApproval atc.BuildApproval
+		var expectedQuery string
+
+		BeforeEach(func() {
+			expectedQuery = ""
+			expectedApproval = atc.BuildApproval{
+				BuildID:   124,
+				Name:      "ship-it",
+				Role:      "member",
+				Status:    atc.ApprovalStatusApproved,
+				DecidedBy: "some-user",
+			}
+		})
+
+		JustBeforeEach(func() {
+			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/builds/mybuild/approve"
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedURL, expectedQuery),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedApproval),
+				),
+			)
+		})
+
+		It("approves the build's waiting approval", func() {
+			approval, err := team.ApproveJobBuild("mypipeline", "myjob", "mybuild", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(approval).To(Equal(expectedApproval))
+		})
+
+		Context("when the step is given", func() {
+			BeforeEach(func() {
+				expectedQuery = "step=ship-it"
+			})
+
+			It("approves the named approval", func() {
+				approval, err := team.ApproveJobBuild("mypipeline", "myjob", "mybuild", "ship-it")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(approval).To(Equal(expectedApproval))
+			})
+		})
+	})
+
+	Describe("RejectJobBuild", func() {
+		var expectedApproval atc.BuildApproval
+
+		BeforeEach(func() {
+			expectedApproval = atc.BuildApproval{
+				BuildID:   124,
+				Name:      "ship-it",
+				Role:      "member",
+				Status:    atc.ApprovalStatusRejected,
+				DecidedBy: "some-user",
+			}
+			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/builds/mybuild/reject"
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedURL, "step=ship-it"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedApproval),
+				),
+			)
+		})
+
+		It("rejects the named approval", func() {
+			approval, err := team.RejectJobBuild("mypipeline", "myjob", "mybuild", "ship-it")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(approval).To(Equal(expectedApproval))
+		})
+	})
+
 	Describe("JobBuild", func() {
 		var (
 			expectedBuild atc.Build
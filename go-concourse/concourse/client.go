@@ -18,10 +18,13 @@ type Client interface {
 	Build(buildID string) (atc.Build, bool, error)
 	BuildEvents(buildID string) (Events, error)
 	BuildResources(buildID int) (atc.BuildInputsOutputs, bool, error)
+	ListBuildArtifacts(buildID int) ([]atc.BuildArtifact, bool, error)
+	GetBuildArtifact(buildID int, name string) (io.ReadCloser, bool, error)
 	AbortBuild(buildID string) error
 	BuildPlan(buildID int) (atc.PublicBuildPlan, bool, error)
 	SendInputToBuildPlan(buildID int, planID atc.PlanID, src io.Reader) (bool, error)
 	ReadOutputFromBuildPlan(buildID int, planID atc.PlanID) (io.ReadCloser, bool, error)
+	BuildQueue() (atc.BuildQueue, error)
 	SaveWorker(atc.Worker, *time.Duration) (*atc.Worker, error)
 	ListWorkers() ([]atc.Worker, error)
 	PruneWorker(workerName string) error
@@ -66,6 +66,18 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	BuildQueueStub        func() (atc.BuildQueue, error)
+	buildQueueMutex       sync.RWMutex
+	buildQueueArgsForCall []struct {
+	}
+	buildQueueReturns struct {
+		result1 atc.BuildQueue
+		result2 error
+	}
+	buildQueueReturnsOnCall map[int]struct {
+		result1 atc.BuildQueue
+		result2 error
+	}
 	BuildResourcesStub        func(int) (atc.BuildInputsOutputs, bool, error)
 	buildResourcesMutex       sync.RWMutex
 	buildResourcesArgsForCall []struct {
@@ -96,6 +108,22 @@ type FakeClient struct {
 		result2 concourse.Pagination
 		result3 error
 	}
+	GetBuildArtifactStub        func(int, string) (io.ReadCloser, bool, error)
+	getBuildArtifactMutex       sync.RWMutex
+	getBuildArtifactArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	getBuildArtifactReturns struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
+	getBuildArtifactReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
 	GetCLIReaderStub        func(string, string) (io.ReadCloser, http.Header, error)
 	getCLIReaderMutex       sync.RWMutex
 	getCLIReaderArgsForCall []struct {
@@ -145,6 +173,21 @@ type FakeClient struct {
 	landWorkerReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ListBuildArtifactsStub        func(int) ([]atc.BuildArtifact, bool, error)
+	listBuildArtifactsMutex       sync.RWMutex
+	listBuildArtifactsArgsForCall []struct {
+		arg1 int
+	}
+	listBuildArtifactsReturns struct {
+		result1 []atc.BuildArtifact
+		result2 bool
+		result3 error
+	}
+	listBuildArtifactsReturnsOnCall map[int]struct {
+		result1 []atc.BuildArtifact
+		result2 bool
+		result3 error
+	}
 	ListPipelinesStub        func() ([]atc.Pipeline, error)
 	listPipelinesMutex       sync.RWMutex
 	listPipelinesArgsForCall []struct {
@@ -529,6 +572,61 @@ func (fake *FakeClient) BuildPlanReturnsOnCall(i int, result1 atc.PublicBuildPla
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) BuildQueue() (atc.BuildQueue, error) {
+	fake.buildQueueMutex.Lock()
+	ret, specificReturn := fake.buildQueueReturnsOnCall[len(fake.buildQueueArgsForCall)]
+	fake.buildQueueArgsForCall = append(fake.buildQueueArgsForCall, struct {
+	}{})
+	fake.recordInvocation("BuildQueue", []interface{}{})
+	fake.buildQueueMutex.Unlock()
+	if fake.BuildQueueStub != nil {
+		return fake.BuildQueueStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.buildQueueReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) BuildQueueCallCount() int {
+	fake.buildQueueMutex.RLock()
+	defer fake.buildQueueMutex.RUnlock()
+	return len(fake.buildQueueArgsForCall)
+}
+
+func (fake *FakeClient) BuildQueueCalls(stub func() (atc.BuildQueue, error)) {
+	fake.buildQueueMutex.Lock()
+	defer fake.buildQueueMutex.Unlock()
+	fake.BuildQueueStub = stub
+}
+
+func (fake *FakeClient) BuildQueueReturns(result1 atc.BuildQueue, result2 error) {
+	fake.buildQueueMutex.Lock()
+	defer fake.buildQueueMutex.Unlock()
+	fake.BuildQueueStub = nil
+	fake.buildQueueReturns = struct {
+		result1 atc.BuildQueue
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) BuildQueueReturnsOnCall(i int, result1 atc.BuildQueue, result2 error) {
+	fake.buildQueueMutex.Lock()
+	defer fake.buildQueueMutex.Unlock()
+	fake.BuildQueueStub = nil
+	if fake.buildQueueReturnsOnCall == nil {
+		fake.buildQueueReturnsOnCall = make(map[int]struct {
+			result1 atc.BuildQueue
+			result2 error
+		})
+	}
+	fake.buildQueueReturnsOnCall[i] = struct {
+		result1 atc.BuildQueue
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) BuildResources(arg1 int) (atc.BuildInputsOutputs, bool, error) {
 	fake.buildResourcesMutex.Lock()
 	ret, specificReturn := fake.buildResourcesReturnsOnCall[len(fake.buildResourcesArgsForCall)]
@@ -661,6 +759,73 @@ func (fake *FakeClient) BuildsReturnsOnCall(i int, result1 []atc.Build, result2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) GetBuildArtifact(arg1 int, arg2 string) (io.ReadCloser, bool, error) {
+	fake.getBuildArtifactMutex.Lock()
+	ret, specificReturn := fake.getBuildArtifactReturnsOnCall[len(fake.getBuildArtifactArgsForCall)]
+	fake.getBuildArtifactArgsForCall = append(fake.getBuildArtifactArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetBuildArtifact", []interface{}{arg1, arg2})
+	fake.getBuildArtifactMutex.Unlock()
+	if fake.GetBuildArtifactStub != nil {
+		return fake.GetBuildArtifactStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getBuildArtifactReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) GetBuildArtifactCallCount() int {
+	fake.getBuildArtifactMutex.RLock()
+	defer fake.getBuildArtifactMutex.RUnlock()
+	return len(fake.getBuildArtifactArgsForCall)
+}
+
+func (fake *FakeClient) GetBuildArtifactCalls(stub func(int, string) (io.ReadCloser, bool, error)) {
+	fake.getBuildArtifactMutex.Lock()
+	defer fake.getBuildArtifactMutex.Unlock()
+	fake.GetBuildArtifactStub = stub
+}
+
+func (fake *FakeClient) GetBuildArtifactArgsForCall(i int) (int, string) {
+	fake.getBuildArtifactMutex.RLock()
+	defer fake.getBuildArtifactMutex.RUnlock()
+	argsForCall := fake.getBuildArtifactArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) GetBuildArtifactReturns(result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.getBuildArtifactMutex.Lock()
+	defer fake.getBuildArtifactMutex.Unlock()
+	fake.GetBuildArtifactStub = nil
+	fake.getBuildArtifactReturns = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) GetBuildArtifactReturnsOnCall(i int, result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.getBuildArtifactMutex.Lock()
+	defer fake.getBuildArtifactMutex.Unlock()
+	fake.GetBuildArtifactStub = nil
+	if fake.getBuildArtifactReturnsOnCall == nil {
+		fake.getBuildArtifactReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 bool
+			result3 error
+		})
+	}
+	fake.getBuildArtifactReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) GetCLIReader(arg1 string, arg2 string) (io.ReadCloser, http.Header, error) {
 	fake.getCLIReaderMutex.Lock()
 	ret, specificReturn := fake.getCLIReaderReturnsOnCall[len(fake.getCLIReaderArgsForCall)]
@@ -895,6 +1060,72 @@ func (fake *FakeClient) LandWorkerReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) ListBuildArtifacts(arg1 int) ([]atc.BuildArtifact, bool, error) {
+	fake.listBuildArtifactsMutex.Lock()
+	ret, specificReturn := fake.listBuildArtifactsReturnsOnCall[len(fake.listBuildArtifactsArgsForCall)]
+	fake.listBuildArtifactsArgsForCall = append(fake.listBuildArtifactsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListBuildArtifacts", []interface{}{arg1})
+	fake.listBuildArtifactsMutex.Unlock()
+	if fake.ListBuildArtifactsStub != nil {
+		return fake.ListBuildArtifactsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listBuildArtifactsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) ListBuildArtifactsCallCount() int {
+	fake.listBuildArtifactsMutex.RLock()
+	defer fake.listBuildArtifactsMutex.RUnlock()
+	return len(fake.listBuildArtifactsArgsForCall)
+}
+
+func (fake *FakeClient) ListBuildArtifactsCalls(stub func(int) ([]atc.BuildArtifact, bool, error)) {
+	fake.listBuildArtifactsMutex.Lock()
+	defer fake.listBuildArtifactsMutex.Unlock()
+	fake.ListBuildArtifactsStub = stub
+}
+
+func (fake *FakeClient) ListBuildArtifactsArgsForCall(i int) int {
+	fake.listBuildArtifactsMutex.RLock()
+	defer fake.listBuildArtifactsMutex.RUnlock()
+	argsForCall := fake.listBuildArtifactsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) ListBuildArtifactsReturns(result1 []atc.BuildArtifact, result2 bool, result3 error) {
+	fake.listBuildArtifactsMutex.Lock()
+	defer fake.listBuildArtifactsMutex.Unlock()
+	fake.ListBuildArtifactsStub = nil
+	fake.listBuildArtifactsReturns = struct {
+		result1 []atc.BuildArtifact
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) ListBuildArtifactsReturnsOnCall(i int, result1 []atc.BuildArtifact, result2 bool, result3 error) {
+	fake.listBuildArtifactsMutex.Lock()
+	defer fake.listBuildArtifactsMutex.Unlock()
+	fake.ListBuildArtifactsStub = nil
+	if fake.listBuildArtifactsReturnsOnCall == nil {
+		fake.listBuildArtifactsReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildArtifact
+			result2 bool
+			result3 error
+		})
+	}
+	fake.listBuildArtifactsReturnsOnCall[i] = struct {
+		result1 []atc.BuildArtifact
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) ListPipelines() ([]atc.Pipeline, error) {
 	fake.listPipelinesMutex.Lock()
 	ret, specificReturn := fake.listPipelinesReturnsOnCall[len(fake.listPipelinesArgsForCall)]
@@ -1494,10 +1725,14 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.buildEventsMutex.RUnlock()
 	fake.buildPlanMutex.RLock()
 	defer fake.buildPlanMutex.RUnlock()
+	fake.buildQueueMutex.RLock()
+	defer fake.buildQueueMutex.RUnlock()
 	fake.buildResourcesMutex.RLock()
 	defer fake.buildResourcesMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
+	fake.getBuildArtifactMutex.RLock()
+	defer fake.getBuildArtifactMutex.RUnlock()
 	fake.getCLIReaderMutex.RLock()
 	defer fake.getCLIReaderMutex.RUnlock()
 	fake.getInfoMutex.RLock()
@@ -1506,6 +1741,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.hTTPClientMutex.RUnlock()
 	fake.landWorkerMutex.RLock()
 	defer fake.landWorkerMutex.RUnlock()
+	fake.listBuildArtifactsMutex.RLock()
+	defer fake.listBuildArtifactsMutex.RUnlock()
 	fake.listPipelinesMutex.RLock()
 	defer fake.listPipelinesMutex.RUnlock()
 	fake.listTeamsMutex.RLock()
@@ -9,6 +9,22 @@ import (
 )
 
 type FakeTeam struct {
+	ApproveJobBuildStub        func(string, string, string, string) (atc.BuildApproval, error)
+	approveJobBuildMutex       sync.RWMutex
+	approveJobBuildArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	approveJobBuildReturns struct {
+		result1 atc.BuildApproval
+		result2 error
+	}
+	approveJobBuildReturnsOnCall map[int]struct {
+		result1 atc.BuildApproval
+		result2 error
+	}
 	BuildInputsForJobStub        func(string, string) ([]atc.BuildInput, bool, error)
 	buildInputsForJobMutex       sync.RWMutex
 	buildInputsForJobArgsForCall []struct {
@@ -25,6 +41,23 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	BuildInputsForJobBuildStub        func(string, string, string) ([]atc.BuildInput, bool, error)
+	buildInputsForJobBuildMutex       sync.RWMutex
+	buildInputsForJobBuildArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	buildInputsForJobBuildReturns struct {
+		result1 []atc.BuildInput
+		result2 bool
+		result3 error
+	}
+	buildInputsForJobBuildReturnsOnCall map[int]struct {
+		result1 []atc.BuildInput
+		result2 bool
+		result3 error
+	}
 	BuildsStub        func(concourse.Page) ([]atc.Build, concourse.Pagination, error)
 	buildsMutex       sync.RWMutex
 	buildsArgsForCall []struct {
@@ -403,6 +436,22 @@ type FakeTeam struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	NotificationDeliveriesStub        func(string, int) ([]atc.NotificationDelivery, bool, error)
+	notificationDeliveriesMutex       sync.RWMutex
+	notificationDeliveriesArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	notificationDeliveriesReturns struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}
+	notificationDeliveriesReturnsOnCall map[int]struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}
 	OrderingPipelinesStub        func([]string) error
 	orderingPipelinesMutex       sync.RWMutex
 	orderingPipelinesArgsForCall []struct {
@@ -493,6 +542,22 @@ type FakeTeam struct {
 		result4 bool
 		result5 error
 	}
+	RejectJobBuildStub        func(string, string, string, string) (atc.BuildApproval, error)
+	rejectJobBuildMutex       sync.RWMutex
+	rejectJobBuildArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	rejectJobBuildReturns struct {
+		result1 atc.BuildApproval
+		result2 error
+	}
+	rejectJobBuildReturnsOnCall map[int]struct {
+		result1 atc.BuildApproval
+		result2 error
+	}
 	RenamePipelineStub        func(string, string) (bool, error)
 	renamePipelineMutex       sync.RWMutex
 	renamePipelineArgsForCall []struct {
@@ -521,6 +586,21 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	RerunJobBuildStub        func(string, string, string) (atc.Build, error)
+	rerunJobBuildMutex       sync.RWMutex
+	rerunJobBuildArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	rerunJobBuildReturns struct {
+		result1 atc.Build
+		result2 error
+	}
+	rerunJobBuildReturnsOnCall map[int]struct {
+		result1 atc.Build
+		result2 error
+	}
 	ResourceStub        func(string, string) (atc.Resource, bool, error)
 	resourceMutex       sync.RWMutex
 	resourceArgsForCall []struct {
@@ -537,6 +617,23 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	ResourceCheckHistoryStub        func(string, string, int) ([]atc.ResourceCheckHistoryEntry, bool, error)
+	resourceCheckHistoryMutex       sync.RWMutex
+	resourceCheckHistoryArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}
+	resourceCheckHistoryReturns struct {
+		result1 []atc.ResourceCheckHistoryEntry
+		result2 bool
+		result3 error
+	}
+	resourceCheckHistoryReturnsOnCall map[int]struct {
+		result1 []atc.ResourceCheckHistoryEntry
+		result2 bool
+		result3 error
+	}
 	ResourceVersionsStub        func(string, string, concourse.Page) ([]atc.ResourceVersion, concourse.Pagination, bool, error)
 	resourceVersionsMutex       sync.RWMutex
 	resourceVersionsArgsForCall []struct {
@@ -602,6 +699,72 @@ type FakeTeam struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeTeam) ApproveJobBuild(arg1 string, arg2 string, arg3 string, arg4 string) (atc.BuildApproval, error) {
+	fake.approveJobBuildMutex.Lock()
+	ret, specificReturn := fake.approveJobBuildReturnsOnCall[len(fake.approveJobBuildArgsForCall)]
+	fake.approveJobBuildArgsForCall = append(fake.approveJobBuildArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("ApproveJobBuild", []interface{}{arg1, arg2, arg3, arg4})
+	fake.approveJobBuildMutex.Unlock()
+	if fake.ApproveJobBuildStub != nil {
+		return fake.ApproveJobBuildStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.approveJobBuildReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ApproveJobBuildCallCount() int {
+	fake.approveJobBuildMutex.RLock()
+	defer fake.approveJobBuildMutex.RUnlock()
+	return len(fake.approveJobBuildArgsForCall)
+}
+
+func (fake *FakeTeam) ApproveJobBuildCalls(stub func(string, string, string, string) (atc.BuildApproval, error)) {
+	fake.approveJobBuildMutex.Lock()
+	defer fake.approveJobBuildMutex.Unlock()
+	fake.ApproveJobBuildStub = stub
+}
+
+func (fake *FakeTeam) ApproveJobBuildArgsForCall(i int) (string, string, string, string) {
+	fake.approveJobBuildMutex.RLock()
+	defer fake.approveJobBuildMutex.RUnlock()
+	argsForCall := fake.approveJobBuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTeam) ApproveJobBuildReturns(result1 atc.BuildApproval, result2 error) {
+	fake.approveJobBuildMutex.Lock()
+	defer fake.approveJobBuildMutex.Unlock()
+	fake.ApproveJobBuildStub = nil
+	fake.approveJobBuildReturns = struct {
+		result1 atc.BuildApproval
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ApproveJobBuildReturnsOnCall(i int, result1 atc.BuildApproval, result2 error) {
+	fake.approveJobBuildMutex.Lock()
+	defer fake.approveJobBuildMutex.Unlock()
+	fake.ApproveJobBuildStub = nil
+	if fake.approveJobBuildReturnsOnCall == nil {
+		fake.approveJobBuildReturnsOnCall = make(map[int]struct {
+			result1 atc.BuildApproval
+			result2 error
+		})
+	}
+	fake.approveJobBuildReturnsOnCall[i] = struct {
+		result1 atc.BuildApproval
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) BuildInputsForJob(arg1 string, arg2 string) ([]atc.BuildInput, bool, error) {
 	fake.buildInputsForJobMutex.Lock()
 	ret, specificReturn := fake.buildInputsForJobReturnsOnCall[len(fake.buildInputsForJobArgsForCall)]
@@ -669,6 +832,74 @@ func (fake *FakeTeam) BuildInputsForJobReturnsOnCall(i int, result1 []atc.BuildI
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) BuildInputsForJobBuild(arg1 string, arg2 string, arg3 string) ([]atc.BuildInput, bool, error) {
+	fake.buildInputsForJobBuildMutex.Lock()
+	ret, specificReturn := fake.buildInputsForJobBuildReturnsOnCall[len(fake.buildInputsForJobBuildArgsForCall)]
+	fake.buildInputsForJobBuildArgsForCall = append(fake.buildInputsForJobBuildArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("BuildInputsForJobBuild", []interface{}{arg1, arg2, arg3})
+	fake.buildInputsForJobBuildMutex.Unlock()
+	if fake.BuildInputsForJobBuildStub != nil {
+		return fake.BuildInputsForJobBuildStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.buildInputsForJobBuildReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) BuildInputsForJobBuildCallCount() int {
+	fake.buildInputsForJobBuildMutex.RLock()
+	defer fake.buildInputsForJobBuildMutex.RUnlock()
+	return len(fake.buildInputsForJobBuildArgsForCall)
+}
+
+func (fake *FakeTeam) BuildInputsForJobBuildCalls(stub func(string, string, string) ([]atc.BuildInput, bool, error)) {
+	fake.buildInputsForJobBuildMutex.Lock()
+	defer fake.buildInputsForJobBuildMutex.Unlock()
+	fake.BuildInputsForJobBuildStub = stub
+}
+
+func (fake *FakeTeam) BuildInputsForJobBuildArgsForCall(i int) (string, string, string) {
+	fake.buildInputsForJobBuildMutex.RLock()
+	defer fake.buildInputsForJobBuildMutex.RUnlock()
+	argsForCall := fake.buildInputsForJobBuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) BuildInputsForJobBuildReturns(result1 []atc.BuildInput, result2 bool, result3 error) {
+	fake.buildInputsForJobBuildMutex.Lock()
+	defer fake.buildInputsForJobBuildMutex.Unlock()
+	fake.BuildInputsForJobBuildStub = nil
+	fake.buildInputsForJobBuildReturns = struct {
+		result1 []atc.BuildInput
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) BuildInputsForJobBuildReturnsOnCall(i int, result1 []atc.BuildInput, result2 bool, result3 error) {
+	fake.buildInputsForJobBuildMutex.Lock()
+	defer fake.buildInputsForJobBuildMutex.Unlock()
+	fake.BuildInputsForJobBuildStub = nil
+	if fake.buildInputsForJobBuildReturnsOnCall == nil {
+		fake.buildInputsForJobBuildReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildInput
+			result2 bool
+			result3 error
+		})
+	}
+	fake.buildInputsForJobBuildReturnsOnCall[i] = struct {
+		result1 []atc.BuildInput
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) Builds(arg1 concourse.Page) ([]atc.Build, concourse.Pagination, error) {
 	fake.buildsMutex.Lock()
 	ret, specificReturn := fake.buildsReturnsOnCall[len(fake.buildsArgsForCall)]
@@ -2340,6 +2571,73 @@ func (fake *FakeTeam) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeTeam) NotificationDeliveries(arg1 string, arg2 int) ([]atc.NotificationDelivery, bool, error) {
+	fake.notificationDeliveriesMutex.Lock()
+	ret, specificReturn := fake.notificationDeliveriesReturnsOnCall[len(fake.notificationDeliveriesArgsForCall)]
+	fake.notificationDeliveriesArgsForCall = append(fake.notificationDeliveriesArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("NotificationDeliveries", []interface{}{arg1, arg2})
+	fake.notificationDeliveriesMutex.Unlock()
+	if fake.NotificationDeliveriesStub != nil {
+		return fake.NotificationDeliveriesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.notificationDeliveriesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) NotificationDeliveriesCallCount() int {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	return len(fake.notificationDeliveriesArgsForCall)
+}
+
+func (fake *FakeTeam) NotificationDeliveriesCalls(stub func(string, int) ([]atc.NotificationDelivery, bool, error)) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = stub
+}
+
+func (fake *FakeTeam) NotificationDeliveriesArgsForCall(i int) (string, int) {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	argsForCall := fake.notificationDeliveriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) NotificationDeliveriesReturns(result1 []atc.NotificationDelivery, result2 bool, result3 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	fake.notificationDeliveriesReturns = struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) NotificationDeliveriesReturnsOnCall(i int, result1 []atc.NotificationDelivery, result2 bool, result3 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	if fake.notificationDeliveriesReturnsOnCall == nil {
+		fake.notificationDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []atc.NotificationDelivery
+			result2 bool
+			result3 error
+		})
+	}
+	fake.notificationDeliveriesReturnsOnCall[i] = struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) OrderingPipelines(arg1 []string) error {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -2740,6 +3038,72 @@ func (fake *FakeTeam) PipelineConfigReturnsOnCall(i int, result1 atc.Config, res
 	}{result1, result2, result3, result4, result5}
 }
 
+func (fake *FakeTeam) RejectJobBuild(arg1 string, arg2 string, arg3 string, arg4 string) (atc.BuildApproval, error) {
+	fake.rejectJobBuildMutex.Lock()
+	ret, specificReturn := fake.rejectJobBuildReturnsOnCall[len(fake.rejectJobBuildArgsForCall)]
+	fake.rejectJobBuildArgsForCall = append(fake.rejectJobBuildArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("RejectJobBuild", []interface{}{arg1, arg2, arg3, arg4})
+	fake.rejectJobBuildMutex.Unlock()
+	if fake.RejectJobBuildStub != nil {
+		return fake.RejectJobBuildStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.rejectJobBuildReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) RejectJobBuildCallCount() int {
+	fake.rejectJobBuildMutex.RLock()
+	defer fake.rejectJobBuildMutex.RUnlock()
+	return len(fake.rejectJobBuildArgsForCall)
+}
+
+func (fake *FakeTeam) RejectJobBuildCalls(stub func(string, string, string, string) (atc.BuildApproval, error)) {
+	fake.rejectJobBuildMutex.Lock()
+	defer fake.rejectJobBuildMutex.Unlock()
+	fake.RejectJobBuildStub = stub
+}
+
+func (fake *FakeTeam) RejectJobBuildArgsForCall(i int) (string, string, string, string) {
+	fake.rejectJobBuildMutex.RLock()
+	defer fake.rejectJobBuildMutex.RUnlock()
+	argsForCall := fake.rejectJobBuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTeam) RejectJobBuildReturns(result1 atc.BuildApproval, result2 error) {
+	fake.rejectJobBuildMutex.Lock()
+	defer fake.rejectJobBuildMutex.Unlock()
+	fake.RejectJobBuildStub = nil
+	fake.rejectJobBuildReturns = struct {
+		result1 atc.BuildApproval
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RejectJobBuildReturnsOnCall(i int, result1 atc.BuildApproval, result2 error) {
+	fake.rejectJobBuildMutex.Lock()
+	defer fake.rejectJobBuildMutex.Unlock()
+	fake.RejectJobBuildStub = nil
+	if fake.rejectJobBuildReturnsOnCall == nil {
+		fake.rejectJobBuildReturnsOnCall = make(map[int]struct {
+			result1 atc.BuildApproval
+			result2 error
+		})
+	}
+	fake.rejectJobBuildReturnsOnCall[i] = struct {
+		result1 atc.BuildApproval
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) RenamePipeline(arg1 string, arg2 string) (bool, error) {
 	fake.renamePipelineMutex.Lock()
 	ret, specificReturn := fake.renamePipelineReturnsOnCall[len(fake.renamePipelineArgsForCall)]
@@ -2868,6 +3232,71 @@ func (fake *FakeTeam) RenameTeamReturnsOnCall(i int, result1 bool, result2 error
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) RerunJobBuild(arg1 string, arg2 string, arg3 string) (atc.Build, error) {
+	fake.rerunJobBuildMutex.Lock()
+	ret, specificReturn := fake.rerunJobBuildReturnsOnCall[len(fake.rerunJobBuildArgsForCall)]
+	fake.rerunJobBuildArgsForCall = append(fake.rerunJobBuildArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RerunJobBuild", []interface{}{arg1, arg2, arg3})
+	fake.rerunJobBuildMutex.Unlock()
+	if fake.RerunJobBuildStub != nil {
+		return fake.RerunJobBuildStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.rerunJobBuildReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) RerunJobBuildCallCount() int {
+	fake.rerunJobBuildMutex.RLock()
+	defer fake.rerunJobBuildMutex.RUnlock()
+	return len(fake.rerunJobBuildArgsForCall)
+}
+
+func (fake *FakeTeam) RerunJobBuildCalls(stub func(string, string, string) (atc.Build, error)) {
+	fake.rerunJobBuildMutex.Lock()
+	defer fake.rerunJobBuildMutex.Unlock()
+	fake.RerunJobBuildStub = stub
+}
+
+func (fake *FakeTeam) RerunJobBuildArgsForCall(i int) (string, string, string) {
+	fake.rerunJobBuildMutex.RLock()
+	defer fake.rerunJobBuildMutex.RUnlock()
+	argsForCall := fake.rerunJobBuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) RerunJobBuildReturns(result1 atc.Build, result2 error) {
+	fake.rerunJobBuildMutex.Lock()
+	defer fake.rerunJobBuildMutex.Unlock()
+	fake.RerunJobBuildStub = nil
+	fake.rerunJobBuildReturns = struct {
+		result1 atc.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RerunJobBuildReturnsOnCall(i int, result1 atc.Build, result2 error) {
+	fake.rerunJobBuildMutex.Lock()
+	defer fake.rerunJobBuildMutex.Unlock()
+	fake.RerunJobBuildStub = nil
+	if fake.rerunJobBuildReturnsOnCall == nil {
+		fake.rerunJobBuildReturnsOnCall = make(map[int]struct {
+			result1 atc.Build
+			result2 error
+		})
+	}
+	fake.rerunJobBuildReturnsOnCall[i] = struct {
+		result1 atc.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Resource(arg1 string, arg2 string) (atc.Resource, bool, error) {
 	fake.resourceMutex.Lock()
 	ret, specificReturn := fake.resourceReturnsOnCall[len(fake.resourceArgsForCall)]
@@ -2935,6 +3364,74 @@ func (fake *FakeTeam) ResourceReturnsOnCall(i int, result1 atc.Resource, result2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) ResourceCheckHistory(arg1 string, arg2 string, arg3 int) ([]atc.ResourceCheckHistoryEntry, bool, error) {
+	fake.resourceCheckHistoryMutex.Lock()
+	ret, specificReturn := fake.resourceCheckHistoryReturnsOnCall[len(fake.resourceCheckHistoryArgsForCall)]
+	fake.resourceCheckHistoryArgsForCall = append(fake.resourceCheckHistoryArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ResourceCheckHistory", []interface{}{arg1, arg2, arg3})
+	fake.resourceCheckHistoryMutex.Unlock()
+	if fake.ResourceCheckHistoryStub != nil {
+		return fake.ResourceCheckHistoryStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.resourceCheckHistoryReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) ResourceCheckHistoryCallCount() int {
+	fake.resourceCheckHistoryMutex.RLock()
+	defer fake.resourceCheckHistoryMutex.RUnlock()
+	return len(fake.resourceCheckHistoryArgsForCall)
+}
+
+func (fake *FakeTeam) ResourceCheckHistoryCalls(stub func(string, string, int) ([]atc.ResourceCheckHistoryEntry, bool, error)) {
+	fake.resourceCheckHistoryMutex.Lock()
+	defer fake.resourceCheckHistoryMutex.Unlock()
+	fake.ResourceCheckHistoryStub = stub
+}
+
+func (fake *FakeTeam) ResourceCheckHistoryArgsForCall(i int) (string, string, int) {
+	fake.resourceCheckHistoryMutex.RLock()
+	defer fake.resourceCheckHistoryMutex.RUnlock()
+	argsForCall := fake.resourceCheckHistoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) ResourceCheckHistoryReturns(result1 []atc.ResourceCheckHistoryEntry, result2 bool, result3 error) {
+	fake.resourceCheckHistoryMutex.Lock()
+	defer fake.resourceCheckHistoryMutex.Unlock()
+	fake.ResourceCheckHistoryStub = nil
+	fake.resourceCheckHistoryReturns = struct {
+		result1 []atc.ResourceCheckHistoryEntry
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) ResourceCheckHistoryReturnsOnCall(i int, result1 []atc.ResourceCheckHistoryEntry, result2 bool, result3 error) {
+	fake.resourceCheckHistoryMutex.Lock()
+	defer fake.resourceCheckHistoryMutex.Unlock()
+	fake.ResourceCheckHistoryStub = nil
+	if fake.resourceCheckHistoryReturnsOnCall == nil {
+		fake.resourceCheckHistoryReturnsOnCall = make(map[int]struct {
+			result1 []atc.ResourceCheckHistoryEntry
+			result2 bool
+			result3 error
+		})
+	}
+	fake.resourceCheckHistoryReturnsOnCall[i] = struct {
+		result1 []atc.ResourceCheckHistoryEntry
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) ResourceVersions(arg1 string, arg2 string, arg3 concourse.Page) ([]atc.ResourceVersion, concourse.Pagination, bool, error) {
 	fake.resourceVersionsMutex.Lock()
 	ret, specificReturn := fake.resourceVersionsReturnsOnCall[len(fake.resourceVersionsArgsForCall)]
@@ -3202,8 +3699,12 @@ func (fake *FakeTeam) VersionedResourceTypesReturnsOnCall(i int, result1 atc.Ver
 func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.approveJobBuildMutex.RLock()
+	defer fake.approveJobBuildMutex.RUnlock()
 	fake.buildInputsForJobMutex.RLock()
 	defer fake.buildInputsForJobMutex.RUnlock()
+	fake.buildInputsForJobBuildMutex.RLock()
+	defer fake.buildInputsForJobBuildMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
 	fake.buildsWithVersionAsInputMutex.RLock()
@@ -3256,6 +3757,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.listVolumesMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
 	fake.orderingPipelinesMutex.RLock()
 	defer fake.orderingPipelinesMutex.RUnlock()
 	fake.pauseJobMutex.RLock()
@@ -3268,12 +3771,18 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.pipelineBuildsMutex.RUnlock()
 	fake.pipelineConfigMutex.RLock()
 	defer fake.pipelineConfigMutex.RUnlock()
+	fake.rejectJobBuildMutex.RLock()
+	defer fake.rejectJobBuildMutex.RUnlock()
 	fake.renamePipelineMutex.RLock()
 	defer fake.renamePipelineMutex.RUnlock()
 	fake.renameTeamMutex.RLock()
 	defer fake.renameTeamMutex.RUnlock()
+	fake.rerunJobBuildMutex.RLock()
+	defer fake.rerunJobBuildMutex.RUnlock()
 	fake.resourceMutex.RLock()
 	defer fake.resourceMutex.RUnlock()
+	fake.resourceCheckHistoryMutex.RLock()
+	defer fake.resourceCheckHistoryMutex.RUnlock()
 	fake.resourceVersionsMutex.RLock()
 	defer fake.resourceVersionsMutex.RUnlock()
 	fake.unpauseJobMutex.RLock()
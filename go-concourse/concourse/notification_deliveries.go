@@ -0,0 +1,39 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) NotificationDeliveries(pipelineName string, limit int) ([]atc.NotificationDelivery, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"team_name":     team.name,
+	}
+
+	query := url.Values{}
+	if limit > 0 {
+		query.Add(atc.PaginationQueryLimit, strconv.Itoa(limit))
+	}
+
+	var deliveries []atc.NotificationDelivery
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListNotificationDeliveries,
+		Params:      params,
+		Query:       query,
+	}, &internal.Response{
+		Result: &deliveries,
+	})
+	switch err.(type) {
+	case nil:
+		return deliveries, true, nil
+	case internal.ResourceNotFoundError:
+		return deliveries, false, nil
+	default:
+		return deliveries, false, err
+	}
+}
@@ -0,0 +1,129 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Notification Deliveries", func() {
+	Describe("NotificationDeliveries", func() {
+		var (
+			expectedURL        string
+			expectedQuery      string
+			expectedDeliveries []atc.NotificationDelivery
+
+			limit      int
+			deliveries []atc.NotificationDelivery
+			found      bool
+			clientErr  error
+		)
+
+		BeforeEach(func() {
+			expectedURL = "/api/v1/teams/some-team/pipelines/some-pipeline/notification-deliveries"
+			expectedQuery = ""
+			limit = 0
+
+			expectedDeliveries = []atc.NotificationDelivery{
+				{
+					ID:             2,
+					Notification:   "chat",
+					Event:          atc.NotificationEventFailed,
+					BuildID:        12,
+					BuildName:      "3",
+					JobName:        "some-job",
+					Status:         atc.NotificationDeliveryPending,
+					Attempts:       1,
+					ResponseStatus: 502,
+					Error:          "unexpected response: 502 Bad Gateway",
+					CreatedAt:      100,
+					LastAttemptAt:  105,
+				},
+				{
+					ID:           1,
+					Notification: "audit",
+					Event:        atc.NotificationEventStarted,
+					BuildID:      12,
+					BuildName:    "3",
+					JobName:      "some-job",
+					Status:       atc.NotificationDeliveryDelivered,
+					Attempts:     1,
+					CreatedAt:    40,
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			deliveries, found, clientErr = team.NotificationDeliveries("some-pipeline", limit)
+		})
+
+		Context("when the server returns the deliveries", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, expectedQuery),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedDeliveries),
+					),
+				)
+			})
+
+			It("returns the deliveries", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(deliveries).To(Equal(expectedDeliveries))
+			})
+
+			Context("when a limit is given", func() {
+				BeforeEach(func() {
+					limit = 5
+					expectedQuery = "limit=5"
+
+					atcServer.SetHandler(0, ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, expectedQuery),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedDeliveries),
+					))
+				})
+
+				It("passes it along", func() {
+					Expect(clientErr).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+				})
+			})
+		})
+
+		Context("when the server returns a 404", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false for found and a nil error", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the server returns a 500", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusInternalServerError, ""),
+					),
+				)
+			})
+
+			It("returns false for found and an error", func() {
+				Expect(clientErr).To(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
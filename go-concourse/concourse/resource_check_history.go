@@ -0,0 +1,40 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) ResourceCheckHistory(pipelineName string, resourceName string, limit int) ([]atc.ResourceCheckHistoryEntry, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"resource_name": resourceName,
+		"team_name":     team.name,
+	}
+
+	query := url.Values{}
+	if limit > 0 {
+		query.Add(atc.PaginationQueryLimit, strconv.Itoa(limit))
+	}
+
+	var entries []atc.ResourceCheckHistoryEntry
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListResourceCheckHistory,
+		Params:      params,
+		Query:       query,
+	}, &internal.Response{
+		Result: &entries,
+	})
+	switch err.(type) {
+	case nil:
+		return entries, true, nil
+	case internal.ResourceNotFoundError:
+		return entries, false, nil
+	default:
+		return entries, false, err
+	}
+}
@@ -0,0 +1,117 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Resource Check History", func() {
+	Describe("ResourceCheckHistory", func() {
+		var (
+			expectedURL     string
+			expectedQuery   string
+			expectedEntries []atc.ResourceCheckHistoryEntry
+
+			limit     int
+			entries   []atc.ResourceCheckHistoryEntry
+			found     bool
+			clientErr error
+		)
+
+		BeforeEach(func() {
+			expectedURL = "/api/v1/teams/some-team/pipelines/some-pipeline/resources/some-resource/check-history"
+			expectedQuery = ""
+			limit = 0
+
+			exitStatus := 1
+			expectedEntries = []atc.ResourceCheckHistoryEntry{
+				{
+					ID:         2,
+					StartTime:  100,
+					EndTime:    105,
+					WorkerName: "some-worker",
+					ExitStatus: &exitStatus,
+					Stderr:     "some-stderr",
+				},
+				{
+					ID:       1,
+					Versions: []atc.Version{{"ref": "v1"}},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			entries, found, clientErr = team.ResourceCheckHistory("some-pipeline", "some-resource", limit)
+		})
+
+		Context("when the server returns the check history", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, expectedQuery),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedEntries),
+					),
+				)
+			})
+
+			It("returns the check history", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(entries).To(Equal(expectedEntries))
+			})
+
+			Context("when a limit is given", func() {
+				BeforeEach(func() {
+					limit = 5
+					expectedQuery = "limit=5"
+
+					atcServer.SetHandler(0, ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, expectedQuery),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedEntries),
+					))
+				})
+
+				It("passes it along", func() {
+					Expect(clientErr).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+				})
+			})
+		})
+
+		Context("when the server returns a 404", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false for found and a nil error", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the server returns a 500", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusInternalServerError, ""),
+					),
+				)
+			})
+
+			It("returns false for found and an error", func() {
+				Expect(clientErr).To(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
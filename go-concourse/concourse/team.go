@@ -16,6 +16,7 @@ type Team interface {
 
 	Pipeline(name string) (atc.Pipeline, bool, error)
 	PipelineBuilds(pipelineName string, page Page) ([]atc.Build, Pagination, bool, error)
+	NotificationDeliveries(pipelineName string, limit int) ([]atc.NotificationDelivery, bool, error)
 	DeletePipeline(pipelineName string) (bool, error)
 	PausePipeline(pipelineName string) (bool, error)
 	UnpausePipeline(pipelineName string) (bool, error)
@@ -29,11 +30,15 @@ type Team interface {
 	CreatePipelineBuild(pipelineName string, plan atc.Plan) (atc.Build, error)
 
 	BuildInputsForJob(pipelineName string, jobName string) ([]atc.BuildInput, bool, error)
+	BuildInputsForJobBuild(pipelineName string, jobName string, buildName string) ([]atc.BuildInput, bool, error)
 
 	Job(pipelineName, jobName string) (atc.Job, bool, error)
 	JobBuild(pipelineName, jobName, buildName string) (atc.Build, bool, error)
 	JobBuilds(pipelineName string, jobName string, page Page) ([]atc.Build, Pagination, bool, error)
 	CreateJobBuild(pipelineName string, jobName string) (atc.Build, error)
+	RerunJobBuild(pipelineName string, jobName string, buildName string) (atc.Build, error)
+	ApproveJobBuild(pipelineName string, jobName string, buildName string, stepName string) (atc.BuildApproval, error)
+	RejectJobBuild(pipelineName string, jobName string, buildName string, stepName string) (atc.BuildApproval, error)
 	ListJobs(pipelineName string) ([]atc.Job, error)
 
 	PauseJob(pipelineName string, jobName string) (bool, error)
@@ -45,6 +50,7 @@ type Team interface {
 	ListResources(pipelineName string) ([]atc.Resource, error)
 	VersionedResourceTypes(pipelineName string) (atc.VersionedResourceTypes, bool, error)
 	ResourceVersions(pipelineName string, resourceName string, page Page) ([]atc.ResourceVersion, Pagination, bool, error)
+	ResourceCheckHistory(pipelineName string, resourceName string, limit int) ([]atc.ResourceCheckHistoryEntry, bool, error)
 	CheckResource(pipelineName string, resourceName string, version atc.Version) (bool, error)
 	CheckResourceType(pipelineName string, resourceTypeName string, version atc.Version) (bool, error)
 	DisableResourceVersion(pipelineName string, resourceName string, resourceVersionID int) (bool, error)
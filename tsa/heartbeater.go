@@ -298,9 +298,58 @@ func (heartbeater *Heartbeater) pingWorker(logger lager.Logger) (atc.Worker, boo
 	registration.ActiveContainers = len(containers)
 	registration.ActiveVolumes = len(volumes)
 
+	heartbeater.reportCapacity(logger, &registration, containers)
+
 	return registration, true
 }
 
+// reportCapacity fills in how much room the worker has left, based on
+// Garden's capacity less what its containers are currently using. Failing to
+// determine it is not fatal; the worker is just reported with an unknown
+// capacity.
+func (heartbeater *Heartbeater) reportCapacity(logger lager.Logger, registration *atc.Worker, containers []garden.Container) {
+	capacity, err := heartbeater.gardenClient.Capacity()
+	if err != nil {
+		logger.Error("failed-to-fetch-capacity", err)
+		return
+	}
+
+	var usedMemory, usedDisk uint64
+	if len(containers) > 0 {
+		handles := make([]string, len(containers))
+		for i, container := range containers {
+			handles[i] = container.Handle()
+		}
+
+		metrics, err := heartbeater.gardenClient.BulkMetrics(handles)
+		if err != nil {
+			logger.Error("failed-to-fetch-container-metrics", err)
+			return
+		}
+
+		for _, entry := range metrics {
+			if entry.Err != nil {
+				continue
+			}
+
+			usedMemory += entry.Metrics.MemoryStat.TotalUsageTowardLimit
+			usedDisk += entry.Metrics.DiskStat.ExclusiveBytesUsed
+		}
+	}
+
+	registration.MaxContainers = int(capacity.MaxContainers)
+	registration.FreeMemory = freeBytes(capacity.MemoryInBytes, usedMemory)
+	registration.FreeDisk = freeBytes(capacity.DiskInBytes, usedDisk)
+}
+
+func freeBytes(total uint64, used uint64) int64 {
+	if used >= total {
+		return 0
+	}
+
+	return int64(total - used)
+}
+
 func (heartbeater *Heartbeater) ttl() time.Duration {
 	return heartbeater.interval * 2
 }
@@ -3,6 +3,7 @@ package tsa_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"time"
 
@@ -272,6 +273,51 @@ var _ = Describe("Heartbeater", func() {
 			})
 		})
 
+		Context("when garden reports its capacity", func() {
+			BeforeEach(func() {
+				fakeGardenClient.CapacityReturns(garden.Capacity{
+					MemoryInBytes: 1024,
+					DiskInBytes:   4096,
+					MaxContainers: 250,
+				}, nil)
+
+				fakeGardenClient.BulkMetricsReturns(map[string]garden.ContainerMetricsEntry{
+					"some-handle": {
+						Metrics: garden.Metrics{
+							MemoryStat: garden.ContainerMemoryStat{TotalUsageTowardLimit: 24},
+							DiskStat:   garden.ContainerDiskStat{ExclusiveBytesUsed: 96},
+						},
+					},
+					"some-other-handle": {
+						Err: garden.NewError("container went away"),
+					},
+				}, nil)
+
+				fakeATC1.AppendHandlers(verifyRegister)
+			})
+
+			It("registers with the capacity left over from the containers", func() {
+				expectedWorker.ActiveContainers = 2
+				expectedWorker.ActiveVolumes = 3
+				expectedWorker.MaxContainers = 250
+				expectedWorker.FreeMemory = 1000
+				expectedWorker.FreeDisk = 4000
+				Eventually(registrations).Should(Receive(Equal(registration{expectedWorker, 2 * interval})))
+			})
+
+			Context("when fetching the container metrics fails", func() {
+				BeforeEach(func() {
+					fakeGardenClient.BulkMetricsReturns(nil, errors.New("nope"))
+				})
+
+				It("registers without a capacity", func() {
+					expectedWorker.ActiveContainers = 2
+					expectedWorker.ActiveVolumes = 3
+					Eventually(registrations).Should(Receive(Equal(registration{expectedWorker, 2 * interval})))
+				})
+			})
+		})
+
 		Context("when heartbeat returns worker is landed", func() {
 			BeforeEach(func() {
 				heartbeated := make(chan registration, 100)
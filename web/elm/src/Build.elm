@@ -1115,9 +1115,17 @@ viewHistoryItem currentBuild build =
             class (Concourse.BuildStatus.show build.status)
         ]
         [ Html.a
-            [ onLeftClick (SwitchToBuild build)
-            , href (Routes.buildRoute build)
-            ]
+            ([ onLeftClick (SwitchToBuild build)
+             , href (Routes.buildRoute build)
+             ]
+                ++ (case build.rerunOf of
+                        Just rerunOf ->
+                            [ title ("rerun of #" ++ rerunOf) ]
+
+                        Nothing ->
+                            []
+                   )
+            )
             [ Html.text build.name
             ]
         ]
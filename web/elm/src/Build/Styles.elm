@@ -104,6 +104,8 @@ type StepHeaderIcon
     = ArrowUp
     | ArrowDown
     | Terminal
+    | Pipeline
+    | Hold
 
 
 stepHeaderIcon : StepHeaderIcon -> List ( String, String )
@@ -119,6 +121,12 @@ stepHeaderIcon icon =
 
                 Terminal ->
                     "terminal"
+
+                Pipeline ->
+                    "breadcrumb-pipeline"
+
+                Hold ->
+                    "pause-white"
     in
     [ ( "height", "28px" )
     , ( "width", "28px" )
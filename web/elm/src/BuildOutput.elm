@@ -224,6 +224,12 @@ handleEvent event model =
             , OutNoop
             )
 
+        Concourse.BuildEvents.WaitingForWorker origin requirements time ->
+            ( updateStep origin.id (appendStepLog ("waiting for a worker satisfying " ++ requirements ++ "\n") time) model
+            , []
+            , OutNoop
+            )
+
         Concourse.BuildEvents.StartTask origin ->
             ( updateStep origin.id setRunning model
             , []
@@ -248,6 +254,48 @@ handleEvent event model =
             , OutNoop
             )
 
+        Concourse.BuildEvents.StartSetPipeline origin ->
+            ( updateStep origin.id setRunning model
+            , []
+            , OutNoop
+            )
+
+        Concourse.BuildEvents.FinishSetPipeline origin succeeded ->
+            ( updateStep origin.id (finishStep (succeededExitStatus succeeded)) model
+            , []
+            , OutNoop
+            )
+
+        Concourse.BuildEvents.StartLoadVar origin ->
+            ( updateStep origin.id setRunning model
+            , []
+            , OutNoop
+            )
+
+        Concourse.BuildEvents.FinishLoadVar origin succeeded ->
+            ( updateStep origin.id (finishStep (succeededExitStatus succeeded)) model
+            , []
+            , OutNoop
+            )
+
+        Concourse.BuildEvents.WaitingForApproval origin _ role time ->
+            ( updateStep origin.id (setRunning << appendStepLog ("waiting for approval by a team " ++ role ++ "\n") time) model
+            , []
+            , OutNoop
+            )
+
+        Concourse.BuildEvents.FinishApproval origin status decidedBy time ->
+            ( updateStep origin.id (finishStep (approvalExitStatus status) << appendStepLog (approvalLog status decidedBy) time) model
+            , []
+            , OutNoop
+            )
+
+        Concourse.BuildEvents.Skipped origin ->
+            ( updateStep origin.id setSkipped model
+            , []
+            , OutNoop
+            )
+
         Concourse.BuildEvents.BuildStatus status date ->
             case model.steps of
                 Just st ->
@@ -284,6 +332,33 @@ updateStep id update model =
     { model | steps = Maybe.map (StepTree.updateAt id update) model.steps }
 
 
+succeededExitStatus : Bool -> Int
+succeededExitStatus succeeded =
+    if succeeded then
+        0
+
+    else
+        1
+
+
+approvalExitStatus : String -> Int
+approvalExitStatus status =
+    succeededExitStatus (status == "approved")
+
+
+approvalLog : String -> String -> String
+approvalLog status decidedBy =
+    case status of
+        "approved" ->
+            "approved by " ++ decidedBy ++ "\n"
+
+        "rejected" ->
+            "rejected by " ++ decidedBy ++ "\n"
+
+        _ ->
+            "approval " ++ status ++ "\n"
+
+
 setRunning : StepTree -> StepTree
 setRunning =
     setStepState StepTree.StepStateRunning
@@ -315,6 +390,11 @@ appendStepLog output mtime tree =
             { step | log = newLog, timestamps = newTimestamps }
 
 
+setSkipped : StepTree -> StepTree
+setSkipped =
+    StepTree.mapAll (\step -> { step | state = StepTree.StepStateSkipped })
+
+
 setStepError : String -> StepTree -> StepTree
 setStepError message tree =
     StepTree.map
@@ -1,5 +1,6 @@
 module Concourse exposing
-    ( AuthSession
+    ( AcrossPlan
+    , AuthSession
     , AuthToken
     , Build
     , BuildDuration
@@ -34,6 +35,7 @@ module Concourse exposing
     , Team
     , TeamName
     , User
+    , VarScopedPlan
     , Version
     , VersionedResource
     , VersionedResourceIdentifier
@@ -62,6 +64,7 @@ import Date exposing (Date)
 import Dict exposing (Dict)
 import Json.Decode
 import Json.Decode.Extra exposing ((|:))
+import Json.Encode
 
 
 
@@ -153,6 +156,7 @@ type alias Build =
     , status : BuildStatus
     , duration : BuildDuration
     , reapTime : Maybe Date
+    , rerunOf : Maybe BuildName
     }
 
 
@@ -188,6 +192,7 @@ decodeBuild =
                 |: Json.Decode.maybe (Json.Decode.field "end_time" (Json.Decode.map dateFromSeconds Json.Decode.float))
            )
         |: Json.Decode.maybe (Json.Decode.field "reap_time" (Json.Decode.map dateFromSeconds Json.Decode.float))
+        |: Json.Decode.maybe (Json.Decode.field "rerun_of_name" Json.Decode.string)
 
 
 decodeBuildStatus : Json.Decode.Decoder BuildStatus
@@ -339,6 +344,11 @@ type BuildStep
     | BuildStepTry BuildPlan
     | BuildStepRetry (Array BuildPlan)
     | BuildStepTimeout BuildPlan
+    | BuildStepConditional BuildPlan
+    | BuildStepAcross AcrossPlan
+    | BuildStepSetPipeline StepName
+    | BuildStepLoadVar StepName
+    | BuildStepApproval StepName
 
 
 type alias HookedPlan =
@@ -347,6 +357,18 @@ type alias HookedPlan =
     }
 
 
+type alias AcrossPlan =
+    { vars : List String
+    , steps : Array VarScopedPlan
+    }
+
+
+type alias VarScopedPlan =
+    { step : BuildPlan
+    , values : List String
+    }
+
+
 decodeBuildPlan : Json.Decode.Decoder BuildPlan
 decodeBuildPlan =
     Json.Decode.at [ "plan" ] <|
@@ -372,6 +394,11 @@ decodeBuildPlan_ =
             , Json.Decode.field "try" <| lazy (\_ -> decodeBuildStepTry)
             , Json.Decode.field "retry" <| lazy (\_ -> decodeBuildStepRetry)
             , Json.Decode.field "timeout" <| lazy (\_ -> decodeBuildStepTimeout)
+            , Json.Decode.field "conditional" <| lazy (\_ -> decodeBuildStepConditional)
+            , Json.Decode.field "across" <| lazy (\_ -> decodeBuildStepAcross)
+            , Json.Decode.field "set_pipeline" <| lazy (\_ -> decodeBuildStepSetPipeline)
+            , Json.Decode.field "load_var" <| lazy (\_ -> decodeBuildStepLoadVar)
+            , Json.Decode.field "approval" <| lazy (\_ -> decodeBuildStepApproval)
             ]
 
 
@@ -381,6 +408,24 @@ decodeBuildStepTask =
         |: Json.Decode.field "name" Json.Decode.string
 
 
+decodeBuildStepSetPipeline : Json.Decode.Decoder BuildStep
+decodeBuildStepSetPipeline =
+    Json.Decode.succeed BuildStepSetPipeline
+        |: Json.Decode.field "name" Json.Decode.string
+
+
+decodeBuildStepLoadVar : Json.Decode.Decoder BuildStep
+decodeBuildStepLoadVar =
+    Json.Decode.succeed BuildStepLoadVar
+        |: Json.Decode.field "name" Json.Decode.string
+
+
+decodeBuildStepApproval : Json.Decode.Decoder BuildStep
+decodeBuildStepApproval =
+    Json.Decode.succeed BuildStepApproval
+        |: Json.Decode.field "name" Json.Decode.string
+
+
 decodeBuildStepGet : Json.Decode.Decoder BuildStep
 decodeBuildStepGet =
     Json.Decode.succeed BuildStepGet
@@ -462,6 +507,35 @@ decodeBuildStepTimeout =
         |: (Json.Decode.field "step" <| lazy (\_ -> decodeBuildPlan_))
 
 
+decodeBuildStepConditional : Json.Decode.Decoder BuildStep
+decodeBuildStepConditional =
+    Json.Decode.succeed BuildStepConditional
+        |: (Json.Decode.field "step" <| lazy (\_ -> decodeBuildPlan_))
+
+
+decodeBuildStepAcross : Json.Decode.Decoder BuildStep
+decodeBuildStepAcross =
+    Json.Decode.map BuildStepAcross <|
+        Json.Decode.succeed AcrossPlan
+            |: Json.Decode.field "vars" (Json.Decode.list (Json.Decode.field "name" Json.Decode.string))
+            |: Json.Decode.field "steps" (Json.Decode.array (lazy (\_ -> decodeVarScopedPlan)))
+
+
+decodeVarScopedPlan : Json.Decode.Decoder VarScopedPlan
+decodeVarScopedPlan =
+    Json.Decode.succeed VarScopedPlan
+        |: (Json.Decode.field "step" <| lazy (\_ -> decodeBuildPlan_))
+        |: Json.Decode.field "values" (Json.Decode.list decodeAcrossValue)
+
+
+decodeAcrossValue : Json.Decode.Decoder String
+decodeAcrossValue =
+    Json.Decode.oneOf
+        [ Json.Decode.string
+        , Json.Decode.map (Json.Encode.encode 0) Json.Decode.value
+        ]
+
+
 
 -- Info
 
@@ -12,10 +12,18 @@ import EventSource.LowLevel as ES
 type BuildEvent
     = BuildStatus Concourse.BuildStatus Date
     | Initialize Origin
+    | WaitingForWorker Origin String (Maybe Date)
     | StartTask Origin
     | FinishTask Origin Int
     | FinishGet Origin Int Concourse.Version Concourse.Metadata
     | FinishPut Origin Int Concourse.Version Concourse.Metadata
+    | Skipped Origin
+    | StartSetPipeline Origin
+    | FinishSetPipeline Origin Bool
+    | StartLoadVar Origin
+    | FinishLoadVar Origin Bool
+    | WaitingForApproval Origin String String (Maybe Date)
+    | FinishApproval Origin String String (Maybe Date)
     | Log Origin String (Maybe Date)
     | Error Origin String
     | BuildError String
@@ -67,6 +75,15 @@ decodeBuildEvent eventType =
                 "data"
                 (Json.Decode.map Initialize (Json.Decode.field "origin" decodeOrigin))
 
+        "waiting-for-worker" ->
+            Json.Decode.field
+                "data"
+                (Json.Decode.map3 WaitingForWorker
+                    (Json.Decode.field "origin" decodeOrigin)
+                    (Json.Decode.field "requirements" Json.Decode.string)
+                    (Json.Decode.maybe <| Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.float)
+                )
+
         "initialize-task" ->
             Json.Decode.field
                 "data"
@@ -91,6 +108,57 @@ decodeBuildEvent eventType =
         "finish-put" ->
             Json.Decode.field "data" (decodeFinishResource FinishPut)
 
+        "start-set-pipeline" ->
+            Json.Decode.field
+                "data"
+                (Json.Decode.map StartSetPipeline (Json.Decode.field "origin" decodeOrigin))
+
+        "finish-set-pipeline" ->
+            Json.Decode.field
+                "data"
+                (Json.Decode.map2 FinishSetPipeline
+                    (Json.Decode.field "origin" decodeOrigin)
+                    (Json.Decode.field "succeeded" Json.Decode.bool)
+                )
+
+        "start-load-var" ->
+            Json.Decode.field
+                "data"
+                (Json.Decode.map StartLoadVar (Json.Decode.field "origin" decodeOrigin))
+
+        "finish-load-var" ->
+            Json.Decode.field
+                "data"
+                (Json.Decode.map2 FinishLoadVar
+                    (Json.Decode.field "origin" decodeOrigin)
+                    (Json.Decode.field "succeeded" Json.Decode.bool)
+                )
+
+        "waiting-for-approval" ->
+            Json.Decode.field
+                "data"
+                (Json.Decode.map4 WaitingForApproval
+                    (Json.Decode.field "origin" decodeOrigin)
+                    (Json.Decode.field "name" Json.Decode.string)
+                    (Json.Decode.field "role" Json.Decode.string)
+                    (Json.Decode.maybe <| Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.float)
+                )
+
+        "finish-approval" ->
+            Json.Decode.field
+                "data"
+                (Json.Decode.map4 FinishApproval
+                    (Json.Decode.field "origin" decodeOrigin)
+                    (Json.Decode.field "status" Json.Decode.string)
+                    (Json.Decode.map (Maybe.withDefault "") <| Json.Decode.maybe <| Json.Decode.field "decided_by" Json.Decode.string)
+                    (Json.Decode.maybe <| Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.float)
+                )
+
+        "skipped" ->
+            Json.Decode.field
+                "data"
+                (Json.Decode.map Skipped (Json.Decode.field "origin" decodeOrigin))
+
         unknown ->
             Json.Decode.fail ("unknown event type: " ++ unknown)
 
@@ -13,6 +13,7 @@ module StepTree exposing
     , finished
     , init
     , map
+    , mapAll
     , parseHighlight
     , setHighlight
     , switchTab
@@ -54,6 +55,11 @@ type StepTree
     | Try StepTree
     | Retry StepID (Array StepTree) Int TabFocus
     | Timeout StepTree
+    | Conditional StepTree
+    | Across (List String) (Array (List String)) (Array StepTree)
+    | SetPipeline Step
+    | LoadVar Step
+    | Approval Step
 
 
 type TabFocus
@@ -95,6 +101,7 @@ type StepState
     | StepStateSucceeded
     | StepStateFailed
     | StepStateErrored
+    | StepStateSkipped
 
 
 type alias StepFocus =
@@ -131,6 +138,15 @@ init hl resources plan =
         Concourse.BuildStepTask name ->
             initBottom hl Task plan.id name
 
+        Concourse.BuildStepSetPipeline name ->
+            initBottom hl SetPipeline plan.id name
+
+        Concourse.BuildStepLoadVar name ->
+            initBottom hl LoadVar plan.id name
+
+        Concourse.BuildStepApproval name ->
+            initBottom hl Approval plan.id name
+
         Concourse.BuildStepGet name version ->
             initBottom hl (Get << setupGetStep resources name version) plan.id name
 
@@ -218,6 +234,32 @@ init hl resources plan =
         Concourse.BuildStepTimeout plan ->
             initWrappedStep hl resources Timeout plan
 
+        Concourse.BuildStepConditional subPlan ->
+            let
+                wrapped =
+                    initWrappedStep hl resources Conditional subPlan
+            in
+            { wrapped | foci = Dict.insert plan.id (Focus.create identity identity) wrapped.foci }
+
+        Concourse.BuildStepAcross { vars, steps } ->
+            let
+                inited =
+                    Array.map (.step >> init hl resources) steps
+
+                trees =
+                    Array.map .tree inited
+
+                subFoci =
+                    Array.map .foci inited
+
+                wrappedSubFoci =
+                    Array.indexedMap wrapMultiStep subFoci
+
+                foci =
+                    Array.foldr Dict.union Dict.empty wrappedSubFoci
+            in
+            Model (Across vars (Array.map .values steps) trees) foci False hl
+
 
 treeIsActive : StepTree -> Bool
 treeIsActive tree =
@@ -246,9 +288,15 @@ treeIsActive tree =
         Timeout tree ->
             treeIsActive tree
 
+        Conditional tree ->
+            treeIsActive tree
+
         Retry _ trees _ _ ->
             List.any treeIsActive (Array.toList trees)
 
+        Across _ _ trees ->
+            List.any treeIsActive (Array.toList trees)
+
         Task step ->
             stepIsActive step
 
@@ -261,6 +309,15 @@ treeIsActive tree =
         DependentGet step ->
             stepIsActive step
 
+        SetPipeline step ->
+            stepIsActive step
+
+        LoadVar step ->
+            stepIsActive step
+
+        Approval step ->
+            stepIsActive step
+
 
 stepIsActive : Step -> Bool
 stepIsActive =
@@ -391,10 +448,59 @@ map f tree =
         DependentGet step ->
             DependentGet (f step)
 
+        SetPipeline step ->
+            SetPipeline (f step)
+
+        LoadVar step ->
+            LoadVar (f step)
+
+        Approval step ->
+            Approval (f step)
+
         _ ->
             tree
 
 
+mapAll : (Step -> Step) -> StepTree -> StepTree
+mapAll f tree =
+    case tree of
+        Aggregate trees ->
+            Aggregate (Array.map (mapAll f) trees)
+
+        Do trees ->
+            Do (Array.map (mapAll f) trees)
+
+        OnSuccess hookedStep ->
+            OnSuccess { hookedStep | step = mapAll f hookedStep.step, hook = mapAll f hookedStep.hook }
+
+        OnFailure hookedStep ->
+            OnFailure { hookedStep | step = mapAll f hookedStep.step, hook = mapAll f hookedStep.hook }
+
+        OnAbort hookedStep ->
+            OnAbort { hookedStep | step = mapAll f hookedStep.step, hook = mapAll f hookedStep.hook }
+
+        Ensure hookedStep ->
+            Ensure { hookedStep | step = mapAll f hookedStep.step, hook = mapAll f hookedStep.hook }
+
+        Try step ->
+            Try (mapAll f step)
+
+        Retry id trees tab focus ->
+            Retry id (Array.map (mapAll f) trees) tab focus
+
+        Timeout step ->
+            Timeout (mapAll f step)
+
+        Conditional step ->
+            Conditional (mapAll f step)
+
+        Across vars values trees ->
+            Across vars values (Array.map (mapAll f) trees)
+
+        _ ->
+            map f tree
+
+
 initBottom : Highlight -> (Step -> StepTree) -> StepID -> StepName -> Model
 initBottom hl create id name =
     let
@@ -498,6 +604,9 @@ getStep tree =
         Timeout step ->
             step
 
+        Conditional step ->
+            step
+
         _ ->
             Debug.crash "impossible"
 
@@ -523,6 +632,9 @@ updateStep update tree =
         Timeout step ->
             Timeout (update step)
 
+        Conditional step ->
+            Conditional (update step)
+
         _ ->
             Debug.crash "impossible"
 
@@ -584,6 +696,9 @@ getMultiStepIndex idx tree =
                 Retry _ trees _ _ ->
                     trees
 
+                Across _ _ trees ->
+                    trees
+
                 _ ->
                     Debug.crash "impossible"
     in
@@ -616,6 +731,9 @@ setMultiStepIndex idx update tree =
                 User ->
                     Retry id updatedSteps tab User
 
+        Across vars values trees ->
+            Across vars values (Array.set idx (update (getMultiStepIndex idx tree)) trees)
+
         _ ->
             Debug.crash "impossible"
 
@@ -640,6 +758,15 @@ viewTree model tree =
         Put step ->
             viewStep model step Styles.ArrowUp
 
+        SetPipeline step ->
+            viewStep model step Styles.Pipeline
+
+        LoadVar step ->
+            viewStep model step Styles.ArrowDown
+
+        Approval step ->
+            viewStep model step Styles.Hold
+
         Try step ->
             viewTree model step
 
@@ -658,10 +785,17 @@ viewTree model tree =
         Timeout step ->
             viewTree model step
 
+        Conditional step ->
+            viewTree model step
+
         Aggregate steps ->
             Html.div [ class "aggregate" ]
                 (Array.toList <| Array.map (viewSeq model) steps)
 
+        Across vars values steps ->
+            Html.div [ class "across" ]
+                (List.map2 (viewAcross model vars) (Array.toList values) (Array.toList steps))
+
         Do steps ->
             Html.div [ class "do" ]
                 (Array.toList <| Array.map (viewSeq model) steps)
@@ -695,6 +829,18 @@ viewSeq model tree =
     Html.div [ class "seq" ] [ viewTree model tree ]
 
 
+viewAcross : Model -> List String -> List String -> StepTree -> Html Msg
+viewAcross model vars values tree =
+    Html.div [ class "seq" ]
+        [ Html.div [ class "across-values" ]
+            [ Html.text <|
+                String.join ", " <|
+                    List.map2 (\var value -> var ++ ": " ++ value) vars values
+            ]
+        , viewTree model tree
+        ]
+
+
 viewHooked : String -> Model -> StepTree -> StepTree -> Html Msg
 viewHooked name model step hook =
     Html.div [ class "hooked" ]
@@ -706,8 +852,8 @@ viewHooked name model step hook =
 
 
 isActive : StepState -> Bool
-isActive =
-    (/=) StepStatePending
+isActive state =
+    state /= StepStatePending && state /= StepStateSkipped
 
 
 autoExpanded : StepState -> Bool
@@ -873,6 +1019,13 @@ viewStepState state buildFinished =
                 ]
                 []
 
+        StepStateSkipped ->
+            Html.i
+                [ attribute "data-step-state" "skipped"
+                , class "right fa fa-fw fa-step-forward"
+                ]
+                []
+
 
 showHighlight : Highlight -> String
 showHighlight hl =
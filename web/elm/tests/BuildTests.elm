@@ -63,6 +63,7 @@ all =
                     , finishedAt = Nothing
                     }
                 , reapTime = Nothing
+                , rerunOf = Nothing
                 }
 
             startedBuild : Concourse.Build
@@ -81,6 +82,7 @@ all =
                     , finishedAt = Nothing
                     }
                 , reapTime = Nothing
+                , rerunOf = Nothing
                 }
 
             fetchBuild : Build.Model -> ( Build.Model, List Effects.Effect )
@@ -2882,6 +2882,7 @@ givenPipelineWithJob user =
                     , status = Concourse.BuildStatusSucceeded
                     , duration = { startedAt = Nothing, finishedAt = Nothing }
                     , reapTime = Nothing
+                    , rerunOf = Nothing
                     }
           , transitionBuild = Nothing
           , paused = False
@@ -3007,6 +3008,7 @@ running job =
                     , finishedAt = Nothing
                     }
                 , reapTime = Nothing
+                , rerunOf = Nothing
                 }
     }
 
@@ -3047,6 +3049,7 @@ jobWithNameTransitionedAt jobName transitionedAt status =
                 , finishedAt = Nothing
                 }
             , reapTime = Nothing
+            , rerunOf = Nothing
             }
     , transitionBuild =
         transitionedAt
@@ -3066,6 +3069,7 @@ jobWithNameTransitionedAt jobName transitionedAt status =
                         , finishedAt = Just <| Date.fromTime t
                         }
                     , reapTime = Nothing
+                    , rerunOf = Nothing
                     }
                 )
     , paused = False
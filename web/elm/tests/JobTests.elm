@@ -53,6 +53,7 @@ all =
                         , finishedAt = Just (Date.fromTime 0)
                         }
                     , reapTime = Just (Date.fromTime 0)
+                    , rerunOf = Nothing
                     }
 
                 someJob : Concourse.Job
@@ -203,6 +204,7 @@ all =
                                                         , finishedAt = Nothing
                                                         }
                                                   , reapTime = Nothing
+                                                  , rerunOf = Nothing
                                                   }
                                                 ]
                                         in
@@ -483,6 +485,7 @@ all =
                                                     , finishedAt = Nothing
                                                     }
                                               , reapTime = Nothing
+                                              , rerunOf = Nothing
                                               }
                                             ]
                                     in
@@ -550,6 +553,7 @@ all =
                                                     , finishedAt = Nothing
                                                     }
                                               , reapTime = Nothing
+                                              , rerunOf = Nothing
                                               }
                                             ]
                                     in
@@ -656,6 +660,7 @@ all =
                                                     , finishedAt = Nothing
                                                     }
                                               , reapTime = Nothing
+                                              , rerunOf = Nothing
                                               }
                                             ]
                                     in
@@ -747,6 +752,7 @@ all =
                                     , finishedAt = Nothing
                                     }
                               , reapTime = Nothing
+                              , rerunOf = Nothing
                               }
                             ]
 
@@ -160,6 +160,7 @@ all =
                                         , finishedAt = Nothing
                                         }
                                   , reapTime = Nothing
+                                  , rerunOf = Nothing
                                   }
                                 ]
                             )
@@ -194,6 +195,7 @@ all =
                                         , finishedAt = Nothing
                                         }
                                   , reapTime = Nothing
+                                  , rerunOf = Nothing
                                   }
                                 ]
                             )
@@ -26,6 +26,11 @@ all =
         , initEnsure
         , initTry
         , initTimeout
+        , initConditional
+        , initAcross
+        , initSetPipeline
+        , initLoadVar
+        , initApproval
         ]
 
 
@@ -79,6 +84,84 @@ initTask =
             ]
 
 
+initSetPipeline : Test
+initSetPipeline =
+    let
+        { tree, foci, finished } =
+            StepTree.init StepTree.HighlightNothing
+                emptyResources
+                { id = "some-id"
+                , step = BuildStepSetPipeline "some-pipeline"
+                }
+    in
+        describe "init with SetPipeline"
+            [ test "the tree" <|
+                \_ ->
+                    Expect.equal
+                        (StepTree.SetPipeline (someStep "some-id" "some-pipeline" StepTree.StepStatePending))
+                        tree
+            , test "using the focus" <|
+                \_ ->
+                    assertFocus "some-id"
+                        foci
+                        tree
+                        (\s -> { s | state = StepTree.StepStateSucceeded })
+                        (StepTree.SetPipeline (someStep "some-id" "some-pipeline" StepTree.StepStateSucceeded))
+            ]
+
+
+initLoadVar : Test
+initLoadVar =
+    let
+        { tree, foci, finished } =
+            StepTree.init StepTree.HighlightNothing
+                emptyResources
+                { id = "some-id"
+                , step = BuildStepLoadVar "some-var"
+                }
+    in
+        describe "init with LoadVar"
+            [ test "the tree" <|
+                \_ ->
+                    Expect.equal
+                        (StepTree.LoadVar (someStep "some-id" "some-var" StepTree.StepStatePending))
+                        tree
+            , test "using the focus" <|
+                \_ ->
+                    assertFocus "some-id"
+                        foci
+                        tree
+                        (\s -> { s | state = StepTree.StepStateSucceeded })
+                        (StepTree.LoadVar (someStep "some-id" "some-var" StepTree.StepStateSucceeded))
+            ]
+
+
+initApproval : Test
+initApproval =
+    let
+        { tree, foci, finished } =
+            StepTree.init StepTree.HighlightNothing
+                emptyResources
+                { id = "some-id"
+                , step = BuildStepApproval "ship-it"
+                }
+    in
+        describe "init with Approval"
+            [ test "the tree" <|
+                \_ ->
+                    Expect.equal
+                        (StepTree.Approval (someStep "some-id" "ship-it" StepTree.StepStatePending))
+                        tree
+            , test "using the focus" <|
+                \_ ->
+                    assertFocus "some-id"
+                        foci
+                        tree
+                        (\s -> { s | state = StepTree.StepStateSucceeded })
+                        (StepTree.Approval (someStep "some-id" "ship-it" StepTree.StepStateSucceeded))
+            ]
+
+
 initGet : Test
 initGet =
     let
@@ -478,6 +561,98 @@ initTimeout =
             ]
 
 
+initConditional : Test
+initConditional =
+    let
+        { tree, foci, finished } =
+            StepTree.init StepTree.HighlightNothing
+                emptyResources
+                { id = "conditional-id"
+                , step =
+                    BuildStepConditional { id = "task-a-id", step = BuildStepTask "task-a" }
+                }
+    in
+        describe "init with Conditional"
+            [ test "the tree" <|
+                \_ ->
+                    Expect.equal
+                        (StepTree.Conditional <|
+                            StepTree.Task (someStep "task-a-id" "task-a" StepTree.StepStatePending)
+                        )
+                        tree
+            , test "updating a step via the focus" <|
+                \_ ->
+                    assertFocus "task-a-id"
+                        foci
+                        tree
+                        (\s -> { s | state = StepTree.StepStateSucceeded })
+                        (StepTree.Conditional <|
+                            StepTree.Task (someStep "task-a-id" "task-a" StepTree.StepStateSucceeded)
+                        )
+            , test "skipping every step via the conditional's focus" <|
+                \_ ->
+                    Expect.equal
+                        (StepTree.Conditional <|
+                            StepTree.Task (someStep "task-a-id" "task-a" StepTree.StepStateSkipped)
+                        )
+                        (case Dict.get "conditional-id" foci of
+                            Nothing ->
+                                Debug.crash "no focus for conditional-id"
+
+                            Just focus ->
+                                Focus.update focus (StepTree.mapAll (\s -> { s | state = StepTree.StepStateSkipped })) tree
+                        )
+            ]
+
+
+initAcross : Test
+initAcross =
+    let
+        { tree, foci, finished } =
+            StepTree.init StepTree.HighlightNothing
+                emptyResources
+                { id = "across-id"
+                , step =
+                    BuildStepAcross
+                        { vars = [ "go" ]
+                        , steps =
+                            Array.fromList
+                                [ { step = { id = "task-a-id", step = BuildStepTask "task-a" }, values = [ "1.11" ] }
+                                , { step = { id = "task-b-id", step = BuildStepTask "task-b" }, values = [ "1.12" ] }
+                                ]
+                        }
+                }
+    in
+        describe "init with Across"
+            [ test "the tree" <|
+                \_ ->
+                    Expect.equal
+                        (StepTree.Across [ "go" ]
+                            (Array.fromList [ [ "1.11" ], [ "1.12" ] ])
+                            (Array.fromList
+                                [ StepTree.Task (someStep "task-a-id" "task-a" StepTree.StepStatePending)
+                                , StepTree.Task (someStep "task-b-id" "task-b" StepTree.StepStatePending)
+                                ]
+                            )
+                        )
+                        tree
+            , test "updating a step via the focus" <|
+                \_ ->
+                    assertFocus "task-b-id"
+                        foci
+                        tree
+                        (\s -> { s | state = StepTree.StepStateFailed })
+                        (StepTree.Across [ "go" ]
+                            (Array.fromList [ [ "1.11" ], [ "1.12" ] ])
+                            (Array.fromList
+                                [ StepTree.Task (someStep "task-a-id" "task-a" StepTree.StepStatePending)
+                                , StepTree.Task (someStep "task-b-id" "task-b" StepTree.StepStateFailed)
+                                ]
+                            )
+                        )
+            ]
+
+
 updateStep : (StepTree.Step -> StepTree.Step) -> StepTree.StepTree -> StepTree.StepTree
 updateStep f tree =
     case tree of